@@ -0,0 +1,70 @@
+// Backfill это инструмент для заполнения вычисляемых данных уже существующих песен:
+// языка текста и статистики текста.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/repositories/postgresql"
+	"github.com/sedonn/song-library-service/internal/services/song"
+)
+
+// configPath должен содержать путь к файлу конфигурации (.yaml).
+var configPath = flag.String("config_path", "", "Path to the .yaml config file.")
+
+// batchSize содержит количество песен, обрабатываемых за одну итерацию.
+//
+// По умолчанию: 500.
+var batchSize = flag.Int("batch_size", 500, "Number of songs processed per batch.")
+
+func main() {
+	flag.Parse()
+	if *configPath == "" {
+		panic("config_path is empty: " + *configPath)
+	}
+	if *batchSize <= 0 {
+		panic("batch_size must be positive")
+	}
+
+	cfg := config.MustLoadByPath(*configPath)
+	log := logger.New(cfg.Env)
+
+	repository, err := postgresql.New(cfg)
+	if err != nil {
+		panic(err)
+	}
+	log.Info("database connected", slog.String("database", cfg.DB.Database))
+
+	ctx := context.Background()
+
+	var lastID, processed uint64
+	for {
+		songs, err := repository.SongsAfter(ctx, lastID, *batchSize)
+		if err != nil {
+			panic("failed to get songs: " + err.Error())
+		}
+		if len(songs) == 0 {
+			break
+		}
+
+		for _, s := range songs {
+			song.AnalyzeLyrics(&s)
+
+			if err := repository.UpdateSongLyricsStats(ctx, s); err != nil {
+				panic("failed to update song lyrics stats: " + err.Error())
+			}
+
+			lastID = s.ID
+			processed++
+		}
+
+		log.Info("batch processed", slog.Uint64("last_id", lastID), slog.Uint64("processed", processed))
+	}
+
+	log.Info("backfill finished", slog.Uint64("processed", processed))
+}
@@ -0,0 +1,88 @@
+// Musicinfo-stub это локальная заглушка внешнего сервиса информации о песнях,
+// которая реализует контракт из internal/clients/musicinfo/openapi.yaml.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/sedonn/song-library-service/internal/clients/musicinfo"
+	"github.com/sedonn/song-library-service/internal/clients/musicinfo/musicinfotest"
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+)
+
+// port содержит порт, на котором запускается заглушка.
+//
+// По умолчанию: 8082.
+var port = flag.Int("port", 8082, "Port of the stub server.")
+
+// songs содержит песни, о которых известно заглушке.
+var songs = []musicinfotest.Song{
+	{
+		Artist: "Muse",
+		Title:  "Supermassive Black Hole",
+		SongInfoResponse: musicinfo.SongInfoResponse{
+			ReleaseDate: "2006-07-16",
+			Lyrics:      "Ooh baby, don't you know I suffer?\nOoh baby, can you hear me moan?",
+			Link:        "https://www.youtube.com/watch?v=Xsp3_a-PMTw",
+		},
+	},
+	{
+		Artist: "The Beatles",
+		Title:  "Yesterday",
+		SongInfoResponse: musicinfo.SongInfoResponse{
+			ReleaseDate: "1965-08-06",
+			Lyrics:      "Yesterday\nAll my troubles seemed so far away",
+			Link:        "https://www.youtube.com/watch?v=NrgmdOz227I",
+		},
+	},
+	{
+		Artist: "Кино",
+		Title:  "Группа крови",
+		SongInfoResponse: musicinfo.SongInfoResponse{
+			ReleaseDate: "1988-01-05",
+			Lyrics:      "Теплое место, но улицы ждут\nОтпечатков наших ног",
+		},
+	},
+}
+
+func main() {
+	flag.Parse()
+
+	log := logger.New(config.EnvLocal)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", *port),
+		Handler:           musicinfotest.NewHandler(songs...),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		log.Info("music info stub started", slog.String("addr", server.Addr))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			panic(err)
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
+	<-stop
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		log.Error("failed to stop music info stub", slog.String("error", err.Error()))
+	}
+	log.Info("music info stub stopped")
+}
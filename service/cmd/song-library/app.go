@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -11,9 +12,21 @@ import (
 	"github.com/sedonn/song-library-service/internal/pkg/logger"
 )
 
-//	@title			Song-library-service
-//	@description	Микросервис библиотеки песен.
-//	@BasePath		/api/v1
+// main запускает микросервис библиотеки песен.
+//
+//	@title						Song-library-service
+//	@description				Микросервис библиотеки песен.
+//	@BasePath					/api/v1
+//
+//	@securityDefinitions.apikey	ApiKeyAuth
+//	@in							header
+//	@name						X-API-Key
+//	@description				Статический API-ключ из конфигурации или базы данных.
+//
+//	@securityDefinitions.apikey	BearerAuth
+//	@in							header
+//	@name						Authorization
+//	@description				JWT в формате "Bearer <token>".
 func main() {
 	cfg := config.MustLoad()
 
@@ -22,10 +35,22 @@ func main() {
 
 	application := app.New(log, cfg)
 	go application.RESTApp.MustRun()
+	go application.MetricsApp.MustRun()
+	go application.GRPCApp.MustRun()
+	application.WorkerApp.Run()
+	application.SchedulerApp.Run()
 
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
 	<-stop
 
 	application.RESTApp.Stop()
+	application.MetricsApp.Stop()
+	application.GRPCApp.Stop()
+	application.SchedulerApp.Stop()
+	application.WorkerApp.Stop()
+
+	if err := application.Tracing.Shutdown(context.Background()); err != nil {
+		log.Error("failed to shutdown tracing", logger.ErrorString(err))
+	}
 }
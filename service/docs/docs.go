@@ -15,9 +15,94 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/artists/": {
+        "/albums/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Поиск альбомов по подстроке названия, подстроке названия или псевдонима исполнителя и типу альбома.\nАльбомы возвращаются без списков композиций и упорядочены по названию.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "album"
+                ],
+                "summary": "Поиск альбомов.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ArtistName ищет по подстроке названия или любого псевдонима исполнителя альбома.",
+                        "name": "artistName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "album",
+                            "single",
+                            "ep",
+                            "compilation"
+                        ],
+                        "type": "string",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/albumrest.SearchAlbumsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Добавить нового исполнителя. Название исполнителя должно быть уникальным.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавить новый альбом вместе со списком композиций. Номер композиции должен быть уникальным в пределах диска.",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,17 +110,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "artist"
+                    "album"
                 ],
-                "summary": "Добавить нового исполнителя.",
+                "summary": "Добавить новый альбом.",
                 "parameters": [
                     {
-                        "description": "Данные нового исполнителя",
-                        "name": "artist",
+                        "description": "Данные нового альбома",
+                        "name": "album",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/artistrest.CreateArtistRequest"
+                            "$ref": "#/definitions/albumrest.CreateAlbumRequest"
                         }
                     }
                 ],
@@ -43,27 +128,59 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/artistrest.CreateArtistResponse"
+                            "$ref": "#/definitions/albumrest.CreateAlbumResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     }
                 }
             }
         },
-        "/artists/{artist-id}": {
+        "/albums/{album-id}": {
             "get": {
-                "description": "Получить данные определенного исполнителя.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получить данные определенного альбома вместе со списком композиций, упорядоченным по номеру диска и номеру композиции.",
                 "consumes": [
                     "application/json"
                 ],
@@ -71,13 +188,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "artist"
+                    "album"
                 ],
-                "summary": "Получить данные определенного исполнителяяяя.",
+                "summary": "Получить данные определенного альбома.",
                 "parameters": [
                     {
                         "type": "integer",
-                        "name": "artist-id",
+                        "name": "album-id",
                         "in": "path",
                         "required": true
                     }
@@ -86,31 +203,51 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/artistrest.GetArtistResponse"
+                            "$ref": "#/definitions/albumrest.GetAlbumResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "Удалить данные исполнителя.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удалить данные альбома. Песни альбома не удаляются.",
                 "consumes": [
                     "application/json"
                 ],
@@ -118,13 +255,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "artist"
+                    "album"
                 ],
-                "summary": "Удалить данные исполнителя.",
+                "summary": "Удалить данные альбома.",
                 "parameters": [
                     {
                         "type": "integer",
-                        "name": "artist-id",
+                        "name": "album-id",
                         "in": "path",
                         "required": true
                     }
@@ -133,31 +270,51 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/artistrest.RemoveArtistResponse"
+                            "$ref": "#/definitions/albumrest.RemoveAlbumResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     }
                 }
             },
             "patch": {
-                "description": "Изменить данные исполнителя.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Изменить данные альбома. Переданный список композиций полностью заменяет текущий.",
                 "consumes": [
                     "application/json"
                 ],
@@ -165,23 +322,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "artist"
+                    "album"
                 ],
-                "summary": "Изменить данные исполнителя.",
+                "summary": "Изменить данные альбома.",
                 "parameters": [
                     {
                         "type": "integer",
-                        "name": "artist-id",
+                        "name": "album-id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Новые данные исполнителя",
-                        "name": "artist",
+                        "description": "Новые данные альбома",
+                        "name": "album",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/artistrest.ChangeArtistRequestBody"
+                            "$ref": "#/definitions/albumrest.ChangeAlbumRequestBody"
                         }
                     }
                 ],
@@ -189,33 +346,59 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/artistrest.ChangeArtistResponse"
+                            "$ref": "#/definitions/albumrest.ChangeAlbumResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     }
                 }
             }
         },
-        "/songs/": {
+        "/artists/": {
             "get": {
-                "description": "Поиск определенной песни по всем атрибутам.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Поиск исполнителей по подстроке названия или псевдонима, стране и типу исполнителя.\nИсполнители упорядочены по названию для сортировки.",
                 "consumes": [
                     "application/json"
                 ],
@@ -223,23 +406,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "song"
+                    "artist"
                 ],
-                "summary": "Поиск определенной песни.",
+                "summary": "Поиск исполнителей.",
                 "parameters": [
                     {
                         "type": "string",
-                        "name": "artistName",
+                        "name": "country",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "link",
+                        "description": "Name ищет по подстроке названия или любого псевдонима исполнителя.",
+                        "name": "name",
                         "in": "query"
                     },
                     {
+                        "enum": [
+                            "person",
+                            "group"
+                        ],
                         "type": "string",
-                        "name": "name",
+                        "name": "type",
                         "in": "query"
                     }
                 ],
@@ -247,25 +435,45 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/songrest.SearchSongsResponse"
+                            "$ref": "#/definitions/artistrest.SearchArtistsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     }
                 }
             },
             "post": {
-                "description": "Добавление новой песни. Для разделения куплетов необходимо использовать '\\n\\n'.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавить нового исполнителя. Название исполнителя должно быть уникальным без учета регистра и лишних пробелов среди названий и псевдонимов исполнителей.\nЕсли название для сортировки не задано, то артикль в начале названия переносится в конец: \"The Beatles\" - \"Beatles, The\".\nГод распада не может быть раньше года основания.\nКоды ISNI и MBID проверяются по формату, ISNI - также по контрольной сумме. Оба кода должны быть уникальными.",
                 "consumes": [
                     "application/json"
                 ],
@@ -273,17 +481,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "song"
+                    "artist"
                 ],
-                "summary": "Добавить новую песню.",
+                "summary": "Добавить нового исполнителя.",
                 "parameters": [
                     {
-                        "description": "Данные новой песни",
-                        "name": "song",
+                        "description": "Данные нового исполнителя",
+                        "name": "artist",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/songrest.CreateSongRequest"
+                            "$ref": "#/definitions/artistrest.CreateArtistRequest"
                         }
                     }
                 ],
@@ -291,33 +499,53 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/songrest.CreateSongResponse"
+                            "$ref": "#/definitions/artistrest.CreateArtistResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     }
                 }
             }
         },
-        "/songs/{song-id}": {
-            "delete": {
-                "description": "Удалить данные песни.",
+        "/artists/by-isni/{isni}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Найти исполнителя по коду ISNI. Код может содержать пробелы, например 0000 0001 2281 955X.",
                 "consumes": [
                     "application/json"
                 ],
@@ -325,13 +553,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "song"
+                    "artist"
                 ],
-                "summary": "Удалить данные песни.",
+                "summary": "Найти исполнителя по ISNI.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "song-id",
+                        "type": "string",
+                        "description": "Код ISNI",
+                        "name": "isni",
                         "in": "path",
                         "required": true
                     }
@@ -340,31 +569,53 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/songrest.RemoveSongResponse"
+                            "$ref": "#/definitions/artistrest.GetArtistResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     }
                 }
-            },
-            "patch": {
-                "description": "Изменить данные песни. Для разделения куплетов необходимо использовать '\\n\\n'.",
+            }
+        },
+        "/artists/by-mbid/{mbid}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Найти исполнителя по идентификатору MusicBrainz (UUID).",
                 "consumes": [
                     "application/json"
                 ],
@@ -372,57 +623,69 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "song"
+                    "artist"
                 ],
-                "summary": "Изменить данные песни.",
+                "summary": "Найти исполнителя по MusicBrainz ID.",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "name": "song-id",
+                        "type": "string",
+                        "description": "MusicBrainz ID",
+                        "name": "mbid",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Новые данные песни",
-                        "name": "song",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/songrest.ChangeSongRequestBody"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/songrest.ChangeSongResponse"
+                            "$ref": "#/definitions/artistrest.GetArtistResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
+                            "$ref": "#/definitions/mwerror.Problem"
                         }
                     }
                 }
             }
         },
-        "/songs/{song-id}/couplets": {
+        "/artists/{artist-id}": {
             "get": {
-                "description": "Получить данные определенной песни с пагинацией по куплетами.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получить данные определенного исполнителя.",
                 "consumes": [
                     "application/json"
                 ],
@@ -430,359 +693,5775 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "song"
+                    "artist"
                 ],
-                "summary": "Получить данные определенной песни.",
+                "summary": "Получить данные определенного исполнителяяяя.",
                 "parameters": [
                     {
                         "type": "integer",
-                        "name": "song-id",
+                        "name": "artist-id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/artistrest.GetArtistResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
                     },
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "pageNumber",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 10,
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удалить данные исполнителя.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "artist"
+                ],
+                "summary": "Удалить данные исполнителя.",
+                "parameters": [
+                    {
                         "type": "integer",
-                        "name": "pageSize",
-                        "in": "query"
+                        "name": "artist-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/artistrest.RemoveArtistResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Изменить данные исполнителя. Переданные поля заменяют текущие значения, список ссылок заменяется полностью.\nЕсли название изменяется без названия для сортировки, то название для сортировки строится заново.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "artist"
+                ],
+                "summary": "Изменить данные исполнителя.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "artist-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новые данные исполнителя",
+                        "name": "artist",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/artistrest.ChangeArtistRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/artistrest.ChangeArtistResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{artist-id}/aliases": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получить альтернативные названия исполнителя, по которым он находится при поиске песен.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "artist"
+                ],
+                "summary": "Получить псевдонимы исполнителя.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "artist-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/artistrest.GetArtistAliasesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавить альтернативное название исполнителя. Псевдоним должен быть уникальным без учета регистра и лишних пробелов среди названий и псевдонимов исполнителей.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "artist"
+                ],
+                "summary": "Добавить псевдоним исполнителя.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "artist-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Псевдоним исполнителя",
+                        "name": "alias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/artistrest.AddArtistAliasRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/artistrest.AddArtistAliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{artist-id}/aliases/{alias-id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удалить альтернативное название исполнителя.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "artist"
+                ],
+                "summary": "Удалить псевдоним исполнителя.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID исполнителя",
+                        "name": "artist-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID псевдонима",
+                        "name": "alias-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/artistrest.RemoveArtistAliasResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/artists/{artist-id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Перенести песни, альбомы, участие в создании песен и псевдонимы исполнителя-дубликата на исполнителя и удалить дубликат. Название дубликата становится псевдонимом исполнителя. Данные самого исполнителя не изменяются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "artist"
+                ],
+                "summary": "Слить исполнителя-дубликата с исполнителем.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "artist-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Исполнитель-дубликат",
+                        "name": "duplicate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/artistrest.MergeArtistsRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/artistrest.MergeArtistsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/duplicates/artists": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Найти исполнителей с одинаковым названием без учета регистра, знаков препинания, пробелов и артикля the в начале. Пагинация выполняется по группам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "duplicate"
+                ],
+                "summary": "Найти дубликаты исполнителей.",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "pageNumber",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 10,
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/duplicaterest.FindDuplicateArtistsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/duplicates/songs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Найти песни одного исполнителя с одинаковыми названиями или похожими текстами. Названия песен и исполнителей сравниваются без учета регистра, знаков препинания, пробелов и артикля the в начале. Тексты считаются похожими при сходстве от 0.8. Для каждой песни группы указано сходство текста с текстом первой песни группы от 0 до 1. Пагинация выполняется по группам.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "duplicate"
+                ],
+                "summary": "Найти дубликаты песен.",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "pageNumber",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 10,
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/duplicaterest.FindDuplicateSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получить все жанры или все свободные метки, упорядоченные по названию.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tag"
+                ],
+                "summary": "Получить словарь жанров или меток.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.GetTagsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавить новый жанр или метку. Название должно быть уникальным в пределах словаря.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tag"
+                ],
+                "summary": "Добавить новый жанр или метку.",
+                "parameters": [
+                    {
+                        "description": "Данные новой метки",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.CreateTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.CreateTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/genres/{tag-id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удалить жанр или метку. Метка также отвязывается от всех песен.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tag"
+                ],
+                "summary": "Удалить жанр или метку.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "tag-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.RemoveTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переименовать жанр или метку. Привязки к песням сохраняются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tag"
+                ],
+                "summary": "Переименовать жанр или метку.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "tag-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новые данные метки",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.ChangeTagRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.ChangeTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Поиск фоновых задач по песне, виду и статусу задачи. Новые задачи идут первыми.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "job"
+                ],
+                "summary": "Поиск фоновых задач.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "songId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "running",
+                            "done",
+                            "dead"
+                        ],
+                        "type": "string",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "enrich_song",
+                            "validate_link"
+                        ],
+                        "type": "string",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jobrest.SearchJobsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/jobs/{job-id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получить статус фоновой задачи. Ожидающая задача (pending) выполняется в runAt, неудачные попытки повторяются с экспоненциальной паузой.\nЗадача со статусом dead не выполнена за допустимое количество попыток, причина указана в lastError.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "job"
+                ],
+                "summary": "Получить фоновую задачу.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID задачи",
+                        "name": "job-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/jobrest.GetJobResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Поиск плейлистов по подстроке названия.\nПлейлисты возвращаются без песен и упорядочены по названию.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Поиск плейлистов.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Name ищет по подстроке названия плейлиста.",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.SearchPlaylistsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавить новый пустой плейлист.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Добавить новый плейлист.",
+                "parameters": [
+                    {
+                        "description": "Данные нового плейлиста",
+                        "name": "playlist",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.CreatePlaylistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.CreatePlaylistResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Создать плейлист из файла в формате M3U8, XSPF или JSPF, переданного в теле запроса.\nЗаписи файла сопоставляются с песнями библиотеки по ссылке или по названию песни и исполнителя.\nНесопоставленные записи возвращаются в ответе. Размер файла не должен превышать 1 МБ.",
+                "consumes": [
+                    "text/plain"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Импорт плейлиста.",
+                "parameters": [
+                    {
+                        "enum": [
+                            "m3u8",
+                            "xspf",
+                            "jspf"
+                        ],
+                        "type": "string",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maxLength": 130,
+                        "type": "string",
+                        "description": "Name это название нового плейлиста. По умолчанию используется название из файла.",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Файл плейлиста",
+                        "name": "playlist",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.ImportPlaylistResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{playlist-id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получить название и описание определенного плейлиста.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Получить данные определенного плейлиста.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "playlist-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.GetPlaylistResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удалить плейлист. Песни плейлиста не удаляются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Удалить плейлист.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "playlist-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.RemovePlaylistResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Изменить название или описание плейлиста.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Изменить данные плейлиста.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "playlist-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новые данные плейлиста",
+                        "name": "playlist",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.ChangePlaylistRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.ChangePlaylistResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{playlist-id}/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Экспорт песен плейлиста в порядке их следования в формате M3U8, XSPF или JSPF.\nСсылка песни используется как расположение записи плейлиста.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.apple.mpegurl",
+                    "application/xspf+xml",
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Экспорт плейлиста.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "playlist-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "m3u8",
+                            "xspf",
+                            "jspf"
+                        ],
+                        "type": "string",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{playlist-id}/songs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получить песни определенного плейлиста в порядке их следования с пагинацией.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Получить песни плейлиста.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "playlist-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "pageNumber",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 10,
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.GetPlaylistSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавить песню в определенную позицию плейлиста, начиная с 1. Без позиции песня добавляется в конец плейлиста.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Добавить песню в плейлист.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "playlist-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Песня и ее позиция",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.AddPlaylistSongRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.AddPlaylistSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{playlist-id}/songs/{song-id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удалить песню из плейлиста. Порядок остальных песен не изменяется.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Удалить песню из плейлиста.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID плейлиста",
+                        "name": "playlist-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID песни",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.RemovePlaylistSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переместить песню в определенную позицию плейлиста, начиная с 1. Позиция больше количества песен перемещает песню в конец плейлиста.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlist"
+                ],
+                "summary": "Переместить песню плейлиста.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID плейлиста",
+                        "name": "playlist-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID песни",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новая позиция песни",
+                        "name": "position",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.MovePlaylistSongRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/playlistrest.MovePlaylistSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Поиск определенной песни по всем атрибутам.\nПри facets=true ответ содержит количество найденных песен по каждому жанру и каждой метке.\nФильтр linkStatus отбирает песни по результату последней проверки ссылки.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Поиск определенной песни.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "artistName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CreditedArtistName ищет по основному исполнителю или любому участнику создания песни.",
+                        "name": "creditedArtistName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Facets включает в ответ количество найденных песен по каждому жанру и каждой метке.",
+                        "name": "facets",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Genres и Tags ищут песни, у которых есть все указанные жанры и метки.",
+                        "name": "genre",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 8,
+                        "type": "string",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "link",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ok",
+                            "broken",
+                            "unchecked"
+                        ],
+                        "type": "string",
+                        "description": "LinkStatus ищет песни со ссылками по результату последней проверки доступности ссылки.",
+                        "name": "linkStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.SearchSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавление новой песни. Для разделения куплетов необходимо использовать '\\n\\n'.\nПоле artist задает основного исполнителя, credits - остальных участников создания песни.\nКоды ISRC и ISWC проверяются по формату и контрольной сумме, ISRC должен быть уникальным.\nЕсли дата выхода, текст или ссылка не указаны, то они заполняются из внешнего сервиса информации о песнях в фоне.\nСсылка проверяется на доступность в фоне. Статусы фоновых задач песни доступны в /jobs/?songId={id}.\nСсылка приводится к каноническому виду без параметров отслеживания, поддерживаются схемы http и https.\nСсылка на запись известного музыкального сервиса (YouTube, Spotify, Apple Music, Deezer, SoundCloud,\nЯндекс Музыка) не может быть у нескольких песен.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Добавить новую песню.",
+                "parameters": [
+                    {
+                        "description": "Данные новой песни",
+                        "name": "song",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/songrest.CreateSongRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.CreateSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/by-isrc/{isrc}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Найти песню по коду записи ISRC. Код может содержать дефисы и быть в любом регистре.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Найти песню по ISRC.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Код ISRC",
+                        "name": "isrc",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.GetSongByISRCResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/by-iswc/{iswc}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Найти все песни, которые являются записями произведения с кодом ISWC, упорядоченные по дате выхода.\nКод может содержать разделители, например T-034.524.680-1.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Найти записи произведения по ISWC.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Код ISWC",
+                        "name": "iswc",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.GetSongsByISWCResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Экспорт песен, найденных по тем же параметрам, что и в поиске, как плейлиста в формате M3U8, XSPF или JSPF.\nСсылка песни используется как расположение записи плейлиста. Экспортируются не более 10000 первых песен.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/vnd.apple.mpegurl",
+                    "application/xspf+xml",
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Экспорт найденных песен.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "artistName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CreditedArtistName ищет по основному исполнителю или любому участнику создания песни.",
+                        "name": "creditedArtistName",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "m3u8",
+                            "xspf",
+                            "jspf"
+                        ],
+                        "type": "string",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "description": "Genres и Tags ищут песни, у которых есть все указанные жанры и метки.",
+                        "name": "genre",
+                        "in": "query"
+                    },
+                    {
+                        "maxLength": 8,
+                        "type": "string",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "link",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "ok",
+                            "broken",
+                            "unchecked"
+                        ],
+                        "type": "string",
+                        "description": "LinkStatus ищет песни со ссылками по результату последней проверки доступности ссылки.",
+                        "name": "linkStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "csv",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/link-report": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Количество песен со ссылками по статусу ссылки и песни с недоступными ссылками.\nСсылки проверяются периодически. Ссылка недоступна, если при последней проверке ответ не получен или имеет код статуса 4xx или 5xx.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Отчет о доступности ссылок песен.",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "pageNumber",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 10,
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.GetLinkReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{song-id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удалить данные песни.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Удалить данные песни.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.RemoveSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Изменить данные песни. Для разделения куплетов необходимо использовать '\\n\\n'.\nПереданный список credits полностью заменяет текущий список участников.\nНовая ссылка приводится к каноническому виду так же, как при добавлении песни.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Изменить данные песни.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новые данные песни",
+                        "name": "song",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/songrest.ChangeSongRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.ChangeSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{song-id}/couplets": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получить данные определенной песни с пагинацией по куплетами.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Получить данные определенной песни.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "pageNumber",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 10,
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.GetSongResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{song-id}/genres": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Полностью заменить жанры песни. Жанры должны существовать в словаре жанров. Пустой список отвязывает все жанры.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Изменить жанры песни.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новые жанры песни",
+                        "name": "genres",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/songrest.ChangeSongGenresRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.ChangeSongTagsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{song-id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Перенести участников, метки, места в альбомах и плейлистах и связи песни-дубликата на песню и удалить дубликат. Данные самой песни не изменяются. Если песня уже есть в альбоме или плейлисте, место дубликата удаляется.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Слить песню-дубликат с песней.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Песня-дубликат",
+                        "name": "duplicate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/songrest.MergeSongsRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.MergeSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{song-id}/related": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получить оригиналы песни и производные от нее песни: каверы, ремиксы, концертные версии и семплы. Песни сгруппированы по типу связи.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Получить связанные песни.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.GetRelatedSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{song-id}/relations": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Отметить песню как кавер, ремикс, концертную версию или семпл другой песни. Песня не может прямо или через другие песни оказаться оригиналом самой себя.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Связать песню с оригиналом.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Тип связи и оригинал",
+                        "name": "relation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/songrest.LinkSongsRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.LinkSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{song-id}/relations/{relation-type}/{original-id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удалить связь определенного типа между производной песней и оригиналом. Сами песни не удаляются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Удалить связь песни с оригиналом.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID производной песни",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "cover",
+                            "remix",
+                            "live",
+                            "sample"
+                        ],
+                        "type": "string",
+                        "description": "Тип связи",
+                        "name": "relation-type",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID оригинала",
+                        "name": "original-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.UnlinkSongsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/songs/{song-id}/tags": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Полностью заменить свободные метки песни. Отсутствующие метки создаются автоматически. Пустой список отвязывает все метки.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "song"
+                ],
+                "summary": "Изменить метки песни.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "song-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новые метки песни",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/songrest.ChangeSongTagsRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/songrest.ChangeSongTagsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Получить все жанры или все свободные метки, упорядоченные по названию.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tag"
+                ],
+                "summary": "Получить словарь жанров или меток.",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.GetTagsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Добавить новый жанр или метку. Название должно быть уникальным в пределах словаря.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tag"
+                ],
+                "summary": "Добавить новый жанр или метку.",
+                "parameters": [
+                    {
+                        "description": "Данные новой метки",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.CreateTagRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.CreateTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{tag-id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Удалить жанр или метку. Метка также отвязывается от всех песен.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tag"
+                ],
+                "summary": "Удалить жанр или метку.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "tag-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.RemoveTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Переименовать жанр или метку. Привязки к песням сохраняются.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tag"
+                ],
+                "summary": "Переименовать жанр или метку.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "tag-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новые данные метки",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.ChangeTagRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/tagrest.ChangeTagResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/mwerror.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "albumrest.ChangeAlbumRequestBody": {
+            "type": "object",
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistIDAPI"
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "tracks": {
+                    "description": "Tracks полностью заменяет список композиций альбома, если передан.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AlbumTrackAttributesAPI"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "album",
+                        "single",
+                        "ep",
+                        "compilation"
+                    ]
+                }
+            }
+        },
+        "albumrest.ChangeAlbumResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "releaseDate",
+                "title",
+                "type"
+            ],
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AlbumTrackAPI"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "album",
+                        "single",
+                        "ep",
+                        "compilation"
+                    ]
+                }
+            }
+        },
+        "albumrest.CreateAlbumRequest": {
+            "type": "object",
+            "required": [
+                "releaseDate",
+                "title",
+                "type"
+            ],
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistIDAPI"
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AlbumTrackAttributesAPI"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "album",
+                        "single",
+                        "ep",
+                        "compilation"
+                    ]
+                }
+            }
+        },
+        "albumrest.CreateAlbumResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "releaseDate",
+                "title",
+                "type"
+            ],
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AlbumTrackAPI"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "album",
+                        "single",
+                        "ep",
+                        "compilation"
+                    ]
+                }
+            }
+        },
+        "albumrest.GetAlbumResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "releaseDate",
+                "title",
+                "type"
+            ],
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AlbumTrackAPI"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "album",
+                        "single",
+                        "ep",
+                        "compilation"
+                    ]
+                }
+            }
+        },
+        "albumrest.RemoveAlbumResponse": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "albumrest.SearchAlbumsResponse": {
+            "type": "object",
+            "properties": {
+                "albums": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.AlbumAPI"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.PaginationMetadataAPI"
+                }
+            }
+        },
+        "artistrest.AddArtistAliasRequestBody": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                }
+            }
+        },
+        "artistrest.AddArtistAliasResponse": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                }
+            }
+        },
+        "artistrest.ChangeArtistRequestBody": {
+            "type": "object",
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "country": {
+                    "type": "string"
+                },
+                "disbandedYear": {
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "formedYear": {
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "isni": {
+                    "type": "string",
+                    "maxLength": 19
+                },
+                "links": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mbid": {
+                    "type": "string",
+                    "maxLength": 36
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "sortName": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "group"
+                    ]
+                }
+            }
+        },
+        "artistrest.ChangeArtistResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "country": {
+                    "type": "string"
+                },
+                "disbandedYear": {
+                    "description": "DisbandedYear это год распада группы или год смерти исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "formedYear": {
+                    "description": "FormedYear это год основания группы или год рождения исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isni": {
+                    "description": "ISNI и MBID (идентификатор MusicBrainz) могут содержать разделители и любой регистр, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 19
+                },
+                "links": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mbid": {
+                    "type": "string",
+                    "maxLength": 36
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "sortName": {
+                    "description": "SortName по умолчанию строится из названия переносом артикля в конец: \"The Beatles\" - \"Beatles, The\".",
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "group"
+                    ]
+                }
+            }
+        },
+        "artistrest.CreateArtistRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "country": {
+                    "type": "string"
+                },
+                "disbandedYear": {
+                    "description": "DisbandedYear это год распада группы или год смерти исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "formedYear": {
+                    "description": "FormedYear это год основания группы или год рождения исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "isni": {
+                    "description": "ISNI и MBID (идентификатор MusicBrainz) могут содержать разделители и любой регистр, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 19
+                },
+                "links": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mbid": {
+                    "type": "string",
+                    "maxLength": 36
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "sortName": {
+                    "description": "SortName по умолчанию строится из названия переносом артикля в конец: \"The Beatles\" - \"Beatles, The\".",
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "group"
+                    ]
+                }
+            }
+        },
+        "artistrest.CreateArtistResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "country": {
+                    "type": "string"
+                },
+                "disbandedYear": {
+                    "description": "DisbandedYear это год распада группы или год смерти исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "formedYear": {
+                    "description": "FormedYear это год основания группы или год рождения исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isni": {
+                    "description": "ISNI и MBID (идентификатор MusicBrainz) могут содержать разделители и любой регистр, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 19
+                },
+                "links": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mbid": {
+                    "type": "string",
+                    "maxLength": 36
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "sortName": {
+                    "description": "SortName по умолчанию строится из названия переносом артикля в конец: \"The Beatles\" - \"Beatles, The\".",
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "group"
+                    ]
+                }
+            }
+        },
+        "artistrest.GetArtistAliasesResponse": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ArtistAliasAPI"
+                    }
+                },
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistIDAPI"
+                }
+            }
+        },
+        "artistrest.GetArtistResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "country": {
+                    "type": "string"
+                },
+                "disbandedYear": {
+                    "description": "DisbandedYear это год распада группы или год смерти исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "formedYear": {
+                    "description": "FormedYear это год основания группы или год рождения исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isni": {
+                    "description": "ISNI и MBID (идентификатор MusicBrainz) могут содержать разделители и любой регистр, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 19
+                },
+                "links": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mbid": {
+                    "type": "string",
+                    "maxLength": 36
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "sortName": {
+                    "description": "SortName по умолчанию строится из названия переносом артикля в конец: \"The Beatles\" - \"Beatles, The\".",
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "group"
+                    ]
+                }
+            }
+        },
+        "artistrest.MergeArtistsRequestBody": {
+            "type": "object",
+            "properties": {
+                "duplicate": {
+                    "description": "Duplicate это исполнитель, который сливается с основным исполнителем и удаляется.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ArtistIDAPI"
+                        }
+                    ]
+                }
+            }
+        },
+        "artistrest.MergeArtistsResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "country": {
+                    "type": "string"
+                },
+                "disbandedYear": {
+                    "description": "DisbandedYear это год распада группы или год смерти исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "formedYear": {
+                    "description": "FormedYear это год основания группы или год рождения исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isni": {
+                    "description": "ISNI и MBID (идентификатор MusicBrainz) могут содержать разделители и любой регистр, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 19
+                },
+                "links": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mbid": {
+                    "type": "string",
+                    "maxLength": 36
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "sortName": {
+                    "description": "SortName по умолчанию строится из названия переносом артикля в конец: \"The Beatles\" - \"Beatles, The\".",
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "group"
+                    ]
+                }
+            }
+        },
+        "artistrest.RemoveArtistAliasResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "artistrest.RemoveArtistResponse": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "artistrest.SearchArtistsResponse": {
+            "type": "object",
+            "properties": {
+                "artists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ArtistAPI"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.PaginationMetadataAPI"
+                }
+            }
+        },
+        "duplicaterest.FindDuplicateArtistsResponse": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DuplicateArtistGroupAPI"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.PaginationMetadataAPI"
+                }
+            }
+        },
+        "duplicaterest.FindDuplicateSongsResponse": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DuplicateSongGroupAPI"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.PaginationMetadataAPI"
+                }
+            }
+        },
+        "jobrest.GetJobResponse": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "runAt": {
+                    "description": "RunAt это время следующей попытки ожидающей задачи.",
+                    "type": "string"
+                },
+                "song": {
+                    "$ref": "#/definitions/models.SongIDAPI"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "jobrest.SearchJobsResponse": {
+            "type": "object",
+            "properties": {
+                "jobs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.JobAPI"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.PaginationMetadataAPI"
+                }
+            }
+        },
+        "models.AlbumAPI": {
+            "type": "object",
+            "required": [
+                "id",
+                "releaseDate",
+                "title",
+                "type"
+            ],
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "album",
+                        "single",
+                        "ep",
+                        "compilation"
+                    ]
+                }
+            }
+        },
+        "models.AlbumTrackAPI": {
+            "type": "object",
+            "properties": {
+                "discNumber": {
+                    "type": "integer"
+                },
+                "song": {
+                    "$ref": "#/definitions/models.SongAPI"
+                },
+                "trackNumber": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.AlbumTrackAttributesAPI": {
+            "type": "object",
+            "required": [
+                "discNumber",
+                "trackNumber"
+            ],
+            "properties": {
+                "discNumber": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "song": {
+                    "$ref": "#/definitions/models.SongIDAPI"
+                },
+                "trackNumber": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "models.ArtistAPI": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "bio": {
+                    "type": "string",
+                    "maxLength": 10000
+                },
+                "country": {
+                    "type": "string"
+                },
+                "disbandedYear": {
+                    "description": "DisbandedYear это год распада группы или год смерти исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "formedYear": {
+                    "description": "FormedYear это год основания группы или год рождения исполнителя.",
+                    "type": "integer",
+                    "maximum": 9999,
+                    "minimum": 1000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isni": {
+                    "description": "ISNI и MBID (идентификатор MusicBrainz) могут содержать разделители и любой регистр, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 19
+                },
+                "links": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mbid": {
+                    "type": "string",
+                    "maxLength": 36
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "sortName": {
+                    "description": "SortName по умолчанию строится из названия переносом артикля в конец: \"The Beatles\" - \"Beatles, The\".",
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "group"
+                    ]
+                }
+            }
+        },
+        "models.ArtistAliasAPI": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                }
+            }
+        },
+        "models.ArtistIDAPI": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DuplicateArtistGroupAPI": {
+            "type": "object",
+            "properties": {
+                "artists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ArtistAPI"
+                    }
+                }
+            }
+        },
+        "models.DuplicateSongAPI": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongCreditAPI"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isrc": {
+                    "description": "ISRC это код записи, уникальный среди песен. ISWC это код произведения, общий для всех его записей.\nКоды могут содержать разделители, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "iswc": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "language": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "linkHealth": {
+                    "description": "LinkHealth это результат последней проверки доступности ссылки.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.LinkHealthAPI"
+                        }
+                    ]
+                },
+                "linkMediaId": {
+                    "type": "string"
+                },
+                "linkProvider": {
+                    "description": "LinkProvider и LinkMediaID заполняются, если ссылка указывает на запись известного музыкального сервиса.",
+                    "type": "string"
+                },
+                "lyricsSimilarity": {
+                    "description": "LyricsSimilarity это сходство текста песни с текстом первой песни группы от 0 до 1.",
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "releaseDate": {
+                    "description": "ReleaseDate, Text и Link новой песни можно не указывать: они заполняются из внешнего сервиса информации о песнях.",
+                    "type": "string"
+                },
+                "stats": {
+                    "$ref": "#/definitions/models.LyricsStatsAPI"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DuplicateSongGroupAPI": {
+            "type": "object",
+            "properties": {
+                "songs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DuplicateSongAPI"
+                    }
+                }
+            }
+        },
+        "models.FacetAPI": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.JobAPI": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "runAt": {
+                    "description": "RunAt это время следующей попытки ожидающей задачи.",
+                    "type": "string"
+                },
+                "song": {
+                    "$ref": "#/definitions/models.SongIDAPI"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.LinkHealthAPI": {
+            "type": "object",
+            "properties": {
+                "checkedAt": {
+                    "type": "string"
+                },
+                "failureStreak": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "statusCode": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.LinkStatsAPI": {
+            "type": "object",
+            "properties": {
+                "broken": {
+                    "type": "integer"
+                },
+                "ok": {
+                    "type": "integer"
+                },
+                "unchecked": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.LyricsStatsAPI": {
+            "type": "object",
+            "properties": {
+                "coupletCount": {
+                    "type": "integer"
+                },
+                "lineCount": {
+                    "type": "integer"
+                },
+                "readingTime": {
+                    "description": "ReadingTime примерное время чтения текста в секундах.",
+                    "type": "integer"
+                },
+                "uniqueWordRatio": {
+                    "type": "number"
+                },
+                "wordCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.Pagination": {
+            "type": "object",
+            "properties": {
+                "pageNumber": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "pageSize": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 10
+                }
+            }
+        },
+        "models.PaginationMetadataAPI": {
+            "type": "object",
+            "properties": {
+                "currentPageNumber": {
+                    "type": "integer"
+                },
+                "pageCount": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "recordCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PlaylistAPI": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                }
+            }
+        },
+        "models.PlaylistEntryAPI": {
+            "type": "object",
+            "properties": {
+                "creator": {
+                    "type": "string"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "position": {
+                    "description": "Position это позиция записи в файле, начиная с 1.",
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SongAPI": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongCreditAPI"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isrc": {
+                    "description": "ISRC это код записи, уникальный среди песен. ISWC это код произведения, общий для всех его записей.\nКоды могут содержать разделители, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "iswc": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "language": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "linkHealth": {
+                    "description": "LinkHealth это результат последней проверки доступности ссылки.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.LinkHealthAPI"
+                        }
+                    ]
+                },
+                "linkMediaId": {
+                    "type": "string"
+                },
+                "linkProvider": {
+                    "description": "LinkProvider и LinkMediaID заполняются, если ссылка указывает на запись известного музыкального сервиса.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "releaseDate": {
+                    "description": "ReleaseDate, Text и Link новой песни можно не указывать: они заполняются из внешнего сервиса информации о песнях.",
+                    "type": "string"
+                },
+                "stats": {
+                    "$ref": "#/definitions/models.LyricsStatsAPI"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SongCreditAPI": {
+            "type": "object",
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "role": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SongCreditAttributesAPI": {
+            "type": "object",
+            "required": [
+                "role"
+            ],
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistIDAPI"
+                },
+                "role": {
+                    "type": "string",
+                    "enum": [
+                        "featured",
+                        "producer",
+                        "composer",
+                        "lyricist"
+                    ]
+                }
+            }
+        },
+        "models.SongFacetsAPI": {
+            "type": "object",
+            "properties": {
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FacetAPI"
+                    }
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.FacetAPI"
+                    }
+                }
+            }
+        },
+        "models.SongIDAPI": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.TagAPI": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "mwerror.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "artist.id"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "id is a required field"
+                },
+                "param": {
+                    "type": "string"
+                },
+                "rule": {
+                    "type": "string",
+                    "example": "required"
+                }
+            }
+        },
+        "mwerror.Problem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "SONG_NOT_FOUND"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "song not found"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/mwerror.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "3f2c7a9e1b4d5c6e8f0a1b2c3d4e5f60"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "about:blank"
+                }
+            }
+        },
+        "playlistrest.AddPlaylistSongRequestBody": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "description": "Position это позиция песни в плейлисте, начиная с 1. По умолчанию песня добавляется в конец плейлиста.",
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "song": {
+                    "$ref": "#/definitions/models.SongIDAPI"
+                }
+            }
+        },
+        "playlistrest.AddPlaylistSongResponse": {
+            "type": "object",
+            "properties": {
+                "playlistId": {
+                    "type": "integer"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "song": {
+                    "$ref": "#/definitions/models.SongIDAPI"
+                }
+            }
+        },
+        "playlistrest.ChangePlaylistRequestBody": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                }
+            }
+        },
+        "playlistrest.ChangePlaylistResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                }
+            }
+        },
+        "playlistrest.CreatePlaylistRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                }
+            }
+        },
+        "playlistrest.CreatePlaylistResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                }
+            }
+        },
+        "playlistrest.GetPlaylistResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                }
+            }
+        },
+        "playlistrest.GetPlaylistSongsResponse": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/models.PaginationMetadataAPI"
+                },
+                "songs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongAPI"
+                    }
+                }
+            }
+        },
+        "playlistrest.ImportPlaylistResponse": {
+            "type": "object",
+            "properties": {
+                "matchedCount": {
+                    "type": "integer"
+                },
+                "playlist": {
+                    "$ref": "#/definitions/models.PlaylistAPI"
+                },
+                "unmatched": {
+                    "description": "Unmatched это записи файла, для которых не найдены песни библиотеки.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlaylistEntryAPI"
+                    }
+                }
+            }
+        },
+        "playlistrest.MovePlaylistSongRequestBody": {
+            "type": "object",
+            "required": [
+                "position"
+            ],
+            "properties": {
+                "position": {
+                    "description": "Position это новая позиция песни в плейлисте, начиная с 1.",
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "playlistrest.MovePlaylistSongResponse": {
+            "type": "object",
+            "properties": {
+                "playlistId": {
+                    "type": "integer"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "song": {
+                    "$ref": "#/definitions/models.SongIDAPI"
+                }
+            }
+        },
+        "playlistrest.RemovePlaylistResponse": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "playlistrest.RemovePlaylistSongResponse": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "playlistrest.SearchPlaylistsResponse": {
+            "type": "object",
+            "properties": {
+                "pagination": {
+                    "$ref": "#/definitions/models.PaginationMetadataAPI"
+                },
+                "playlists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PlaylistAPI"
                     }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/songrest.GetSongResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/mwerror.ErrorResponse"
-                        }
+                }
+            }
+        },
+        "songrest.ChangeSongGenresRequestBody": {
+            "type": "object",
+            "required": [
+                "genres"
+            ],
+            "properties": {
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "artistrest.ChangeArtistRequestBody": {
+        },
+        "songrest.ChangeSongRequestBody": {
             "type": "object",
             "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistIDAPI"
+                },
+                "credits": {
+                    "description": "Credits полностью заменяет список участников создания песни, если передан.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongCreditAttributesAPI"
+                    }
+                },
+                "isrc": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "iswc": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "link": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string",
                     "maxLength": 130
+                },
+                "releaseDate": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
                 }
             }
         },
-        "artistrest.ChangeArtistResponse": {
+        "songrest.ChangeSongResponse": {
             "type": "object",
             "required": [
                 "id",
                 "name"
             ],
             "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongCreditAPI"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "id": {
                     "type": "integer"
                 },
+                "isrc": {
+                    "description": "ISRC это код записи, уникальный среди песен. ISWC это код произведения, общий для всех его записей.\nКоды могут содержать разделители, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "iswc": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "language": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "linkHealth": {
+                    "description": "LinkHealth это результат последней проверки доступности ссылки.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.LinkHealthAPI"
+                        }
+                    ]
+                },
+                "linkMediaId": {
+                    "type": "string"
+                },
+                "linkProvider": {
+                    "description": "LinkProvider и LinkMediaID заполняются, если ссылка указывает на запись известного музыкального сервиса.",
+                    "type": "string"
+                },
                 "name": {
                     "type": "string",
                     "maxLength": 130
+                },
+                "releaseDate": {
+                    "description": "ReleaseDate, Text и Link новой песни можно не указывать: они заполняются из внешнего сервиса информации о песнях.",
+                    "type": "string"
+                },
+                "stats": {
+                    "$ref": "#/definitions/models.LyricsStatsAPI"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string"
                 }
             }
         },
-        "artistrest.CreateArtistRequest": {
+        "songrest.ChangeSongTagsRequestBody": {
+            "type": "object",
+            "required": [
+                "tags"
+            ],
+            "properties": {
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "songrest.ChangeSongTagsResponse": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongCreditAPI"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isrc": {
+                    "description": "ISRC это код записи, уникальный среди песен. ISWC это код произведения, общий для всех его записей.\nКоды могут содержать разделители, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "iswc": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "language": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "linkHealth": {
+                    "description": "LinkHealth это результат последней проверки доступности ссылки.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.LinkHealthAPI"
+                        }
+                    ]
+                },
+                "linkMediaId": {
+                    "type": "string"
+                },
+                "linkProvider": {
+                    "description": "LinkProvider и LinkMediaID заполняются, если ссылка указывает на запись известного музыкального сервиса.",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "releaseDate": {
+                    "description": "ReleaseDate, Text и Link новой песни можно не указывать: они заполняются из внешнего сервиса информации о песнях.",
+                    "type": "string"
+                },
+                "stats": {
+                    "$ref": "#/definitions/models.LyricsStatsAPI"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "songrest.CreateSongRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistIDAPI"
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongCreditAttributesAPI"
+                    }
+                },
+                "isrc": {
+                    "description": "ISRC это код записи, уникальный среди песен. ISWC это код произведения, общий для всех его записей.\nКоды могут содержать разделители, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "iswc": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "link": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 130
+                },
+                "releaseDate": {
+                    "description": "ReleaseDate, Text и Link новой песни можно не указывать: они заполняются из внешнего сервиса информации о песнях.",
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "songrest.CreateSongResponse": {
             "type": "object",
             "required": [
+                "id",
                 "name"
             ],
             "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongCreditAPI"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isrc": {
+                    "description": "ISRC это код записи, уникальный среди песен. ISWC это код произведения, общий для всех его записей.\nКоды могут содержать разделители, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "iswc": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "language": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "linkHealth": {
+                    "description": "LinkHealth это результат последней проверки доступности ссылки.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.LinkHealthAPI"
+                        }
+                    ]
+                },
+                "linkMediaId": {
+                    "type": "string"
+                },
+                "linkProvider": {
+                    "description": "LinkProvider и LinkMediaID заполняются, если ссылка указывает на запись известного музыкального сервиса.",
+                    "type": "string"
+                },
                 "name": {
                     "type": "string",
                     "maxLength": 130
+                },
+                "releaseDate": {
+                    "description": "ReleaseDate, Text и Link новой песни можно не указывать: они заполняются из внешнего сервиса информации о песнях.",
+                    "type": "string"
+                },
+                "stats": {
+                    "$ref": "#/definitions/models.LyricsStatsAPI"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "songrest.GetLinkReportResponse": {
+            "type": "object",
+            "properties": {
+                "brokenSongs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongAPI"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/models.PaginationMetadataAPI"
+                },
+                "stats": {
+                    "$ref": "#/definitions/models.LinkStatsAPI"
                 }
             }
         },
-        "artistrest.CreateArtistResponse": {
+        "songrest.GetRelatedSongsResponse": {
             "type": "object",
-            "required": [
-                "id",
-                "name"
-            ],
             "properties": {
-                "id": {
-                    "type": "integer"
+                "derivatives": {
+                    "description": "Derivatives это песни, производные от песни, сгруппированные по типу связи.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/models.SongAPI"
+                        }
+                    }
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 130
+                "originals": {
+                    "description": "Originals это песни, производной от которых является песня, сгруппированные по типу связи.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/models.SongAPI"
+                        }
+                    }
+                },
+                "song": {
+                    "$ref": "#/definitions/models.SongIDAPI"
                 }
             }
         },
-        "artistrest.GetArtistResponse": {
+        "songrest.GetSongByISRCResponse": {
             "type": "object",
             "required": [
                 "id",
                 "name"
             ],
             "properties": {
+                "artist": {
+                    "$ref": "#/definitions/models.ArtistAPI"
+                },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongCreditAPI"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "id": {
                     "type": "integer"
                 },
+                "isrc": {
+                    "description": "ISRC это код записи, уникальный среди песен. ISWC это код произведения, общий для всех его записей.\nКоды могут содержать разделители, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "iswc": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "language": {
+                    "type": "string"
+                },
+                "link": {
+                    "type": "string"
+                },
+                "linkHealth": {
+                    "description": "LinkHealth это результат последней проверки доступности ссылки.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.LinkHealthAPI"
+                        }
+                    ]
+                },
+                "linkMediaId": {
+                    "type": "string"
+                },
+                "linkProvider": {
+                    "description": "LinkProvider и LinkMediaID заполняются, если ссылка указывает на запись известного музыкального сервиса.",
+                    "type": "string"
+                },
                 "name": {
                     "type": "string",
                     "maxLength": 130
+                },
+                "releaseDate": {
+                    "description": "ReleaseDate, Text и Link новой песни можно не указывать: они заполняются из внешнего сервиса информации о песнях.",
+                    "type": "string"
+                },
+                "stats": {
+                    "$ref": "#/definitions/models.LyricsStatsAPI"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string"
                 }
             }
         },
-        "artistrest.RemoveArtistResponse": {
+        "songrest.GetSongResponse": {
             "type": "object",
-            "required": [
-                "id"
-            ],
             "properties": {
-                "id": {
-                    "type": "integer"
+                "pagination": {
+                    "$ref": "#/definitions/models.PaginationMetadataAPI"
+                },
+                "song": {
+                    "$ref": "#/definitions/models.SongAPI"
                 }
             }
         },
-        "models.ArtistAPI": {
+        "songrest.GetSongsByISWCResponse": {
             "type": "object",
-            "required": [
-                "id",
-                "name"
-            ],
             "properties": {
-                "id": {
-                    "type": "integer"
+                "iswc": {
+                    "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 130
+                "songs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongAPI"
+                    }
                 }
             }
         },
-        "models.ArtistIDAPI": {
+        "songrest.LinkSongsRequestBody": {
             "type": "object",
             "required": [
-                "id"
+                "type"
             ],
             "properties": {
-                "id": {
-                    "type": "integer"
+                "original": {
+                    "$ref": "#/definitions/models.SongIDAPI"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "cover",
+                        "remix",
+                        "live",
+                        "sample"
+                    ]
                 }
             }
         },
-        "models.Pagination": {
+        "songrest.LinkSongsResponse": {
             "type": "object",
             "properties": {
-                "pageNumber": {
-                    "type": "integer",
-                    "minimum": 1
+                "original": {
+                    "$ref": "#/definitions/models.SongIDAPI"
                 },
-                "pageSize": {
-                    "type": "integer",
-                    "maximum": 100,
-                    "minimum": 10
+                "song": {
+                    "$ref": "#/definitions/models.SongIDAPI"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "models.PaginationMetadataAPI": {
+        "songrest.MergeSongsRequestBody": {
             "type": "object",
             "properties": {
-                "currentPageNumber": {
-                    "type": "integer"
-                },
-                "pageCount": {
-                    "type": "integer"
-                },
-                "pageSize": {
-                    "type": "integer"
-                },
-                "recordCount": {
-                    "type": "integer"
+                "duplicate": {
+                    "description": "Duplicate это песня, которая сливается с основной песней и удаляется.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SongIDAPI"
+                        }
+                    ]
                 }
             }
         },
-        "models.SongAPI": {
+        "songrest.MergeSongsResponse": {
             "type": "object",
             "required": [
                 "id",
-                "link",
-                "name",
-                "releaseDate",
-                "text"
+                "name"
             ],
             "properties": {
                 "artist": {
                     "$ref": "#/definitions/models.ArtistAPI"
                 },
+                "credits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongCreditAPI"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "id": {
                     "type": "integer"
                 },
+                "isrc": {
+                    "description": "ISRC это код записи, уникальный среди песен. ISWC это код произведения, общий для всех его записей.\nКоды могут содержать разделители, но хранятся и возвращаются в каноническом виде.",
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "iswc": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "language": {
+                    "type": "string"
+                },
                 "link": {
                     "type": "string"
                 },
+                "linkHealth": {
+                    "description": "LinkHealth это результат последней проверки доступности ссылки.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.LinkHealthAPI"
+                        }
+                    ]
+                },
+                "linkMediaId": {
+                    "type": "string"
+                },
+                "linkProvider": {
+                    "description": "LinkProvider и LinkMediaID заполняются, если ссылка указывает на запись известного музыкального сервиса.",
+                    "type": "string"
+                },
                 "name": {
                     "type": "string",
                     "maxLength": 130
                 },
                 "releaseDate": {
+                    "description": "ReleaseDate, Text и Link новой песни можно не указывать: они заполняются из внешнего сервиса информации о песнях.",
                     "type": "string"
                 },
+                "stats": {
+                    "$ref": "#/definitions/models.LyricsStatsAPI"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "text": {
                     "type": "string"
                 }
             }
         },
-        "mwerror.ErrorResponse": {
+        "songrest.RemoveSongResponse": {
             "type": "object",
+            "required": [
+                "id"
+            ],
             "properties": {
-                "error": {
-                    "type": "string"
+                "id": {
+                    "type": "integer"
                 }
             }
         },
-        "songrest.ChangeSongRequestBody": {
+        "songrest.SearchSongsResponse": {
             "type": "object",
             "properties": {
-                "artist": {
-                    "$ref": "#/definitions/models.ArtistIDAPI"
+                "facets": {
+                    "description": "Facets заполняется только по запросу клиента.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.SongFacetsAPI"
+                        }
+                    ]
                 },
-                "link": {
-                    "type": "string"
+                "pagination": {
+                    "$ref": "#/definitions/models.PaginationMetadataAPI"
                 },
-                "name": {
-                    "type": "string",
-                    "maxLength": 130
+                "songs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.SongAPI"
+                    }
+                }
+            }
+        },
+        "songrest.UnlinkSongsResponse": {
+            "type": "object",
+            "properties": {
+                "original": {
+                    "$ref": "#/definitions/models.SongIDAPI"
                 },
-                "releaseDate": {
-                    "type": "string"
+                "song": {
+                    "$ref": "#/definitions/models.SongIDAPI"
                 },
-                "text": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "songrest.ChangeSongResponse": {
+        "tagrest.ChangeTagRequestBody": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "tagrest.ChangeTagResponse": {
             "type": "object",
             "required": [
                 "id",
-                "link",
-                "name",
-                "releaseDate",
-                "text"
+                "name"
             ],
             "properties": {
-                "artist": {
-                    "$ref": "#/definitions/models.ArtistAPI"
-                },
                 "id": {
                     "type": "integer"
                 },
-                "link": {
-                    "type": "string"
-                },
                 "name": {
                     "type": "string",
-                    "maxLength": 130
-                },
-                "releaseDate": {
-                    "type": "string"
-                },
-                "text": {
-                    "type": "string"
+                    "maxLength": 64
                 }
             }
         },
-        "songrest.CreateSongRequest": {
+        "tagrest.CreateTagRequest": {
             "type": "object",
             "required": [
-                "link",
-                "name",
-                "releaseDate",
-                "text"
+                "name"
             ],
             "properties": {
-                "artist": {
-                    "$ref": "#/definitions/models.ArtistIDAPI"
-                },
-                "link": {
-                    "type": "string"
-                },
                 "name": {
                     "type": "string",
-                    "maxLength": 130
-                },
-                "releaseDate": {
-                    "type": "string"
-                },
-                "text": {
-                    "type": "string"
+                    "maxLength": 64
                 }
             }
         },
-        "songrest.CreateSongResponse": {
+        "tagrest.CreateTagResponse": {
             "type": "object",
             "required": [
                 "id",
-                "link",
-                "name",
-                "releaseDate",
-                "text"
+                "name"
             ],
             "properties": {
-                "artist": {
-                    "$ref": "#/definitions/models.ArtistAPI"
-                },
                 "id": {
                     "type": "integer"
                 },
-                "link": {
-                    "type": "string"
-                },
                 "name": {
                     "type": "string",
-                    "maxLength": 130
-                },
-                "releaseDate": {
-                    "type": "string"
-                },
-                "text": {
-                    "type": "string"
+                    "maxLength": 64
                 }
             }
         },
-        "songrest.GetSongResponse": {
+        "tagrest.GetTagsResponse": {
             "type": "object",
             "properties": {
-                "pagination": {
-                    "$ref": "#/definitions/models.PaginationMetadataAPI"
-                },
-                "song": {
-                    "$ref": "#/definitions/models.SongAPI"
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.TagAPI"
+                    }
                 }
             }
         },
-        "songrest.RemoveSongResponse": {
+        "tagrest.RemoveTagResponse": {
             "type": "object",
             "required": [
                 "id"
@@ -792,20 +6471,20 @@ const docTemplate = `{
                     "type": "integer"
                 }
             }
+        }
+    },
+    "securityDefinitions": {
+        "ApiKeyAuth": {
+            "description": "Статический API-ключ из конфигурации или базы данных.",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
         },
-        "songrest.SearchSongsResponse": {
-            "type": "object",
-            "properties": {
-                "pagination": {
-                    "$ref": "#/definitions/models.PaginationMetadataAPI"
-                },
-                "songs": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.SongAPI"
-                    }
-                }
-            }
+        "BearerAuth": {
+            "description": "JWT в формате \"Bearer \u003ctoken\u003e\".",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
         }
     }
 }`
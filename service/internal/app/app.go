@@ -1,34 +1,144 @@
 package app
 
 import (
+	"context"
 	"log/slog"
 
+	grpcapp "github.com/sedonn/song-library-service/internal/app/grpc"
+	metricsapp "github.com/sedonn/song-library-service/internal/app/metrics"
 	restapp "github.com/sedonn/song-library-service/internal/app/rest"
+	schedulerapp "github.com/sedonn/song-library-service/internal/app/scheduler"
+	workerapp "github.com/sedonn/song-library-service/internal/app/worker"
+	"github.com/sedonn/song-library-service/internal/clients/linkcheck"
+	"github.com/sedonn/song-library-service/internal/clients/musicinfo"
 	"github.com/sedonn/song-library-service/internal/config"
+	icauth "github.com/sedonn/song-library-service/internal/controllers/grpc/interceptor/auth"
+	mwauth "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/auth"
+	mwratelimit "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/ratelimit"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/tracing"
 	"github.com/sedonn/song-library-service/internal/repositories/postgresql"
+	"github.com/sedonn/song-library-service/internal/services/album"
 	"github.com/sedonn/song-library-service/internal/services/artist"
+	"github.com/sedonn/song-library-service/internal/services/auth"
+	"github.com/sedonn/song-library-service/internal/services/duplicate"
+	"github.com/sedonn/song-library-service/internal/services/job"
+	"github.com/sedonn/song-library-service/internal/services/playlist"
+	"github.com/sedonn/song-library-service/internal/services/quota"
 	"github.com/sedonn/song-library-service/internal/services/song"
+	"github.com/sedonn/song-library-service/internal/services/tag"
 )
 
 // App это микросервис библиотеки песен.
 type App struct {
-	RESTApp *restapp.App
+	RESTApp      *restapp.App
+	MetricsApp   *metricsapp.App
+	GRPCApp      *grpcapp.App
+	WorkerApp    *workerapp.App
+	SchedulerApp *schedulerapp.App
+	Tracing      *tracing.Provider
 }
 
 // New создает новый микросервис библиотеки песен.
 func New(log *slog.Logger, cfg *config.Config) *App {
+	tracingProvider, err := tracing.New(context.Background(), &cfg.Tracing)
+	if err != nil {
+		panic(err)
+	}
+	log.Info("tracing initialized", slog.String("exporter", cfg.Tracing.Exporter))
+
 	repository, err := postgresql.New(cfg)
 	if err != nil {
 		panic(err)
 	}
 	log.Info("database connected", slog.String("database", cfg.DB.Database))
 
-	artistService := artist.New(log, repository, repository, repository, repository)
-	songService := song.New(log, repository, repository, repository, repository)
+	artistService := artist.New(log, repository, repository, repository, repository, repository, repository)
+	var songInfoProvider song.SongInfoProvider
+	if cfg.MusicInfo.URL != "" {
+		songInfoProvider = musicinfo.New(&cfg.MusicInfo)
+		log.Info("song info enrichment enabled", slog.String("url", cfg.MusicInfo.URL))
+	}
+
+	songService := song.New(
+		log,
+		repository,
+		repository,
+		repository,
+		repository,
+		repository,
+		repository,
+		repository,
+		repository,
+		repository,
+		songInfoProvider,
+		linkcheck.New(&cfg.LinkCheck),
+	)
+	albumService := album.New(log, repository, repository, repository, repository)
+	genreService := tag.New(log, models.TagKindGenre, repository, repository, repository, repository)
+	tagService := tag.New(log, models.TagKindTag, repository, repository, repository, repository)
+	playlistService := playlist.New(log, repository, repository, repository, repository, repository, repository)
+	duplicateService := duplicate.New(log, repository)
+
+	jobService := job.New(log, &cfg.Jobs, repository, repository)
+	jobService.Handle(models.JobTypeEnrichSong, songService.EnrichSong)
+	jobService.Handle(models.JobTypeValidateLink, songService.ValidateSongLink)
+
+	var (
+		authenticator     mwauth.Authenticator
+		grpcAuthenticator icauth.Authenticator
+	)
+	if cfg.Auth.Enabled {
+		authService, err := auth.New(log, &cfg.Auth, repository)
+		if err != nil {
+			panic(err)
+		}
+		authenticator = authService
+		grpcAuthenticator = authService
+	}
+
+	var quotaService mwratelimit.QuotaService
+	if cfg.Quota.Daily > 0 || len(cfg.Quota.Clients) > 0 {
+		quotaService = quota.New(log, &cfg.Quota, repository)
+		log.Info("daily request quotas enabled", slog.Uint64("daily", cfg.Quota.Daily))
+	}
+
+	restApp := restapp.New(
+		log,
+		&cfg.REST,
+		&cfg.GraphQL,
+		&cfg.I18n,
+		&cfg.RateLimit,
+		authenticator,
+		quotaService,
+		artistService,
+		songService,
+		albumService,
+		genreService,
+		tagService,
+		playlistService,
+		duplicateService,
+		jobService,
+		artistService,
+		songService,
+	)
+
+	grpcApp := grpcapp.New(log, &cfg.GRPC, grpcAuthenticator, artistService, songService)
 
-	restApp := restapp.New(log, &cfg.REST, artistService, songService)
+	schedulerApp := schedulerapp.New(log, schedulerapp.Task{
+		Name:     "schedule link checks",
+		Interval: cfg.LinkCheck.ScheduleInterval,
+		Run: func(ctx context.Context) error {
+			return songService.ScheduleLinkChecks(ctx, cfg.LinkCheck.RecheckAfter, cfg.LinkCheck.BatchSize)
+		},
+	})
 
 	return &App{
-		RESTApp: restApp,
+		RESTApp:      restApp,
+		MetricsApp:   metricsapp.New(log, &cfg.REST),
+		GRPCApp:      grpcApp,
+		WorkerApp:    workerapp.New(log, &cfg.Jobs, jobService),
+		SchedulerApp: schedulerApp,
+		Tracing:      tracingProvider,
 	}
 }
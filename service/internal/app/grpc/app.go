@@ -0,0 +1,111 @@
+package grpcapp
+
+import (
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	artistgrpc "github.com/sedonn/song-library-service/internal/controllers/grpc/artist"
+	icauth "github.com/sedonn/song-library-service/internal/controllers/grpc/interceptor/auth"
+	icerror "github.com/sedonn/song-library-service/internal/controllers/grpc/interceptor/error"
+	ictenant "github.com/sedonn/song-library-service/internal/controllers/grpc/interceptor/tenant"
+	songgrpc "github.com/sedonn/song-library-service/internal/controllers/grpc/song"
+)
+
+// App это gRPC-сервер.
+type App struct {
+	log          *slog.Logger
+	gRPCServer   *grpc.Server
+	healthServer *health.Server
+	address      string
+}
+
+// New создает новый gRPC-сервер с рефлексией и проверкой состояния.
+// Если объект аутентификации не передан, то сервер доступен без аутентификации.
+func New(
+	log *slog.Logger,
+	cfg *config.GRPCConfig,
+	auth icauth.Authenticator,
+	as artistgrpc.ArtistService,
+	ss songgrpc.SongService,
+) *App {
+	unary := []grpc.UnaryServerInterceptor{icerror.NewUnary()}
+	stream := []grpc.StreamServerInterceptor{icerror.NewStream()}
+	if auth != nil {
+		unary = append(unary, icauth.NewUnary(auth))
+		stream = append(stream, icauth.NewStream(auth))
+	} else {
+		log.Warn("gRPC authentication disabled")
+	}
+	unary = append(unary, ictenant.NewUnary())
+	stream = append(stream, ictenant.NewStream())
+
+	gRPCServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
+
+	artistgrpc.New(as).Register(gRPCServer)
+	songgrpc.New(ss).Register(gRPCServer)
+
+	healthServer := health.NewServer()
+	for service := range gRPCServer.GetServiceInfo() {
+		healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+	}
+	healthpb.RegisterHealthServer(gRPCServer, healthServer)
+	reflection.Register(gRPCServer)
+
+	return &App{
+		log:          log,
+		gRPCServer:   gRPCServer,
+		healthServer: healthServer,
+		address:      net.JoinHostPort("", strconv.Itoa(cfg.Port)),
+	}
+}
+
+// MustRun запускает gRPC-сервер. Паникует при ошибке.
+func (a *App) MustRun() {
+	if err := a.Run(); err != nil {
+		panic(err)
+	}
+}
+
+// Run запускает gRPC-сервер.
+func (a *App) Run() error {
+	a.log.Info("starting gRPC server", slog.String("address", a.address))
+
+	l, err := net.Listen("tcp", a.address)
+	if err != nil {
+		return fmt.Errorf("failed to listen gRPC address: %w", err)
+	}
+
+	return a.Serve(l)
+}
+
+// Serve принимает подключения gRPC-сервера на переданном адресе.
+func (a *App) Serve(l net.Listener) error {
+	a.healthServer.Resume()
+	if err := a.gRPCServer.Serve(l); err != nil {
+		return fmt.Errorf("failed to serve gRPC: %w", err)
+	}
+
+	return nil
+}
+
+// Stop останавливает gRPC-сервер. Перед остановкой все сервисы переводятся в состояние NOT_SERVING,
+// а уже выполняющиеся запросы завершаются.
+func (a *App) Stop() {
+	a.log.Info("shutting down gRPC server")
+
+	a.healthServer.Shutdown()
+	a.gRPCServer.GracefulStop()
+
+	a.log.Info("gRPC server is shut down")
+}
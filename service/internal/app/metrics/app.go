@@ -0,0 +1,80 @@
+package metricsapp
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/metrics"
+)
+
+// App это сервер метрик в формате Prometheus. Метрики доступны по маршруту /metrics на отдельном порту,
+// чтобы не открывать их клиентам REST-API.
+type App struct {
+	log        *slog.Logger
+	httpServer *http.Server
+}
+
+// New создает новый сервер метрик. Если порт сервера метрик не задан, то сервер не запускается.
+func New(log *slog.Logger, cfg *config.RESTConfig) *App {
+	a := &App{log: log}
+	if cfg.MetricsPort == 0 {
+		return a
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	a.httpServer = &http.Server{
+		Addr:    net.JoinHostPort("", strconv.Itoa(cfg.MetricsPort)),
+		Handler: mux,
+	}
+
+	return a
+}
+
+// MustRun запускает сервер метрик. Паникует при ошибке.
+func (a *App) MustRun() {
+	if err := a.Run(); err != nil {
+		panic(err)
+	}
+}
+
+// Run запускает сервер метрик.
+func (a *App) Run() error {
+	if a.httpServer == nil {
+		a.log.Warn("metrics server disabled")
+
+		return nil
+	}
+
+	a.log.Info("starting metrics server", slog.String("address", a.httpServer.Addr))
+
+	if err := a.httpServer.ListenAndServe(); err != nil {
+		if !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Stop останавливает сервер метрик.
+func (a *App) Stop() {
+	if a.httpServer == nil {
+		return
+	}
+
+	a.log.Info("shutting down metrics server")
+
+	if err := a.httpServer.Shutdown(context.Background()); err != nil {
+		a.log.Error("failed to shut down metrics server", logger.ErrorString(err))
+	}
+
+	a.log.Info("metrics server is shut down")
+}
@@ -9,13 +9,27 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
 
 	"github.com/sedonn/song-library-service/internal/config"
+	graphqlapi "github.com/sedonn/song-library-service/internal/controllers/graphql"
+	albumrest "github.com/sedonn/song-library-service/internal/controllers/rest/album"
 	artistrest "github.com/sedonn/song-library-service/internal/controllers/rest/artist"
+	duplicaterest "github.com/sedonn/song-library-service/internal/controllers/rest/duplicate"
+	jobrest "github.com/sedonn/song-library-service/internal/controllers/rest/job"
+	mwauth "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/auth"
 	mwerror "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/error"
+	mwmetrics "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/metrics"
+	mwratelimit "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/ratelimit"
+	mwrequestid "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/requestid"
+	mwtenant "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/tenant"
+	mwtracing "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/tracing"
+	playlistrest "github.com/sedonn/song-library-service/internal/controllers/rest/playlist"
 	songrest "github.com/sedonn/song-library-service/internal/controllers/rest/song"
 	"github.com/sedonn/song-library-service/internal/controllers/rest/swagdocs"
+	tagrest "github.com/sedonn/song-library-service/internal/controllers/rest/tag"
 	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/ratelimit"
 )
 
 // App это REST-сервер.
@@ -25,20 +39,65 @@ type App struct {
 }
 
 // New создает новый REST-сервер.
-func New(log *slog.Logger, cfg *config.RESTConfig, as artistrest.ArtistService, ss songrest.SongService) *App {
+func New(
+	log *slog.Logger,
+	cfg *config.RESTConfig,
+	graphQLCfg *config.GraphQLConfig,
+	i18nCfg *config.I18nConfig,
+	rateLimitCfg *config.RateLimitConfig,
+	auth mwauth.Authenticator,
+	qs mwratelimit.QuotaService,
+	as artistrest.ArtistService,
+	ss songrest.SongService,
+	als albumrest.AlbumService,
+	gs tagrest.TagService,
+	ts tagrest.TagService,
+	ps playlistrest.PlaylistService,
+	ds duplicaterest.DuplicateService,
+	js jobrest.JobService,
+	gqlas graphqlapi.ArtistService,
+	gqlss graphqlapi.SongService,
+) *App {
 	router := gin.Default()
+	// Сервисы получают контекст gin, поэтому значения из контекста запроса должны быть доступны через него.
+	router.ContextWithFallback = true
+	// IP-адрес клиента используется для ограничения частоты запросов, поэтому заголовкам прокси-серверов
+	// доверяется, только если они пришли от известного прокси-сервера.
+	if err := router.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		panic(err)
+	}
 
-	router.Use(mwerror.New())
+	router.Use(mwtracing.New(otel.GetTracerProvider()), mwmetrics.New(), mwrequestid.New(), mwerror.New(i18nCfg))
 
-	api := router.Group("api")
+	var middlewares []gin.HandlerFunc
+	if auth != nil {
+		middlewares = append(middlewares, mwauth.New(auth))
+	} else {
+		log.Warn("REST-API authentication disabled")
+	}
+	if rateLimitCfg.Enabled {
+		middlewares = append(middlewares, mwratelimit.New(rateLimitCfg, ratelimit.NewMemory(), qs, graphqlapi.QueryPath))
+	} else {
+		log.Warn("REST-API rate limiting disabled")
+	}
+	middlewares = append(middlewares, mwtenant.New())
+
+	api := router.Group("api", middlewares...)
 	{
 		v1 := api.Group("/v1")
 		{
 			artistrest.New(as).BindTo(v1)
 			songrest.New(ss).BindTo(v1)
+			albumrest.New(als).BindTo(v1)
+			tagrest.New(gs, "/genres").BindTo(v1)
+			tagrest.New(ts, "/tags").BindTo(v1)
+			playlistrest.New(ps).BindTo(v1)
+			duplicaterest.New(ds).BindTo(v1)
+			jobrest.New(js).BindTo(v1)
 		}
 	}
 
+	graphqlapi.New(graphQLCfg, gqlas, gqlss).BindTo(router, middlewares...)
 	swagdocs.BindTo(router)
 
 	srv := &http.Server{
@@ -52,6 +111,11 @@ func New(log *slog.Logger, cfg *config.RESTConfig, as artistrest.ArtistService,
 	}
 }
 
+// Handler возвращает обработчик запросов REST-API сервера.
+func (a *App) Handler() http.Handler {
+	return a.httpServer.Handler
+}
+
 // MustRun запускает REST-API сервер. Паникует при ошибке.
 func (a *App) MustRun() {
 	if err := a.Run(); err != nil {
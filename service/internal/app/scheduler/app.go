@@ -0,0 +1,79 @@
+package schedulerapp
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+)
+
+// Task это периодическая задача.
+type Task struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// App это планировщик периодических задач.
+type App struct {
+	log   *slog.Logger
+	tasks []Task
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New создает новый планировщик периодических задач.
+func New(log *slog.Logger, tasks ...Task) *App {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &App{
+		log:    log,
+		tasks:  tasks,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Run запускает периодические задачи в фоне. Каждая задача выполняется сразу после запуска,
+// а затем через свой интервал после завершения предыдущего выполнения.
+func (a *App) Run() {
+	a.log.Info("starting scheduler", slog.Int("tasks", len(a.tasks)))
+
+	for _, t := range a.tasks {
+		a.wg.Add(1)
+		go a.schedule(t)
+	}
+}
+
+// Stop останавливает планировщик, отменяя выполняемые задачи.
+func (a *App) Stop() {
+	a.log.Info("shutting down scheduler")
+
+	a.cancel()
+	a.wg.Wait()
+
+	a.log.Info("scheduler is shut down")
+}
+
+// schedule выполняет задачу периодически, пока планировщик не остановлен.
+func (a *App) schedule(t Task) {
+	defer a.wg.Done()
+
+	log := a.log.With(slog.String("task", t.Name))
+
+	for {
+		if err := t.Run(a.ctx); err != nil && a.ctx.Err() == nil {
+			log.Error("scheduled task failed", logger.ErrorString(err))
+		}
+
+		select {
+		case <-a.ctx.Done():
+			return
+		case <-time.After(t.Interval):
+		}
+	}
+}
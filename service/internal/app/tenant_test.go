@@ -0,0 +1,298 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	icauth "github.com/sedonn/song-library-service/internal/controllers/grpc/interceptor/auth"
+	pb "github.com/sedonn/song-library-service/internal/controllers/grpc/proto/songlibrary/v1"
+	mwauth "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/auth"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/tracing"
+)
+
+// testConfigPathKey это переменная окружения с путем к конфигурации микросервиса с подготовленной БД.
+// Без нее тесты, которым нужна БД, пропускаются.
+const testConfigPathKey = "TEST_CONFIG_PATH"
+
+// tenantA и tenantB это библиотеки, к которым привязаны API-ключи тестовых клиентов.
+// Ключ клиента совпадает с названием библиотеки.
+const (
+	tenantA = "isolation-a"
+	tenantB = "isolation-b"
+)
+
+var (
+	testAppOnce sync.Once
+	testApp     *App
+)
+
+// newTestApp создает микросервис с API-ключами клиентов библиотек tenantA и tenantB. Микросервис создается
+// один раз на все тесты, потому что метрики регистрируются глобально.
+func newTestApp(t *testing.T) *App {
+	t.Helper()
+
+	path := os.Getenv(testConfigPathKey)
+	if path == "" {
+		t.Skipf("%s not set", testConfigPathKey)
+	}
+
+	testAppOnce.Do(func() {
+		cfg := config.MustLoadByPath(path)
+		cfg.Auth.Enabled = true
+		cfg.Auth.APIKeys = append(cfg.Auth.APIKeys, testAPIKey(tenantA), testAPIKey(tenantB))
+		cfg.RateLimit.Enabled = false
+		cfg.Quota = config.QuotaConfig{}
+		cfg.MusicInfo.URL = ""
+		cfg.Tracing.Exporter = tracing.ExporterNone
+
+		testApp = New(logger.NewDiscardLogger(), cfg)
+	})
+
+	return testApp
+}
+
+// testAPIKey возвращает API-ключ администратора, привязанный к определенной библиотеке.
+func testAPIKey(tenantID string) config.APIKeyConfig {
+	sum := sha256.Sum256([]byte(tenantID))
+
+	return config.APIKeyConfig{
+		Name:   tenantID,
+		Hash:   hex.EncodeToString(sum[:]),
+		Roles:  []string{"admin"},
+		Tenant: tenantID,
+	}
+}
+
+// testName возвращает название записи, которое не совпадает с названиями записей предыдущих запусков тестов.
+func testName(t *testing.T) string {
+	return fmt.Sprintf("%s %d", t.Name(), time.Now().UnixNano())
+}
+
+// doREST выполняет запрос к REST-API от имени клиента библиотеки, проверяет статус ответа
+// и разбирает тело ответа в resp, если он передан.
+func doREST(t *testing.T, h http.Handler, tenantID, method, path string, body, resp any, wantStatus int) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&buf).Encode(body))
+	}
+
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(mwauth.APIKeyHeader, tenantID)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	require.Equalf(t, wantStatus, w.Code, "%s %s: %s", method, path, w.Body.String())
+	if resp != nil {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	}
+}
+
+func TestApp_RESTTenantIsolation(t *testing.T) {
+	h := newTestApp(t).RESTApp.Handler()
+
+	name := testName(t)
+
+	var artist models.ArtistAPI
+	doREST(t, h, tenantA, http.MethodPost, "/api/v1/artists/", models.ArtistAttributesAPI{Name: name}, &artist, http.StatusOK)
+	artistPath := fmt.Sprintf("/api/v1/artists/%d", artist.ID)
+	t.Cleanup(func() { doREST(t, h, tenantA, http.MethodDelete, artistPath, nil, nil, http.StatusOK) })
+
+	var song models.SongAPI
+	doREST(t, h, tenantA, http.MethodPost, "/api/v1/songs/", map[string]any{
+		"name":   name,
+		"artist": models.ArtistIDAPI{ID: artist.ID},
+	}, &song, http.StatusOK)
+	songPath := fmt.Sprintf("/api/v1/songs/%d", song.ID)
+
+	query := "?name=" + url.QueryEscape(name)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		body   any
+	}{
+		{name: "Get artist", method: http.MethodGet, path: artistPath},
+		{name: "Change artist", method: http.MethodPatch, path: artistPath, body: map[string]any{"name": name + " changed"}},
+		{name: "Remove artist", method: http.MethodDelete, path: artistPath},
+		{name: "Get artist aliases", method: http.MethodGet, path: artistPath + "/aliases"},
+		{name: "Get song", method: http.MethodGet, path: songPath + "/couplets"},
+		{name: "Change song", method: http.MethodPatch, path: songPath, body: map[string]any{"name": name + " changed"}},
+		{name: "Change song tags", method: http.MethodPut, path: songPath + "/tags", body: map[string]any{"tags": []string{"live"}}},
+		{name: "Remove song", method: http.MethodDelete, path: songPath},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doREST(t, h, tenantB, tt.method, tt.path, tt.body, nil, http.StatusNotFound)
+		})
+	}
+
+	t.Run("Search artists", func(t *testing.T) {
+		var artists models.ArtistsAPI
+		doREST(t, h, tenantB, http.MethodGet, "/api/v1/artists/"+query, nil, &artists, http.StatusOK)
+		assert.Empty(t, artists.Artists)
+	})
+
+	t.Run("Search songs", func(t *testing.T) {
+		var songs models.SongsAPI
+		doREST(t, h, tenantB, http.MethodGet, "/api/v1/songs/"+query, nil, &songs, http.StatusOK)
+		assert.Empty(t, songs.Songs)
+	})
+
+	t.Run("Records unchanged", func(t *testing.T) {
+		var got models.SongWithCoupletPaginationAPI
+		doREST(t, h, tenantA, http.MethodGet, songPath+"/couplets", nil, &got, http.StatusOK)
+		assert.Equal(t, name, got.Song.Name)
+		assert.Equal(t, name, got.Song.Artist.Name)
+		assert.Empty(t, got.Song.Tags)
+
+		var songs models.SongsAPI
+		doREST(t, h, tenantA, http.MethodGet, "/api/v1/songs/"+query, nil, &songs, http.StatusOK)
+		assert.Len(t, songs.Songs, 1)
+	})
+}
+
+func TestApp_GRPCTenantIsolation(t *testing.T) {
+	a := newTestApp(t)
+
+	l := bufconn.Listen(1024 * 1024)
+	go func() { _ = a.GRPCApp.Serve(l) }()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	artistClient := pb.NewArtistServiceClient(conn)
+	songClient := pb.NewSongServiceClient(conn)
+
+	ctxA := metadata.AppendToOutgoingContext(context.Background(), icauth.APIKeyMetadata, tenantA)
+	ctxB := metadata.AppendToOutgoingContext(context.Background(), icauth.APIKeyMetadata, tenantB)
+
+	name := testName(t)
+
+	artist, err := artistClient.CreateArtist(ctxA, &pb.CreateArtistRequest{Attributes: &pb.ArtistAttributes{Name: name}})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, err := artistClient.RemoveArtist(ctxA, &pb.RemoveArtistRequest{Id: artist.GetId()})
+		assert.NoError(t, err)
+	})
+
+	song, err := songClient.CreateSong(ctxA, &pb.CreateSongRequest{
+		Attributes: &pb.SongAttributes{Name: name},
+		ArtistId:   artist.GetId(),
+	})
+	require.NoError(t, err)
+
+	pagination := &pb.Pagination{PageNumber: 1, PageSize: 10}
+
+	tests := []struct {
+		name string
+		call func(ctx context.Context) error
+	}{
+		{
+			name: "GetArtist",
+			call: func(ctx context.Context) error {
+				_, err := artistClient.GetArtist(ctx, &pb.GetArtistRequest{Id: artist.GetId()})
+				return err
+			},
+		},
+		{
+			name: "RemoveArtist",
+			call: func(ctx context.Context) error {
+				_, err := artistClient.RemoveArtist(ctx, &pb.RemoveArtistRequest{Id: artist.GetId()})
+				return err
+			},
+		},
+		{
+			name: "GetSongWithCoupletPagination",
+			call: func(ctx context.Context) error {
+				_, err := songClient.GetSongWithCoupletPagination(ctx, &pb.GetSongWithCoupletPaginationRequest{
+					Id:         song.GetId(),
+					Pagination: pagination,
+				})
+				return err
+			},
+		},
+		{
+			name: "ChangeSong",
+			call: func(ctx context.Context) error {
+				_, err := songClient.ChangeSong(ctx, &pb.ChangeSongRequest{
+					Id:         song.GetId(),
+					Attributes: &pb.SongAttributes{Name: name + " changed"},
+				})
+				return err
+			},
+		},
+		{
+			name: "RemoveSong",
+			call: func(ctx context.Context) error {
+				_, err := songClient.RemoveSong(ctx, &pb.RemoveSongRequest{Id: song.GetId()})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(ctxB)
+			assert.Equalf(t, codes.NotFound, status.Code(err), "error = %v", err)
+		})
+	}
+
+	t.Run("SearchArtists", func(t *testing.T) {
+		resp, err := artistClient.SearchArtists(ctxB, &pb.SearchArtistsRequest{Name: name, Pagination: pagination})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetArtists())
+	})
+
+	t.Run("SearchSongs", func(t *testing.T) {
+		resp, err := songClient.SearchSongs(ctxB, &pb.SearchSongsRequest{
+			Filter:     &pb.SongsFilter{Name: name},
+			Pagination: pagination,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetSongs())
+	})
+
+	t.Run("Records unchanged", func(t *testing.T) {
+		resp, err := songClient.GetSongWithCoupletPagination(ctxA, &pb.GetSongWithCoupletPaginationRequest{
+			Id:         song.GetId(),
+			Pagination: pagination,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, name, resp.GetSong().GetAttributes().GetName())
+	})
+}
@@ -0,0 +1,118 @@
+package workerapp
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+)
+
+// JobService описывает поведение объекта, который обеспечивает выполнение фоновых задач.
+type JobService interface {
+	// ClaimJobs берет в работу не более limit задач, время выполнения которых наступило.
+	ClaimJobs(ctx context.Context, limit int) (models.Jobs, error)
+	// ProcessJob выполняет взятую в работу задачу и сохраняет результат попытки.
+	ProcessJob(ctx context.Context, j models.Job) error
+}
+
+// App это пул обработчиков фоновых задач.
+type App struct {
+	log        *slog.Logger
+	cfg        *config.JobsConfig
+	jobService JobService
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	stop   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New создает новый пул обработчиков фоновых задач.
+func New(log *slog.Logger, cfg *config.JobsConfig, js JobService) *App {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &App{
+		log:        log,
+		cfg:        cfg,
+		jobService: js,
+		ctx:        ctx,
+		cancel:     cancel,
+		stop:       make(chan struct{}),
+	}
+}
+
+// Run запускает обработчики фоновых задач в фоне.
+func (a *App) Run() {
+	a.log.Info("starting job workers", slog.Int("workers", a.cfg.Workers))
+
+	for range a.cfg.Workers {
+		a.wg.Add(1)
+		go a.work()
+	}
+}
+
+// Stop останавливает обработчики фоновых задач. Новые задачи не берутся в работу, а выполняемые задачи
+// завершаются в течение ShutdownTimeout, после чего отменяются.
+func (a *App) Stop() {
+	a.log.Info("shutting down job workers")
+
+	close(a.stop)
+
+	done := make(chan struct{})
+	go func() {
+		a.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(a.cfg.ShutdownTimeout):
+		a.log.Warn("job workers shutdown timed out, canceling running jobs")
+		a.cancel()
+		<-done
+	}
+	a.cancel()
+
+	a.log.Info("job workers are shut down")
+}
+
+// work берет в работу и выполняет задачи по одной, пока пул не остановлен.
+// Если задач нет, то очередь проверяется снова через PollInterval.
+func (a *App) work() {
+	defer a.wg.Done()
+
+	for {
+		select {
+		case <-a.stop:
+			return
+		default:
+		}
+
+		jobs, err := a.jobService.ClaimJobs(a.ctx, 1)
+		if err != nil {
+			a.log.Error("failed to claim jobs", logger.ErrorString(err))
+		}
+		if err != nil || len(jobs) == 0 {
+			select {
+			case <-a.stop:
+				return
+			case <-time.After(a.cfg.PollInterval):
+			}
+			continue
+		}
+
+		if err := a.jobService.ProcessJob(a.ctx, jobs[0]); err != nil {
+			a.log.Error(
+				"failed to process job",
+				slog.Uint64("id", jobs[0].ID),
+				slog.String("tenant", jobs[0].TenantID),
+				slog.String("type", jobs[0].Type),
+				logger.ErrorString(err),
+			)
+		}
+	}
+}
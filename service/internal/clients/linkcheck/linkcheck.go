@@ -0,0 +1,253 @@
+// Package linkcheck содержит клиент проверки доступности ссылок песен.
+package linkcheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/sedonn/song-library-service/internal/config"
+)
+
+// maxDiscardSize ограничивает размер тела ответа, которое читается для повторного использования соединения.
+const maxDiscardSize = 64 << 10
+
+// maxIdleHosts это количество хостов, после которого забываются хосты без запланированных запросов.
+const maxIdleHosts = 1024
+
+// maxRedirects это максимальное количество перенаправлений при проверке ссылки.
+const maxRedirects = 10
+
+var (
+	// ErrForbiddenAddress ссылка ведет на адрес, который не является публичным адресом интернета.
+	ErrForbiddenAddress = errors.New("link address is not public")
+	// ErrUnsupportedScheme ссылка использует протокол, отличный от HTTP и HTTPS.
+	ErrUnsupportedScheme = errors.New("link scheme is not supported")
+)
+
+// nonPublicPrefixes это специальные диапазоны адресов, которые не проверяются методами netip.Addr.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+// Client это клиент проверки доступности ссылок.
+// Количество одновременных запросов ограничено, а запросы к одному хосту выполняются не чаще,
+// чем раз в определенный промежуток времени. Запросы, в том числе по перенаправлениям, соблюдают
+// это ограничение и выполняются только к разрешенным адресам.
+type Client struct {
+	httpClient   *http.Client
+	allowed      func(netip.Addr) bool
+	timeout      time.Duration
+	hostInterval time.Duration
+	slots        chan struct{}
+
+	mu sync.Mutex
+	// nextRequest хранит время, начиная с которого разрешен следующий запрос к хосту.
+	nextRequest map[string]time.Time
+}
+
+// New создает новый клиент проверки доступности ссылок. Если адреса локальных и внутренних сетей
+// не разрешены в конфигурации, то клиент подключается только к публичным адресам.
+func New(cfg *config.LinkCheckConfig) *Client {
+	c := &Client{
+		allowed:      isPublic,
+		timeout:      cfg.Timeout,
+		hostInterval: cfg.HostInterval,
+		slots:        make(chan struct{}, max(cfg.Concurrency, 1)),
+		nextRequest:  make(map[string]time.Time),
+	}
+	if cfg.AllowPrivateNetworks {
+		c.allowed = func(netip.Addr) bool { return true }
+	}
+
+	// Адрес проверяется после разрешения имени хоста перед каждым подключением, поэтому имя хоста
+	// не может указывать на внутренний адрес. Прокси из окружения не используется, иначе проверялся бы
+	// адрес прокси вместо адреса ссылки.
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second, Control: c.control}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	c.httpClient = &http.Client{Transport: transport, CheckRedirect: c.checkRedirect}
+
+	return c
+}
+
+// isPublic проверяет, что адрес является публичным адресом интернета: не является адресом локальной петли,
+// частной, локальной для канала (включая адрес метаданных облака 169.254.169.254) или специальной сети.
+func isPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+
+	for _, p := range nonPublicPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// control запрещает подключение к неразрешенным адресам.
+func (c *Client) control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+
+	if !c.allowed(addr) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+
+	return nil
+}
+
+// checkRedirect проверяет ссылку перенаправления так же, как исходную ссылку, ограничивает
+// количество перенаправлений и дожидается очереди к хосту перенаправления.
+func (c *Client) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxRedirects)
+	}
+
+	if err := c.checkURL(req.URL); err != nil {
+		return err
+	}
+
+	return c.waitHost(req.Context(), req.URL.Host)
+}
+
+// checkURL проверяет протокол ссылки и адрес, если хост ссылки задан адресом.
+// Адреса хостов, заданных именем, проверяются при подключении.
+func (c *Client) checkURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%w: %s", ErrUnsupportedScheme, u.Scheme)
+	}
+
+	if addr, err := netip.ParseAddr(u.Hostname()); err == nil && !c.allowed(addr) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+
+	return nil
+}
+
+// CheckLink возвращает код статуса ответа на HEAD-запрос по ссылке с учетом перенаправлений.
+// Если сервер не поддерживает HEAD-запросы, то выполняется GET-запрос.
+// Время ожидания очереди к хосту ссылки не входит в ограничение времени проверки, а ожидание очереди
+// к хостам перенаправлений входит. Ссылки и перенаправления
+// на неразрешенные адреса не проверяются и возвращают ErrForbiddenAddress.
+func (c *Client) CheckLink(ctx context.Context, link string) (int, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := c.checkURL(u); err != nil {
+		return 0, err
+	}
+
+	code, err := c.request(ctx, http.MethodHead, u)
+	if err != nil {
+		return 0, err
+	}
+
+	if code == http.StatusMethodNotAllowed || code == http.StatusNotImplemented {
+		return c.request(ctx, http.MethodGet, u)
+	}
+
+	return code, nil
+}
+
+// request дожидается своей очереди к хосту и свободного места среди одновременных запросов,
+// выполняет запрос определенным методом и возвращает код статуса ответа.
+func (c *Client) request(ctx context.Context, method string, u *url.URL) (int, error) {
+	if err := c.waitHost(ctx, u.Host); err != nil {
+		return 0, err
+	}
+
+	select {
+	case c.slots <- struct{}{}:
+		defer func() { <-c.slots }()
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDiscardSize))
+
+	return resp.StatusCode, nil
+}
+
+// waitHost занимает очередь к хосту и дожидается ее.
+func (c *Client) waitHost(ctx context.Context, host string) error {
+	if c.hostInterval <= 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	now := time.Now()
+	at := now
+	if next, ok := c.nextRequest[host]; ok && next.After(now) {
+		at = next
+	}
+	c.nextRequest[host] = at.Add(c.hostInterval)
+
+	if len(c.nextRequest) > maxIdleHosts {
+		for h, next := range c.nextRequest {
+			if !next.After(now) {
+				delete(c.nextRequest, h)
+			}
+		}
+	}
+	c.mu.Unlock()
+
+	if !at.After(now) {
+		return nil
+	}
+
+	timer := time.NewTimer(at.Sub(now))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,253 @@
+package linkcheck
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedonn/song-library-service/internal/config"
+)
+
+func TestClient_CheckLink(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/get-only", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name     string
+		path     string
+		wantCode int
+		wantErr  bool
+	}{
+		{name: "OK", path: "/ok", wantCode: http.StatusOK},
+		{name: "OK after redirect", path: "/moved", wantCode: http.StatusOK},
+		{name: "OK GET fallback", path: "/get-only", wantCode: http.StatusOK},
+		{name: "Not found", path: "/missing", wantCode: http.StatusNotFound},
+		{name: "Error timeout", path: "/slow", wantErr: true},
+	}
+
+	c := New(&config.LinkCheckConfig{Timeout: 100 * time.Millisecond, AllowPrivateNetworks: true})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, err := c.CheckLink(context.Background(), srv.URL+tt.path)
+			assert.Equal(t, tt.wantCode, code)
+			assert.Equal(t, tt.wantErr, err != nil, "error = %v", err)
+		})
+	}
+}
+
+func TestClient_CheckLink_HostInterval(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		requests []time.Time
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, time.Now())
+		mu.Unlock()
+	}))
+	t.Cleanup(srv.Close)
+
+	interval := 50 * time.Millisecond
+	c := New(&config.LinkCheckConfig{Timeout: time.Second, Concurrency: 4, HostInterval: interval, AllowPrivateNetworks: true})
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			code, err := c.CheckLink(context.Background(), srv.URL)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, code)
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, requests, 3)
+	slices.SortFunc(requests, func(a, b time.Time) int { return a.Compare(b) })
+	for i := 1; i < len(requests); i++ {
+		assert.GreaterOrEqual(t, requests[i].Sub(requests[i-1]), interval-5*time.Millisecond,
+			"requests to one host must be spaced by host interval")
+	}
+}
+
+func TestClient_CheckLink_RedirectHostInterval(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		requests []time.Time
+	)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, time.Now())
+		mu.Unlock()
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, time.Now())
+		mu.Unlock()
+
+		http.Redirect(w, r, "/ok", http.StatusMovedPermanently)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	interval := 50 * time.Millisecond
+	c := New(&config.LinkCheckConfig{Timeout: time.Second, HostInterval: interval, AllowPrivateNetworks: true})
+
+	code, err := c.CheckLink(context.Background(), srv.URL+"/moved")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+
+	require.Len(t, requests, 2)
+	assert.GreaterOrEqual(t, requests[1].Sub(requests[0]), interval-5*time.Millisecond,
+		"redirects to one host must be spaced by host interval")
+}
+
+func TestClient_CheckLink_Concurrency(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := New(&config.LinkCheckConfig{Timeout: time.Second, Concurrency: 2, AllowPrivateNetworks: true})
+
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := c.CheckLink(context.Background(), srv.URL)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2), "concurrent requests must not exceed concurrency limit")
+}
+
+func TestClient_CheckLink_ForbiddenAddress(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	tests := []struct {
+		name    string
+		link    string
+		wantErr error
+	}{
+		{name: "Loopback", link: srv.URL},
+		{name: "Localhost", link: strings.Replace(srv.URL, "127.0.0.1", "localhost", 1)},
+		{name: "Cloud metadata", link: "http://169.254.169.254/latest/meta-data/"},
+		{name: "Private network", link: "http://10.0.0.1/"},
+		{name: "IPv4-mapped loopback", link: "http://[::ffff:127.0.0.1]/"},
+		{name: "Unsupported scheme", link: "file:///etc/passwd", wantErr: ErrUnsupportedScheme},
+	}
+
+	c := New(&config.LinkCheckConfig{Timeout: time.Second})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			code, err := c.CheckLink(context.Background(), tt.link)
+			assert.ErrorIs(t, err, cmp.Or(tt.wantErr, ErrForbiddenAddress))
+			assert.Zero(t, code)
+		})
+	}
+
+	t.Cleanup(func() {
+		assert.Zero(t, requests.Load(), "forbidden addresses must not be requested")
+	})
+}
+
+func TestClient_CheckLink_ForbiddenRedirect(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	c := New(&config.LinkCheckConfig{Timeout: time.Second})
+	// Тестовый сервер работает на адресе локальной петли, поэтому разрешается только он.
+	c.allowed = func(addr netip.Addr) bool { return addr.IsLoopback() || isPublic(addr) }
+
+	code, err := c.CheckLink(context.Background(), srv.URL)
+	assert.ErrorIs(t, err, ErrForbiddenAddress, "redirect to cloud metadata must not be followed")
+	assert.Zero(t, code)
+}
+
+func TestIsPublic(t *testing.T) {
+	t.Parallel()
+
+	for addr, want := range map[string]bool{
+		"93.184.215.14":   true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"::1":             false,
+		"10.1.2.3":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"100.64.0.1":      false,
+		"0.0.0.0":         false,
+		"fd00:ec2::254":   false,
+		"fe80::1":         false,
+		"::ffff:10.0.0.1": false,
+	} {
+		assert.Equal(t, want, isPublic(netip.MustParseAddr(addr)), addr)
+	}
+}
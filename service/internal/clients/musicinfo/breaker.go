@@ -0,0 +1,75 @@
+package musicinfo
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker это автоматический выключатель, который прекращает запросы к сервису после нескольких неудачных
+// запросов подряд. Пока выключатель разомкнут, запросы не выполняются. После паузы выключатель пропускает
+// один пробный запрос: успешный запрос замыкает выключатель, неудачный - снова размыкает его.
+// Выключатель с неположительным порогом никогда не размыкается.
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// newBreaker создает новый замкнутый выключатель.
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow проверяет, можно ли выполнить запрос. Если запрос разрешен, то его результат
+// необходимо передать в done.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.threshold <= 0 || b.failures < b.threshold {
+		return true
+	}
+
+	if b.probing || b.now().Before(b.openUntil) {
+		return false
+	}
+
+	b.probing = true
+
+	return true
+}
+
+// done учитывает результат разрешенного запроса.
+func (b *breaker) done(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+
+	if success {
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.threshold > 0 && b.failures >= b.threshold {
+		b.openUntil = b.now().Add(b.cooldown)
+	}
+}
+
+// release завершает разрешенный запрос без учета результата, например если запрос отменен вызывающей стороной.
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
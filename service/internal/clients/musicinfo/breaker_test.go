@@ -0,0 +1,44 @@
+package musicinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 19, 0, 0, 0, 0, time.UTC)
+	b := newBreaker(2, time.Minute)
+	b.now = func() time.Time { return now }
+
+	for range 2 {
+		assert.True(t, b.allow())
+		b.done(false)
+	}
+	assert.False(t, b.allow(), "breaker must open after threshold failures")
+
+	now = now.Add(time.Minute)
+	assert.True(t, b.allow(), "breaker must allow a probe after cooldown")
+	assert.False(t, b.allow(), "breaker must allow a single probe")
+	b.done(false)
+	assert.False(t, b.allow(), "failed probe must open breaker again")
+
+	now = now.Add(time.Minute)
+	assert.True(t, b.allow())
+	b.done(true)
+	assert.True(t, b.allow(), "successful probe must close breaker")
+	assert.True(t, b.allow())
+}
+
+func TestBreaker_Disabled(t *testing.T) {
+	t.Parallel()
+
+	b := newBreaker(0, time.Minute)
+	for range 10 {
+		assert.True(t, b.allow())
+		b.done(false)
+	}
+}
@@ -0,0 +1,193 @@
+// Package musicinfo содержит клиент внешнего сервиса информации о песнях, который по названиям исполнителя и песни
+// возвращает дату выхода, текст и ссылку песни. Контракт сервиса описан в openapi.yaml.
+package musicinfo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// SongInfoPath это путь метода получения информации о песне.
+const SongInfoPath = "/v1/songs/info"
+
+// DateLayout это формат даты выхода песни в ответе сервиса.
+const DateLayout = time.DateOnly
+
+// maxResponseSize ограничивает размер читаемого тела ответа.
+const maxResponseSize = 1 << 20
+
+var (
+	// ErrSongInfoNotFound сервису неизвестна песня.
+	ErrSongInfoNotFound = errors.New("song info not found")
+
+	// ErrCircuitOpen запросы к сервису временно не выполняются после нескольких неудачных запросов подряд.
+	ErrCircuitOpen = errors.New("music info service circuit is open")
+
+	// ErrInvalidResponse ответ сервиса не соответствует контракту.
+	ErrInvalidResponse = errors.New("invalid music info service response")
+)
+
+// StatusError это ответ сервиса с неожиданным кодом статуса.
+type StatusError struct {
+	Code int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("music info service responded with status %d", e.Code)
+}
+
+// SongInfoResponse это тело успешного ответа метода получения информации о песне.
+type SongInfoResponse struct {
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	Lyrics      string `json:"lyrics,omitempty"`
+	Link        string `json:"link,omitempty"`
+}
+
+// Client это клиент внешнего сервиса информации о песнях.
+// Каждая попытка запроса ограничена по времени, неудачные попытки повторяются с экспоненциальной паузой,
+// а после нескольких неудачных запросов подряд запросы временно не выполняются.
+type Client struct {
+	baseURL      string
+	httpClient   *http.Client
+	timeout      time.Duration
+	retries      int
+	retryBackoff time.Duration
+	breaker      *breaker
+}
+
+// New создает новый клиент сервиса информации о песнях.
+func New(cfg *config.MusicInfoConfig) *Client {
+	return &Client{
+		baseURL:      strings.TrimSuffix(cfg.URL, "/"),
+		httpClient:   &http.Client{},
+		timeout:      cfg.Timeout,
+		retries:      cfg.Retries,
+		retryBackoff: cfg.RetryBackoff,
+		breaker:      newBreaker(cfg.BreakerThreshold, cfg.BreakerCooldown),
+	}
+}
+
+// SongInfo возвращает информацию о песне определенного исполнителя.
+// Отсутствие песни в сервисе не считается неудачным запросом.
+func (c *Client) SongInfo(ctx context.Context, artist, title string) (models.SongInfo, error) {
+	if !c.breaker.allow() {
+		return models.SongInfo{}, ErrCircuitOpen
+	}
+
+	info, err := c.songInfoWithRetries(ctx, artist, title)
+	switch {
+	case err == nil, errors.Is(err, ErrSongInfoNotFound):
+		c.breaker.done(true)
+
+	case ctx.Err() != nil:
+		c.breaker.release()
+
+	default:
+		c.breaker.done(false)
+	}
+
+	return info, err
+}
+
+// songInfoWithRetries запрашивает информацию о песне, повторяя неудачные попытки.
+func (c *Client) songInfoWithRetries(ctx context.Context, artist, title string) (models.SongInfo, error) {
+	backoff := c.retryBackoff
+	for attempt := 0; ; attempt++ {
+		info, err := c.songInfo(ctx, artist, title)
+		if err == nil || !isRetryable(err) || attempt >= c.retries {
+			return info, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return models.SongInfo{}, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// songInfo выполняет одну попытку запроса информации о песне.
+func (c *Client) songInfo(ctx context.Context, artist, title string) (models.SongInfo, error) {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	query := url.Values{"artist": {artist}, "title": {title}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+SongInfoPath+"?"+query.Encode(), nil)
+	if err != nil {
+		return models.SongInfo{}, err
+	}
+	req.Header.Set("Accept", "application/json")
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return models.SongInfo{}, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return models.SongInfo{}, ErrSongInfoNotFound
+	default:
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+		return models.SongInfo{}, &StatusError{Code: resp.StatusCode}
+	}
+
+	var body SongInfoResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&body); err != nil {
+		return models.SongInfo{}, fmt.Errorf("%w: %w", ErrInvalidResponse, err)
+	}
+
+	return body.songInfo()
+}
+
+// songInfo трансформирует тело ответа в модель информации о песне.
+func (r SongInfoResponse) songInfo() (models.SongInfo, error) {
+	info := models.SongInfo{Text: r.Lyrics, Link: r.Link}
+
+	if r.ReleaseDate != "" {
+		releaseDate, err := time.Parse(DateLayout, r.ReleaseDate)
+		if err != nil {
+			return models.SongInfo{}, fmt.Errorf("%w: release date: %w", ErrInvalidResponse, err)
+		}
+		info.ReleaseDate = releaseDate
+	}
+
+	if r.Link != "" {
+		u, err := url.Parse(r.Link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return models.SongInfo{}, fmt.Errorf("%w: link %q", ErrInvalidResponse, r.Link)
+		}
+	}
+
+	return info, nil
+}
+
+// isRetryable проверяет, имеет ли смысл повторить попытку после определенной ошибки:
+// сетевой ошибки, истечения времени попытки, перегрузки или внутренней ошибки сервиса.
+func isRetryable(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code == http.StatusTooManyRequests || statusErr.Code >= http.StatusInternalServerError
+	}
+
+	return !errors.Is(err, ErrSongInfoNotFound) && !errors.Is(err, ErrInvalidResponse)
+}
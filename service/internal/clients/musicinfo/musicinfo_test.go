@@ -0,0 +1,157 @@
+package musicinfo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedonn/song-library-service/internal/clients/musicinfo"
+	"github.com/sedonn/song-library-service/internal/clients/musicinfo/musicinfotest"
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+var stubSong = musicinfotest.Song{
+	Artist: "Muse",
+	Title:  "Supermassive Black Hole",
+	SongInfoResponse: musicinfo.SongInfoResponse{
+		ReleaseDate: "2006-07-16",
+		Lyrics:      "Ooh baby, don't you know I suffer?",
+		Link:        "https://www.youtube.com/watch?v=Xsp3_a-PMTw",
+	},
+}
+
+func newClient(url string) *musicinfo.Client {
+	return musicinfo.New(&config.MusicInfoConfig{
+		URL:              url,
+		Timeout:          200 * time.Millisecond,
+		Retries:          2,
+		RetryBackoff:     time.Millisecond,
+		BreakerThreshold: 2,
+		BreakerCooldown:  time.Hour,
+	})
+}
+
+func TestClient_SongInfo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		artist       string
+		title        string
+		setup        func(s *musicinfotest.Server)
+		want         models.SongInfo
+		wantErr      error
+		wantRequests int
+	}{
+		{
+			name:   "OK",
+			artist: " muse ",
+			title:  "SUPERMASSIVE BLACK HOLE",
+			want: models.SongInfo{
+				ReleaseDate: time.Date(2006, time.July, 16, 0, 0, 0, 0, time.UTC),
+				Text:        "Ooh baby, don't you know I suffer?",
+				Link:        "https://www.youtube.com/watch?v=Xsp3_a-PMTw",
+			},
+			wantRequests: 1,
+		},
+		{
+			name:         "Error song info not found",
+			artist:       "Muse",
+			title:        "Uprising",
+			wantErr:      musicinfo.ErrSongInfoNotFound,
+			wantRequests: 1,
+		},
+		{
+			name:   "OK after retries",
+			artist: "Muse",
+			title:  "Supermassive Black Hole",
+			setup:  func(s *musicinfotest.Server) { s.FailNext(2) },
+			want: models.SongInfo{
+				ReleaseDate: time.Date(2006, time.July, 16, 0, 0, 0, 0, time.UTC),
+				Text:        "Ooh baby, don't you know I suffer?",
+				Link:        "https://www.youtube.com/watch?v=Xsp3_a-PMTw",
+			},
+			wantRequests: 3,
+		},
+		{
+			name:         "Error retries exhausted",
+			artist:       "Muse",
+			title:        "Supermassive Black Hole",
+			setup:        func(s *musicinfotest.Server) { s.FailNext(3) },
+			wantErr:      &musicinfo.StatusError{Code: 503},
+			wantRequests: 3,
+		},
+		{
+			name:         "Error attempt timeout",
+			artist:       "Muse",
+			title:        "Supermassive Black Hole",
+			setup:        func(s *musicinfotest.Server) { s.SetDelay(time.Second) },
+			wantErr:      context.DeadlineExceeded,
+			wantRequests: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := musicinfotest.NewServer(stubSong)
+			defer s.Close()
+			if tt.setup != nil {
+				tt.setup(s)
+			}
+
+			got, err := newClient(s.URL).SongInfo(context.Background(), tt.artist, tt.title)
+			if tt.wantErr != nil {
+				if statusErr, ok := tt.wantErr.(*musicinfo.StatusError); ok {
+					var gotErr *musicinfo.StatusError
+					require.ErrorAsf(t, err, &gotErr, "got %v, want %v", err, tt.wantErr)
+					assert.Equal(t, statusErr.Code, gotErr.Code)
+				} else {
+					assert.ErrorIsf(t, err, tt.wantErr, "got %v, want %v", err, tt.wantErr)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantRequests, s.Requests())
+		})
+	}
+}
+
+func TestClient_SongInfo_CircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	s := musicinfotest.NewServer(stubSong)
+	defer s.Close()
+	s.FailNext(6)
+
+	c := newClient(s.URL)
+	for range 2 {
+		_, err := c.SongInfo(context.Background(), stubSong.Artist, stubSong.Title)
+		require.Error(t, err)
+	}
+	require.Equal(t, 6, s.Requests())
+
+	_, err := c.SongInfo(context.Background(), stubSong.Artist, stubSong.Title)
+	assert.ErrorIs(t, err, musicinfo.ErrCircuitOpen)
+	assert.Equal(t, 6, s.Requests())
+}
+
+func TestClient_SongInfo_InvalidResponse(t *testing.T) {
+	t.Parallel()
+
+	s := musicinfotest.NewServer(musicinfotest.Song{
+		Artist:           "Muse",
+		Title:            "Uprising",
+		SongInfoResponse: musicinfo.SongInfoResponse{ReleaseDate: "16.07.2006"},
+	})
+	defer s.Close()
+
+	_, err := newClient(s.URL).SongInfo(context.Background(), "Muse", "Uprising")
+	assert.ErrorIs(t, err, musicinfo.ErrInvalidResponse)
+	assert.Equal(t, 1, s.Requests())
+}
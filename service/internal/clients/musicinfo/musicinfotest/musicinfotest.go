@@ -0,0 +1,131 @@
+// Package musicinfotest содержит заглушку внешнего сервиса информации о песнях для тестов и локального запуска.
+// Заглушка реализует контракт из openapi.yaml клиента musicinfo и позволяет имитировать сбои сервиса.
+package musicinfotest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/sedonn/song-library-service/internal/clients/musicinfo"
+	"github.com/sedonn/song-library-service/internal/pkg/names"
+)
+
+// Song это песня, о которой известно заглушке.
+type Song struct {
+	Artist string
+	Title  string
+	musicinfo.SongInfoResponse
+}
+
+// Handler это HTTP-обработчик заглушки. Песни ищутся по названиям исполнителя и песни
+// без учета регистра и лишних пробелов.
+type Handler struct {
+	mu       sync.Mutex
+	songs    map[string]musicinfo.SongInfoResponse
+	failures int
+	delay    time.Duration
+	requests int
+}
+
+// NewHandler создает новый обработчик заглушки с определенными песнями.
+func NewHandler(songs ...Song) *Handler {
+	h := &Handler{songs: make(map[string]musicinfo.SongInfoResponse, len(songs))}
+	for _, s := range songs {
+		h.songs[songKey(s.Artist, s.Title)] = s.SongInfoResponse
+	}
+
+	return h
+}
+
+// FailNext отвечает на определенное количество следующих запросов ошибкой 503.
+func (h *Handler) FailNext(n int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.failures = n
+}
+
+// SetDelay задерживает ответы на все следующие запросы на определенное время.
+func (h *Handler) SetDelay(d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.delay = d
+}
+
+// Requests возвращает количество полученных запросов.
+func (h *Handler) Requests() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.requests
+}
+
+// ServeHTTP обрабатывает запрос информации о песне.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet || r.URL.Path != musicinfo.SongInfoPath {
+		http.NotFound(w, r)
+		return
+	}
+
+	artist, title := r.URL.Query().Get("artist"), r.URL.Query().Get("title")
+
+	h.mu.Lock()
+	h.requests++
+	delay, fail := h.delay, h.failures > 0
+	if fail {
+		h.failures--
+	}
+	info, ok := h.songs[songKey(artist, title)]
+	h.mu.Unlock()
+
+	if delay > 0 {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case fail:
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "service unavailable"})
+
+	case artist == "" || title == "":
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "artist and title are required"})
+
+	case !ok:
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "song not found"})
+
+	default:
+		_ = json.NewEncoder(w).Encode(info)
+	}
+}
+
+// Server это HTTP-сервер заглушки для тестов.
+type Server struct {
+	*httptest.Server
+	*Handler
+}
+
+// NewServer запускает новый сервер заглушки с определенными песнями. Сервер необходимо закрыть после использования.
+func NewServer(songs ...Song) *Server {
+	h := NewHandler(songs...)
+
+	return &Server{
+		Server:  httptest.NewServer(h),
+		Handler: h,
+	}
+}
+
+// songKey возвращает ключ песни определенного исполнителя.
+func songKey(artist, title string) string {
+	return names.Normalize(artist) + "\x00" + names.Normalize(title)
+}
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"os"
 	"slices"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -15,14 +16,123 @@ const (
 
 // Config хранит конфигурацию приложения.
 type Config struct {
-	Env  string     `yaml:"env" env-default:"local"`
-	REST RESTConfig `yaml:"rest"`
-	DB   DBConfig   `yaml:"db"`
+	Env       string          `yaml:"env" env-default:"local"`
+	REST      RESTConfig      `yaml:"rest"`
+	GRPC      GRPCConfig      `yaml:"grpc"`
+	GraphQL   GraphQLConfig   `yaml:"graphql"`
+	I18n      I18nConfig      `yaml:"i18n"`
+	Auth      AuthConfig      `yaml:"auth"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Quota     QuotaConfig     `yaml:"quota"`
+	DB        DBConfig        `yaml:"db"`
+	MusicInfo MusicInfoConfig `yaml:"music_info"`
+	LinkCheck LinkCheckConfig `yaml:"link_check"`
+	Jobs      JobsConfig      `yaml:"jobs"`
+	Tracing   TracingConfig   `yaml:"tracing"`
 }
 
 // RESTConfig хранит конфигурацию REST-API сервера.
 type RESTConfig struct {
 	Port int `yaml:"port" env:"REST_PORT"`
+	// MetricsPort это порт сервера метрик в формате Prometheus. Сервер метрик не запускается, если порт не задан.
+	MetricsPort int `yaml:"metrics_port" env:"REST_METRICS_PORT"`
+	// TrustedProxies это адреса и подсети прокси-серверов, которым доверяется заголовок X-Forwarded-For.
+	// Если список пуст, то IP-адрес клиента определяется только по адресу подключения.
+	TrustedProxies []string `yaml:"trusted_proxies" env:"REST_TRUSTED_PROXIES" env-separator:","`
+}
+
+// GRPCConfig хранит конфигурацию gRPC-сервера.
+type GRPCConfig struct {
+	Port int `yaml:"port" env:"GRPC_PORT"`
+}
+
+// GraphQLConfig хранит конфигурацию GraphQL-API.
+type GraphQLConfig struct {
+	// MaxDepth ограничивает вложенность полей запроса, MaxComplexity - количество полей, которые разрешаются
+	// при выполнении запроса с учетом размеров списков.
+	MaxDepth      int `yaml:"max_depth" env:"GRAPHQL_MAX_DEPTH" env-default:"8"`
+	MaxComplexity int `yaml:"max_complexity" env:"GRAPHQL_MAX_COMPLEXITY" env-default:"5000"`
+	// GraphiQL включает страницу GraphiQL по маршруту /graphiql.
+	GraphiQL bool `yaml:"graphiql" env:"GRAPHQL_GRAPHIQL"`
+}
+
+// I18nConfig хранит конфигурацию перевода сообщений API.
+type I18nConfig struct {
+	// FallbackLocale это язык сообщений, если клиент не запросил ни один из поддерживаемых языков (en, ru).
+	FallbackLocale string `yaml:"fallback_locale" env:"I18N_FALLBACK_LOCALE" env-default:"en"`
+}
+
+// AuthConfig хранит конфигурацию аутентификации и авторизации клиентов API.
+type AuthConfig struct {
+	// Enabled включает аутентификацию. Если аутентификация выключена, то API доступно всем без ограничений.
+	Enabled bool `yaml:"enabled" env:"AUTH_ENABLED" env-default:"true"`
+	// APIKeys это статические API-ключи. API-ключи также хранятся в БД.
+	APIKeys []APIKeyConfig `yaml:"api_keys"`
+	JWT     JWTConfig      `yaml:"jwt"`
+	// Roles это шаблоны прав доступа каждой роли в формате ресурс:действие, где вместо ресурса или действия
+	// можно указать *. Если роли не заданы, то используются роли viewer, editor и admin по умолчанию.
+	Roles map[string][]string `yaml:"roles"`
+}
+
+// APIKeyConfig хранит статический API-ключ.
+type APIKeyConfig struct {
+	// Name это название ключа, которое записывается в логи как клиент API.
+	Name string `yaml:"name"`
+	// Hash это SHA-256 от ключа в шестнадцатеричном виде.
+	Hash string `yaml:"hash"`
+	// Roles это роли клиента API.
+	Roles []string `yaml:"roles"`
+	// Tenant это библиотека, к которой привязан ключ. Клиент с ключом без библиотеки работает с библиотекой
+	// по умолчанию, а с правом tenants:switch выбирает библиотеку заголовком X-Tenant-ID.
+	Tenant string `yaml:"tenant"`
+}
+
+// JWTConfig хранит конфигурацию проверки JWT. Токены принимаются, если задан хотя бы один ключ.
+type JWTConfig struct {
+	// HS256Secret это общий секрет для токенов с подписью HS256.
+	HS256Secret string `yaml:"hs256_secret" env:"AUTH_JWT_HS256_SECRET"`
+	// RS256PublicKeyFile это путь к открытому ключу RSA в формате PEM для токенов с подписью RS256.
+	RS256PublicKeyFile string `yaml:"rs256_public_key_file" env:"AUTH_JWT_RS256_PUBLIC_KEY_FILE"`
+	// JWKSFile это путь к набору ключей в формате JWKS.
+	JWKSFile string `yaml:"jwks_file" env:"AUTH_JWT_JWKS_FILE"`
+	// Issuer и Audience это ожидаемые издатель и получатель токена. Не проверяются, если не заданы.
+	Issuer   string `yaml:"issuer" env:"AUTH_JWT_ISSUER"`
+	Audience string `yaml:"audience" env:"AUTH_JWT_AUDIENCE"`
+	// RolesClaim это утверждение токена с ролями клиента в виде массива строк или строки с ролями через пробел.
+	RolesClaim string `yaml:"roles_claim" env:"AUTH_JWT_ROLES_CLAIM" env-default:"roles"`
+	// TenantClaim это утверждение токена с библиотекой, к которой привязан клиент.
+	TenantClaim string `yaml:"tenant_claim" env:"AUTH_JWT_TENANT_CLAIM" env-default:"tenant"`
+	// Leeway это допустимое расхождение часов при проверке сроков действия токена.
+	Leeway time.Duration `yaml:"leeway" env:"AUTH_JWT_LEEWAY" env-default:"30s"`
+}
+
+// RateLimitConfig хранит конфигурацию ограничения частоты запросов к REST-API. Запросы ограничиваются
+// для каждого клиента отдельно: аутентифицированный клиент определяется по API-ключу или субъекту токена,
+// остальные - по IP-адресу. Запросы на чтение (GET, HEAD, OPTIONS) и изменение ограничиваются раздельно.
+type RateLimitConfig struct {
+	Enabled bool `yaml:"enabled" env:"RATE_LIMIT_ENABLED" env-default:"true"`
+	// ReadRate это среднее количество запросов на чтение в секунду, ReadBurst - количество запросов,
+	// которые клиент может выполнить подряд без ожидания.
+	ReadRate  float64 `yaml:"read_rate" env:"RATE_LIMIT_READ_RATE" env-default:"20"`
+	ReadBurst int     `yaml:"read_burst" env:"RATE_LIMIT_READ_BURST" env-default:"40"`
+	// WriteRate и WriteBurst ограничивают запросы на изменение так же, как ReadRate и ReadBurst.
+	WriteRate  float64 `yaml:"write_rate" env:"RATE_LIMIT_WRITE_RATE" env-default:"5"`
+	WriteBurst int     `yaml:"write_burst" env:"RATE_LIMIT_WRITE_BURST" env-default:"10"`
+}
+
+// QuotaConfig хранит конфигурацию суточных квот запросов к REST-API. Количество запросов клиентов
+// за сутки (UTC) хранится в БД.
+type QuotaConfig struct {
+	// Daily это количество запросов, которые клиент может выполнить за сутки. Квоты не проверяются, если
+	// значение не задано.
+	Daily uint64 `yaml:"daily" env:"QUOTA_DAILY"`
+	// Clients это квоты отдельных клиентов вместо Daily. Клиент задается так же, как в логах:
+	// api_key:название, jwt:субъект или ip:адрес.
+	Clients map[string]uint64 `yaml:"clients"`
+	// Batch это количество запросов, которые резервируются в квоте клиента одним запросом к БД. Экземпляр
+	// микросервиса расходует резерв без обращения к БД, а неизрасходованный резерв считается использованным,
+	// поэтому при перезапуске клиент теряет не более Batch-1 запросов квоты на каждый экземпляр.
+	Batch uint64 `yaml:"batch" env:"QUOTA_BATCH" env-default:"10"`
 }
 
 // DBConfig хранит конфигурацию подключения к базе данных.
@@ -34,6 +144,73 @@ type DBConfig struct {
 	Database string `yaml:"database" env:"DB_NAME" env-required:"true"`
 }
 
+// MusicInfoConfig хранит конфигурацию клиента внешнего сервиса информации о песнях.
+// Если адрес сервиса не задан, то данные новых песен не дополняются.
+type MusicInfoConfig struct {
+	URL string `yaml:"url" env:"MUSIC_INFO_URL"`
+	// Timeout ограничивает время одной попытки запроса.
+	Timeout time.Duration `yaml:"timeout" env:"MUSIC_INFO_TIMEOUT" env-default:"2s"`
+	// Retries это количество повторных попыток после неудачной. Пауза перед каждой следующей попыткой удваивается,
+	// начиная с RetryBackoff.
+	Retries      int           `yaml:"retries" env:"MUSIC_INFO_RETRIES" env-default:"2"`
+	RetryBackoff time.Duration `yaml:"retry_backoff" env:"MUSIC_INFO_RETRY_BACKOFF" env-default:"100ms"`
+	// BreakerThreshold это количество неудачных запросов подряд, после которого запросы не выполняются
+	// в течение BreakerCooldown.
+	BreakerThreshold int           `yaml:"breaker_threshold" env:"MUSIC_INFO_BREAKER_THRESHOLD" env-default:"5"`
+	BreakerCooldown  time.Duration `yaml:"breaker_cooldown" env:"MUSIC_INFO_BREAKER_COOLDOWN" env-default:"30s"`
+}
+
+// LinkCheckConfig хранит конфигурацию периодической проверки доступности ссылок песен.
+type LinkCheckConfig struct {
+	// Timeout ограничивает время проверки одной ссылки.
+	Timeout time.Duration `yaml:"timeout" env:"LINK_CHECK_TIMEOUT" env-default:"10s"`
+	// Concurrency это количество одновременных запросов по ссылкам.
+	Concurrency int `yaml:"concurrency" env:"LINK_CHECK_CONCURRENCY" env-default:"8"`
+	// HostInterval это минимальная пауза между запросами к одному хосту.
+	HostInterval time.Duration `yaml:"host_interval" env:"LINK_CHECK_HOST_INTERVAL" env-default:"1s"`
+	// ScheduleInterval это пауза между поисками ссылок, которые пора проверить.
+	ScheduleInterval time.Duration `yaml:"schedule_interval" env:"LINK_CHECK_SCHEDULE_INTERVAL" env-default:"10m"`
+	// RecheckAfter это время, после которого ссылка проверяется снова.
+	RecheckAfter time.Duration `yaml:"recheck_after" env:"LINK_CHECK_RECHECK_AFTER" env-default:"24h"`
+	// BatchSize это максимальное количество ссылок, проверка которых ставится в очередь за один раз.
+	BatchSize int `yaml:"batch_size" env:"LINK_CHECK_BATCH_SIZE" env-default:"500"`
+	// AllowPrivateNetworks разрешает проверку ссылок на адреса локальных и внутренних сетей. По умолчанию
+	// такие ссылки не проверяются, чтобы через ссылки песен нельзя было обращаться к внутренним сервисам.
+	AllowPrivateNetworks bool `yaml:"allow_private_networks" env:"LINK_CHECK_ALLOW_PRIVATE_NETWORKS"`
+}
+
+// JobsConfig хранит конфигурацию фоновой обработки задач.
+type JobsConfig struct {
+	// Workers это количество одновременно выполняемых задач.
+	Workers int `yaml:"workers" env:"JOBS_WORKERS" env-default:"4"`
+	// PollInterval это пауза между проверками очереди, если в ней нет задач.
+	PollInterval time.Duration `yaml:"poll_interval" env:"JOBS_POLL_INTERVAL" env-default:"1s"`
+	// Lease это время, после которого незавершенная задача считается брошенной и снова берется в работу.
+	Lease time.Duration `yaml:"lease" env:"JOBS_LEASE" env-default:"5m"`
+	// MaxAttempts это количество попыток, после которого задача больше не выполняется.
+	MaxAttempts uint32 `yaml:"max_attempts" env:"JOBS_MAX_ATTEMPTS" env-default:"5"`
+	// RetryBackoff это пауза перед второй попыткой. Пауза перед каждой следующей попыткой удваивается,
+	// но не превышает MaxRetryBackoff.
+	RetryBackoff    time.Duration `yaml:"retry_backoff" env:"JOBS_RETRY_BACKOFF" env-default:"10s"`
+	MaxRetryBackoff time.Duration `yaml:"max_retry_backoff" env:"JOBS_MAX_RETRY_BACKOFF" env-default:"10m"`
+	// ShutdownTimeout ограничивает ожидание выполняемых задач при остановке, после чего задачи отменяются.
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"JOBS_SHUTDOWN_TIMEOUT" env-default:"30s"`
+}
+
+// TracingConfig хранит конфигурацию трассировки OpenTelemetry.
+type TracingConfig struct {
+	// Exporter это способ экспорта спанов: none - трассировка выключена, otlp - по протоколу OTLP/HTTP,
+	// stdout - в стандартный вывод или файл File.
+	Exporter string `yaml:"exporter" env:"TRACING_EXPORTER" env-default:"none"`
+	// OTLPEndpoint это адрес приемника OTLP/HTTP, например http://localhost:4318/v1/traces. Если адрес не задан,
+	// то используются стандартные переменные окружения OTEL_EXPORTER_OTLP_*.
+	OTLPEndpoint string `yaml:"otlp_endpoint" env:"TRACING_OTLP_ENDPOINT"`
+	// File это файл, в который дописываются спаны при экспорте stdout.
+	File string `yaml:"file" env:"TRACING_FILE"`
+	// SampleRatio это доля записываемых трассировок, если решение о записи не принято вызывающей стороной.
+	SampleRatio float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+}
+
 // MustLoad загружает текущую конфигурацию микросервиса на основе пути к файлу конфигурации,
 // получаемого из флага запуска или переменной окружения.
 //
@@ -0,0 +1,60 @@
+package graphqlapi
+
+import (
+	"errors"
+
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// Коды ошибок, которые возвращаются в поле extensions.code ошибок GraphQL.
+const (
+	codeBadUserInput    = "BAD_USER_INPUT"
+	codeNotFound        = "NOT_FOUND"
+	codeForbidden       = "FORBIDDEN"
+	codeQueryTooDeep    = "QUERY_TOO_DEEP"
+	codeQueryTooComplex = "QUERY_TOO_COMPLEX"
+	codeInternal        = "INTERNAL_SERVER_ERROR"
+)
+
+// Error это ошибка GraphQL-API с кодом ошибки.
+type Error struct {
+	Code    string
+	Message string
+}
+
+// Error возвращает текст ошибки.
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Extensions возвращает дополнительные поля ошибки GraphQL.
+func (e *Error) Extensions() map[string]any {
+	return map[string]any{"code": e.Code}
+}
+
+// errorCodes сопоставляет ошибки бизнес-логики кодам ошибок GraphQL-API.
+var errorCodes = []struct {
+	err  error
+	code string
+}{
+	{services.ErrSongNotFound, codeNotFound},
+	{services.ErrArtistNotFound, codeNotFound},
+	{services.ErrPageNumberOutOfRange, codeBadUserInput},
+	{services.ErrForbidden, codeForbidden},
+}
+
+// resolveError преобразует ошибку бизнес-логики в ошибку GraphQL-API. Текст неизвестных ошибок скрывается.
+func resolveError(err error) error {
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			return &Error{Code: c.code, Message: err.Error()}
+		}
+	}
+
+	return &Error{Code: codeInternal, Message: "internal server error"}
+}
+
+// badUserInput создает ошибку некорректных аргументов запроса.
+func badUserInput(err error) error {
+	return &Error{Code: codeBadUserInput, Message: err.Error()}
+}
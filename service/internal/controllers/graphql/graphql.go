@@ -0,0 +1,74 @@
+// Package graphqlapi содержит GraphQL-API исполнителей и песен.
+package graphqlapi
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// QueryPath это маршрут запросов GraphQL-API. Схема не содержит мутаций, поэтому запросы только читают данные.
+const QueryPath = "/graphql"
+
+// ArtistService описывает поведение объекта, который обеспечивает бизнес-логику работы с исполнителями.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=ArtistService
+type ArtistService interface {
+	// GetArtist получает данные определенного исполнителя.
+	GetArtist(ctx context.Context, id uint64) (models.ArtistAPI, error)
+	// SearchArtists выполняет поиск исполнителей по определенным параметрам.
+	// Исполнители упорядочены по названию для сортировки.
+	SearchArtists(ctx context.Context, attrs models.Artist, p models.Pagination) (models.ArtistsAPI, error)
+}
+
+// SongService описывает поведение объекта, который обеспечивает бизнес-логику работы с песнями.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=SongService
+type SongService interface {
+	// GetSong возвращает определенную песню с полным текстом.
+	GetSong(ctx context.Context, id uint64) (models.SongAPI, error)
+	// SearchSongs выполняет поиск песен по определенным параметрам.
+	SearchSongs(ctx context.Context, attrs models.Song, p models.Pagination, withFacets bool) (models.SongsAPI, error)
+	// GetArtistsSongs возвращает не более limit первых песен каждого из определенных исполнителей одним запросом.
+	GetArtistsSongs(ctx context.Context, artistIDs []uint64, limit int) (map[uint64][]models.SongAPI, error)
+}
+
+// Endpoints это конечные точки GraphQL-API.
+type Endpoints struct {
+	cfg           *config.GraphQLConfig
+	schema        graphql.Schema
+	artistService ArtistService
+	songService   SongService
+}
+
+// New создает новый объект конечных точек GraphQL-API. Паникует, если схема GraphQL некорректна.
+func New(cfg *config.GraphQLConfig, as ArtistService, ss SongService) *Endpoints {
+	e := &Endpoints{
+		cfg:           cfg,
+		artistService: as,
+		songService:   ss,
+	}
+
+	schema, err := e.newSchema()
+	if err != nil {
+		panic("failed to build graphql schema: " + err.Error())
+	}
+	e.schema = schema
+
+	return e
+}
+
+// BindTo привязывает конечные точки к определенному маршрутизатору.
+// Страница GraphiQL подключается, только если она включена в конфигурации.
+// Middleware применяются только к конечной точке запросов, страница GraphiQL доступна без них.
+func (e *Endpoints) BindTo(router *gin.Engine, middlewares ...gin.HandlerFunc) {
+	router.POST(QueryPath, append(middlewares, e.queryHandler)...)
+
+	if e.cfg.GraphiQL {
+		router.GET("/graphiql", graphiQLHandler)
+	}
+}
@@ -0,0 +1,214 @@
+package graphqlapi
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/controllers/graphql/mocks"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+var (
+	errUnexpected = errors.New("unexpected error")
+	testConfig    = &config.GraphQLConfig{MaxDepth: 6, MaxComplexity: 1000}
+	muse          = models.ArtistAPI{
+		ArtistIDAPI:         models.ArtistIDAPI{ID: 1},
+		ArtistAttributesAPI: models.ArtistAttributesAPI{Name: "Muse", Type: models.ArtistTypeGroup},
+	}
+	radiohead = models.ArtistAPI{
+		ArtistIDAPI:         models.ArtistIDAPI{ID: 2},
+		ArtistAttributesAPI: models.ArtistAttributesAPI{Name: "Radiohead", Type: models.ArtistTypeGroup},
+	}
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	os.Exit(m.Run())
+}
+
+// song создает песню определенного исполнителя.
+func song(id uint64, name string, a models.ArtistAPI) models.SongAPI {
+	return models.SongAPI{
+		SongIDAPI:         models.SongIDAPI{ID: id},
+		SongAttributesAPI: models.SongAttributesAPI{Name: name},
+		Artist:            a,
+	}
+}
+
+// response это ответ на GraphQL-запрос.
+type response struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+// query выполняет GraphQL-запрос к конечной точке /graphql.
+func query(t *testing.T, as ArtistService, ss SongService, q string) response {
+	t.Helper()
+
+	router := gin.New()
+	New(testConfig, as, ss).BindTo(router)
+
+	body, err := json.Marshal(QueryRequest{Query: q})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body))))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	return resp
+}
+
+func TestEndpoints_Query(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		query     string
+		setup     func(as *mocks.ArtistService, ss *mocks.SongService)
+		wantData  string
+		wantCodes []string
+	}{
+		{
+			name:  "Query song with artist and artist songs",
+			query: `{ song(id: "1") { name artist { name songs(first: 2) { id name } } } }`,
+			setup: func(_ *mocks.ArtistService, ss *mocks.SongService) {
+				ss.On("GetSong", mock.Anything, uint64(1)).Once().Return(song(1, "Uprising", muse), nil)
+				ss.
+					On("GetArtistsSongs", mock.Anything, []uint64{1}, 2).
+					Once().
+					Return(map[uint64][]models.SongAPI{1: {song(1, "Uprising", muse), song(2, "Starlight", muse)}}, nil)
+			},
+			wantData: `{"song": {"name": "Uprising", "artist": {"name": "Muse", "songs": [
+				{"id": "1", "name": "Uprising"},
+				{"id": "2", "name": "Starlight"}
+			]}}}`,
+		},
+		{
+			name:  "Query artists songs loaded in one batch",
+			query: `{ artists(pageSize: 20) { nodes { name songs(first: 1) { name } } pageInfo { recordCount hasNextPage } } }`,
+			setup: func(as *mocks.ArtistService, ss *mocks.SongService) {
+				as.
+					On("SearchArtists", mock.Anything, models.Artist{}, models.Pagination{PageNumber: 1, PageSize: 20}).
+					Once().
+					Return(models.ArtistsAPI{
+						Artists:    []models.ArtistAPI{muse, radiohead},
+						Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 1, PageSize: 20, RecordCount: 2},
+					}, nil)
+				ss.
+					On("GetArtistsSongs", mock.Anything, []uint64{1, 2}, 1).
+					Once().
+					Return(map[uint64][]models.SongAPI{1: {song(1, "Uprising", muse)}}, nil)
+			},
+			wantData: `{"artists": {"nodes": [
+				{"name": "Muse", "songs": [{"name": "Uprising"}]},
+				{"name": "Radiohead", "songs": []}
+			], "pageInfo": {"recordCount": 2, "hasNextPage": false}}}`,
+		},
+		{
+			name:  "Query songs by filter",
+			query: `{ songs(filter: {name: "rising", genres: ["rock"], linkStatus: BROKEN}) { nodes { id } } }`,
+			setup: func(_ *mocks.ArtistService, ss *mocks.SongService) {
+				ss.
+					On("SearchSongs", mock.Anything, models.Song{
+						Name:       "rising",
+						LinkHealth: models.LinkHealth{Status: models.LinkStatusBroken},
+						Tags:       models.TagsFromNames(models.TagKindGenre, []string{"rock"}),
+					}, models.Pagination{PageNumber: 1, PageSize: defaultPageSize}, false).
+					Once().
+					Return(models.SongsAPI{Songs: []models.SongAPI{song(1, "Uprising", muse)}}, nil)
+			},
+			wantData: `{"songs": {"nodes": [{"id": "1"}]}}`,
+		},
+		{
+			name:  "Query error song not found",
+			query: `{ song(id: "1") { name } }`,
+			setup: func(_ *mocks.ArtistService, ss *mocks.SongService) {
+				ss.On("GetSong", mock.Anything, uint64(1)).Once().Return(models.SongAPI{}, services.ErrSongNotFound)
+			},
+			wantData:  `{"song": null}`,
+			wantCodes: []string{codeNotFound},
+		},
+		{
+			name:  "Query error unexpected",
+			query: `{ artist(id: "1") { name } }`,
+			setup: func(as *mocks.ArtistService, _ *mocks.SongService) {
+				as.On("GetArtist", mock.Anything, uint64(1)).Once().Return(models.ArtistAPI{}, errUnexpected)
+			},
+			wantData:  `{"artist": null}`,
+			wantCodes: []string{codeInternal},
+		},
+		{
+			name:      "Query error invalid id",
+			query:     `{ artist(id: "abc") { name } }`,
+			wantData:  `{"artist": null}`,
+			wantCodes: []string{codeBadUserInput},
+		},
+		{
+			name:      "Query error page size too large",
+			query:     `{ songs(pageSize: 1000) { nodes { id } } }`,
+			wantData:  `null`,
+			wantCodes: []string{codeBadUserInput},
+		},
+		{
+			name:      "Query error too deep",
+			query:     `{ song(id: "1") { artist { songs { artist { songs { artist { songs { id } } } } } } } }`,
+			wantData:  `null`,
+			wantCodes: []string{codeQueryTooDeep},
+		},
+		{
+			name:      "Query error too complex",
+			query:     `{ artists(pageSize: 100) { nodes { songs(first: 100) { id } } } }`,
+			wantData:  `null`,
+			wantCodes: []string{codeQueryTooComplex},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			as, ss := mocks.NewArtistService(t), mocks.NewSongService(t)
+			if tt.setup != nil {
+				tt.setup(as, ss)
+			}
+
+			resp := query(t, as, ss, tt.query)
+
+			codes := make([]string, 0, len(resp.Errors))
+			for _, e := range resp.Errors {
+				code, _ := e.Extensions["code"].(string)
+				codes = append(codes, code)
+			}
+			assert.ElementsMatchf(t, tt.wantCodes, codes, "Endpoints.query() errors = %v, wantCodes %v", resp.Errors, tt.wantCodes)
+			assert.JSONEq(t, tt.wantData, string(resp.Data))
+		})
+	}
+}
+
+func TestEndpoints_Query_Introspection(t *testing.T) {
+	t.Parallel()
+
+	resp := query(t, mocks.NewArtistService(t), mocks.NewSongService(t),
+		`{ __schema { types { name fields { name type { name ofType { name ofType { name ofType { name } } } } } } } }`)
+	assert.Empty(t, resp.Errors)
+	assert.Contains(t, string(resp.Data), `"SongConnection"`)
+}
@@ -0,0 +1,58 @@
+package graphqlapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// QueryRequest это GraphQL-запрос.
+type QueryRequest struct {
+	Query         string         `json:"query" binding:"required"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// queryHandler это хендлер, который выполняет GraphQL-запросы.
+// Ошибки разбора, проверки и выполнения запроса возвращаются в теле ответа со статусом 200.
+func (e *Endpoints) queryHandler(ctx *gin.Context) {
+	var req QueryRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, e.execute(ctx.Request.Context(), req))
+}
+
+// execute разбирает и проверяет запрос, проверяет ограничения глубины и сложности и выполняет запрос.
+// Для каждого запроса создаются свои загрузчики данных.
+func (e *Endpoints) execute(ctx context.Context, req QueryRequest) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(req.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	if vr := graphql.ValidateDocument(&e.schema, doc, nil); !vr.IsValid {
+		return &graphql.Result{Errors: vr.Errors}
+	}
+
+	if err := checkLimits(doc, req.OperationName, req.Variables, e.cfg.MaxDepth, e.cfg.MaxComplexity); err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(gqlerrors.NewError(err.Error(), nil, "", nil, nil, err))}
+	}
+
+	return graphql.Execute(graphql.ExecuteParams{
+		Schema:        e.schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       withLoaders(ctx, e.songService),
+	})
+}
@@ -0,0 +1,143 @@
+package graphqlapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// listSizeArgs это аргументы, которые задают количество элементов списка.
+var listSizeArgs = []string{"pageSize", "first"}
+
+// listFields это поля со списками и количество элементов в них по умолчанию.
+var listFields = map[string]int{
+	"songs":   defaultPageSize,
+	"artists": defaultPageSize,
+}
+
+// checkLimits проверяет, что глубина и сложность выполняемой операции не превышают допустимые.
+//
+// Глубина это наибольшая вложенность полей. Сложность это количество полей, которые будут разрешены:
+// каждое поле стоит 1, а сложность вложенных полей списка умножается на количество его элементов.
+// Служебные поля интроспекции не учитываются.
+func checkLimits(doc *ast.Document, operationName string, variables map[string]any, maxDepth, maxComplexity int) error {
+	a := analyzer{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+	}
+
+	var op *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.FragmentDefinition:
+			a.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if operationName == "" || (d.Name != nil && d.Name.Value == operationName) {
+				op = d
+			}
+		}
+	}
+	if op == nil {
+		return nil
+	}
+
+	depth, complexity := a.measure(op.SelectionSet)
+	if depth > maxDepth {
+		return &Error{
+			Code:    codeQueryTooDeep,
+			Message: fmt.Sprintf("query depth %d exceeds maximum depth %d", depth, maxDepth),
+		}
+	}
+	if complexity > maxComplexity {
+		return &Error{
+			Code:    codeQueryTooComplex,
+			Message: fmt.Sprintf("query complexity %d exceeds maximum complexity %d", complexity, maxComplexity),
+		}
+	}
+
+	return nil
+}
+
+// analyzer вычисляет глубину и сложность операции.
+type analyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+}
+
+// measure возвращает глубину и сложность набора полей. Циклы фрагментов исключаются проверкой запроса.
+func (a *analyzer) measure(set *ast.SelectionSet) (depth, complexity int) {
+	if set == nil {
+		return 0, 0
+	}
+
+	for _, sel := range set.Selections {
+		var d, c int
+
+		switch s := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name.Value, "__") {
+				continue
+			}
+
+			d, c = a.measure(s.SelectionSet)
+			d, c = d+1, 1+c*a.listSize(s)
+
+		case *ast.InlineFragment:
+			d, c = a.measure(s.SelectionSet)
+
+		case *ast.FragmentSpread:
+			if f, ok := a.fragments[s.Name.Value]; ok {
+				d, c = a.measure(f.SelectionSet)
+			}
+		}
+
+		depth = max(depth, d)
+		complexity += c
+	}
+
+	return depth, complexity
+}
+
+// listSize возвращает количество элементов, которое вернет поле. Для полей, которые не являются списками, возвращает 1.
+// Количество ограничено наибольшим размером страницы, потому что больший размер не пройдет проверку аргументов.
+func (a *analyzer) listSize(f *ast.Field) int {
+	size, ok := listFields[f.Name.Value]
+	if !ok {
+		return 1
+	}
+
+	for _, arg := range f.Arguments {
+		for _, name := range listSizeArgs {
+			if arg.Name.Value != name {
+				continue
+			}
+
+			if n, ok := a.intValue(arg.Value); ok {
+				size = n
+			}
+		}
+	}
+
+	return min(max(size, 1), maxPageSize)
+}
+
+// intValue возвращает целое значение аргумента, заданное в запросе или через переменную.
+func (a *analyzer) intValue(v ast.Value) (int, bool) {
+	switch v := v.(type) {
+	case *ast.IntValue:
+		n, err := strconv.Atoi(v.Value)
+		return n, err == nil
+
+	case *ast.Variable:
+		switch n := a.variables[v.Name.Value].(type) {
+		case float64:
+			return int(n), true
+		case int:
+			return n, true
+		}
+	}
+
+	return 0, false
+}
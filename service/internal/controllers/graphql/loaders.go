@@ -0,0 +1,48 @@
+package graphqlapi
+
+import (
+	"context"
+	"sync"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/dataloader"
+)
+
+// loadersKey это ключ загрузчиков данных запроса в контексте.
+type loadersKey struct{}
+
+// loaders это загрузчики данных одного GraphQL-запроса.
+type loaders struct {
+	songService SongService
+
+	mu sync.Mutex
+	// artistSongs загружают песни исполнителей. Загрузчик создается для каждого запрошенного количества песен,
+	// чтобы одним пакетом загружались песни с одинаковым ограничением.
+	artistSongs map[int]*dataloader.Loader[uint64, []models.SongAPI]
+}
+
+// withLoaders добавляет в контекст новые загрузчики данных.
+func withLoaders(ctx context.Context, ss SongService) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{
+		songService: ss,
+		artistSongs: make(map[int]*dataloader.Loader[uint64, []models.SongAPI]),
+	})
+}
+
+// loadArtistSongs откладывает загрузку не более limit первых песен определенного исполнителя.
+// Песни всех исполнителей одного уровня запроса загружаются одним пакетом.
+func loadArtistSongs(ctx context.Context, artistID uint64, limit int) func() ([]models.SongAPI, error) {
+	l := ctx.Value(loadersKey{}).(*loaders)
+
+	l.mu.Lock()
+	loader, ok := l.artistSongs[limit]
+	if !ok {
+		loader = dataloader.New(func(ctx context.Context, artistIDs []uint64) (map[uint64][]models.SongAPI, error) {
+			return l.songService.GetArtistsSongs(ctx, artistIDs, limit)
+		})
+		l.artistSongs[limit] = loader
+	}
+	l.mu.Unlock()
+
+	return loader.Load(ctx, artistID)
+}
@@ -0,0 +1,537 @@
+package graphqlapi
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/graphql-go/graphql"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// Размеры страниц по умолчанию и наибольший размер страницы.
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+)
+
+// releaseDateLayout это формат даты выхода песни.
+const releaseDateLayout = time.DateOnly
+
+// songsFilter это параметры поиска песен с теми же правилами проверки, что и у REST-API.
+type songsFilter struct {
+	Language string   `binding:"omitempty,lte=8"`
+	Genres   []string `binding:"omitempty,dive,lte=64"`
+	Tags     []string `binding:"omitempty,dive,lte=64"`
+}
+
+// artistsFilter это параметры поиска исполнителей с теми же правилами проверки, что и у REST-API.
+type artistsFilter struct {
+	Country string `binding:"omitempty,iso3166_1_alpha2"`
+}
+
+// newSchema создает схему GraphQL-API.
+func (e *Endpoints) newSchema() (graphql.Schema, error) {
+	artistTypeEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "ArtistType",
+		Values: graphql.EnumValueConfigMap{
+			"PERSON": &graphql.EnumValueConfig{Value: models.ArtistTypePerson},
+			"GROUP":  &graphql.EnumValueConfig{Value: models.ArtistTypeGroup},
+		},
+	})
+
+	linkStatusEnum := graphql.NewEnum(graphql.EnumConfig{
+		Name:        "LinkStatus",
+		Description: "Статус ссылки по результату последней проверки доступности.",
+		Values: graphql.EnumValueConfigMap{
+			"OK":        &graphql.EnumValueConfig{Value: models.LinkStatusOK},
+			"BROKEN":    &graphql.EnumValueConfig{Value: models.LinkStatusBroken},
+			"UNCHECKED": &graphql.EnumValueConfig{Value: models.LinkStatusUnchecked},
+		},
+	})
+
+	pageInfoType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "PageInfo",
+		Description: "Данные страницы списка.",
+		Fields: graphql.Fields{
+			"currentPageNumber": pageInfoField(func(m models.PaginationMetadataAPI) any { return m.CurrentPageNumber }),
+			"pageCount":         pageInfoField(func(m models.PaginationMetadataAPI) any { return m.PageCount }),
+			"pageSize":          pageInfoField(func(m models.PaginationMetadataAPI) any { return m.PageSize }),
+			"recordCount":       pageInfoField(func(m models.PaginationMetadataAPI) any { return m.RecordCount }),
+			"hasNextPage": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					m := p.Source.(models.PaginationMetadataAPI)
+					return m.CurrentPageNumber < m.PageCount, nil
+				},
+			},
+		},
+	})
+
+	linkHealthType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "LinkHealth",
+		Description: "Результат последней проверки доступности ссылки.",
+		Fields: graphql.Fields{
+			"status": &graphql.Field{
+				Type: graphql.NewNonNull(linkStatusEnum),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.LinkHealthAPI).Status, nil
+				},
+			},
+			"statusCode": &graphql.Field{
+				Type: graphql.Int,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if code := p.Source.(models.LinkHealthAPI).StatusCode; code != 0 {
+						return code, nil
+					}
+					return nil, nil
+				},
+			},
+			"checkedAt": &graphql.Field{
+				Type: graphql.DateTime,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.LinkHealthAPI).CheckedAt, nil
+				},
+			},
+			"failureStreak": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Int),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.LinkHealthAPI).FailureStreak, nil
+				},
+			},
+		},
+	})
+
+	var songType *graphql.Object
+
+	artistType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Artist",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"id": artistField(graphql.NewNonNull(graphql.ID), func(a models.ArtistAPI) any {
+					return strconv.FormatUint(a.ID, 10)
+				}),
+				"name":     artistField(graphql.NewNonNull(graphql.String), func(a models.ArtistAPI) any { return a.Name }),
+				"sortName": artistField(graphql.NewNonNull(graphql.String), func(a models.ArtistAPI) any { return a.SortName }),
+				"country":  artistField(graphql.String, func(a models.ArtistAPI) any { return nonEmpty(a.Country) }),
+				"type":     artistField(artistTypeEnum, func(a models.ArtistAPI) any { return nonEmpty(a.Type) }),
+				"formedYear": artistField(graphql.Int, func(a models.ArtistAPI) any {
+					return nonZero(a.FormedYear)
+				}),
+				"disbandedYear": artistField(graphql.Int, func(a models.ArtistAPI) any {
+					return nonZero(a.DisbandedYear)
+				}),
+				"bio": artistField(graphql.String, func(a models.ArtistAPI) any { return nonEmpty(a.Bio) }),
+				"links": artistField(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))), func(a models.ArtistAPI) any {
+					if a.Links == nil {
+						return []string{}
+					}
+					return a.Links
+				}),
+				"isni": artistField(graphql.String, func(a models.ArtistAPI) any { return nonEmpty(a.ISNI) }),
+				"mbid": artistField(graphql.String, func(a models.ArtistAPI) any { return nonEmpty(a.MBID) }),
+				"songs": &graphql.Field{
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(songType))),
+					Description: "Первые песни исполнителя. Песни всех исполнителей одного уровня запроса загружаются одним пакетом.",
+					Args: graphql.FieldConfigArgument{
+						"first": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultPageSize},
+					},
+					Resolve: e.resolveArtistSongs,
+				},
+			}
+		}),
+	})
+
+	songCreditType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "SongCredit",
+		Description: "Участие исполнителя в создании песни.",
+		Fields: graphql.Fields{
+			"role": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.SongCreditAPI).Role, nil
+				},
+			},
+			"artist": &graphql.Field{
+				Type: graphql.NewNonNull(artistType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.SongCreditAPI).Artist, nil
+				},
+			},
+		},
+	})
+
+	songType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Song",
+		Fields: graphql.Fields{
+			"id": songField(graphql.NewNonNull(graphql.ID), func(s models.SongAPI) any {
+				return strconv.FormatUint(s.ID, 10)
+			}),
+			"name": songField(graphql.NewNonNull(graphql.String), func(s models.SongAPI) any { return s.Name }),
+			"releaseDate": songField(graphql.String, func(s models.SongAPI) any {
+				if s.ReleaseDate.IsZero() {
+					return nil
+				}
+				return s.ReleaseDate.Format(releaseDateLayout)
+			}),
+			"text":         songField(graphql.String, func(s models.SongAPI) any { return nonEmpty(s.Text) }),
+			"link":         songField(graphql.String, func(s models.SongAPI) any { return nonEmpty(s.Link) }),
+			"linkProvider": songField(graphql.String, func(s models.SongAPI) any { return nonEmpty(s.LinkProvider) }),
+			"linkMediaId":  songField(graphql.String, func(s models.SongAPI) any { return nonEmpty(s.LinkMediaID) }),
+			"linkHealth":   songField(graphql.NewNonNull(linkHealthType), func(s models.SongAPI) any { return s.LinkHealth }),
+			"isrc":         songField(graphql.String, func(s models.SongAPI) any { return nonEmpty(s.ISRC) }),
+			"iswc":         songField(graphql.String, func(s models.SongAPI) any { return nonEmpty(s.ISWC) }),
+			"language":     songField(graphql.String, func(s models.SongAPI) any { return nonEmpty(s.Language) }),
+			"genres": songField(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))), func(s models.SongAPI) any {
+				return nonNilStrings(s.Genres)
+			}),
+			"tags": songField(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))), func(s models.SongAPI) any {
+				return nonNilStrings(s.Tags)
+			}),
+			"credits": songField(graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(songCreditType))), func(s models.SongAPI) any {
+				if s.Credits == nil {
+					return []models.SongCreditAPI{}
+				}
+				return s.Credits
+			}),
+			"artist": &graphql.Field{
+				Type:        graphql.NewNonNull(artistType),
+				Description: "Исполнитель песни. Загружается вместе с песней без отдельного запроса.",
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.SongAPI).Artist, nil
+				},
+			},
+		},
+	})
+
+	songConnectionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SongConnection",
+		Fields: graphql.Fields{
+			"nodes": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(songType))),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.SongsAPI).Songs, nil
+				},
+			},
+			"pageInfo": &graphql.Field{
+				Type: graphql.NewNonNull(pageInfoType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.SongsAPI).Pagination, nil
+				},
+			},
+		},
+	})
+
+	artistConnectionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ArtistConnection",
+		Fields: graphql.Fields{
+			"nodes": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(artistType))),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.ArtistsAPI).Artists, nil
+				},
+			},
+			"pageInfo": &graphql.Field{
+				Type: graphql.NewNonNull(pageInfoType),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return p.Source.(models.ArtistsAPI).Pagination, nil
+				},
+			},
+		},
+	})
+
+	songsFilterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "SongsFilter",
+		Description: "Параметры поиска песен. Строковые параметры, кроме языка, ищутся по подстроке.",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":               &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"artistName":         &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"creditedArtistName": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"link":               &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"language":           &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"genres":             &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+			"tags":               &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(graphql.String))},
+			"linkStatus":         &graphql.InputObjectFieldConfig{Type: linkStatusEnum},
+		},
+	})
+
+	artistsFilterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:        "ArtistsFilter",
+		Description: "Параметры поиска исполнителей. Название ищется по подстроке названия или любого псевдонима.",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":    &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"country": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"type":    &graphql.InputObjectFieldConfig{Type: artistTypeEnum},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"song": &graphql.Field{
+				Type: songType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: e.resolveSong,
+			},
+			"artist": &graphql.Field{
+				Type: artistType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.ID)},
+				},
+				Resolve: e.resolveArtist,
+			},
+			"songs": &graphql.Field{
+				Type: graphql.NewNonNull(songConnectionType),
+				Args: paginationArgs(graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: songsFilterType},
+				}),
+				Resolve: e.resolveSongs,
+			},
+			"artists": &graphql.Field{
+				Type: graphql.NewNonNull(artistConnectionType),
+				Args: paginationArgs(graphql.FieldConfigArgument{
+					"filter": &graphql.ArgumentConfig{Type: artistsFilterType},
+				}),
+				Resolve: e.resolveArtists,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+// resolveSong возвращает определенную песню.
+func (e *Endpoints) resolveSong(p graphql.ResolveParams) (any, error) {
+	id, err := parseID(p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	song, err := e.songService.GetSong(p.Context, id)
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return song, nil
+}
+
+// resolveArtist возвращает определенного исполнителя.
+func (e *Endpoints) resolveArtist(p graphql.ResolveParams) (any, error) {
+	id, err := parseID(p.Args["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := e.artistService.GetArtist(p.Context, id)
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return a, nil
+}
+
+// resolveSongs выполняет поиск песен.
+func (e *Endpoints) resolveSongs(p graphql.ResolveParams) (any, error) {
+	pagination, err := parsePagination(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, _ := p.Args["filter"].(map[string]any)
+	genres, tags := stringsArg(filter, "genres"), stringsArg(filter, "tags")
+	if err := binding.Validator.ValidateStruct(songsFilter{
+		Language: stringArg(filter, "language"),
+		Genres:   genres,
+		Tags:     tags,
+	}); err != nil {
+		return nil, badUserInput(err)
+	}
+
+	songs, err := e.songService.SearchSongs(p.Context, models.Song{
+		Name: stringArg(filter, "name"),
+		Artist: models.Artist{
+			Name: stringArg(filter, "artistName"),
+		},
+		Link:           stringArg(filter, "link"),
+		LinkHealth:     models.LinkHealth{Status: stringArg(filter, "linkStatus")},
+		Language:       stringArg(filter, "language"),
+		CreditedArtist: stringArg(filter, "creditedArtistName"),
+		Tags: append(
+			models.TagsFromNames(models.TagKindGenre, genres),
+			models.TagsFromNames(models.TagKindTag, tags)...,
+		),
+	}, pagination, false)
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return songs, nil
+}
+
+// resolveArtists выполняет поиск исполнителей.
+func (e *Endpoints) resolveArtists(p graphql.ResolveParams) (any, error) {
+	pagination, err := parsePagination(p.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, _ := p.Args["filter"].(map[string]any)
+	country := stringArg(filter, "country")
+	if err := binding.Validator.ValidateStruct(artistsFilter{Country: country}); err != nil {
+		return nil, badUserInput(err)
+	}
+
+	artists, err := e.artistService.SearchArtists(p.Context, models.Artist{
+		Name:    stringArg(filter, "name"),
+		Country: country,
+		Type:    stringArg(filter, "type"),
+	}, pagination)
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return artists, nil
+}
+
+// resolveArtistSongs откладывает загрузку первых песен исполнителя до загрузки песен всех исполнителей
+// того же уровня запроса.
+func (e *Endpoints) resolveArtistSongs(p graphql.ResolveParams) (any, error) {
+	first, _ := p.Args["first"].(int)
+	if first < 1 || first > maxPageSize {
+		return nil, badUserInput(fmt.Errorf("first must be between 1 and %d", maxPageSize))
+	}
+
+	load := loadArtistSongs(p.Context, p.Source.(models.ArtistAPI).ID, first)
+
+	return func() (any, error) {
+		songs, err := load()
+		if err != nil {
+			return nil, resolveError(err)
+		}
+		if songs == nil {
+			songs = []models.SongAPI{}
+		}
+
+		return songs, nil
+	}, nil
+}
+
+// paginationArgs добавляет к аргументам поля аргументы постраничной навигации.
+func paginationArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	args["pageNumber"] = &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 1}
+	args["pageSize"] = &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: defaultPageSize}
+
+	return args
+}
+
+// parsePagination проверяет аргументы постраничной навигации.
+func parsePagination(args map[string]any) (models.Pagination, error) {
+	pageNumber, _ := args["pageNumber"].(int)
+	pageSize, _ := args["pageSize"].(int)
+	if pageNumber < 1 || pageSize < 1 {
+		return models.Pagination{}, badUserInput(fmt.Errorf("pageNumber and pageSize must be positive"))
+	}
+
+	p := models.Pagination{PageNumber: uint64(pageNumber), PageSize: uint32(min(pageSize, maxPageSize+1))}
+	if err := binding.Validator.ValidateStruct(p); err != nil {
+		return models.Pagination{}, badUserInput(err)
+	}
+
+	return p, nil
+}
+
+// parseID преобразует идентификатор GraphQL в ID записи.
+func parseID(v any) (uint64, error) {
+	s, _ := v.(string)
+
+	id, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || id == 0 {
+		return 0, badUserInput(fmt.Errorf("invalid id %q", s))
+	}
+
+	return id, nil
+}
+
+// stringArg возвращает строковое поле входного объекта.
+func stringArg(m map[string]any, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+// stringsArg возвращает поле входного объекта со списком строк.
+func stringsArg(m map[string]any, key string) []string {
+	values, _ := m[key].([]any)
+	if values == nil {
+		return nil
+	}
+
+	strs := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			strs = append(strs, s)
+		}
+	}
+
+	return strs
+}
+
+// songField создает поле песни, значение которого вычисляется по песне.
+func songField(t graphql.Output, value func(s models.SongAPI) any) *graphql.Field {
+	return &graphql.Field{
+		Type: t,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return value(p.Source.(models.SongAPI)), nil
+		},
+	}
+}
+
+// artistField создает поле исполнителя, значение которого вычисляется по исполнителю.
+func artistField(t graphql.Output, value func(a models.ArtistAPI) any) *graphql.Field {
+	return &graphql.Field{
+		Type: t,
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return value(p.Source.(models.ArtistAPI)), nil
+		},
+	}
+}
+
+// pageInfoField создает обязательное целочисленное поле данных страницы.
+func pageInfoField(value func(m models.PaginationMetadataAPI) any) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewNonNull(graphql.Int),
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return value(p.Source.(models.PaginationMetadataAPI)), nil
+		},
+	}
+}
+
+// nonEmpty возвращает nil для пустой строки, чтобы незаполненные поля возвращались как null.
+func nonEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
+
+// nonZero возвращает nil для нулевого года, чтобы неизвестный год возвращался как null.
+func nonZero(year uint32) any {
+	if year == 0 {
+		return nil
+	}
+
+	return year
+}
+
+// nonNilStrings возвращает пустой список вместо nil для обязательных списков строк.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+
+	return s
+}
@@ -0,0 +1,63 @@
+// Package artistgrpc содержит gRPC-сервер сервиса исполнителей.
+package artistgrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/sedonn/song-library-service/internal/controllers/grpc/proto/songlibrary/v1"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// ArtistService описывает поведение объекта, который обеспечивает бизнес-логику работы с исполнителями.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=ArtistService
+type ArtistService interface {
+	// GetArtist получает данные определенного исполнителя.
+	GetArtist(ctx context.Context, id uint64) (models.ArtistAPI, error)
+	// GetArtistByISNI возвращает исполнителя с определенным кодом ISNI. Код может содержать пробелы.
+	GetArtistByISNI(ctx context.Context, isni string) (models.ArtistAPI, error)
+	// GetArtistByMBID возвращает исполнителя с определенным идентификатором MusicBrainz.
+	GetArtistByMBID(ctx context.Context, mbid string) (models.ArtistAPI, error)
+	// SearchArtists выполняет поиск исполнителей по определенным параметрам.
+	// Исполнители упорядочены по названию для сортировки.
+	SearchArtists(ctx context.Context, attrs models.Artist, p models.Pagination) (models.ArtistsAPI, error)
+	// CreateArtist добавляет нового исполнителя.
+	// Если название для сортировки не задано, то оно строится из названия исполнителя.
+	CreateArtist(ctx context.Context, a models.Artist) (models.ArtistAPI, error)
+	// ChangeArtist обновляет данные определенного исполнителя.
+	// Если название исполнителя изменяется без названия для сортировки, то название для сортировки строится заново.
+	ChangeArtist(ctx context.Context, a models.Artist) (models.ArtistAPI, error)
+	// RemoveArtist удаляет определенного исполнителя.
+	RemoveArtist(ctx context.Context, id uint64) (models.ArtistIDAPI, error)
+	// MergeArtists сливает исполнителя-дубликата с определенным исполнителем.
+	// Песни, альбомы, участие в создании песен и псевдонимы дубликата переносятся на исполнителя, после чего дубликат удаляется.
+	// Название дубликата становится псевдонимом исполнителя.
+	MergeArtists(ctx context.Context, id, duplicateID uint64) (models.ArtistAPI, error)
+	// GetArtistAliases возвращает псевдонимы определенного исполнителя.
+	GetArtistAliases(ctx context.Context, artistID uint64) (models.ArtistAliasesAPI, error)
+	// AddArtistAlias добавляет новый псевдоним исполнителя.
+	// Псевдоним не может совпадать с названием или псевдонимом какого-либо исполнителя без учета регистра и лишних пробелов.
+	AddArtistAlias(ctx context.Context, alias models.ArtistAlias) (models.ArtistAliasAPI, error)
+	// RemoveArtistAlias удаляет определенный псевдоним определенного исполнителя.
+	RemoveArtistAlias(ctx context.Context, artistID, aliasID uint64) (models.ArtistAliasIDAPI, error)
+}
+
+// Server это gRPC-сервер сервиса исполнителей.
+type Server struct {
+	pb.UnimplementedArtistServiceServer
+	artistService ArtistService
+}
+
+// New создает новый gRPC-сервер сервиса исполнителей.
+func New(s ArtistService) *Server {
+	return &Server{
+		artistService: s,
+	}
+}
+
+// Register регистрирует сервис исполнителей на определенном gRPC-сервере.
+func (s *Server) Register(srv *grpc.Server) {
+	pb.RegisterArtistServiceServer(srv, s)
+}
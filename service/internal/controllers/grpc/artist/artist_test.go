@@ -0,0 +1,226 @@
+package artistgrpc
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/sedonn/song-library-service/internal/controllers/grpc/artist/mocks"
+	icerror "github.com/sedonn/song-library-service/internal/controllers/grpc/interceptor/error"
+	pb "github.com/sedonn/song-library-service/internal/controllers/grpc/proto/songlibrary/v1"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+var errUnexpected = errors.New("unexpected error")
+
+// newTestClient запускает gRPC-сервер сервиса исполнителей поверх bufconn и возвращает клиент к нему.
+func newTestClient(t *testing.T, as ArtistService) pb.ArtistServiceClient {
+	t.Helper()
+
+	l := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(icerror.NewUnary()),
+		grpc.ChainStreamInterceptor(icerror.NewStream()),
+	)
+	New(as).Register(srv)
+	go func() { _ = srv.Serve(l) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewArtistServiceClient(conn)
+}
+
+func TestServer_GetArtist(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		id       uint64
+		artist   models.ArtistAPI
+		err      error
+		call     bool
+		wantCode codes.Code
+	}{
+		{
+			name: "GetArtist success",
+			id:   1,
+			artist: models.ArtistAPI{
+				ArtistIDAPI:         models.ArtistIDAPI{ID: 1},
+				ArtistAttributesAPI: models.ArtistAttributesAPI{Name: "Muse", Country: "GB", Type: models.ArtistTypeGroup},
+			},
+			call:     true,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "GetArtist error artist not found",
+			id:       1,
+			err:      services.ErrArtistNotFound,
+			call:     true,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "GetArtist error unexpected",
+			id:       1,
+			err:      errUnexpected,
+			call:     true,
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "GetArtist error without id",
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			as := mocks.NewArtistService(t)
+			if tt.call {
+				as.On("GetArtist", mock.Anything, tt.id).Once().Return(tt.artist, tt.err)
+			}
+
+			got, err := newTestClient(t, as).GetArtist(context.Background(), &pb.GetArtistRequest{Id: tt.id})
+			assert.Equalf(t, tt.wantCode, status.Code(err), "ArtistService.GetArtist() error = %v, wantCode %v", err, tt.wantCode)
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tt.artist.ID, got.GetId())
+				assert.Equal(t, tt.artist.Name, got.GetAttributes().GetName())
+				assert.Equal(t, tt.artist.Country, got.GetAttributes().GetCountry())
+			}
+		})
+	}
+}
+
+func TestServer_CreateArtist(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		attrs    *pb.ArtistAttributes
+		err      error
+		call     bool
+		wantCode codes.Code
+	}{
+		{
+			name:     "CreateArtist success",
+			attrs:    &pb.ArtistAttributes{Name: "Muse", Country: "GB"},
+			call:     true,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "CreateArtist error artist exists",
+			attrs:    &pb.ArtistAttributes{Name: "Muse"},
+			err:      services.ErrArtistExists,
+			call:     true,
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "CreateArtist error active years invalid",
+			attrs:    &pb.ArtistAttributes{Name: "Muse", FormedYear: 1994, DisbandedYear: 1990},
+			err:      services.ErrArtistActiveYearsInvalid,
+			call:     true,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "CreateArtist error without name",
+			attrs:    &pb.ArtistAttributes{Country: "GB"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "CreateArtist error invalid country",
+			attrs:    &pb.ArtistAttributes{Name: "Muse", Country: "GBR"},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			as := mocks.NewArtistService(t)
+			if tt.call {
+				as.
+					On("CreateArtist", mock.Anything, mock.MatchedBy(func(a models.Artist) bool {
+						return a.Name == tt.attrs.GetName() && a.Country == tt.attrs.GetCountry()
+					})).
+					Once().
+					Return(models.ArtistAPI{ArtistIDAPI: models.ArtistIDAPI{ID: 1}}, tt.err)
+			}
+
+			_, err := newTestClient(t, as).CreateArtist(context.Background(), &pb.CreateArtistRequest{Attributes: tt.attrs})
+			assert.Equalf(t, tt.wantCode, status.Code(err), "ArtistService.CreateArtist() error = %v, wantCode %v", err, tt.wantCode)
+		})
+	}
+}
+
+func TestServer_RemoveArtistAlias(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		req      *pb.RemoveArtistAliasRequest
+		err      error
+		call     bool
+		wantCode codes.Code
+	}{
+		{
+			name:     "RemoveArtistAlias success",
+			req:      &pb.RemoveArtistAliasRequest{ArtistId: 1, AliasId: 2},
+			call:     true,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "RemoveArtistAlias error alias not found",
+			req:      &pb.RemoveArtistAliasRequest{ArtistId: 1, AliasId: 2},
+			err:      services.ErrArtistAliasNotFound,
+			call:     true,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "RemoveArtistAlias error without alias id",
+			req:      &pb.RemoveArtistAliasRequest{ArtistId: 1},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			as := mocks.NewArtistService(t)
+			if tt.call {
+				as.
+					On("RemoveArtistAlias", mock.Anything, tt.req.GetArtistId(), tt.req.GetAliasId()).
+					Once().
+					Return(models.ArtistAliasIDAPI{ID: tt.req.GetAliasId()}, tt.err)
+			}
+
+			got, err := newTestClient(t, as).RemoveArtistAlias(context.Background(), tt.req)
+			assert.Equalf(t, tt.wantCode, status.Code(err), "ArtistService.RemoveArtistAlias() error = %v, wantCode %v", err, tt.wantCode)
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tt.req.GetAliasId(), got.GetId())
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+package artistgrpc
+
+import (
+	"context"
+
+	pb "github.com/sedonn/song-library-service/internal/controllers/grpc/proto/songlibrary/v1"
+	"github.com/sedonn/song-library-service/internal/controllers/grpc/protoconv"
+	"github.com/sedonn/song-library-service/internal/controllers/grpc/validation"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// artistsFilter это параметры поиска исполнителей с теми же правилами проверки, что и у REST-API.
+type artistsFilter struct {
+	Country string `binding:"omitempty,iso3166_1_alpha2"`
+	Type    string `binding:"omitempty,oneof=person group"`
+}
+
+// GetArtist возвращает данные определенного исполнителя.
+func (s *Server) GetArtist(ctx context.Context, req *pb.GetArtistRequest) (*pb.Artist, error) {
+	if err := validation.Var("id", req.GetId(), "required"); err != nil {
+		return nil, err
+	}
+
+	a, err := s.artistService.GetArtist(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Artist(a), nil
+}
+
+// GetArtistByISNI возвращает исполнителя с определенным кодом ISNI.
+func (s *Server) GetArtistByISNI(ctx context.Context, req *pb.GetArtistByISNIRequest) (*pb.Artist, error) {
+	if err := validation.Var("isni", req.GetIsni(), "required,lte=19"); err != nil {
+		return nil, err
+	}
+
+	a, err := s.artistService.GetArtistByISNI(ctx, req.GetIsni())
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Artist(a), nil
+}
+
+// GetArtistByMBID возвращает исполнителя с определенным идентификатором MusicBrainz.
+func (s *Server) GetArtistByMBID(ctx context.Context, req *pb.GetArtistByMBIDRequest) (*pb.Artist, error) {
+	if err := validation.Var("mbid", req.GetMbid(), "required,lte=36"); err != nil {
+		return nil, err
+	}
+
+	a, err := s.artistService.GetArtistByMBID(ctx, req.GetMbid())
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Artist(a), nil
+}
+
+// SearchArtists выполняет поиск исполнителей.
+func (s *Server) SearchArtists(ctx context.Context, req *pb.SearchArtistsRequest) (*pb.SearchArtistsResponse, error) {
+	if err := validation.Struct(artistsFilter{Country: req.GetCountry(), Type: req.GetType()}); err != nil {
+		return nil, err
+	}
+	p, err := protoconv.Pagination(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	artists, err := s.artistService.SearchArtists(ctx, models.Artist{
+		Name:    req.GetName(),
+		Country: req.GetCountry(),
+		Type:    req.GetType(),
+	}, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchArtistsResponse{
+		Artists:    protoconv.Artists(artists.Artists),
+		Pagination: protoconv.PaginationMetadata(artists.Pagination),
+	}, nil
+}
+
+// CreateArtist добавляет нового исполнителя.
+func (s *Server) CreateArtist(ctx context.Context, req *pb.CreateArtistRequest) (*pb.Artist, error) {
+	attrs := req.GetAttributes()
+	if err := validation.Struct(models.ArtistAttributesAPI{
+		Name:          attrs.GetName(),
+		SortName:      attrs.GetSortName(),
+		Country:       attrs.GetCountry(),
+		Type:          attrs.GetType(),
+		FormedYear:    attrs.GetFormedYear(),
+		DisbandedYear: attrs.GetDisbandedYear(),
+		Bio:           attrs.GetBio(),
+		Links:         attrs.GetLinks(),
+		ISNI:          attrs.GetIsni(),
+		MBID:          attrs.GetMbid(),
+	}); err != nil {
+		return nil, err
+	}
+
+	a, err := s.artistService.CreateArtist(ctx, artist(0, attrs))
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Artist(a), nil
+}
+
+// ChangeArtist обновляет заданные атрибуты определенного исполнителя.
+func (s *Server) ChangeArtist(ctx context.Context, req *pb.ChangeArtistRequest) (*pb.Artist, error) {
+	if err := validation.Var("id", req.GetId(), "required"); err != nil {
+		return nil, err
+	}
+	attrs := req.GetAttributes()
+	if err := validation.Struct(models.ArtistOptionalAttributesAPI{
+		Name:          attrs.GetName(),
+		SortName:      attrs.GetSortName(),
+		Country:       attrs.GetCountry(),
+		Type:          attrs.GetType(),
+		FormedYear:    attrs.GetFormedYear(),
+		DisbandedYear: attrs.GetDisbandedYear(),
+		Bio:           attrs.GetBio(),
+		Links:         attrs.GetLinks(),
+		ISNI:          attrs.GetIsni(),
+		MBID:          attrs.GetMbid(),
+	}); err != nil {
+		return nil, err
+	}
+
+	a, err := s.artistService.ChangeArtist(ctx, artist(req.GetId(), attrs))
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Artist(a), nil
+}
+
+// RemoveArtist удаляет определенного исполнителя.
+func (s *Server) RemoveArtist(ctx context.Context, req *pb.RemoveArtistRequest) (*pb.RemoveArtistResponse, error) {
+	if err := validation.Var("id", req.GetId(), "required"); err != nil {
+		return nil, err
+	}
+
+	id, err := s.artistService.RemoveArtist(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveArtistResponse{Id: id.ID}, nil
+}
+
+// MergeArtists сливает исполнителя-дубликата с определенным исполнителем.
+func (s *Server) MergeArtists(ctx context.Context, req *pb.MergeArtistsRequest) (*pb.Artist, error) {
+	if err := validation.Var("id", req.GetId(), "required"); err != nil {
+		return nil, err
+	}
+	if err := validation.Var("duplicate_id", req.GetDuplicateId(), "required"); err != nil {
+		return nil, err
+	}
+
+	a, err := s.artistService.MergeArtists(ctx, req.GetId(), req.GetDuplicateId())
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Artist(a), nil
+}
+
+// GetArtistAliases возвращает псевдонимы определенного исполнителя.
+func (s *Server) GetArtistAliases(
+	ctx context.Context,
+	req *pb.GetArtistAliasesRequest,
+) (*pb.GetArtistAliasesResponse, error) {
+	if err := validation.Var("artist_id", req.GetArtistId(), "required"); err != nil {
+		return nil, err
+	}
+
+	aliases, err := s.artistService.GetArtistAliases(ctx, req.GetArtistId())
+	if err != nil {
+		return nil, err
+	}
+
+	pbAliases := make([]*pb.ArtistAlias, len(aliases.Aliases))
+	for i, a := range aliases.Aliases {
+		pbAliases[i] = protoconv.ArtistAlias(a)
+	}
+
+	return &pb.GetArtistAliasesResponse{ArtistId: aliases.Artist.ID, Aliases: pbAliases}, nil
+}
+
+// AddArtistAlias добавляет новый псевдоним исполнителя.
+func (s *Server) AddArtistAlias(ctx context.Context, req *pb.AddArtistAliasRequest) (*pb.ArtistAlias, error) {
+	if err := validation.Var("artist_id", req.GetArtistId(), "required"); err != nil {
+		return nil, err
+	}
+	if err := validation.Struct(models.ArtistAliasAttributesAPI{Name: req.GetName()}); err != nil {
+		return nil, err
+	}
+
+	alias, err := s.artistService.AddArtistAlias(ctx, models.ArtistAlias{
+		ArtistID: req.GetArtistId(),
+		Name:     req.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.ArtistAlias(alias), nil
+}
+
+// RemoveArtistAlias удаляет определенный псевдоним определенного исполнителя.
+func (s *Server) RemoveArtistAlias(
+	ctx context.Context,
+	req *pb.RemoveArtistAliasRequest,
+) (*pb.RemoveArtistAliasResponse, error) {
+	if err := validation.Var("artist_id", req.GetArtistId(), "required"); err != nil {
+		return nil, err
+	}
+	if err := validation.Var("alias_id", req.GetAliasId(), "required"); err != nil {
+		return nil, err
+	}
+
+	id, err := s.artistService.RemoveArtistAlias(ctx, req.GetArtistId(), req.GetAliasId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveArtistAliasResponse{Id: id.ID}, nil
+}
+
+// artist создает модель БД исполнителя по его атрибутам.
+func artist(id uint64, attrs *pb.ArtistAttributes) models.Artist {
+	return models.Artist{
+		ID:            id,
+		Name:          attrs.GetName(),
+		SortName:      attrs.GetSortName(),
+		Country:       attrs.GetCountry(),
+		Type:          attrs.GetType(),
+		FormedYear:    attrs.GetFormedYear(),
+		DisbandedYear: attrs.GetDisbandedYear(),
+		Bio:           attrs.GetBio(),
+		Links:         attrs.GetLinks(),
+		ISNI:          attrs.GetIsni(),
+		MBID:          attrs.GetMbid(),
+	}
+}
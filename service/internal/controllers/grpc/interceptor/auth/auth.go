@@ -0,0 +1,125 @@
+// Package icauth содержит interceptor-ы для аутентификации клиентов gRPC-сервера по API-ключу или JWT.
+package icauth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// APIKeyMetadata это ключ метаданных запроса с API-ключом.
+const APIKeyMetadata = "x-api-key"
+
+// Authenticator описывает поведение объекта, который проверяет учетные данные клиентов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=Authenticator
+type Authenticator interface {
+	// AuthenticateAPIKey проверяет API-ключ и возвращает клиента, которому он принадлежит.
+	AuthenticateAPIKey(ctx context.Context, key string) (principal.Principal, error)
+	// AuthenticateToken проверяет JWT и возвращает клиента, для которого он выпущен.
+	AuthenticateToken(ctx context.Context, token string) (principal.Principal, error)
+}
+
+// NewUnary создает interceptor, который пропускает только унарные вызовы с принятым API-ключом в метаданных
+// x-api-key или JWT в метаданных authorization: Bearer. Аутентифицированный клиент добавляется в контекст вызова.
+// Проверка состояния сервера доступна без аутентификации.
+func NewUnary(a Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if public(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		ctx, err := authenticate(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// NewStream создает interceptor, который аутентифицирует клиентов потоковых вызовов так же, как NewUnary.
+func NewStream(a Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if public(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx, err := authenticate(ss.Context(), a)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream это поток вызова с контекстом, в который добавлен аутентифицированный клиент.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context возвращает контекст потока вызова.
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// public проверяет, что метод доступен без аутентификации.
+func public(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+// authenticate проверяет учетные данные из метаданных вызова и добавляет клиента в контекст.
+func authenticate(ctx context.Context, a Authenticator) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	var (
+		p   principal.Principal
+		err error
+	)
+
+	switch token, ok := bearerToken(first(md, "authorization")); {
+	case ok:
+		p, err = a.AuthenticateToken(ctx, token)
+
+	case first(md, APIKeyMetadata) != "":
+		p, err = a.AuthenticateAPIKey(ctx, first(md, APIKeyMetadata))
+
+	default:
+		err = services.ErrUnauthenticated
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return principal.NewContext(ctx, p), nil
+}
+
+// first возвращает первое значение метаданных по ключу.
+func first(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+
+	return ""
+}
+
+// bearerToken возвращает токен из значения метаданных authorization со схемой Bearer.
+func bearerToken(authorization string) (string, bool) {
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
@@ -0,0 +1,94 @@
+package icauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sedonn/song-library-service/internal/controllers/grpc/interceptor/auth/mocks"
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+func TestNewUnary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		method        string
+		md            metadata.MD
+		authenticator func(t *testing.T) Authenticator
+		wantActor     string
+		wantErr       error
+	}{
+		{
+			name:   "Bearer token",
+			method: "/song.SongService/GetSong",
+			md:     metadata.Pairs("authorization", "Bearer token-1"),
+			authenticator: func(t *testing.T) Authenticator {
+				a := mocks.NewAuthenticator(t)
+				a.
+					On("AuthenticateToken", mock.Anything, "token-1").
+					Once().
+					Return(principal.Principal{Subject: "user-1", Method: principal.MethodJWT}, nil)
+
+				return a
+			},
+			wantActor: "jwt:user-1",
+		},
+		{
+			name:   "API key",
+			method: "/song.SongService/GetSong",
+			md:     metadata.Pairs(APIKeyMetadata, "key-1"),
+			authenticator: func(t *testing.T) Authenticator {
+				a := mocks.NewAuthenticator(t)
+				a.
+					On("AuthenticateAPIKey", mock.Anything, "key-1").
+					Once().
+					Return(principal.Principal{Subject: "importer", Method: principal.MethodAPIKey}, nil)
+
+				return a
+			},
+			wantActor: "api_key:importer",
+		},
+		{
+			name:          "No credentials",
+			method:        "/song.SongService/GetSong",
+			authenticator: func(t *testing.T) Authenticator { return mocks.NewAuthenticator(t) },
+			wantErr:       services.ErrUnauthenticated,
+		},
+		{
+			name:          "Health check",
+			method:        "/grpc.health.v1.Health/Check",
+			authenticator: func(t *testing.T) Authenticator { return mocks.NewAuthenticator(t) },
+			wantActor:     "anonymous",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			handler := func(ctx context.Context, _ any) (any, error) {
+				p, ok := principal.FromContext(ctx)
+				if !ok {
+					return "anonymous", nil
+				}
+
+				return p.String(), nil
+			}
+
+			got, err := NewUnary(tt.authenticator(t))(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.ErrorIsf(t, err, tt.wantErr, "NewUnary() error = %v, wantErr %v", err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantActor, got)
+			}
+		})
+	}
+}
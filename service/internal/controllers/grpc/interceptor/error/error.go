@@ -0,0 +1,102 @@
+// Package icerror содержит interceptor-ы для глобальной обработки ошибок gRPC-сервера.
+package icerror
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// errorCodes сопоставляет ошибки бизнес-логики кодам статуса gRPC.
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{services.ErrSongNotFound, codes.NotFound},
+	{services.ErrArtistNotFound, codes.NotFound},
+	{services.ErrArtistAliasNotFound, codes.NotFound},
+	{services.ErrAlbumNotFound, codes.NotFound},
+	{services.ErrGenreNotFound, codes.NotFound},
+	{services.ErrTagNotFound, codes.NotFound},
+	{services.ErrPlaylistNotFound, codes.NotFound},
+	{services.ErrPlaylistItemNotFound, codes.NotFound},
+	{services.ErrSongRelationNotFound, codes.NotFound},
+	{services.ErrJobNotFound, codes.NotFound},
+
+	{services.ErrArtistExists, codes.AlreadyExists},
+	{services.ErrArtistAliasExists, codes.AlreadyExists},
+	{services.ErrAlbumTrackConflict, codes.AlreadyExists},
+	{services.ErrGenreExists, codes.AlreadyExists},
+	{services.ErrTagExists, codes.AlreadyExists},
+	{services.ErrPlaylistItemExists, codes.AlreadyExists},
+	{services.ErrSongRelationExists, codes.AlreadyExists},
+	{services.ErrIdentifierExists, codes.AlreadyExists},
+	{services.ErrSongLinkExists, codes.AlreadyExists},
+
+	{services.ErrArtistActiveYearsInvalid, codes.InvalidArgument},
+	{services.ErrInvalidPlaylistFile, codes.InvalidArgument},
+	{services.ErrMergeIntoItself, codes.InvalidArgument},
+	{services.ErrInvalidIdentifier, codes.InvalidArgument},
+	{services.ErrInvalidSongLink, codes.InvalidArgument},
+	{services.ErrInvalidTenant, codes.InvalidArgument},
+	{playlistfmt.ErrUnsupportedFormat, codes.InvalidArgument},
+
+	{services.ErrSongRelationCycle, codes.FailedPrecondition},
+
+	{services.ErrPageNumberOutOfRange, codes.OutOfRange},
+
+	{services.ErrUnauthenticated, codes.Unauthenticated},
+	{services.ErrForbidden, codes.PermissionDenied},
+	{services.ErrTenantForbidden, codes.PermissionDenied},
+
+	{services.ErrRateLimited, codes.ResourceExhausted},
+	{services.ErrQuotaExceeded, codes.ResourceExhausted},
+
+	{context.Canceled, codes.Canceled},
+	{context.DeadlineExceeded, codes.DeadlineExceeded},
+}
+
+// Status преобразует ошибку в статус gRPC.
+// Ошибки, которые уже содержат статус, не изменяются. Текст неизвестных ошибок скрывается.
+func Status(err error) *status.Status {
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+
+	for _, v := range errorCodes {
+		if errors.Is(err, v.err) {
+			return status.New(v.code, err.Error())
+		}
+	}
+
+	return status.New(codes.Internal, "internal server error")
+}
+
+// NewUnary создает interceptor, который преобразует ошибки унарных вызовов в статусы gRPC.
+func NewUnary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, Status(err).Err()
+		}
+
+		return resp, nil
+	}
+}
+
+// NewStream создает interceptor, который преобразует ошибки потоковых вызовов в статусы gRPC.
+func NewStream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return Status(err).Err()
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,62 @@
+// Package ictenant содержит interceptor-ы, которые определяют библиотеку, с которой работает клиент gRPC-сервера.
+package ictenant
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// TenantMetadata это ключ метаданных вызова с идентификатором библиотеки.
+const TenantMetadata = "x-tenant-id"
+
+// NewUnary создает interceptor, который добавляет в контекст унарного вызова библиотеку клиента.
+// Клиент, привязанный к библиотеке, работает с ней, клиенты с правом выбора библиотеки выбирают ее метаданными x-tenant-id,
+// остальные клиенты работают с библиотекой по умолчанию.
+// Должен выполняться после аутентификации клиента.
+func NewUnary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// NewStream создает interceptor, который определяет библиотеку клиента потоковых вызовов так же, как NewUnary.
+func NewStream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := resolve(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// serverStream это поток вызова с контекстом, в который добавлена библиотека клиента.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context возвращает контекст потока вызова.
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// resolve добавляет в контекст библиотеку клиента с учетом метаданных вызова.
+func resolve(ctx context.Context) (context.Context, error) {
+	var requested string
+	if v := metadata.ValueFromIncomingContext(ctx, TenantMetadata); len(v) > 0 {
+		requested = v[0]
+	}
+
+	return services.ResolveTenant(ctx, requested)
+}
@@ -0,0 +1,94 @@
+package ictenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/pkg/tenant"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+func TestNewUnary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		principal  *principal.Principal
+		md         metadata.MD
+		wantTenant string
+		wantErr    error
+	}{
+		{
+			name:       "Default tenant",
+			wantTenant: tenant.Default,
+		},
+		{
+			name:       "Tenant from metadata",
+			principal:  &principal.Principal{Subject: "admin", Grants: []string{rbac.TenantsSwitch}},
+			md:         metadata.Pairs(TenantMetadata, "team-a"),
+			wantTenant: "team-a",
+		},
+		{
+			name:      "Unbound principal without grant",
+			principal: &principal.Principal{Subject: "viewer", Grants: []string{"*:read"}},
+			md:        metadata.Pairs(TenantMetadata, "team-a"),
+			wantErr:   services.ErrTenantForbidden,
+		},
+		{
+			name:    "Anonymous client",
+			md:      metadata.Pairs(TenantMetadata, "team-a"),
+			wantErr: services.ErrTenantForbidden,
+		},
+		{
+			name:       "Tenant from principal",
+			principal:  &principal.Principal{Subject: "importer", Tenant: "team-b"},
+			wantTenant: "team-b",
+		},
+		{
+			name:      "Other tenant",
+			principal: &principal.Principal{Subject: "importer", Tenant: "team-b"},
+			md:        metadata.Pairs(TenantMetadata, "team-a"),
+			wantErr:   services.ErrTenantForbidden,
+		},
+		{
+			name:    "Invalid tenant",
+			md:      metadata.Pairs(TenantMetadata, "../team-a"),
+			wantErr: services.ErrInvalidTenant,
+		},
+		{
+			name:      "Invalid tenant from principal",
+			principal: &principal.Principal{Subject: "importer", Tenant: "../team-b"},
+			wantErr:   services.ErrInvalidTenant,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			if tt.principal != nil {
+				ctx = principal.NewContext(ctx, *tt.principal)
+			}
+
+			handler := func(ctx context.Context, _ any) (any, error) {
+				id, _ := tenant.FromContext(ctx)
+
+				return id, nil
+			}
+
+			got, err := NewUnary()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/song.SongService/GetSong"}, handler)
+			assert.ErrorIsf(t, err, tt.wantErr, "NewUnary() error = %v, wantErr %v", err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.wantTenant, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,1524 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: songlibrary/v1/artist.proto
+
+package songlibraryv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Artist это исполнитель.
+type Artist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attributes *ArtistAttributes `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *Artist) Reset() {
+	*x = Artist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Artist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artist) ProtoMessage() {}
+
+func (x *Artist) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artist.ProtoReflect.Descriptor instead.
+func (*Artist) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Artist) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Artist) GetAttributes() *ArtistAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+// ArtistAttributes это атрибуты исполнителя.
+type ArtistAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SortName string `protobuf:"bytes,2,opt,name=sort_name,json=sortName,proto3" json:"sort_name,omitempty"`
+	// country это код страны по ISO 3166-1 alpha-2.
+	Country string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	// type это person или group.
+	Type          string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	FormedYear    uint32   `protobuf:"varint,5,opt,name=formed_year,json=formedYear,proto3" json:"formed_year,omitempty"`
+	DisbandedYear uint32   `protobuf:"varint,6,opt,name=disbanded_year,json=disbandedYear,proto3" json:"disbanded_year,omitempty"`
+	Bio           string   `protobuf:"bytes,7,opt,name=bio,proto3" json:"bio,omitempty"`
+	Links         []string `protobuf:"bytes,8,rep,name=links,proto3" json:"links,omitempty"`
+	Isni          string   `protobuf:"bytes,9,opt,name=isni,proto3" json:"isni,omitempty"`
+	Mbid          string   `protobuf:"bytes,10,opt,name=mbid,proto3" json:"mbid,omitempty"`
+}
+
+func (x *ArtistAttributes) Reset() {
+	*x = ArtistAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtistAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistAttributes) ProtoMessage() {}
+
+func (x *ArtistAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistAttributes.ProtoReflect.Descriptor instead.
+func (*ArtistAttributes) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArtistAttributes) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArtistAttributes) GetSortName() string {
+	if x != nil {
+		return x.SortName
+	}
+	return ""
+}
+
+func (x *ArtistAttributes) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ArtistAttributes) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ArtistAttributes) GetFormedYear() uint32 {
+	if x != nil {
+		return x.FormedYear
+	}
+	return 0
+}
+
+func (x *ArtistAttributes) GetDisbandedYear() uint32 {
+	if x != nil {
+		return x.DisbandedYear
+	}
+	return 0
+}
+
+func (x *ArtistAttributes) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *ArtistAttributes) GetLinks() []string {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+func (x *ArtistAttributes) GetIsni() string {
+	if x != nil {
+		return x.Isni
+	}
+	return ""
+}
+
+func (x *ArtistAttributes) GetMbid() string {
+	if x != nil {
+		return x.Mbid
+	}
+	return ""
+}
+
+// ArtistAlias это псевдоним исполнителя.
+type ArtistAlias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ArtistAlias) Reset() {
+	*x = ArtistAlias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtistAlias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistAlias) ProtoMessage() {}
+
+func (x *ArtistAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistAlias.ProtoReflect.Descriptor instead.
+func (*ArtistAlias) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ArtistAlias) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ArtistAlias) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetArtistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetArtistRequest) Reset() {
+	*x = GetArtistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArtistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtistRequest) ProtoMessage() {}
+
+func (x *GetArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtistRequest.ProtoReflect.Descriptor instead.
+func (*GetArtistRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetArtistRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetArtistByISNIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Isni string `protobuf:"bytes,1,opt,name=isni,proto3" json:"isni,omitempty"`
+}
+
+func (x *GetArtistByISNIRequest) Reset() {
+	*x = GetArtistByISNIRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArtistByISNIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtistByISNIRequest) ProtoMessage() {}
+
+func (x *GetArtistByISNIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtistByISNIRequest.ProtoReflect.Descriptor instead.
+func (*GetArtistByISNIRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetArtistByISNIRequest) GetIsni() string {
+	if x != nil {
+		return x.Isni
+	}
+	return ""
+}
+
+type GetArtistByMBIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mbid string `protobuf:"bytes,1,opt,name=mbid,proto3" json:"mbid,omitempty"`
+}
+
+func (x *GetArtistByMBIDRequest) Reset() {
+	*x = GetArtistByMBIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArtistByMBIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtistByMBIDRequest) ProtoMessage() {}
+
+func (x *GetArtistByMBIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtistByMBIDRequest.ProtoReflect.Descriptor instead.
+func (*GetArtistByMBIDRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetArtistByMBIDRequest) GetMbid() string {
+	if x != nil {
+		return x.Mbid
+	}
+	return ""
+}
+
+type SearchArtistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name ищет по подстроке названия или любого псевдонима исполнителя.
+	Name       string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Country    string      `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	Type       string      `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Pagination *Pagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *SearchArtistsRequest) Reset() {
+	*x = SearchArtistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchArtistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArtistsRequest) ProtoMessage() {}
+
+func (x *SearchArtistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArtistsRequest.ProtoReflect.Descriptor instead.
+func (*SearchArtistsRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchArtistsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchArtistsRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *SearchArtistsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SearchArtistsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchArtistsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Artists    []*Artist           `protobuf:"bytes,1,rep,name=artists,proto3" json:"artists,omitempty"`
+	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *SearchArtistsResponse) Reset() {
+	*x = SearchArtistsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchArtistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArtistsResponse) ProtoMessage() {}
+
+func (x *SearchArtistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArtistsResponse.ProtoReflect.Descriptor instead.
+func (*SearchArtistsResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchArtistsResponse) GetArtists() []*Artist {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+func (x *SearchArtistsResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type CreateArtistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attributes *ArtistAttributes `protobuf:"bytes,1,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *CreateArtistRequest) Reset() {
+	*x = CreateArtistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateArtistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateArtistRequest) ProtoMessage() {}
+
+func (x *CreateArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateArtistRequest.ProtoReflect.Descriptor instead.
+func (*CreateArtistRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateArtistRequest) GetAttributes() *ArtistAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type ChangeArtistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// attributes содержит новые значения атрибутов. Пустые значения не изменяются.
+	Attributes *ArtistAttributes `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *ChangeArtistRequest) Reset() {
+	*x = ChangeArtistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeArtistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeArtistRequest) ProtoMessage() {}
+
+func (x *ChangeArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeArtistRequest.ProtoReflect.Descriptor instead.
+func (*ChangeArtistRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChangeArtistRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeArtistRequest) GetAttributes() *ArtistAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type RemoveArtistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveArtistRequest) Reset() {
+	*x = RemoveArtistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveArtistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveArtistRequest) ProtoMessage() {}
+
+func (x *RemoveArtistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveArtistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveArtistRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RemoveArtistRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveArtistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveArtistResponse) Reset() {
+	*x = RemoveArtistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveArtistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveArtistResponse) ProtoMessage() {}
+
+func (x *RemoveArtistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveArtistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveArtistResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveArtistResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type MergeArtistsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DuplicateId uint64 `protobuf:"varint,2,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+}
+
+func (x *MergeArtistsRequest) Reset() {
+	*x = MergeArtistsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeArtistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeArtistsRequest) ProtoMessage() {}
+
+func (x *MergeArtistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeArtistsRequest.ProtoReflect.Descriptor instead.
+func (*MergeArtistsRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MergeArtistsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MergeArtistsRequest) GetDuplicateId() uint64 {
+	if x != nil {
+		return x.DuplicateId
+	}
+	return 0
+}
+
+type GetArtistAliasesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistId uint64 `protobuf:"varint,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+}
+
+func (x *GetArtistAliasesRequest) Reset() {
+	*x = GetArtistAliasesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArtistAliasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtistAliasesRequest) ProtoMessage() {}
+
+func (x *GetArtistAliasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtistAliasesRequest.ProtoReflect.Descriptor instead.
+func (*GetArtistAliasesRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetArtistAliasesRequest) GetArtistId() uint64 {
+	if x != nil {
+		return x.ArtistId
+	}
+	return 0
+}
+
+type GetArtistAliasesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistId uint64         `protobuf:"varint,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	Aliases  []*ArtistAlias `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (x *GetArtistAliasesResponse) Reset() {
+	*x = GetArtistAliasesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetArtistAliasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArtistAliasesResponse) ProtoMessage() {}
+
+func (x *GetArtistAliasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArtistAliasesResponse.ProtoReflect.Descriptor instead.
+func (*GetArtistAliasesResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetArtistAliasesResponse) GetArtistId() uint64 {
+	if x != nil {
+		return x.ArtistId
+	}
+	return 0
+}
+
+func (x *GetArtistAliasesResponse) GetAliases() []*ArtistAlias {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
+type AddArtistAliasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistId uint64 `protobuf:"varint,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *AddArtistAliasRequest) Reset() {
+	*x = AddArtistAliasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddArtistAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddArtistAliasRequest) ProtoMessage() {}
+
+func (x *AddArtistAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddArtistAliasRequest.ProtoReflect.Descriptor instead.
+func (*AddArtistAliasRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddArtistAliasRequest) GetArtistId() uint64 {
+	if x != nil {
+		return x.ArtistId
+	}
+	return 0
+}
+
+func (x *AddArtistAliasRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RemoveArtistAliasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistId uint64 `protobuf:"varint,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	AliasId  uint64 `protobuf:"varint,2,opt,name=alias_id,json=aliasId,proto3" json:"alias_id,omitempty"`
+}
+
+func (x *RemoveArtistAliasRequest) Reset() {
+	*x = RemoveArtistAliasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveArtistAliasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveArtistAliasRequest) ProtoMessage() {}
+
+func (x *RemoveArtistAliasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveArtistAliasRequest.ProtoReflect.Descriptor instead.
+func (*RemoveArtistAliasRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RemoveArtistAliasRequest) GetArtistId() uint64 {
+	if x != nil {
+		return x.ArtistId
+	}
+	return 0
+}
+
+func (x *RemoveArtistAliasRequest) GetAliasId() uint64 {
+	if x != nil {
+		return x.AliasId
+	}
+	return 0
+}
+
+type RemoveArtistAliasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveArtistAliasResponse) Reset() {
+	*x = RemoveArtistAliasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_artist_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveArtistAliasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveArtistAliasResponse) ProtoMessage() {}
+
+func (x *RemoveArtistAliasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_artist_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveArtistAliasResponse.ProtoReflect.Descriptor instead.
+func (*RemoveArtistAliasResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_artist_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveArtistAliasResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+var File_songlibrary_v1_artist_proto protoreflect.FileDescriptor
+
+var file_songlibrary_v1_artist_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x73,
+	0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x73,
+	0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5a, 0x0a, 0x06, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x10, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6f,
+	0x72, 0x6d, 0x65, 0x64, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x59, 0x65, 0x61, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x64,
+	0x69, 0x73, 0x62, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x62, 0x61, 0x6e, 0x64, 0x65, 0x64, 0x59, 0x65,
+	0x61, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x62, 0x69, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73,
+	0x6e, 0x69, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x6e, 0x69, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x62, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x62,
+	0x69, 0x64, 0x22, 0x31, 0x0a, 0x0b, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x22, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x42, 0x79, 0x49, 0x53, 0x4e, 0x49, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x6e, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x69, 0x73, 0x6e, 0x69, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x42, 0x79, 0x4d, 0x42, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x62, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x62, 0x69, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a,
+	0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52,
+	0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73,
+	0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x57, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x22, 0x25,
+	0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x26, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x48, 0x0a,
+	0x13, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x22,
+	0x6e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61,
+	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6e, 0x67,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x22,
+	0x48, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x0a, 0x18, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x49, 0x64, 0x22, 0x2b, 0x0a,
+	0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x32, 0xc3, 0x07, 0x0a, 0x0d, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x73, 0x6f, 0x6e, 0x67,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x6f,
+	0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x42, 0x79, 0x49, 0x53, 0x4e, 0x49, 0x12, 0x26, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x42, 0x79, 0x49, 0x53, 0x4e, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x42, 0x79, 0x4d, 0x42, 0x49, 0x44, 0x12, 0x26, 0x2e, 0x73, 0x6f, 0x6e, 0x67,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x42, 0x79, 0x4d, 0x42, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x5c, 0x0a, 0x0d, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6e,
+	0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73,
+	0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x6f, 0x6e, 0x67,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x12, 0x59, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x12, 0x23, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x73,
+	0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x72, 0x67, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x65, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x12, 0x27, 0x2e,
+	0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x6f, 0x6e, 0x67,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x68, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x28, 0x2e, 0x73, 0x6f,
+	0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x65, 0x5a, 0x63, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
+	0x65, 0x64, 0x6f, 0x6e, 0x6e, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x2d, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x2f,
+	0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_songlibrary_v1_artist_proto_rawDescOnce sync.Once
+	file_songlibrary_v1_artist_proto_rawDescData = file_songlibrary_v1_artist_proto_rawDesc
+)
+
+func file_songlibrary_v1_artist_proto_rawDescGZIP() []byte {
+	file_songlibrary_v1_artist_proto_rawDescOnce.Do(func() {
+		file_songlibrary_v1_artist_proto_rawDescData = protoimpl.X.CompressGZIP(file_songlibrary_v1_artist_proto_rawDescData)
+	})
+	return file_songlibrary_v1_artist_proto_rawDescData
+}
+
+var file_songlibrary_v1_artist_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_songlibrary_v1_artist_proto_goTypes = []any{
+	(*Artist)(nil),                    // 0: songlibrary.v1.Artist
+	(*ArtistAttributes)(nil),          // 1: songlibrary.v1.ArtistAttributes
+	(*ArtistAlias)(nil),               // 2: songlibrary.v1.ArtistAlias
+	(*GetArtistRequest)(nil),          // 3: songlibrary.v1.GetArtistRequest
+	(*GetArtistByISNIRequest)(nil),    // 4: songlibrary.v1.GetArtistByISNIRequest
+	(*GetArtistByMBIDRequest)(nil),    // 5: songlibrary.v1.GetArtistByMBIDRequest
+	(*SearchArtistsRequest)(nil),      // 6: songlibrary.v1.SearchArtistsRequest
+	(*SearchArtistsResponse)(nil),     // 7: songlibrary.v1.SearchArtistsResponse
+	(*CreateArtistRequest)(nil),       // 8: songlibrary.v1.CreateArtistRequest
+	(*ChangeArtistRequest)(nil),       // 9: songlibrary.v1.ChangeArtistRequest
+	(*RemoveArtistRequest)(nil),       // 10: songlibrary.v1.RemoveArtistRequest
+	(*RemoveArtistResponse)(nil),      // 11: songlibrary.v1.RemoveArtistResponse
+	(*MergeArtistsRequest)(nil),       // 12: songlibrary.v1.MergeArtistsRequest
+	(*GetArtistAliasesRequest)(nil),   // 13: songlibrary.v1.GetArtistAliasesRequest
+	(*GetArtistAliasesResponse)(nil),  // 14: songlibrary.v1.GetArtistAliasesResponse
+	(*AddArtistAliasRequest)(nil),     // 15: songlibrary.v1.AddArtistAliasRequest
+	(*RemoveArtistAliasRequest)(nil),  // 16: songlibrary.v1.RemoveArtistAliasRequest
+	(*RemoveArtistAliasResponse)(nil), // 17: songlibrary.v1.RemoveArtistAliasResponse
+	(*Pagination)(nil),                // 18: songlibrary.v1.Pagination
+	(*PaginationMetadata)(nil),        // 19: songlibrary.v1.PaginationMetadata
+}
+var file_songlibrary_v1_artist_proto_depIdxs = []int32{
+	1,  // 0: songlibrary.v1.Artist.attributes:type_name -> songlibrary.v1.ArtistAttributes
+	18, // 1: songlibrary.v1.SearchArtistsRequest.pagination:type_name -> songlibrary.v1.Pagination
+	0,  // 2: songlibrary.v1.SearchArtistsResponse.artists:type_name -> songlibrary.v1.Artist
+	19, // 3: songlibrary.v1.SearchArtistsResponse.pagination:type_name -> songlibrary.v1.PaginationMetadata
+	1,  // 4: songlibrary.v1.CreateArtistRequest.attributes:type_name -> songlibrary.v1.ArtistAttributes
+	1,  // 5: songlibrary.v1.ChangeArtistRequest.attributes:type_name -> songlibrary.v1.ArtistAttributes
+	2,  // 6: songlibrary.v1.GetArtistAliasesResponse.aliases:type_name -> songlibrary.v1.ArtistAlias
+	3,  // 7: songlibrary.v1.ArtistService.GetArtist:input_type -> songlibrary.v1.GetArtistRequest
+	4,  // 8: songlibrary.v1.ArtistService.GetArtistByISNI:input_type -> songlibrary.v1.GetArtistByISNIRequest
+	5,  // 9: songlibrary.v1.ArtistService.GetArtistByMBID:input_type -> songlibrary.v1.GetArtistByMBIDRequest
+	6,  // 10: songlibrary.v1.ArtistService.SearchArtists:input_type -> songlibrary.v1.SearchArtistsRequest
+	8,  // 11: songlibrary.v1.ArtistService.CreateArtist:input_type -> songlibrary.v1.CreateArtistRequest
+	9,  // 12: songlibrary.v1.ArtistService.ChangeArtist:input_type -> songlibrary.v1.ChangeArtistRequest
+	10, // 13: songlibrary.v1.ArtistService.RemoveArtist:input_type -> songlibrary.v1.RemoveArtistRequest
+	12, // 14: songlibrary.v1.ArtistService.MergeArtists:input_type -> songlibrary.v1.MergeArtistsRequest
+	13, // 15: songlibrary.v1.ArtistService.GetArtistAliases:input_type -> songlibrary.v1.GetArtistAliasesRequest
+	15, // 16: songlibrary.v1.ArtistService.AddArtistAlias:input_type -> songlibrary.v1.AddArtistAliasRequest
+	16, // 17: songlibrary.v1.ArtistService.RemoveArtistAlias:input_type -> songlibrary.v1.RemoveArtistAliasRequest
+	0,  // 18: songlibrary.v1.ArtistService.GetArtist:output_type -> songlibrary.v1.Artist
+	0,  // 19: songlibrary.v1.ArtistService.GetArtistByISNI:output_type -> songlibrary.v1.Artist
+	0,  // 20: songlibrary.v1.ArtistService.GetArtistByMBID:output_type -> songlibrary.v1.Artist
+	7,  // 21: songlibrary.v1.ArtistService.SearchArtists:output_type -> songlibrary.v1.SearchArtistsResponse
+	0,  // 22: songlibrary.v1.ArtistService.CreateArtist:output_type -> songlibrary.v1.Artist
+	0,  // 23: songlibrary.v1.ArtistService.ChangeArtist:output_type -> songlibrary.v1.Artist
+	11, // 24: songlibrary.v1.ArtistService.RemoveArtist:output_type -> songlibrary.v1.RemoveArtistResponse
+	0,  // 25: songlibrary.v1.ArtistService.MergeArtists:output_type -> songlibrary.v1.Artist
+	14, // 26: songlibrary.v1.ArtistService.GetArtistAliases:output_type -> songlibrary.v1.GetArtistAliasesResponse
+	2,  // 27: songlibrary.v1.ArtistService.AddArtistAlias:output_type -> songlibrary.v1.ArtistAlias
+	17, // 28: songlibrary.v1.ArtistService.RemoveArtistAlias:output_type -> songlibrary.v1.RemoveArtistAliasResponse
+	18, // [18:29] is the sub-list for method output_type
+	7,  // [7:18] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_songlibrary_v1_artist_proto_init() }
+func file_songlibrary_v1_artist_proto_init() {
+	if File_songlibrary_v1_artist_proto != nil {
+		return
+	}
+	file_songlibrary_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_songlibrary_v1_artist_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Artist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ArtistAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*ArtistAlias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GetArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GetArtistByISNIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*GetArtistByMBIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchArtistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchArtistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveArtistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveArtistResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeArtistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetArtistAliasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetArtistAliasesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*AddArtistAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveArtistAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_artist_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveArtistAliasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_songlibrary_v1_artist_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_songlibrary_v1_artist_proto_goTypes,
+		DependencyIndexes: file_songlibrary_v1_artist_proto_depIdxs,
+		MessageInfos:      file_songlibrary_v1_artist_proto_msgTypes,
+	}.Build()
+	File_songlibrary_v1_artist_proto = out.File
+	file_songlibrary_v1_artist_proto_rawDesc = nil
+	file_songlibrary_v1_artist_proto_goTypes = nil
+	file_songlibrary_v1_artist_proto_depIdxs = nil
+}
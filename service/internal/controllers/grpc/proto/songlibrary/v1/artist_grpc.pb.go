@@ -0,0 +1,527 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: songlibrary/v1/artist.proto
+
+package songlibraryv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ArtistService_GetArtist_FullMethodName         = "/songlibrary.v1.ArtistService/GetArtist"
+	ArtistService_GetArtistByISNI_FullMethodName   = "/songlibrary.v1.ArtistService/GetArtistByISNI"
+	ArtistService_GetArtistByMBID_FullMethodName   = "/songlibrary.v1.ArtistService/GetArtistByMBID"
+	ArtistService_SearchArtists_FullMethodName     = "/songlibrary.v1.ArtistService/SearchArtists"
+	ArtistService_CreateArtist_FullMethodName      = "/songlibrary.v1.ArtistService/CreateArtist"
+	ArtistService_ChangeArtist_FullMethodName      = "/songlibrary.v1.ArtistService/ChangeArtist"
+	ArtistService_RemoveArtist_FullMethodName      = "/songlibrary.v1.ArtistService/RemoveArtist"
+	ArtistService_MergeArtists_FullMethodName      = "/songlibrary.v1.ArtistService/MergeArtists"
+	ArtistService_GetArtistAliases_FullMethodName  = "/songlibrary.v1.ArtistService/GetArtistAliases"
+	ArtistService_AddArtistAlias_FullMethodName    = "/songlibrary.v1.ArtistService/AddArtistAlias"
+	ArtistService_RemoveArtistAlias_FullMethodName = "/songlibrary.v1.ArtistService/RemoveArtistAlias"
+)
+
+// ArtistServiceClient is the client API for ArtistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ArtistService это сервис исполнителей.
+type ArtistServiceClient interface {
+	// GetArtist возвращает данные определенного исполнителя.
+	GetArtist(ctx context.Context, in *GetArtistRequest, opts ...grpc.CallOption) (*Artist, error)
+	// GetArtistByISNI возвращает исполнителя с определенным кодом ISNI. Код может содержать пробелы.
+	GetArtistByISNI(ctx context.Context, in *GetArtistByISNIRequest, opts ...grpc.CallOption) (*Artist, error)
+	// GetArtistByMBID возвращает исполнителя с определенным идентификатором MusicBrainz.
+	GetArtistByMBID(ctx context.Context, in *GetArtistByMBIDRequest, opts ...grpc.CallOption) (*Artist, error)
+	// SearchArtists выполняет поиск исполнителей. Исполнители упорядочены по названию для сортировки.
+	SearchArtists(ctx context.Context, in *SearchArtistsRequest, opts ...grpc.CallOption) (*SearchArtistsResponse, error)
+	// CreateArtist добавляет нового исполнителя.
+	CreateArtist(ctx context.Context, in *CreateArtistRequest, opts ...grpc.CallOption) (*Artist, error)
+	// ChangeArtist обновляет заданные атрибуты определенного исполнителя.
+	ChangeArtist(ctx context.Context, in *ChangeArtistRequest, opts ...grpc.CallOption) (*Artist, error)
+	// RemoveArtist удаляет определенного исполнителя.
+	RemoveArtist(ctx context.Context, in *RemoveArtistRequest, opts ...grpc.CallOption) (*RemoveArtistResponse, error)
+	// MergeArtists сливает исполнителя-дубликата с определенным исполнителем.
+	MergeArtists(ctx context.Context, in *MergeArtistsRequest, opts ...grpc.CallOption) (*Artist, error)
+	// GetArtistAliases возвращает псевдонимы определенного исполнителя.
+	GetArtistAliases(ctx context.Context, in *GetArtistAliasesRequest, opts ...grpc.CallOption) (*GetArtistAliasesResponse, error)
+	// AddArtistAlias добавляет новый псевдоним исполнителя.
+	AddArtistAlias(ctx context.Context, in *AddArtistAliasRequest, opts ...grpc.CallOption) (*ArtistAlias, error)
+	// RemoveArtistAlias удаляет определенный псевдоним определенного исполнителя.
+	RemoveArtistAlias(ctx context.Context, in *RemoveArtistAliasRequest, opts ...grpc.CallOption) (*RemoveArtistAliasResponse, error)
+}
+
+type artistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewArtistServiceClient(cc grpc.ClientConnInterface) ArtistServiceClient {
+	return &artistServiceClient{cc}
+}
+
+func (c *artistServiceClient) GetArtist(ctx context.Context, in *GetArtistRequest, opts ...grpc.CallOption) (*Artist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, ArtistService_GetArtist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) GetArtistByISNI(ctx context.Context, in *GetArtistByISNIRequest, opts ...grpc.CallOption) (*Artist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, ArtistService_GetArtistByISNI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) GetArtistByMBID(ctx context.Context, in *GetArtistByMBIDRequest, opts ...grpc.CallOption) (*Artist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, ArtistService_GetArtistByMBID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) SearchArtists(ctx context.Context, in *SearchArtistsRequest, opts ...grpc.CallOption) (*SearchArtistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchArtistsResponse)
+	err := c.cc.Invoke(ctx, ArtistService_SearchArtists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) CreateArtist(ctx context.Context, in *CreateArtistRequest, opts ...grpc.CallOption) (*Artist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, ArtistService_CreateArtist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) ChangeArtist(ctx context.Context, in *ChangeArtistRequest, opts ...grpc.CallOption) (*Artist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, ArtistService_ChangeArtist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) RemoveArtist(ctx context.Context, in *RemoveArtistRequest, opts ...grpc.CallOption) (*RemoveArtistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveArtistResponse)
+	err := c.cc.Invoke(ctx, ArtistService_RemoveArtist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) MergeArtists(ctx context.Context, in *MergeArtistsRequest, opts ...grpc.CallOption) (*Artist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Artist)
+	err := c.cc.Invoke(ctx, ArtistService_MergeArtists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) GetArtistAliases(ctx context.Context, in *GetArtistAliasesRequest, opts ...grpc.CallOption) (*GetArtistAliasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetArtistAliasesResponse)
+	err := c.cc.Invoke(ctx, ArtistService_GetArtistAliases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) AddArtistAlias(ctx context.Context, in *AddArtistAliasRequest, opts ...grpc.CallOption) (*ArtistAlias, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArtistAlias)
+	err := c.cc.Invoke(ctx, ArtistService_AddArtistAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) RemoveArtistAlias(ctx context.Context, in *RemoveArtistAliasRequest, opts ...grpc.CallOption) (*RemoveArtistAliasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveArtistAliasResponse)
+	err := c.cc.Invoke(ctx, ArtistService_RemoveArtistAlias_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArtistServiceServer is the server API for ArtistService service.
+// All implementations must embed UnimplementedArtistServiceServer
+// for forward compatibility.
+//
+// ArtistService это сервис исполнителей.
+type ArtistServiceServer interface {
+	// GetArtist возвращает данные определенного исполнителя.
+	GetArtist(context.Context, *GetArtistRequest) (*Artist, error)
+	// GetArtistByISNI возвращает исполнителя с определенным кодом ISNI. Код может содержать пробелы.
+	GetArtistByISNI(context.Context, *GetArtistByISNIRequest) (*Artist, error)
+	// GetArtistByMBID возвращает исполнителя с определенным идентификатором MusicBrainz.
+	GetArtistByMBID(context.Context, *GetArtistByMBIDRequest) (*Artist, error)
+	// SearchArtists выполняет поиск исполнителей. Исполнители упорядочены по названию для сортировки.
+	SearchArtists(context.Context, *SearchArtistsRequest) (*SearchArtistsResponse, error)
+	// CreateArtist добавляет нового исполнителя.
+	CreateArtist(context.Context, *CreateArtistRequest) (*Artist, error)
+	// ChangeArtist обновляет заданные атрибуты определенного исполнителя.
+	ChangeArtist(context.Context, *ChangeArtistRequest) (*Artist, error)
+	// RemoveArtist удаляет определенного исполнителя.
+	RemoveArtist(context.Context, *RemoveArtistRequest) (*RemoveArtistResponse, error)
+	// MergeArtists сливает исполнителя-дубликата с определенным исполнителем.
+	MergeArtists(context.Context, *MergeArtistsRequest) (*Artist, error)
+	// GetArtistAliases возвращает псевдонимы определенного исполнителя.
+	GetArtistAliases(context.Context, *GetArtistAliasesRequest) (*GetArtistAliasesResponse, error)
+	// AddArtistAlias добавляет новый псевдоним исполнителя.
+	AddArtistAlias(context.Context, *AddArtistAliasRequest) (*ArtistAlias, error)
+	// RemoveArtistAlias удаляет определенный псевдоним определенного исполнителя.
+	RemoveArtistAlias(context.Context, *RemoveArtistAliasRequest) (*RemoveArtistAliasResponse, error)
+	mustEmbedUnimplementedArtistServiceServer()
+}
+
+// UnimplementedArtistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedArtistServiceServer struct{}
+
+func (UnimplementedArtistServiceServer) GetArtist(context.Context, *GetArtistRequest) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtist not implemented")
+}
+func (UnimplementedArtistServiceServer) GetArtistByISNI(context.Context, *GetArtistByISNIRequest) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtistByISNI not implemented")
+}
+func (UnimplementedArtistServiceServer) GetArtistByMBID(context.Context, *GetArtistByMBIDRequest) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtistByMBID not implemented")
+}
+func (UnimplementedArtistServiceServer) SearchArtists(context.Context, *SearchArtistsRequest) (*SearchArtistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArtists not implemented")
+}
+func (UnimplementedArtistServiceServer) CreateArtist(context.Context, *CreateArtistRequest) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateArtist not implemented")
+}
+func (UnimplementedArtistServiceServer) ChangeArtist(context.Context, *ChangeArtistRequest) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeArtist not implemented")
+}
+func (UnimplementedArtistServiceServer) RemoveArtist(context.Context, *RemoveArtistRequest) (*RemoveArtistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveArtist not implemented")
+}
+func (UnimplementedArtistServiceServer) MergeArtists(context.Context, *MergeArtistsRequest) (*Artist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeArtists not implemented")
+}
+func (UnimplementedArtistServiceServer) GetArtistAliases(context.Context, *GetArtistAliasesRequest) (*GetArtistAliasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArtistAliases not implemented")
+}
+func (UnimplementedArtistServiceServer) AddArtistAlias(context.Context, *AddArtistAliasRequest) (*ArtistAlias, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddArtistAlias not implemented")
+}
+func (UnimplementedArtistServiceServer) RemoveArtistAlias(context.Context, *RemoveArtistAliasRequest) (*RemoveArtistAliasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveArtistAlias not implemented")
+}
+func (UnimplementedArtistServiceServer) mustEmbedUnimplementedArtistServiceServer() {}
+func (UnimplementedArtistServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeArtistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ArtistServiceServer will
+// result in compilation errors.
+type UnsafeArtistServiceServer interface {
+	mustEmbedUnimplementedArtistServiceServer()
+}
+
+func RegisterArtistServiceServer(s grpc.ServiceRegistrar, srv ArtistServiceServer) {
+	// If the following call pancis, it indicates UnimplementedArtistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ArtistService_ServiceDesc, srv)
+}
+
+func _ArtistService_GetArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).GetArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_GetArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).GetArtist(ctx, req.(*GetArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_GetArtistByISNI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtistByISNIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).GetArtistByISNI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_GetArtistByISNI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).GetArtistByISNI(ctx, req.(*GetArtistByISNIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_GetArtistByMBID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtistByMBIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).GetArtistByMBID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_GetArtistByMBID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).GetArtistByMBID(ctx, req.(*GetArtistByMBIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_SearchArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArtistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).SearchArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_SearchArtists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).SearchArtists(ctx, req.(*SearchArtistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_CreateArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).CreateArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_CreateArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).CreateArtist(ctx, req.(*CreateArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_ChangeArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).ChangeArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_ChangeArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).ChangeArtist(ctx, req.(*ChangeArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_RemoveArtist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveArtistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).RemoveArtist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_RemoveArtist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).RemoveArtist(ctx, req.(*RemoveArtistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_MergeArtists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeArtistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).MergeArtists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_MergeArtists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).MergeArtists(ctx, req.(*MergeArtistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_GetArtistAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtistAliasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).GetArtistAliases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_GetArtistAliases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).GetArtistAliases(ctx, req.(*GetArtistAliasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_AddArtistAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddArtistAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).AddArtistAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_AddArtistAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).AddArtistAlias(ctx, req.(*AddArtistAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_RemoveArtistAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveArtistAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).RemoveArtistAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtistService_RemoveArtistAlias_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).RemoveArtistAlias(ctx, req.(*RemoveArtistAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ArtistService_ServiceDesc is the grpc.ServiceDesc for ArtistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ArtistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "songlibrary.v1.ArtistService",
+	HandlerType: (*ArtistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetArtist",
+			Handler:    _ArtistService_GetArtist_Handler,
+		},
+		{
+			MethodName: "GetArtistByISNI",
+			Handler:    _ArtistService_GetArtistByISNI_Handler,
+		},
+		{
+			MethodName: "GetArtistByMBID",
+			Handler:    _ArtistService_GetArtistByMBID_Handler,
+		},
+		{
+			MethodName: "SearchArtists",
+			Handler:    _ArtistService_SearchArtists_Handler,
+		},
+		{
+			MethodName: "CreateArtist",
+			Handler:    _ArtistService_CreateArtist_Handler,
+		},
+		{
+			MethodName: "ChangeArtist",
+			Handler:    _ArtistService_ChangeArtist_Handler,
+		},
+		{
+			MethodName: "RemoveArtist",
+			Handler:    _ArtistService_RemoveArtist_Handler,
+		},
+		{
+			MethodName: "MergeArtists",
+			Handler:    _ArtistService_MergeArtists_Handler,
+		},
+		{
+			MethodName: "GetArtistAliases",
+			Handler:    _ArtistService_GetArtistAliases_Handler,
+		},
+		{
+			MethodName: "AddArtistAlias",
+			Handler:    _ArtistService_AddArtistAlias_Handler,
+		},
+		{
+			MethodName: "RemoveArtistAlias",
+			Handler:    _ArtistService_RemoveArtistAlias_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "songlibrary/v1/artist.proto",
+}
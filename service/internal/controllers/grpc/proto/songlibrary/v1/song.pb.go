@@ -0,0 +1,3140 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: songlibrary/v1/song.proto
+
+package songlibraryv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Song это песня.
+type Song struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attributes *SongAttributes `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Artist     *Artist         `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
+	// link_provider и link_media_id заполняются, если ссылка указывает на запись известного музыкального сервиса.
+	LinkProvider string        `protobuf:"bytes,4,opt,name=link_provider,json=linkProvider,proto3" json:"link_provider,omitempty"`
+	LinkMediaId  string        `protobuf:"bytes,5,opt,name=link_media_id,json=linkMediaId,proto3" json:"link_media_id,omitempty"`
+	Credits      []*SongCredit `protobuf:"bytes,6,rep,name=credits,proto3" json:"credits,omitempty"`
+	Genres       []string      `protobuf:"bytes,7,rep,name=genres,proto3" json:"genres,omitempty"`
+	Tags         []string      `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Language     string        `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	Stats        *LyricsStats  `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
+	LinkHealth   *LinkHealth   `protobuf:"bytes,11,opt,name=link_health,json=linkHealth,proto3" json:"link_health,omitempty"`
+}
+
+func (x *Song) Reset() {
+	*x = Song{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Song) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Song) ProtoMessage() {}
+
+func (x *Song) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Song.ProtoReflect.Descriptor instead.
+func (*Song) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Song) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Song) GetAttributes() *SongAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Song) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+func (x *Song) GetLinkProvider() string {
+	if x != nil {
+		return x.LinkProvider
+	}
+	return ""
+}
+
+func (x *Song) GetLinkMediaId() string {
+	if x != nil {
+		return x.LinkMediaId
+	}
+	return ""
+}
+
+func (x *Song) GetCredits() []*SongCredit {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+func (x *Song) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *Song) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Song) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Song) GetStats() *LyricsStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Song) GetLinkHealth() *LinkHealth {
+	if x != nil {
+		return x.LinkHealth
+	}
+	return nil
+}
+
+// SongAttributes это атрибуты песни.
+type SongAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	// text это текст песни, куплеты которого разделены '\n\n'.
+	Text string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Link string `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	Isrc string `protobuf:"bytes,5,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	Iswc string `protobuf:"bytes,6,opt,name=iswc,proto3" json:"iswc,omitempty"`
+}
+
+func (x *SongAttributes) Reset() {
+	*x = SongAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongAttributes) ProtoMessage() {}
+
+func (x *SongAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongAttributes.ProtoReflect.Descriptor instead.
+func (*SongAttributes) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SongAttributes) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SongAttributes) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+func (x *SongAttributes) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SongAttributes) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *SongAttributes) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *SongAttributes) GetIswc() string {
+	if x != nil {
+		return x.Iswc
+	}
+	return ""
+}
+
+// SongCredit это участник создания песни.
+type SongCredit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// role это featured, producer, composer или lyricist.
+	Role   string  `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Artist *Artist `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"`
+}
+
+func (x *SongCredit) Reset() {
+	*x = SongCredit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongCredit) ProtoMessage() {}
+
+func (x *SongCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongCredit.ProtoReflect.Descriptor instead.
+func (*SongCredit) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SongCredit) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SongCredit) GetArtist() *Artist {
+	if x != nil {
+		return x.Artist
+	}
+	return nil
+}
+
+// SongCreditAttributes это участник создания новой или изменяемой песни.
+type SongCreditAttributes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistId uint64 `protobuf:"varint,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	Role     string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *SongCreditAttributes) Reset() {
+	*x = SongCreditAttributes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongCreditAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongCreditAttributes) ProtoMessage() {}
+
+func (x *SongCreditAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongCreditAttributes.ProtoReflect.Descriptor instead.
+func (*SongCreditAttributes) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SongCreditAttributes) GetArtistId() uint64 {
+	if x != nil {
+		return x.ArtistId
+	}
+	return 0
+}
+
+func (x *SongCreditAttributes) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// SongCredits это список участников создания песни.
+type SongCredits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credits []*SongCreditAttributes `protobuf:"bytes,1,rep,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (x *SongCredits) Reset() {
+	*x = SongCredits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongCredits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongCredits) ProtoMessage() {}
+
+func (x *SongCredits) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongCredits.ProtoReflect.Descriptor instead.
+func (*SongCredits) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SongCredits) GetCredits() []*SongCreditAttributes {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+// LyricsStats это статистика текста песни.
+type LyricsStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LineCount       uint32  `protobuf:"varint,1,opt,name=line_count,json=lineCount,proto3" json:"line_count,omitempty"`
+	CoupletCount    uint32  `protobuf:"varint,2,opt,name=couplet_count,json=coupletCount,proto3" json:"couplet_count,omitempty"`
+	WordCount       uint32  `protobuf:"varint,3,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	UniqueWordRatio float64 `protobuf:"fixed64,4,opt,name=unique_word_ratio,json=uniqueWordRatio,proto3" json:"unique_word_ratio,omitempty"`
+	// reading_time это примерное время чтения текста в секундах.
+	ReadingTime uint32 `protobuf:"varint,5,opt,name=reading_time,json=readingTime,proto3" json:"reading_time,omitempty"`
+}
+
+func (x *LyricsStats) Reset() {
+	*x = LyricsStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LyricsStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LyricsStats) ProtoMessage() {}
+
+func (x *LyricsStats) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LyricsStats.ProtoReflect.Descriptor instead.
+func (*LyricsStats) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LyricsStats) GetLineCount() uint32 {
+	if x != nil {
+		return x.LineCount
+	}
+	return 0
+}
+
+func (x *LyricsStats) GetCoupletCount() uint32 {
+	if x != nil {
+		return x.CoupletCount
+	}
+	return 0
+}
+
+func (x *LyricsStats) GetWordCount() uint32 {
+	if x != nil {
+		return x.WordCount
+	}
+	return 0
+}
+
+func (x *LyricsStats) GetUniqueWordRatio() float64 {
+	if x != nil {
+		return x.UniqueWordRatio
+	}
+	return 0
+}
+
+func (x *LyricsStats) GetReadingTime() uint32 {
+	if x != nil {
+		return x.ReadingTime
+	}
+	return 0
+}
+
+// LinkHealth это результат последней проверки доступности ссылки.
+type LinkHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// status это ok, broken или unchecked.
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	StatusCode    int32                  `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	FailureStreak uint32                 `protobuf:"varint,4,opt,name=failure_streak,json=failureStreak,proto3" json:"failure_streak,omitempty"`
+}
+
+func (x *LinkHealth) Reset() {
+	*x = LinkHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkHealth) ProtoMessage() {}
+
+func (x *LinkHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkHealth.ProtoReflect.Descriptor instead.
+func (*LinkHealth) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LinkHealth) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LinkHealth) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *LinkHealth) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *LinkHealth) GetFailureStreak() uint32 {
+	if x != nil {
+		return x.FailureStreak
+	}
+	return 0
+}
+
+// SongsFilter это параметры поиска песен.
+type SongsFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ArtistName string `protobuf:"bytes,2,opt,name=artist_name,json=artistName,proto3" json:"artist_name,omitempty"`
+	// credited_artist_name ищет по основному исполнителю или любому участнику создания песни.
+	CreditedArtistName string `protobuf:"bytes,3,opt,name=credited_artist_name,json=creditedArtistName,proto3" json:"credited_artist_name,omitempty"`
+	Link               string `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	Language           string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+	// genres и tags ищут песни, у которых есть все указанные жанры и метки.
+	Genres []string `protobuf:"bytes,6,rep,name=genres,proto3" json:"genres,omitempty"`
+	Tags   []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// link_status это ok, broken или unchecked.
+	LinkStatus string `protobuf:"bytes,8,opt,name=link_status,json=linkStatus,proto3" json:"link_status,omitempty"`
+}
+
+func (x *SongsFilter) Reset() {
+	*x = SongsFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongsFilter) ProtoMessage() {}
+
+func (x *SongsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongsFilter.ProtoReflect.Descriptor instead.
+func (*SongsFilter) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SongsFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SongsFilter) GetArtistName() string {
+	if x != nil {
+		return x.ArtistName
+	}
+	return ""
+}
+
+func (x *SongsFilter) GetCreditedArtistName() string {
+	if x != nil {
+		return x.CreditedArtistName
+	}
+	return ""
+}
+
+func (x *SongsFilter) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *SongsFilter) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *SongsFilter) GetGenres() []string {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *SongsFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SongsFilter) GetLinkStatus() string {
+	if x != nil {
+		return x.LinkStatus
+	}
+	return ""
+}
+
+// Facet это количество найденных песен с определенным жанром или меткой.
+type Facet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Facet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Facet) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// SongFacets это количество найденных песен по каждому жанру и каждой метке.
+type SongFacets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Genres []*Facet `protobuf:"bytes,1,rep,name=genres,proto3" json:"genres,omitempty"`
+	Tags   []*Facet `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *SongFacets) Reset() {
+	*x = SongFacets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongFacets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongFacets) ProtoMessage() {}
+
+func (x *SongFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongFacets.ProtoReflect.Descriptor instead.
+func (*SongFacets) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SongFacets) GetGenres() []*Facet {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *SongFacets) GetTags() []*Facet {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// SongRelation это связь производной песни с оригиналом.
+type SongRelation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type это cover, remix, live или sample.
+	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	SongId     uint64 `protobuf:"varint,2,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	OriginalId uint64 `protobuf:"varint,3,opt,name=original_id,json=originalId,proto3" json:"original_id,omitempty"`
+}
+
+func (x *SongRelation) Reset() {
+	*x = SongRelation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongRelation) ProtoMessage() {}
+
+func (x *SongRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongRelation.ProtoReflect.Descriptor instead.
+func (*SongRelation) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SongRelation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SongRelation) GetSongId() uint64 {
+	if x != nil {
+		return x.SongId
+	}
+	return 0
+}
+
+func (x *SongRelation) GetOriginalId() uint64 {
+	if x != nil {
+		return x.OriginalId
+	}
+	return 0
+}
+
+// SongList это список песен.
+type SongList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Songs []*Song `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+}
+
+func (x *SongList) Reset() {
+	*x = SongList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SongList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SongList) ProtoMessage() {}
+
+func (x *SongList) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SongList.ProtoReflect.Descriptor instead.
+func (*SongList) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SongList) GetSongs() []*Song {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+// LinkStats это количество песен со ссылками по статусу ссылки.
+type LinkStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok        uint64 `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Broken    uint64 `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	Unchecked uint64 `protobuf:"varint,3,opt,name=unchecked,proto3" json:"unchecked,omitempty"`
+}
+
+func (x *LinkStats) Reset() {
+	*x = LinkStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkStats) ProtoMessage() {}
+
+func (x *LinkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkStats.ProtoReflect.Descriptor instead.
+func (*LinkStats) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LinkStats) GetOk() uint64 {
+	if x != nil {
+		return x.Ok
+	}
+	return 0
+}
+
+func (x *LinkStats) GetBroken() uint64 {
+	if x != nil {
+		return x.Broken
+	}
+	return 0
+}
+
+func (x *LinkStats) GetUnchecked() uint64 {
+	if x != nil {
+		return x.Unchecked
+	}
+	return 0
+}
+
+type GetSongWithCoupletPaginationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// pagination задает страницу куплетов.
+	Pagination *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetSongWithCoupletPaginationRequest) Reset() {
+	*x = GetSongWithCoupletPaginationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSongWithCoupletPaginationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongWithCoupletPaginationRequest) ProtoMessage() {}
+
+func (x *GetSongWithCoupletPaginationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongWithCoupletPaginationRequest.ProtoReflect.Descriptor instead.
+func (*GetSongWithCoupletPaginationRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSongWithCoupletPaginationRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetSongWithCoupletPaginationRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetSongWithCoupletPaginationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Song       *Song               `protobuf:"bytes,1,opt,name=song,proto3" json:"song,omitempty"`
+	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetSongWithCoupletPaginationResponse) Reset() {
+	*x = GetSongWithCoupletPaginationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSongWithCoupletPaginationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongWithCoupletPaginationResponse) ProtoMessage() {}
+
+func (x *GetSongWithCoupletPaginationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongWithCoupletPaginationResponse.ProtoReflect.Descriptor instead.
+func (*GetSongWithCoupletPaginationResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSongWithCoupletPaginationResponse) GetSong() *Song {
+	if x != nil {
+		return x.Song
+	}
+	return nil
+}
+
+func (x *GetSongWithCoupletPaginationResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter     *SongsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Pagination *Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *SearchSongsRequest) Reset() {
+	*x = SearchSongsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSongsRequest) ProtoMessage() {}
+
+func (x *SearchSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSongsRequest.ProtoReflect.Descriptor instead.
+func (*SearchSongsRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchSongsRequest) GetFilter() *SongsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchSongsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchSongsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Songs      []*Song             `protobuf:"bytes,1,rep,name=songs,proto3" json:"songs,omitempty"`
+	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Facets     *SongFacets         `protobuf:"bytes,3,opt,name=facets,proto3" json:"facets,omitempty"`
+}
+
+func (x *SearchSongsResponse) Reset() {
+	*x = SearchSongsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchSongsResponse) ProtoMessage() {}
+
+func (x *SearchSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchSongsResponse.ProtoReflect.Descriptor instead.
+func (*SearchSongsResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchSongsResponse) GetSongs() []*Song {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+func (x *SearchSongsResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchSongsResponse) GetFacets() *SongFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type ListSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *SongsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *ListSongsRequest) Reset() {
+	*x = ListSongsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSongsRequest) ProtoMessage() {}
+
+func (x *ListSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSongsRequest.ProtoReflect.Descriptor instead.
+func (*ListSongsRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListSongsRequest) GetFilter() *SongsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type ExportSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *SongsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// format это m3u8, xspf или jspf.
+	Format string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *ExportSongsRequest) Reset() {
+	*x = ExportSongsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSongsRequest) ProtoMessage() {}
+
+func (x *ExportSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSongsRequest.ProtoReflect.Descriptor instead.
+func (*ExportSongsRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportSongsRequest) GetFilter() *SongsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportSongsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportSongsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType string `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data        []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportSongsResponse) Reset() {
+	*x = ExportSongsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSongsResponse) ProtoMessage() {}
+
+func (x *ExportSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSongsResponse.ProtoReflect.Descriptor instead.
+func (*ExportSongsResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportSongsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportSongsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attributes *SongAttributes         `protobuf:"bytes,1,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	ArtistId   uint64                  `protobuf:"varint,2,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	Credits    []*SongCreditAttributes `protobuf:"bytes,3,rep,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (x *CreateSongRequest) Reset() {
+	*x = CreateSongRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSongRequest) ProtoMessage() {}
+
+func (x *CreateSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSongRequest.ProtoReflect.Descriptor instead.
+func (*CreateSongRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateSongRequest) GetAttributes() *SongAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *CreateSongRequest) GetArtistId() uint64 {
+	if x != nil {
+		return x.ArtistId
+	}
+	return 0
+}
+
+func (x *CreateSongRequest) GetCredits() []*SongCreditAttributes {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+type GetSongByISRCRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Isrc string `protobuf:"bytes,1,opt,name=isrc,proto3" json:"isrc,omitempty"`
+}
+
+func (x *GetSongByISRCRequest) Reset() {
+	*x = GetSongByISRCRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSongByISRCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongByISRCRequest) ProtoMessage() {}
+
+func (x *GetSongByISRCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongByISRCRequest.ProtoReflect.Descriptor instead.
+func (*GetSongByISRCRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetSongByISRCRequest) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+type GetSongsByISWCRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Iswc string `protobuf:"bytes,1,opt,name=iswc,proto3" json:"iswc,omitempty"`
+}
+
+func (x *GetSongsByISWCRequest) Reset() {
+	*x = GetSongsByISWCRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSongsByISWCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongsByISWCRequest) ProtoMessage() {}
+
+func (x *GetSongsByISWCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongsByISWCRequest.ProtoReflect.Descriptor instead.
+func (*GetSongsByISWCRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetSongsByISWCRequest) GetIswc() string {
+	if x != nil {
+		return x.Iswc
+	}
+	return ""
+}
+
+type GetSongsByISWCResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Iswc  string  `protobuf:"bytes,1,opt,name=iswc,proto3" json:"iswc,omitempty"`
+	Songs []*Song `protobuf:"bytes,2,rep,name=songs,proto3" json:"songs,omitempty"`
+}
+
+func (x *GetSongsByISWCResponse) Reset() {
+	*x = GetSongsByISWCResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSongsByISWCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSongsByISWCResponse) ProtoMessage() {}
+
+func (x *GetSongsByISWCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSongsByISWCResponse.ProtoReflect.Descriptor instead.
+func (*GetSongsByISWCResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetSongsByISWCResponse) GetIswc() string {
+	if x != nil {
+		return x.Iswc
+	}
+	return ""
+}
+
+func (x *GetSongsByISWCResponse) GetSongs() []*Song {
+	if x != nil {
+		return x.Songs
+	}
+	return nil
+}
+
+type GetLinkReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetLinkReportRequest) Reset() {
+	*x = GetLinkReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLinkReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLinkReportRequest) ProtoMessage() {}
+
+func (x *GetLinkReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLinkReportRequest.ProtoReflect.Descriptor instead.
+func (*GetLinkReportRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetLinkReportRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetLinkReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats       *LinkStats          `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	BrokenSongs []*Song             `protobuf:"bytes,2,rep,name=broken_songs,json=brokenSongs,proto3" json:"broken_songs,omitempty"`
+	Pagination  *PaginationMetadata `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetLinkReportResponse) Reset() {
+	*x = GetLinkReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLinkReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLinkReportResponse) ProtoMessage() {}
+
+func (x *GetLinkReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLinkReportResponse.ProtoReflect.Descriptor instead.
+func (*GetLinkReportResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetLinkReportResponse) GetStats() *LinkStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GetLinkReportResponse) GetBrokenSongs() []*Song {
+	if x != nil {
+		return x.BrokenSongs
+	}
+	return nil
+}
+
+func (x *GetLinkReportResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ChangeSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// attributes содержит новые значения атрибутов. Пустые значения не изменяются.
+	Attributes *SongAttributes `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	ArtistId   uint64          `protobuf:"varint,3,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	// credits полностью заменяет список участников создания песни, если задан.
+	Credits *SongCredits `protobuf:"bytes,4,opt,name=credits,proto3" json:"credits,omitempty"`
+}
+
+func (x *ChangeSongRequest) Reset() {
+	*x = ChangeSongRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSongRequest) ProtoMessage() {}
+
+func (x *ChangeSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSongRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSongRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChangeSongRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeSongRequest) GetAttributes() *SongAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *ChangeSongRequest) GetArtistId() uint64 {
+	if x != nil {
+		return x.ArtistId
+	}
+	return 0
+}
+
+func (x *ChangeSongRequest) GetCredits() *SongCredits {
+	if x != nil {
+		return x.Credits
+	}
+	return nil
+}
+
+type RemoveSongRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveSongRequest) Reset() {
+	*x = RemoveSongRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSongRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSongRequest) ProtoMessage() {}
+
+func (x *RemoveSongRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSongRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSongRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RemoveSongRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveSongResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveSongResponse) Reset() {
+	*x = RemoveSongResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveSongResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSongResponse) ProtoMessage() {}
+
+func (x *RemoveSongResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSongResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSongResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RemoveSongResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ChangeSongTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// kind это genre или tag.
+	Kind  string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Names []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (x *ChangeSongTagsRequest) Reset() {
+	*x = ChangeSongTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeSongTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSongTagsRequest) ProtoMessage() {}
+
+func (x *ChangeSongTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSongTagsRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSongTagsRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChangeSongTagsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ChangeSongTagsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ChangeSongTagsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type GetRelatedSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRelatedSongsRequest) Reset() {
+	*x = GetRelatedSongsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelatedSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedSongsRequest) ProtoMessage() {}
+
+func (x *GetRelatedSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedSongsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedSongsRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetRelatedSongsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetRelatedSongsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SongId uint64 `protobuf:"varint,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	// originals это песни, производной от которых является песня, сгруппированные по типу связи.
+	Originals map[string]*SongList `protobuf:"bytes,2,rep,name=originals,proto3" json:"originals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// derivatives это песни, производные от песни, сгруппированные по типу связи.
+	Derivatives map[string]*SongList `protobuf:"bytes,3,rep,name=derivatives,proto3" json:"derivatives,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetRelatedSongsResponse) Reset() {
+	*x = GetRelatedSongsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelatedSongsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelatedSongsResponse) ProtoMessage() {}
+
+func (x *GetRelatedSongsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelatedSongsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedSongsResponse) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetRelatedSongsResponse) GetSongId() uint64 {
+	if x != nil {
+		return x.SongId
+	}
+	return 0
+}
+
+func (x *GetRelatedSongsResponse) GetOriginals() map[string]*SongList {
+	if x != nil {
+		return x.Originals
+	}
+	return nil
+}
+
+func (x *GetRelatedSongsResponse) GetDerivatives() map[string]*SongList {
+	if x != nil {
+		return x.Derivatives
+	}
+	return nil
+}
+
+type LinkSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SongId     uint64 `protobuf:"varint,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Type       string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	OriginalId uint64 `protobuf:"varint,3,opt,name=original_id,json=originalId,proto3" json:"original_id,omitempty"`
+}
+
+func (x *LinkSongsRequest) Reset() {
+	*x = LinkSongsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkSongsRequest) ProtoMessage() {}
+
+func (x *LinkSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkSongsRequest.ProtoReflect.Descriptor instead.
+func (*LinkSongsRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LinkSongsRequest) GetSongId() uint64 {
+	if x != nil {
+		return x.SongId
+	}
+	return 0
+}
+
+func (x *LinkSongsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LinkSongsRequest) GetOriginalId() uint64 {
+	if x != nil {
+		return x.OriginalId
+	}
+	return 0
+}
+
+type UnlinkSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SongId     uint64 `protobuf:"varint,1,opt,name=song_id,json=songId,proto3" json:"song_id,omitempty"`
+	Type       string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	OriginalId uint64 `protobuf:"varint,3,opt,name=original_id,json=originalId,proto3" json:"original_id,omitempty"`
+}
+
+func (x *UnlinkSongsRequest) Reset() {
+	*x = UnlinkSongsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlinkSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkSongsRequest) ProtoMessage() {}
+
+func (x *UnlinkSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkSongsRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkSongsRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UnlinkSongsRequest) GetSongId() uint64 {
+	if x != nil {
+		return x.SongId
+	}
+	return 0
+}
+
+func (x *UnlinkSongsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UnlinkSongsRequest) GetOriginalId() uint64 {
+	if x != nil {
+		return x.OriginalId
+	}
+	return 0
+}
+
+type MergeSongsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DuplicateId uint64 `protobuf:"varint,2,opt,name=duplicate_id,json=duplicateId,proto3" json:"duplicate_id,omitempty"`
+}
+
+func (x *MergeSongsRequest) Reset() {
+	*x = MergeSongsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_songlibrary_v1_song_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeSongsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeSongsRequest) ProtoMessage() {}
+
+func (x *MergeSongsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_songlibrary_v1_song_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeSongsRequest.ProtoReflect.Descriptor instead.
+func (*MergeSongsRequest) Descriptor() ([]byte, []int) {
+	return file_songlibrary_v1_song_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MergeSongsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MergeSongsRequest) GetDuplicateId() uint64 {
+	if x != nil {
+		return x.DuplicateId
+	}
+	return 0
+}
+
+var File_songlibrary_v1_song_proto protoreflect.FileDescriptor
+
+var file_songlibrary_v1_song_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x73, 0x6f, 0x6e,
+	0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x73, 0x6f,
+	0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x73, 0x6f, 0x6e, 0x67, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x03, 0x0a, 0x04, 0x53, 0x6f, 0x6e, 0x67, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x3e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x2e, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12,
+	0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x6e,
+	0x6b, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x6e, 0x67,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x6c, 0x69, 0x6e,
+	0x6b, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x6b,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0xb3, 0x01, 0x0a, 0x0e, 0x53, 0x6f, 0x6e, 0x67, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a,
+	0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x72, 0x63, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x77, 0x63,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x77, 0x63, 0x22, 0x50, 0x0a, 0x0a,
+	0x53, 0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x2e,
+	0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x22, 0x47,
+	0x0a, 0x14, 0x53, 0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x4d, 0x0a, 0x0b, 0x53, 0x6f, 0x6e, 0x67, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x0b, 0x4c, 0x79, 0x72, 0x69, 0x63,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6c, 0x69, 0x6e, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x63, 0x6f,
+	0x75, 0x70, 0x6c, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6f,
+	0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x57, 0x6f, 0x72, 0x64,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x6e,
+	0x6b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6b, 0x22, 0xf1, 0x01, 0x0a, 0x0b, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e,
+	0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x6e, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x31, 0x0a, 0x05, 0x46, 0x61, 0x63, 0x65, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x66, 0x0a, 0x0a, 0x53, 0x6f, 0x6e,
+	0x67, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x72, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x06,
+	0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x22, 0x5c, 0x0a, 0x0c, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22,
+	0x36, 0x0a, 0x08, 0x53, 0x6f, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x73,
+	0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6f, 0x6e,
+	0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67,
+	0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x22, 0x51, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x6f, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x71, 0x0a, 0x23, 0x47, 0x65,
+	0x74, 0x53, 0x6f, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x74,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x94, 0x01,
+	0x0a, 0x24, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x75,
+	0x70, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x6f, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x04, 0x73, 0x6f, 0x6e, 0x67,
+	0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x85, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53,
+	0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6f,
+	0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e,
+	0x67, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb9, 0x01, 0x0a,
+	0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73,
+	0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73,
+	0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x22, 0x47, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73,
+	0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f,
+	0x6e, 0x67, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x22, 0x61, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x22, 0x4c, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6f,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xb0, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e,
+	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73,
+	0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f,
+	0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67,
+	0x42, 0x79, 0x49, 0x53, 0x52, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x73, 0x72, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72,
+	0x63, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x49,
+	0x53, 0x57, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73,
+	0x77, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x77, 0x63, 0x22, 0x58,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x49, 0x53, 0x57, 0x43,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x77, 0x63,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x77, 0x63, 0x12, 0x2a, 0x0a, 0x05,
+	0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x6f,
+	0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e,
+	0x67, 0x52, 0x05, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x22, 0x52, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x62, 0x72, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x73, 0x6f, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x6f, 0x6e, 0x67, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x6f, 0x6e, 0x67, 0x73,
+	0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53,
+	0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6f, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x07, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x22, 0x23,
+	0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x6f, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x15, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x96, 0x03, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x09, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x73, 0x12, 0x5a, 0x0a, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0b, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x73, 0x1a, 0x56, 0x0a,
+	0x0e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x10, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6f, 0x6e,
+	0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x60, 0x0a, 0x10, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x22, 0x62, 0x0a, 0x12, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x6f, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x6e, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x6f, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x11, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x6f,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x64, 0x32, 0x97, 0x0a,
+	0x0a, 0x0b, 0x53, 0x6f, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x89, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x75,
+	0x70, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x70, 0x6c,
+	0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x57, 0x69, 0x74, 0x68,
+	0x43, 0x6f, 0x75, 0x70, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73,
+	0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x20,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x6f,
+	0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x21,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x6f,
+	0x6e, 0x67, 0x42, 0x79, 0x49, 0x53, 0x52, 0x43, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e,
+	0x67, 0x42, 0x79, 0x49, 0x53, 0x52, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x6f, 0x6e, 0x67, 0x12, 0x5f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73,
+	0x42, 0x79, 0x49, 0x53, 0x57, 0x43, 0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73,
+	0x42, 0x79, 0x49, 0x53, 0x57, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x42, 0x79, 0x49, 0x53, 0x57, 0x43, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73,
+	0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x6f, 0x6e,
+	0x67, 0x12, 0x21, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x53, 0x0a, 0x0a, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x21, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x6f,
+	0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4d, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x25, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x54, 0x61, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x12, 0x62,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67,
+	0x73, 0x12, 0x26, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x6f, 0x6e, 0x67,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12,
+	0x20, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x4f, 0x0a, 0x0b, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x22,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x45, 0x0a, 0x0a, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x12, 0x21,
+	0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x53, 0x6f, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6e, 0x67, 0x42, 0x65, 0x5a, 0x63, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x64, 0x6f, 0x6e, 0x6e, 0x2f, 0x73, 0x6f, 0x6e,
+	0x67, 0x2d, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x73, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x76, 0x31,
+	0x3b, 0x73, 0x6f, 0x6e, 0x67, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_songlibrary_v1_song_proto_rawDescOnce sync.Once
+	file_songlibrary_v1_song_proto_rawDescData = file_songlibrary_v1_song_proto_rawDesc
+)
+
+func file_songlibrary_v1_song_proto_rawDescGZIP() []byte {
+	file_songlibrary_v1_song_proto_rawDescOnce.Do(func() {
+		file_songlibrary_v1_song_proto_rawDescData = protoimpl.X.CompressGZIP(file_songlibrary_v1_song_proto_rawDescData)
+	})
+	return file_songlibrary_v1_song_proto_rawDescData
+}
+
+var file_songlibrary_v1_song_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_songlibrary_v1_song_proto_goTypes = []any{
+	(*Song)(nil),                                 // 0: songlibrary.v1.Song
+	(*SongAttributes)(nil),                       // 1: songlibrary.v1.SongAttributes
+	(*SongCredit)(nil),                           // 2: songlibrary.v1.SongCredit
+	(*SongCreditAttributes)(nil),                 // 3: songlibrary.v1.SongCreditAttributes
+	(*SongCredits)(nil),                          // 4: songlibrary.v1.SongCredits
+	(*LyricsStats)(nil),                          // 5: songlibrary.v1.LyricsStats
+	(*LinkHealth)(nil),                           // 6: songlibrary.v1.LinkHealth
+	(*SongsFilter)(nil),                          // 7: songlibrary.v1.SongsFilter
+	(*Facet)(nil),                                // 8: songlibrary.v1.Facet
+	(*SongFacets)(nil),                           // 9: songlibrary.v1.SongFacets
+	(*SongRelation)(nil),                         // 10: songlibrary.v1.SongRelation
+	(*SongList)(nil),                             // 11: songlibrary.v1.SongList
+	(*LinkStats)(nil),                            // 12: songlibrary.v1.LinkStats
+	(*GetSongWithCoupletPaginationRequest)(nil),  // 13: songlibrary.v1.GetSongWithCoupletPaginationRequest
+	(*GetSongWithCoupletPaginationResponse)(nil), // 14: songlibrary.v1.GetSongWithCoupletPaginationResponse
+	(*SearchSongsRequest)(nil),                   // 15: songlibrary.v1.SearchSongsRequest
+	(*SearchSongsResponse)(nil),                  // 16: songlibrary.v1.SearchSongsResponse
+	(*ListSongsRequest)(nil),                     // 17: songlibrary.v1.ListSongsRequest
+	(*ExportSongsRequest)(nil),                   // 18: songlibrary.v1.ExportSongsRequest
+	(*ExportSongsResponse)(nil),                  // 19: songlibrary.v1.ExportSongsResponse
+	(*CreateSongRequest)(nil),                    // 20: songlibrary.v1.CreateSongRequest
+	(*GetSongByISRCRequest)(nil),                 // 21: songlibrary.v1.GetSongByISRCRequest
+	(*GetSongsByISWCRequest)(nil),                // 22: songlibrary.v1.GetSongsByISWCRequest
+	(*GetSongsByISWCResponse)(nil),               // 23: songlibrary.v1.GetSongsByISWCResponse
+	(*GetLinkReportRequest)(nil),                 // 24: songlibrary.v1.GetLinkReportRequest
+	(*GetLinkReportResponse)(nil),                // 25: songlibrary.v1.GetLinkReportResponse
+	(*ChangeSongRequest)(nil),                    // 26: songlibrary.v1.ChangeSongRequest
+	(*RemoveSongRequest)(nil),                    // 27: songlibrary.v1.RemoveSongRequest
+	(*RemoveSongResponse)(nil),                   // 28: songlibrary.v1.RemoveSongResponse
+	(*ChangeSongTagsRequest)(nil),                // 29: songlibrary.v1.ChangeSongTagsRequest
+	(*GetRelatedSongsRequest)(nil),               // 30: songlibrary.v1.GetRelatedSongsRequest
+	(*GetRelatedSongsResponse)(nil),              // 31: songlibrary.v1.GetRelatedSongsResponse
+	(*LinkSongsRequest)(nil),                     // 32: songlibrary.v1.LinkSongsRequest
+	(*UnlinkSongsRequest)(nil),                   // 33: songlibrary.v1.UnlinkSongsRequest
+	(*MergeSongsRequest)(nil),                    // 34: songlibrary.v1.MergeSongsRequest
+	nil,                                          // 35: songlibrary.v1.GetRelatedSongsResponse.OriginalsEntry
+	nil,                                          // 36: songlibrary.v1.GetRelatedSongsResponse.DerivativesEntry
+	(*Artist)(nil),                               // 37: songlibrary.v1.Artist
+	(*timestamppb.Timestamp)(nil),                // 38: google.protobuf.Timestamp
+	(*Pagination)(nil),                           // 39: songlibrary.v1.Pagination
+	(*PaginationMetadata)(nil),                   // 40: songlibrary.v1.PaginationMetadata
+}
+var file_songlibrary_v1_song_proto_depIdxs = []int32{
+	1,  // 0: songlibrary.v1.Song.attributes:type_name -> songlibrary.v1.SongAttributes
+	37, // 1: songlibrary.v1.Song.artist:type_name -> songlibrary.v1.Artist
+	2,  // 2: songlibrary.v1.Song.credits:type_name -> songlibrary.v1.SongCredit
+	5,  // 3: songlibrary.v1.Song.stats:type_name -> songlibrary.v1.LyricsStats
+	6,  // 4: songlibrary.v1.Song.link_health:type_name -> songlibrary.v1.LinkHealth
+	38, // 5: songlibrary.v1.SongAttributes.release_date:type_name -> google.protobuf.Timestamp
+	37, // 6: songlibrary.v1.SongCredit.artist:type_name -> songlibrary.v1.Artist
+	3,  // 7: songlibrary.v1.SongCredits.credits:type_name -> songlibrary.v1.SongCreditAttributes
+	38, // 8: songlibrary.v1.LinkHealth.checked_at:type_name -> google.protobuf.Timestamp
+	8,  // 9: songlibrary.v1.SongFacets.genres:type_name -> songlibrary.v1.Facet
+	8,  // 10: songlibrary.v1.SongFacets.tags:type_name -> songlibrary.v1.Facet
+	0,  // 11: songlibrary.v1.SongList.songs:type_name -> songlibrary.v1.Song
+	39, // 12: songlibrary.v1.GetSongWithCoupletPaginationRequest.pagination:type_name -> songlibrary.v1.Pagination
+	0,  // 13: songlibrary.v1.GetSongWithCoupletPaginationResponse.song:type_name -> songlibrary.v1.Song
+	40, // 14: songlibrary.v1.GetSongWithCoupletPaginationResponse.pagination:type_name -> songlibrary.v1.PaginationMetadata
+	7,  // 15: songlibrary.v1.SearchSongsRequest.filter:type_name -> songlibrary.v1.SongsFilter
+	39, // 16: songlibrary.v1.SearchSongsRequest.pagination:type_name -> songlibrary.v1.Pagination
+	0,  // 17: songlibrary.v1.SearchSongsResponse.songs:type_name -> songlibrary.v1.Song
+	40, // 18: songlibrary.v1.SearchSongsResponse.pagination:type_name -> songlibrary.v1.PaginationMetadata
+	9,  // 19: songlibrary.v1.SearchSongsResponse.facets:type_name -> songlibrary.v1.SongFacets
+	7,  // 20: songlibrary.v1.ListSongsRequest.filter:type_name -> songlibrary.v1.SongsFilter
+	7,  // 21: songlibrary.v1.ExportSongsRequest.filter:type_name -> songlibrary.v1.SongsFilter
+	1,  // 22: songlibrary.v1.CreateSongRequest.attributes:type_name -> songlibrary.v1.SongAttributes
+	3,  // 23: songlibrary.v1.CreateSongRequest.credits:type_name -> songlibrary.v1.SongCreditAttributes
+	0,  // 24: songlibrary.v1.GetSongsByISWCResponse.songs:type_name -> songlibrary.v1.Song
+	39, // 25: songlibrary.v1.GetLinkReportRequest.pagination:type_name -> songlibrary.v1.Pagination
+	12, // 26: songlibrary.v1.GetLinkReportResponse.stats:type_name -> songlibrary.v1.LinkStats
+	0,  // 27: songlibrary.v1.GetLinkReportResponse.broken_songs:type_name -> songlibrary.v1.Song
+	40, // 28: songlibrary.v1.GetLinkReportResponse.pagination:type_name -> songlibrary.v1.PaginationMetadata
+	1,  // 29: songlibrary.v1.ChangeSongRequest.attributes:type_name -> songlibrary.v1.SongAttributes
+	4,  // 30: songlibrary.v1.ChangeSongRequest.credits:type_name -> songlibrary.v1.SongCredits
+	35, // 31: songlibrary.v1.GetRelatedSongsResponse.originals:type_name -> songlibrary.v1.GetRelatedSongsResponse.OriginalsEntry
+	36, // 32: songlibrary.v1.GetRelatedSongsResponse.derivatives:type_name -> songlibrary.v1.GetRelatedSongsResponse.DerivativesEntry
+	11, // 33: songlibrary.v1.GetRelatedSongsResponse.OriginalsEntry.value:type_name -> songlibrary.v1.SongList
+	11, // 34: songlibrary.v1.GetRelatedSongsResponse.DerivativesEntry.value:type_name -> songlibrary.v1.SongList
+	13, // 35: songlibrary.v1.SongService.GetSongWithCoupletPagination:input_type -> songlibrary.v1.GetSongWithCoupletPaginationRequest
+	15, // 36: songlibrary.v1.SongService.SearchSongs:input_type -> songlibrary.v1.SearchSongsRequest
+	17, // 37: songlibrary.v1.SongService.ListSongs:input_type -> songlibrary.v1.ListSongsRequest
+	18, // 38: songlibrary.v1.SongService.ExportSongs:input_type -> songlibrary.v1.ExportSongsRequest
+	20, // 39: songlibrary.v1.SongService.CreateSong:input_type -> songlibrary.v1.CreateSongRequest
+	21, // 40: songlibrary.v1.SongService.GetSongByISRC:input_type -> songlibrary.v1.GetSongByISRCRequest
+	22, // 41: songlibrary.v1.SongService.GetSongsByISWC:input_type -> songlibrary.v1.GetSongsByISWCRequest
+	24, // 42: songlibrary.v1.SongService.GetLinkReport:input_type -> songlibrary.v1.GetLinkReportRequest
+	26, // 43: songlibrary.v1.SongService.ChangeSong:input_type -> songlibrary.v1.ChangeSongRequest
+	27, // 44: songlibrary.v1.SongService.RemoveSong:input_type -> songlibrary.v1.RemoveSongRequest
+	29, // 45: songlibrary.v1.SongService.ChangeSongTags:input_type -> songlibrary.v1.ChangeSongTagsRequest
+	30, // 46: songlibrary.v1.SongService.GetRelatedSongs:input_type -> songlibrary.v1.GetRelatedSongsRequest
+	32, // 47: songlibrary.v1.SongService.LinkSongs:input_type -> songlibrary.v1.LinkSongsRequest
+	33, // 48: songlibrary.v1.SongService.UnlinkSongs:input_type -> songlibrary.v1.UnlinkSongsRequest
+	34, // 49: songlibrary.v1.SongService.MergeSongs:input_type -> songlibrary.v1.MergeSongsRequest
+	14, // 50: songlibrary.v1.SongService.GetSongWithCoupletPagination:output_type -> songlibrary.v1.GetSongWithCoupletPaginationResponse
+	16, // 51: songlibrary.v1.SongService.SearchSongs:output_type -> songlibrary.v1.SearchSongsResponse
+	0,  // 52: songlibrary.v1.SongService.ListSongs:output_type -> songlibrary.v1.Song
+	19, // 53: songlibrary.v1.SongService.ExportSongs:output_type -> songlibrary.v1.ExportSongsResponse
+	0,  // 54: songlibrary.v1.SongService.CreateSong:output_type -> songlibrary.v1.Song
+	0,  // 55: songlibrary.v1.SongService.GetSongByISRC:output_type -> songlibrary.v1.Song
+	23, // 56: songlibrary.v1.SongService.GetSongsByISWC:output_type -> songlibrary.v1.GetSongsByISWCResponse
+	25, // 57: songlibrary.v1.SongService.GetLinkReport:output_type -> songlibrary.v1.GetLinkReportResponse
+	0,  // 58: songlibrary.v1.SongService.ChangeSong:output_type -> songlibrary.v1.Song
+	28, // 59: songlibrary.v1.SongService.RemoveSong:output_type -> songlibrary.v1.RemoveSongResponse
+	0,  // 60: songlibrary.v1.SongService.ChangeSongTags:output_type -> songlibrary.v1.Song
+	31, // 61: songlibrary.v1.SongService.GetRelatedSongs:output_type -> songlibrary.v1.GetRelatedSongsResponse
+	10, // 62: songlibrary.v1.SongService.LinkSongs:output_type -> songlibrary.v1.SongRelation
+	10, // 63: songlibrary.v1.SongService.UnlinkSongs:output_type -> songlibrary.v1.SongRelation
+	0,  // 64: songlibrary.v1.SongService.MergeSongs:output_type -> songlibrary.v1.Song
+	50, // [50:65] is the sub-list for method output_type
+	35, // [35:50] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_songlibrary_v1_song_proto_init() }
+func file_songlibrary_v1_song_proto_init() {
+	if File_songlibrary_v1_song_proto != nil {
+		return
+	}
+	file_songlibrary_v1_artist_proto_init()
+	file_songlibrary_v1_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_songlibrary_v1_song_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Song); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*SongAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SongCredit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*SongCreditAttributes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*SongCredits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*LyricsStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*LinkHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*SongsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Facet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*SongFacets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*SongRelation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*SongList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*LinkStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSongWithCoupletPaginationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSongWithCoupletPaginationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchSongsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchSongsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSongsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportSongsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportSongsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateSongRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSongByISRCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSongsByISWCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*GetSongsByISWCResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLinkReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetLinkReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeSongRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveSongRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveSongResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeSongTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRelatedSongsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRelatedSongsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*LinkSongsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*UnlinkSongsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_songlibrary_v1_song_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*MergeSongsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_songlibrary_v1_song_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   37,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_songlibrary_v1_song_proto_goTypes,
+		DependencyIndexes: file_songlibrary_v1_song_proto_depIdxs,
+		MessageInfos:      file_songlibrary_v1_song_proto_msgTypes,
+	}.Build()
+	File_songlibrary_v1_song_proto = out.File
+	file_songlibrary_v1_song_proto_rawDesc = nil
+	file_songlibrary_v1_song_proto_goTypes = nil
+	file_songlibrary_v1_song_proto_depIdxs = nil
+}
@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: songlibrary/v1/song.proto
+
+package songlibraryv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SongService_GetSongWithCoupletPagination_FullMethodName = "/songlibrary.v1.SongService/GetSongWithCoupletPagination"
+	SongService_SearchSongs_FullMethodName                  = "/songlibrary.v1.SongService/SearchSongs"
+	SongService_ListSongs_FullMethodName                    = "/songlibrary.v1.SongService/ListSongs"
+	SongService_ExportSongs_FullMethodName                  = "/songlibrary.v1.SongService/ExportSongs"
+	SongService_CreateSong_FullMethodName                   = "/songlibrary.v1.SongService/CreateSong"
+	SongService_GetSongByISRC_FullMethodName                = "/songlibrary.v1.SongService/GetSongByISRC"
+	SongService_GetSongsByISWC_FullMethodName               = "/songlibrary.v1.SongService/GetSongsByISWC"
+	SongService_GetLinkReport_FullMethodName                = "/songlibrary.v1.SongService/GetLinkReport"
+	SongService_ChangeSong_FullMethodName                   = "/songlibrary.v1.SongService/ChangeSong"
+	SongService_RemoveSong_FullMethodName                   = "/songlibrary.v1.SongService/RemoveSong"
+	SongService_ChangeSongTags_FullMethodName               = "/songlibrary.v1.SongService/ChangeSongTags"
+	SongService_GetRelatedSongs_FullMethodName              = "/songlibrary.v1.SongService/GetRelatedSongs"
+	SongService_LinkSongs_FullMethodName                    = "/songlibrary.v1.SongService/LinkSongs"
+	SongService_UnlinkSongs_FullMethodName                  = "/songlibrary.v1.SongService/UnlinkSongs"
+	SongService_MergeSongs_FullMethodName                   = "/songlibrary.v1.SongService/MergeSongs"
+)
+
+// SongServiceClient is the client API for SongService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SongService это сервис песен.
+type SongServiceClient interface {
+	// GetSongWithCoupletPagination возвращает определенную песню с пагинацией по куплетам.
+	GetSongWithCoupletPagination(ctx context.Context, in *GetSongWithCoupletPaginationRequest, opts ...grpc.CallOption) (*GetSongWithCoupletPaginationResponse, error)
+	// SearchSongs выполняет поиск песен. Ответ содержит количество найденных песен по каждому жанру и каждой метке.
+	SearchSongs(ctx context.Context, in *SearchSongsRequest, opts ...grpc.CallOption) (*SearchSongsResponse, error)
+	// ListSongs передает все найденные песни потоком, упорядоченными по ID.
+	ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Song], error)
+	// ExportSongs экспортирует найденные песни как плейлист определенного формата.
+	ExportSongs(ctx context.Context, in *ExportSongsRequest, opts ...grpc.CallOption) (*ExportSongsResponse, error)
+	// CreateSong добавляет новую песню.
+	CreateSong(ctx context.Context, in *CreateSongRequest, opts ...grpc.CallOption) (*Song, error)
+	// GetSongByISRC возвращает песню с определенным кодом ISRC. Код может содержать разделители.
+	GetSongByISRC(ctx context.Context, in *GetSongByISRCRequest, opts ...grpc.CallOption) (*Song, error)
+	// GetSongsByISWC возвращает все записи произведения с определенным кодом ISWC. Код может содержать разделители.
+	GetSongsByISWC(ctx context.Context, in *GetSongsByISWCRequest, opts ...grpc.CallOption) (*GetSongsByISWCResponse, error)
+	// GetLinkReport возвращает количество песен со ссылками по статусу ссылки и песни с недоступными ссылками.
+	GetLinkReport(ctx context.Context, in *GetLinkReportRequest, opts ...grpc.CallOption) (*GetLinkReportResponse, error)
+	// ChangeSong обновляет заданные атрибуты определенной песни.
+	ChangeSong(ctx context.Context, in *ChangeSongRequest, opts ...grpc.CallOption) (*Song, error)
+	// RemoveSong удаляет определенную песню.
+	RemoveSong(ctx context.Context, in *RemoveSongRequest, opts ...grpc.CallOption) (*RemoveSongResponse, error)
+	// ChangeSongTags полностью заменяет жанры или свободные метки определенной песни.
+	ChangeSongTags(ctx context.Context, in *ChangeSongTagsRequest, opts ...grpc.CallOption) (*Song, error)
+	// GetRelatedSongs возвращает песни, связанные с определенной песней, сгруппированные по типу и направлению связи.
+	GetRelatedSongs(ctx context.Context, in *GetRelatedSongsRequest, opts ...grpc.CallOption) (*GetRelatedSongsResponse, error)
+	// LinkSongs связывает производную песню с оригиналом.
+	LinkSongs(ctx context.Context, in *LinkSongsRequest, opts ...grpc.CallOption) (*SongRelation, error)
+	// UnlinkSongs удаляет связь производной песни с оригиналом.
+	UnlinkSongs(ctx context.Context, in *UnlinkSongsRequest, opts ...grpc.CallOption) (*SongRelation, error)
+	// MergeSongs сливает песню-дубликат с определенной песней.
+	MergeSongs(ctx context.Context, in *MergeSongsRequest, opts ...grpc.CallOption) (*Song, error)
+}
+
+type songServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSongServiceClient(cc grpc.ClientConnInterface) SongServiceClient {
+	return &songServiceClient{cc}
+}
+
+func (c *songServiceClient) GetSongWithCoupletPagination(ctx context.Context, in *GetSongWithCoupletPaginationRequest, opts ...grpc.CallOption) (*GetSongWithCoupletPaginationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSongWithCoupletPaginationResponse)
+	err := c.cc.Invoke(ctx, SongService_GetSongWithCoupletPagination_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) SearchSongs(ctx context.Context, in *SearchSongsRequest, opts ...grpc.CallOption) (*SearchSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchSongsResponse)
+	err := c.cc.Invoke(ctx, SongService_SearchSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Song], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SongService_ServiceDesc.Streams[0], SongService_ListSongs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ListSongsRequest, Song]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SongService_ListSongsClient = grpc.ServerStreamingClient[Song]
+
+func (c *songServiceClient) ExportSongs(ctx context.Context, in *ExportSongsRequest, opts ...grpc.CallOption) (*ExportSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportSongsResponse)
+	err := c.cc.Invoke(ctx, SongService_ExportSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) CreateSong(ctx context.Context, in *CreateSongRequest, opts ...grpc.CallOption) (*Song, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongService_CreateSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) GetSongByISRC(ctx context.Context, in *GetSongByISRCRequest, opts ...grpc.CallOption) (*Song, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongService_GetSongByISRC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) GetSongsByISWC(ctx context.Context, in *GetSongsByISWCRequest, opts ...grpc.CallOption) (*GetSongsByISWCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSongsByISWCResponse)
+	err := c.cc.Invoke(ctx, SongService_GetSongsByISWC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) GetLinkReport(ctx context.Context, in *GetLinkReportRequest, opts ...grpc.CallOption) (*GetLinkReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLinkReportResponse)
+	err := c.cc.Invoke(ctx, SongService_GetLinkReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) ChangeSong(ctx context.Context, in *ChangeSongRequest, opts ...grpc.CallOption) (*Song, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongService_ChangeSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) RemoveSong(ctx context.Context, in *RemoveSongRequest, opts ...grpc.CallOption) (*RemoveSongResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveSongResponse)
+	err := c.cc.Invoke(ctx, SongService_RemoveSong_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) ChangeSongTags(ctx context.Context, in *ChangeSongTagsRequest, opts ...grpc.CallOption) (*Song, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongService_ChangeSongTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) GetRelatedSongs(ctx context.Context, in *GetRelatedSongsRequest, opts ...grpc.CallOption) (*GetRelatedSongsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelatedSongsResponse)
+	err := c.cc.Invoke(ctx, SongService_GetRelatedSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) LinkSongs(ctx context.Context, in *LinkSongsRequest, opts ...grpc.CallOption) (*SongRelation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongRelation)
+	err := c.cc.Invoke(ctx, SongService_LinkSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) UnlinkSongs(ctx context.Context, in *UnlinkSongsRequest, opts ...grpc.CallOption) (*SongRelation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SongRelation)
+	err := c.cc.Invoke(ctx, SongService_UnlinkSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songServiceClient) MergeSongs(ctx context.Context, in *MergeSongsRequest, opts ...grpc.CallOption) (*Song, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongService_MergeSongs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SongServiceServer is the server API for SongService service.
+// All implementations must embed UnimplementedSongServiceServer
+// for forward compatibility.
+//
+// SongService это сервис песен.
+type SongServiceServer interface {
+	// GetSongWithCoupletPagination возвращает определенную песню с пагинацией по куплетам.
+	GetSongWithCoupletPagination(context.Context, *GetSongWithCoupletPaginationRequest) (*GetSongWithCoupletPaginationResponse, error)
+	// SearchSongs выполняет поиск песен. Ответ содержит количество найденных песен по каждому жанру и каждой метке.
+	SearchSongs(context.Context, *SearchSongsRequest) (*SearchSongsResponse, error)
+	// ListSongs передает все найденные песни потоком, упорядоченными по ID.
+	ListSongs(*ListSongsRequest, grpc.ServerStreamingServer[Song]) error
+	// ExportSongs экспортирует найденные песни как плейлист определенного формата.
+	ExportSongs(context.Context, *ExportSongsRequest) (*ExportSongsResponse, error)
+	// CreateSong добавляет новую песню.
+	CreateSong(context.Context, *CreateSongRequest) (*Song, error)
+	// GetSongByISRC возвращает песню с определенным кодом ISRC. Код может содержать разделители.
+	GetSongByISRC(context.Context, *GetSongByISRCRequest) (*Song, error)
+	// GetSongsByISWC возвращает все записи произведения с определенным кодом ISWC. Код может содержать разделители.
+	GetSongsByISWC(context.Context, *GetSongsByISWCRequest) (*GetSongsByISWCResponse, error)
+	// GetLinkReport возвращает количество песен со ссылками по статусу ссылки и песни с недоступными ссылками.
+	GetLinkReport(context.Context, *GetLinkReportRequest) (*GetLinkReportResponse, error)
+	// ChangeSong обновляет заданные атрибуты определенной песни.
+	ChangeSong(context.Context, *ChangeSongRequest) (*Song, error)
+	// RemoveSong удаляет определенную песню.
+	RemoveSong(context.Context, *RemoveSongRequest) (*RemoveSongResponse, error)
+	// ChangeSongTags полностью заменяет жанры или свободные метки определенной песни.
+	ChangeSongTags(context.Context, *ChangeSongTagsRequest) (*Song, error)
+	// GetRelatedSongs возвращает песни, связанные с определенной песней, сгруппированные по типу и направлению связи.
+	GetRelatedSongs(context.Context, *GetRelatedSongsRequest) (*GetRelatedSongsResponse, error)
+	// LinkSongs связывает производную песню с оригиналом.
+	LinkSongs(context.Context, *LinkSongsRequest) (*SongRelation, error)
+	// UnlinkSongs удаляет связь производной песни с оригиналом.
+	UnlinkSongs(context.Context, *UnlinkSongsRequest) (*SongRelation, error)
+	// MergeSongs сливает песню-дубликат с определенной песней.
+	MergeSongs(context.Context, *MergeSongsRequest) (*Song, error)
+	mustEmbedUnimplementedSongServiceServer()
+}
+
+// UnimplementedSongServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSongServiceServer struct{}
+
+func (UnimplementedSongServiceServer) GetSongWithCoupletPagination(context.Context, *GetSongWithCoupletPaginationRequest) (*GetSongWithCoupletPaginationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSongWithCoupletPagination not implemented")
+}
+func (UnimplementedSongServiceServer) SearchSongs(context.Context, *SearchSongsRequest) (*SearchSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSongs not implemented")
+}
+func (UnimplementedSongServiceServer) ListSongs(*ListSongsRequest, grpc.ServerStreamingServer[Song]) error {
+	return status.Errorf(codes.Unimplemented, "method ListSongs not implemented")
+}
+func (UnimplementedSongServiceServer) ExportSongs(context.Context, *ExportSongsRequest) (*ExportSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSongs not implemented")
+}
+func (UnimplementedSongServiceServer) CreateSong(context.Context, *CreateSongRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSong not implemented")
+}
+func (UnimplementedSongServiceServer) GetSongByISRC(context.Context, *GetSongByISRCRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSongByISRC not implemented")
+}
+func (UnimplementedSongServiceServer) GetSongsByISWC(context.Context, *GetSongsByISWCRequest) (*GetSongsByISWCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSongsByISWC not implemented")
+}
+func (UnimplementedSongServiceServer) GetLinkReport(context.Context, *GetLinkReportRequest) (*GetLinkReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkReport not implemented")
+}
+func (UnimplementedSongServiceServer) ChangeSong(context.Context, *ChangeSongRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSong not implemented")
+}
+func (UnimplementedSongServiceServer) RemoveSong(context.Context, *RemoveSongRequest) (*RemoveSongResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSong not implemented")
+}
+func (UnimplementedSongServiceServer) ChangeSongTags(context.Context, *ChangeSongTagsRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeSongTags not implemented")
+}
+func (UnimplementedSongServiceServer) GetRelatedSongs(context.Context, *GetRelatedSongsRequest) (*GetRelatedSongsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelatedSongs not implemented")
+}
+func (UnimplementedSongServiceServer) LinkSongs(context.Context, *LinkSongsRequest) (*SongRelation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkSongs not implemented")
+}
+func (UnimplementedSongServiceServer) UnlinkSongs(context.Context, *UnlinkSongsRequest) (*SongRelation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkSongs not implemented")
+}
+func (UnimplementedSongServiceServer) MergeSongs(context.Context, *MergeSongsRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeSongs not implemented")
+}
+func (UnimplementedSongServiceServer) mustEmbedUnimplementedSongServiceServer() {}
+func (UnimplementedSongServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeSongServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SongServiceServer will
+// result in compilation errors.
+type UnsafeSongServiceServer interface {
+	mustEmbedUnimplementedSongServiceServer()
+}
+
+func RegisterSongServiceServer(s grpc.ServiceRegistrar, srv SongServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSongServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SongService_ServiceDesc, srv)
+}
+
+func _SongService_GetSongWithCoupletPagination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSongWithCoupletPaginationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).GetSongWithCoupletPagination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_GetSongWithCoupletPagination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).GetSongWithCoupletPagination(ctx, req.(*GetSongWithCoupletPaginationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_SearchSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).SearchSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_SearchSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).SearchSongs(ctx, req.(*SearchSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_ListSongs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListSongsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SongServiceServer).ListSongs(m, &grpc.GenericServerStream[ListSongsRequest, Song]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SongService_ListSongsServer = grpc.ServerStreamingServer[Song]
+
+func _SongService_ExportSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).ExportSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_ExportSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).ExportSongs(ctx, req.(*ExportSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_CreateSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).CreateSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_CreateSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).CreateSong(ctx, req.(*CreateSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_GetSongByISRC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSongByISRCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).GetSongByISRC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_GetSongByISRC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).GetSongByISRC(ctx, req.(*GetSongByISRCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_GetSongsByISWC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSongsByISWCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).GetSongsByISWC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_GetSongsByISWC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).GetSongsByISWC(ctx, req.(*GetSongsByISWCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_GetLinkReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).GetLinkReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_GetLinkReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).GetLinkReport(ctx, req.(*GetLinkReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_ChangeSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).ChangeSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_ChangeSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).ChangeSong(ctx, req.(*ChangeSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_RemoveSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).RemoveSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_RemoveSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).RemoveSong(ctx, req.(*RemoveSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_ChangeSongTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSongTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).ChangeSongTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_ChangeSongTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).ChangeSongTags(ctx, req.(*ChangeSongTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_GetRelatedSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).GetRelatedSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_GetRelatedSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).GetRelatedSongs(ctx, req.(*GetRelatedSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_LinkSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).LinkSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_LinkSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).LinkSongs(ctx, req.(*LinkSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_UnlinkSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).UnlinkSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_UnlinkSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).UnlinkSongs(ctx, req.(*UnlinkSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongService_MergeSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongServiceServer).MergeSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongService_MergeSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongServiceServer).MergeSongs(ctx, req.(*MergeSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SongService_ServiceDesc is the grpc.ServiceDesc for SongService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SongService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "songlibrary.v1.SongService",
+	HandlerType: (*SongServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSongWithCoupletPagination",
+			Handler:    _SongService_GetSongWithCoupletPagination_Handler,
+		},
+		{
+			MethodName: "SearchSongs",
+			Handler:    _SongService_SearchSongs_Handler,
+		},
+		{
+			MethodName: "ExportSongs",
+			Handler:    _SongService_ExportSongs_Handler,
+		},
+		{
+			MethodName: "CreateSong",
+			Handler:    _SongService_CreateSong_Handler,
+		},
+		{
+			MethodName: "GetSongByISRC",
+			Handler:    _SongService_GetSongByISRC_Handler,
+		},
+		{
+			MethodName: "GetSongsByISWC",
+			Handler:    _SongService_GetSongsByISWC_Handler,
+		},
+		{
+			MethodName: "GetLinkReport",
+			Handler:    _SongService_GetLinkReport_Handler,
+		},
+		{
+			MethodName: "ChangeSong",
+			Handler:    _SongService_ChangeSong_Handler,
+		},
+		{
+			MethodName: "RemoveSong",
+			Handler:    _SongService_RemoveSong_Handler,
+		},
+		{
+			MethodName: "ChangeSongTags",
+			Handler:    _SongService_ChangeSongTags_Handler,
+		},
+		{
+			MethodName: "GetRelatedSongs",
+			Handler:    _SongService_GetRelatedSongs_Handler,
+		},
+		{
+			MethodName: "LinkSongs",
+			Handler:    _SongService_LinkSongs_Handler,
+		},
+		{
+			MethodName: "UnlinkSongs",
+			Handler:    _SongService_UnlinkSongs_Handler,
+		},
+		{
+			MethodName: "MergeSongs",
+			Handler:    _SongService_MergeSongs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListSongs",
+			Handler:       _SongService_ListSongs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "songlibrary/v1/song.proto",
+}
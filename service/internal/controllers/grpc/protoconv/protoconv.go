@@ -0,0 +1,159 @@
+// Package protoconv содержит преобразование моделей API в сообщения protobuf и обратно.
+package protoconv
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/sedonn/song-library-service/internal/controllers/grpc/proto/songlibrary/v1"
+	"github.com/sedonn/song-library-service/internal/controllers/grpc/validation"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// Параметры страницы по умолчанию, как и у REST-API.
+const (
+	defaultPageNumber = 1
+	defaultPageSize   = 10
+)
+
+// Pagination преобразует параметры страницы и проверяет их. Нулевые значения заменяются значениями по умолчанию.
+func Pagination(p *pb.Pagination) (models.Pagination, error) {
+	pagination := models.Pagination{
+		PageNumber: p.GetPageNumber(),
+		PageSize:   p.GetPageSize(),
+	}
+	if pagination.PageNumber == 0 {
+		pagination.PageNumber = defaultPageNumber
+	}
+	if pagination.PageSize == 0 {
+		pagination.PageSize = defaultPageSize
+	}
+
+	if err := validation.Struct(pagination); err != nil {
+		return models.Pagination{}, err
+	}
+
+	return pagination, nil
+}
+
+// PaginationMetadata преобразует данные страницы.
+func PaginationMetadata(m models.PaginationMetadataAPI) *pb.PaginationMetadata {
+	return &pb.PaginationMetadata{
+		CurrentPageNumber: m.CurrentPageNumber,
+		PageCount:         m.PageCount,
+		PageSize:          m.PageSize,
+		RecordCount:       m.RecordCount,
+	}
+}
+
+// Artist преобразует исполнителя.
+func Artist(a models.ArtistAPI) *pb.Artist {
+	return &pb.Artist{
+		Id: a.ID,
+		Attributes: &pb.ArtistAttributes{
+			Name:          a.Name,
+			SortName:      a.SortName,
+			Country:       a.Country,
+			Type:          a.Type,
+			FormedYear:    a.FormedYear,
+			DisbandedYear: a.DisbandedYear,
+			Bio:           a.Bio,
+			Links:         a.Links,
+			Isni:          a.ISNI,
+			Mbid:          a.MBID,
+		},
+	}
+}
+
+// Artists преобразует слайс исполнителей.
+func Artists(a []models.ArtistAPI) []*pb.Artist {
+	artists := make([]*pb.Artist, len(a))
+	for i, v := range a {
+		artists[i] = Artist(v)
+	}
+
+	return artists
+}
+
+// ArtistAlias преобразует псевдоним исполнителя.
+func ArtistAlias(a models.ArtistAliasAPI) *pb.ArtistAlias {
+	return &pb.ArtistAlias{Id: a.ID, Name: a.Name}
+}
+
+// Song преобразует песню.
+func Song(s models.SongAPI) *pb.Song {
+	credits := make([]*pb.SongCredit, len(s.Credits))
+	for i, c := range s.Credits {
+		credits[i] = &pb.SongCredit{Role: c.Role, Artist: Artist(c.Artist)}
+	}
+
+	return &pb.Song{
+		Id: s.ID,
+		Attributes: &pb.SongAttributes{
+			Name:        s.Name,
+			ReleaseDate: Timestamp(s.ReleaseDate),
+			Text:        s.Text,
+			Link:        s.Link,
+			Isrc:        s.ISRC,
+			Iswc:        s.ISWC,
+		},
+		Artist:       Artist(s.Artist),
+		LinkProvider: s.LinkProvider,
+		LinkMediaId:  s.LinkMediaID,
+		Credits:      credits,
+		Genres:       s.Genres,
+		Tags:         s.Tags,
+		Language:     s.Language,
+		Stats: &pb.LyricsStats{
+			LineCount:       s.Stats.LineCount,
+			CoupletCount:    s.Stats.CoupletCount,
+			WordCount:       s.Stats.WordCount,
+			UniqueWordRatio: s.Stats.UniqueWordRatio,
+			ReadingTime:     s.Stats.ReadingTime,
+		},
+		LinkHealth: &pb.LinkHealth{
+			Status:        s.LinkHealth.Status,
+			StatusCode:    int32(s.LinkHealth.StatusCode),
+			CheckedAt:     timestampPtr(s.LinkHealth.CheckedAt),
+			FailureStreak: s.LinkHealth.FailureStreak,
+		},
+	}
+}
+
+// Songs преобразует слайс песен.
+func Songs(s []models.SongAPI) []*pb.Song {
+	songs := make([]*pb.Song, len(s))
+	for i, v := range s {
+		songs[i] = Song(v)
+	}
+
+	return songs
+}
+
+// Timestamp преобразует время. Нулевое время преобразуется в nil.
+func Timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+// Time преобразует время. Отсутствующее время преобразуется в нулевое.
+func Time(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+
+	return t.AsTime()
+}
+
+// timestampPtr преобразует необязательное время.
+func timestampPtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
@@ -0,0 +1,452 @@
+package songgrpc
+
+import (
+	"context"
+
+	pb "github.com/sedonn/song-library-service/internal/controllers/grpc/proto/songlibrary/v1"
+	"github.com/sedonn/song-library-service/internal/controllers/grpc/protoconv"
+	"github.com/sedonn/song-library-service/internal/controllers/grpc/validation"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
+)
+
+// listPageSize это количество песен, которые запрашиваются за один раз при передаче песен потоком.
+const listPageSize = 100
+
+// songsFilter это параметры поиска песен с теми же правилами проверки, что и у REST-API.
+type songsFilter struct {
+	Language   string   `binding:"omitempty,lte=8"`
+	Genres     []string `binding:"omitempty,dive,lte=64"`
+	Tags       []string `binding:"omitempty,dive,lte=64"`
+	LinkStatus string   `binding:"omitempty,oneof=ok broken unchecked"`
+}
+
+// songCredits это участники создания песни с теми же правилами проверки, что и у REST-API.
+type songCredits struct {
+	Credits []models.SongCreditAttributesAPI `binding:"omitempty,dive"`
+}
+
+// GetSongWithCoupletPagination возвращает определенную песню с пагинацией по куплетам.
+func (s *Server) GetSongWithCoupletPagination(
+	ctx context.Context,
+	req *pb.GetSongWithCoupletPaginationRequest,
+) (*pb.GetSongWithCoupletPaginationResponse, error) {
+	if err := validation.Var("id", req.GetId(), "required"); err != nil {
+		return nil, err
+	}
+	p, err := protoconv.Pagination(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	song, err := s.songService.GetSongWithCoupletPagination(ctx, req.GetId(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSongWithCoupletPaginationResponse{
+		Song:       protoconv.Song(song.Song),
+		Pagination: protoconv.PaginationMetadata(song.Pagination),
+	}, nil
+}
+
+// SearchSongs выполняет поиск песен. Количество найденных песен по жанрам и меткам передается только на первой странице.
+func (s *Server) SearchSongs(ctx context.Context, req *pb.SearchSongsRequest) (*pb.SearchSongsResponse, error) {
+	attrs, err := filterAttrs(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	p, err := protoconv.Pagination(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	songs, err := s.songService.SearchSongs(ctx, attrs, p, p.PageNumber == 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchSongsResponse{
+		Songs:      protoconv.Songs(songs.Songs),
+		Pagination: protoconv.PaginationMetadata(songs.Pagination),
+		Facets:     facets(songs.Facets),
+	}, nil
+}
+
+// ListSongs передает все найденные песни потоком. Песни запрашиваются страницами по listPageSize штук.
+func (s *Server) ListSongs(req *pb.ListSongsRequest, stream pb.SongService_ListSongsServer) error {
+	attrs, err := filterAttrs(req.GetFilter())
+	if err != nil {
+		return err
+	}
+
+	for p := (models.Pagination{PageNumber: 1, PageSize: listPageSize}); ; p.PageNumber++ {
+		page, err := s.songService.SearchSongs(stream.Context(), attrs, p, false)
+		if err != nil {
+			return err
+		}
+
+		for _, song := range page.Songs {
+			if err := stream.Send(protoconv.Song(song)); err != nil {
+				return err
+			}
+		}
+
+		if len(page.Songs) < listPageSize || p.PageNumber >= page.Pagination.PageCount {
+			return nil
+		}
+	}
+}
+
+// ExportSongs экспортирует найденные песни как плейлист определенного формата.
+func (s *Server) ExportSongs(ctx context.Context, req *pb.ExportSongsRequest) (*pb.ExportSongsResponse, error) {
+	attrs, err := filterAttrs(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	if err := validation.Struct(models.PlaylistFormatAPI{Format: req.GetFormat()}); err != nil {
+		return nil, err
+	}
+
+	f := playlistfmt.Format(req.GetFormat())
+	data, err := s.songService.ExportSongs(ctx, attrs, f)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportSongsResponse{ContentType: f.ContentType(), Data: data}, nil
+}
+
+// CreateSong добавляет новую песню.
+func (s *Server) CreateSong(ctx context.Context, req *pb.CreateSongRequest) (*pb.Song, error) {
+	attrs := models.SongAttributesAPI{
+		Name:        req.GetAttributes().GetName(),
+		ReleaseDate: protoconv.Time(req.GetAttributes().GetReleaseDate()),
+		Text:        req.GetAttributes().GetText(),
+		Link:        req.GetAttributes().GetLink(),
+		ISRC:        req.GetAttributes().GetIsrc(),
+		ISWC:        req.GetAttributes().GetIswc(),
+	}
+	if err := validation.Struct(attrs); err != nil {
+		return nil, err
+	}
+	credits, err := creditsAttrs(req.GetCredits())
+	if err != nil {
+		return nil, err
+	}
+
+	song, err := s.songService.CreateSong(ctx, models.Song{
+		Name:        attrs.Name,
+		ArtistID:    req.GetArtistId(),
+		ReleaseDate: attrs.ReleaseDate,
+		Text:        attrs.Text,
+		Link:        attrs.Link,
+		ISRC:        attrs.ISRC,
+		ISWC:        attrs.ISWC,
+		Credits:     credits,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Song(song), nil
+}
+
+// GetSongByISRC возвращает песню с определенным кодом ISRC.
+func (s *Server) GetSongByISRC(ctx context.Context, req *pb.GetSongByISRCRequest) (*pb.Song, error) {
+	if err := validation.Var("isrc", req.GetIsrc(), "required,lte=15"); err != nil {
+		return nil, err
+	}
+
+	song, err := s.songService.GetSongByISRC(ctx, req.GetIsrc())
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Song(song), nil
+}
+
+// GetSongsByISWC возвращает все записи произведения с определенным кодом ISWC.
+func (s *Server) GetSongsByISWC(ctx context.Context, req *pb.GetSongsByISWCRequest) (*pb.GetSongsByISWCResponse, error) {
+	if err := validation.Var("iswc", req.GetIswc(), "required,lte=15"); err != nil {
+		return nil, err
+	}
+
+	work, err := s.songService.GetSongsByISWC(ctx, req.GetIswc())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetSongsByISWCResponse{Iswc: work.ISWC, Songs: protoconv.Songs(work.Songs)}, nil
+}
+
+// GetLinkReport возвращает количество песен со ссылками по статусу ссылки и песни с недоступными ссылками.
+func (s *Server) GetLinkReport(ctx context.Context, req *pb.GetLinkReportRequest) (*pb.GetLinkReportResponse, error) {
+	p, err := protoconv.Pagination(req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.songService.GetLinkReport(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetLinkReportResponse{
+		Stats: &pb.LinkStats{
+			Ok:        report.Stats.OK,
+			Broken:    report.Stats.Broken,
+			Unchecked: report.Stats.Unchecked,
+		},
+		BrokenSongs: protoconv.Songs(report.BrokenSongs),
+		Pagination:  protoconv.PaginationMetadata(report.Pagination),
+	}, nil
+}
+
+// ChangeSong обновляет заданные атрибуты определенной песни.
+func (s *Server) ChangeSong(ctx context.Context, req *pb.ChangeSongRequest) (*pb.Song, error) {
+	if err := validation.Var("id", req.GetId(), "required"); err != nil {
+		return nil, err
+	}
+	attrs := models.SongOptionalAttributesAPI{
+		Name:        req.GetAttributes().GetName(),
+		ReleaseDate: protoconv.Time(req.GetAttributes().GetReleaseDate()),
+		Text:        req.GetAttributes().GetText(),
+		Link:        req.GetAttributes().GetLink(),
+		ISRC:        req.GetAttributes().GetIsrc(),
+		ISWC:        req.GetAttributes().GetIswc(),
+	}
+	if err := validation.Struct(attrs); err != nil {
+		return nil, err
+	}
+
+	var credits models.SongCredits
+	if req.GetCredits() != nil {
+		c, err := creditsAttrs(req.GetCredits().GetCredits())
+		if err != nil {
+			return nil, err
+		}
+		credits = c
+		if credits == nil {
+			credits = models.SongCredits{}
+		}
+	}
+
+	song, err := s.songService.ChangeSong(ctx, models.Song{
+		ID:          req.GetId(),
+		ArtistID:    req.GetArtistId(),
+		Name:        attrs.Name,
+		ReleaseDate: attrs.ReleaseDate,
+		Text:        attrs.Text,
+		Link:        attrs.Link,
+		ISRC:        attrs.ISRC,
+		ISWC:        attrs.ISWC,
+		Credits:     credits,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Song(song), nil
+}
+
+// RemoveSong удаляет определенную песню.
+func (s *Server) RemoveSong(ctx context.Context, req *pb.RemoveSongRequest) (*pb.RemoveSongResponse, error) {
+	if err := validation.Var("id", req.GetId(), "required"); err != nil {
+		return nil, err
+	}
+
+	id, err := s.songService.RemoveSong(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveSongResponse{Id: id.ID}, nil
+}
+
+// ChangeSongTags полностью заменяет жанры или свободные метки определенной песни.
+// Пустой список удаляет все метки определенного вида.
+func (s *Server) ChangeSongTags(ctx context.Context, req *pb.ChangeSongTagsRequest) (*pb.Song, error) {
+	if err := validation.Var("id", req.GetId(), "required"); err != nil {
+		return nil, err
+	}
+	if err := validation.Var("kind", req.GetKind(), "required,oneof=genre tag"); err != nil {
+		return nil, err
+	}
+	if err := validation.Var("names", req.GetNames(), "omitempty,dive,required,lte=64"); err != nil {
+		return nil, err
+	}
+
+	names := req.GetNames()
+	if names == nil {
+		names = []string{}
+	}
+
+	song, err := s.songService.ChangeSongTags(ctx, req.GetId(), req.GetKind(), names)
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Song(song), nil
+}
+
+// GetRelatedSongs возвращает песни, связанные с определенной песней.
+func (s *Server) GetRelatedSongs(ctx context.Context, req *pb.GetRelatedSongsRequest) (*pb.GetRelatedSongsResponse, error) {
+	if err := validation.Var("id", req.GetId(), "required"); err != nil {
+		return nil, err
+	}
+
+	related, err := s.songService.GetRelatedSongs(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetRelatedSongsResponse{
+		SongId:      related.Song.ID,
+		Originals:   songLists(related.Originals),
+		Derivatives: songLists(related.Derivatives),
+	}, nil
+}
+
+// LinkSongs связывает производную песню с оригиналом.
+func (s *Server) LinkSongs(ctx context.Context, req *pb.LinkSongsRequest) (*pb.SongRelation, error) {
+	rel, err := relationAttrs(req.GetSongId(), req.GetType(), req.GetOriginalId())
+	if err != nil {
+		return nil, err
+	}
+
+	linked, err := s.songService.LinkSongs(ctx, rel)
+	if err != nil {
+		return nil, err
+	}
+
+	return relation(linked), nil
+}
+
+// UnlinkSongs удаляет связь производной песни с оригиналом.
+func (s *Server) UnlinkSongs(ctx context.Context, req *pb.UnlinkSongsRequest) (*pb.SongRelation, error) {
+	rel, err := relationAttrs(req.GetSongId(), req.GetType(), req.GetOriginalId())
+	if err != nil {
+		return nil, err
+	}
+
+	unlinked, err := s.songService.UnlinkSongs(ctx, rel)
+	if err != nil {
+		return nil, err
+	}
+
+	return relation(unlinked), nil
+}
+
+// MergeSongs сливает песню-дубликат с определенной песней.
+func (s *Server) MergeSongs(ctx context.Context, req *pb.MergeSongsRequest) (*pb.Song, error) {
+	if err := validation.Var("id", req.GetId(), "required"); err != nil {
+		return nil, err
+	}
+	if err := validation.Var("duplicate_id", req.GetDuplicateId(), "required"); err != nil {
+		return nil, err
+	}
+
+	song, err := s.songService.MergeSongs(ctx, req.GetId(), req.GetDuplicateId())
+	if err != nil {
+		return nil, err
+	}
+
+	return protoconv.Song(song), nil
+}
+
+// filterAttrs проверяет параметры поиска песен и создает по ним параметры поиска.
+func filterAttrs(f *pb.SongsFilter) (models.Song, error) {
+	if err := validation.Struct(songsFilter{
+		Language:   f.GetLanguage(),
+		Genres:     f.GetGenres(),
+		Tags:       f.GetTags(),
+		LinkStatus: f.GetLinkStatus(),
+	}); err != nil {
+		return models.Song{}, err
+	}
+
+	return models.Song{
+		Name: f.GetName(),
+		Artist: models.Artist{
+			Name: f.GetArtistName(),
+		},
+		Link:           f.GetLink(),
+		LinkHealth:     models.LinkHealth{Status: f.GetLinkStatus()},
+		Language:       f.GetLanguage(),
+		CreditedArtist: f.GetCreditedArtistName(),
+		Tags: append(
+			models.TagsFromNames(models.TagKindGenre, f.GetGenres()),
+			models.TagsFromNames(models.TagKindTag, f.GetTags())...,
+		),
+	}, nil
+}
+
+// creditsAttrs проверяет участников создания песни и преобразует их в модели БД.
+// Сохраняет nil, чтобы отличать отсутствие списка участников от пустого списка.
+func creditsAttrs(credits []*pb.SongCreditAttributes) (models.SongCredits, error) {
+	if credits == nil {
+		return nil, nil
+	}
+
+	attrs := songCredits{Credits: make([]models.SongCreditAttributesAPI, len(credits))}
+	for i, c := range credits {
+		attrs.Credits[i] = models.SongCreditAttributesAPI{
+			Artist: models.ArtistIDAPI{ID: c.GetArtistId()},
+			Role:   c.GetRole(),
+		}
+	}
+	if err := validation.Struct(attrs); err != nil {
+		return nil, err
+	}
+
+	return models.SongCreditsFromAPI(attrs.Credits), nil
+}
+
+// relationAttrs проверяет параметры связи производной песни с оригиналом и создает по ним связь.
+func relationAttrs(songID uint64, relType string, originalID uint64) (models.SongRelation, error) {
+	if err := validation.Var("song_id", songID, "required"); err != nil {
+		return models.SongRelation{}, err
+	}
+	if err := validation.Struct(models.SongRelationAttributesAPI{
+		Type:     relType,
+		Original: models.SongIDAPI{ID: originalID},
+	}); err != nil {
+		return models.SongRelation{}, err
+	}
+
+	return models.SongRelation{SongID: songID, Type: relType, OriginalID: originalID}, nil
+}
+
+// relation преобразует связь производной песни с оригиналом.
+func relation(r models.SongRelationAPI) *pb.SongRelation {
+	return &pb.SongRelation{Type: r.Type, SongId: r.Song.ID, OriginalId: r.Original.ID}
+}
+
+// facets преобразует количество найденных песен по каждому жанру и каждой метке.
+func facets(f *models.SongFacetsAPI) *pb.SongFacets {
+	if f == nil {
+		return nil
+	}
+
+	convert := func(facets []models.FacetAPI) []*pb.Facet {
+		pbFacets := make([]*pb.Facet, len(facets))
+		for i, v := range facets {
+			pbFacets[i] = &pb.Facet{Name: v.Name, Count: v.Count}
+		}
+
+		return pbFacets
+	}
+
+	return &pb.SongFacets{Genres: convert(f.Genres), Tags: convert(f.Tags)}
+}
+
+// songLists преобразует песни, сгруппированные по типу связи.
+func songLists(groups map[string][]models.SongAPI) map[string]*pb.SongList {
+	lists := make(map[string]*pb.SongList, len(groups))
+	for t, songs := range groups {
+		lists[t] = &pb.SongList{Songs: protoconv.Songs(songs)}
+	}
+
+	return lists
+}
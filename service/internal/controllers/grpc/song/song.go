@@ -0,0 +1,71 @@
+// Package songgrpc содержит gRPC-сервер сервиса песен.
+package songgrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/sedonn/song-library-service/internal/controllers/grpc/proto/songlibrary/v1"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
+)
+
+// SongService описывает поведение объекта, который обеспечивает бизнес-логику работы с песнями.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=SongService
+type SongService interface {
+	// GetSongWithCoupletPagination возвращает определенную песню с пагинацией по куплетам.
+	// Текст разбивается на куплеты по \n\n символам.
+	GetSongWithCoupletPagination(ctx context.Context, id uint64, p models.Pagination) (models.SongWithCoupletPaginationAPI, error)
+	// SearchSongs выполняет поиск песен по определенным параметрам.
+	// Поиск выполняется по подстроке каждого указанного поля, язык текста сравнивается точно.
+	// Результат содержит количество найденных песен по каждому жанру и каждой метке.
+	SearchSongs(ctx context.Context, attrs models.Song, p models.Pagination, withFacets bool) (models.SongsAPI, error)
+	// ExportSongs экспортирует найденные по определенным параметрам песни как плейлист определенного формата.
+	ExportSongs(ctx context.Context, attrs models.Song, f playlistfmt.Format) ([]byte, error)
+	// CreateSong добавляют новую песню. Язык и статистика текста вычисляются автоматически.
+	// Незаполненные дата выхода, текст и ссылка заполняются из внешнего сервиса информации о песнях,
+	// а ссылка проверяется на доступность фоновыми задачами.
+	CreateSong(ctx context.Context, s models.Song) (models.SongAPI, error)
+	// GetSongByISRC возвращает песню с определенным кодом ISRC. Код может содержать разделители.
+	GetSongByISRC(ctx context.Context, isrc string) (models.SongAPI, error)
+	// GetSongsByISWC возвращает все записи произведения с определенным кодом ISWC. Код может содержать разделители.
+	GetSongsByISWC(ctx context.Context, iswc string) (models.WorkSongsAPI, error)
+	// GetLinkReport возвращает количество песен со ссылками по статусу ссылки и песни с недоступными ссылками.
+	GetLinkReport(ctx context.Context, p models.Pagination) (models.LinkReportAPI, error)
+	// ChangeSong обновляет данные определенной песни. Новая ссылка проверяется на доступность фоновой задачей.
+	ChangeSong(ctx context.Context, s models.Song) (models.SongAPI, error)
+	// RemoveSong удаляет определенную песню. Песня также удаляется из всех плейлистов и альбомов.
+	RemoveSong(ctx context.Context, id uint64) (models.SongIDAPI, error)
+	// ChangeSongTags полностью заменяет жанры или свободные метки определенной песни.
+	ChangeSongTags(ctx context.Context, id uint64, kind string, names []string) (models.SongAPI, error)
+	// GetRelatedSongs возвращает песни, связанные с определенной песней, сгруппированные по типу и направлению связи.
+	GetRelatedSongs(ctx context.Context, id uint64) (models.RelatedSongsAPI, error)
+	// LinkSongs связывает производную песню с оригиналом.
+	// Песня не может прямо или через другие песни оказаться оригиналом самой себя.
+	LinkSongs(ctx context.Context, rel models.SongRelation) (models.SongRelationAPI, error)
+	// UnlinkSongs удаляет связь производной песни с оригиналом.
+	UnlinkSongs(ctx context.Context, rel models.SongRelation) (models.SongRelationAPI, error)
+	// MergeSongs сливает песню-дубликат с определенной песней. Участники, метки, места в альбомах и плейлистах
+	// и связи дубликата переносятся на песню, после чего дубликат удаляется.
+	MergeSongs(ctx context.Context, id, duplicateID uint64) (models.SongAPI, error)
+}
+
+// Server это gRPC-сервер сервиса песен.
+type Server struct {
+	pb.UnimplementedSongServiceServer
+	songService SongService
+}
+
+// New создает новый gRPC-сервер сервиса песен.
+func New(s SongService) *Server {
+	return &Server{
+		songService: s,
+	}
+}
+
+// Register регистрирует сервис песен на определенном gRPC-сервере.
+func (s *Server) Register(srv *grpc.Server) {
+	pb.RegisterSongServiceServer(srv, s)
+}
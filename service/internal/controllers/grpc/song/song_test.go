@@ -0,0 +1,310 @@
+package songgrpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	icerror "github.com/sedonn/song-library-service/internal/controllers/grpc/interceptor/error"
+	pb "github.com/sedonn/song-library-service/internal/controllers/grpc/proto/songlibrary/v1"
+	"github.com/sedonn/song-library-service/internal/controllers/grpc/song/mocks"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+var errUnexpected = errors.New("unexpected error")
+
+// newTestClient запускает gRPC-сервер сервиса песен поверх bufconn и возвращает клиент к нему.
+func newTestClient(t *testing.T, ss SongService) pb.SongServiceClient {
+	t.Helper()
+
+	l := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(icerror.NewUnary()),
+		grpc.ChainStreamInterceptor(icerror.NewStream()),
+	)
+	New(ss).Register(srv)
+	go func() { _ = srv.Serve(l) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return l.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewSongServiceClient(conn)
+}
+
+func TestServer_GetSongWithCoupletPagination(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		req      *pb.GetSongWithCoupletPaginationRequest
+		song     models.SongWithCoupletPaginationAPI
+		err      error
+		call     bool
+		wantCode codes.Code
+		wantMsg  string
+	}{
+		{
+			name: "GetSongWithCoupletPagination success",
+			req:  &pb.GetSongWithCoupletPaginationRequest{Id: 1},
+			song: models.SongWithCoupletPaginationAPI{
+				Song:       models.SongAPI{SongIDAPI: models.SongIDAPI{ID: 1}},
+				Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 1, PageSize: 10, RecordCount: 1},
+			},
+			call:     true,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "GetSongWithCoupletPagination error song not found",
+			req:      &pb.GetSongWithCoupletPaginationRequest{Id: 1},
+			err:      services.ErrSongNotFound,
+			call:     true,
+			wantCode: codes.NotFound,
+			wantMsg:  services.ErrSongNotFound.Error(),
+		},
+		{
+			name:     "GetSongWithCoupletPagination error page number out of range",
+			req:      &pb.GetSongWithCoupletPaginationRequest{Id: 1, Pagination: &pb.Pagination{PageNumber: 5}},
+			err:      services.ErrPageNumberOutOfRange,
+			call:     true,
+			wantCode: codes.OutOfRange,
+			wantMsg:  services.ErrPageNumberOutOfRange.Error(),
+		},
+		{
+			name:     "GetSongWithCoupletPagination error unexpected is hidden",
+			req:      &pb.GetSongWithCoupletPaginationRequest{Id: 1},
+			err:      errUnexpected,
+			call:     true,
+			wantCode: codes.Internal,
+			wantMsg:  "internal server error",
+		},
+		{
+			name:     "GetSongWithCoupletPagination error without id",
+			req:      &pb.GetSongWithCoupletPaginationRequest{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "GetSongWithCoupletPagination error page size too large",
+			req:      &pb.GetSongWithCoupletPaginationRequest{Id: 1, Pagination: &pb.Pagination{PageSize: 1000}},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ss := mocks.NewSongService(t)
+			if tt.call {
+				ss.
+					On("GetSongWithCoupletPagination", mock.Anything, tt.req.GetId(), mock.AnythingOfType("models.Pagination")).
+					Once().
+					Return(tt.song, tt.err)
+			}
+
+			got, err := newTestClient(t, ss).GetSongWithCoupletPagination(context.Background(), tt.req)
+			assert.Equalf(t, tt.wantCode, status.Code(err), "SongService.GetSongWithCoupletPagination() error = %v, wantCode %v", err, tt.wantCode)
+			if tt.wantMsg != "" {
+				assert.Equal(t, tt.wantMsg, status.Convert(err).Message())
+			}
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, tt.song.Song.ID, got.GetSong().GetId())
+				assert.Equal(t, tt.song.Pagination.RecordCount, got.GetPagination().GetRecordCount())
+			}
+		})
+	}
+}
+
+func TestServer_CreateSong(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		req      *pb.CreateSongRequest
+		err      error
+		call     bool
+		wantCode codes.Code
+	}{
+		{
+			name: "CreateSong success",
+			req: &pb.CreateSongRequest{
+				Attributes: &pb.SongAttributes{Name: "Supermassive Black Hole"},
+				ArtistId:   1,
+				Credits:    []*pb.SongCreditAttributes{{ArtistId: 2, Role: models.CreditRoleComposer}},
+			},
+			call:     true,
+			wantCode: codes.OK,
+		},
+		{
+			name:     "CreateSong error artist not found",
+			req:      &pb.CreateSongRequest{Attributes: &pb.SongAttributes{Name: "Supermassive Black Hole"}, ArtistId: 1},
+			err:      services.ErrArtistNotFound,
+			call:     true,
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "CreateSong error song link exists",
+			req:      &pb.CreateSongRequest{Attributes: &pb.SongAttributes{Name: "Supermassive Black Hole"}, ArtistId: 1},
+			err:      services.ErrSongLinkExists,
+			call:     true,
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "CreateSong error invalid song link",
+			req:      &pb.CreateSongRequest{Attributes: &pb.SongAttributes{Name: "Supermassive Black Hole"}, ArtistId: 1},
+			err:      services.ErrInvalidSongLink,
+			call:     true,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "CreateSong error without name",
+			req:      &pb.CreateSongRequest{ArtistId: 1},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "CreateSong error invalid credit role",
+			req: &pb.CreateSongRequest{
+				Attributes: &pb.SongAttributes{Name: "Supermassive Black Hole"},
+				ArtistId:   1,
+				Credits:    []*pb.SongCreditAttributes{{ArtistId: 2, Role: "drummer"}},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ss := mocks.NewSongService(t)
+			if tt.call {
+				ss.
+					On("CreateSong", mock.Anything, mock.MatchedBy(func(s models.Song) bool {
+						return s.Name == tt.req.GetAttributes().GetName() &&
+							s.ArtistID == tt.req.GetArtistId() &&
+							len(s.Credits) == len(tt.req.GetCredits())
+					})).
+					Once().
+					Return(models.SongAPI{SongIDAPI: models.SongIDAPI{ID: 1}}, tt.err)
+			}
+
+			_, err := newTestClient(t, ss).CreateSong(context.Background(), tt.req)
+			assert.Equalf(t, tt.wantCode, status.Code(err), "SongService.CreateSong() error = %v, wantCode %v", err, tt.wantCode)
+		})
+	}
+}
+
+func TestServer_ListSongs(t *testing.T) {
+	t.Parallel()
+
+	songs := func(from, count int) []models.SongAPI {
+		s := make([]models.SongAPI, count)
+		for i := range s {
+			s[i] = models.SongAPI{SongIDAPI: models.SongIDAPI{ID: uint64(from + i)}}
+		}
+
+		return s
+	}
+
+	tests := []struct {
+		name      string
+		req       *pb.ListSongsRequest
+		pages     []models.SongsAPI
+		err       error
+		wantCount int
+		wantCode  codes.Code
+	}{
+		{
+			name: "ListSongs success several pages",
+			req:  &pb.ListSongsRequest{Filter: &pb.SongsFilter{Name: "hole"}},
+			pages: []models.SongsAPI{
+				{Songs: songs(1, listPageSize), Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 2}},
+				{Songs: songs(listPageSize+1, 50), Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 2, PageCount: 2}},
+			},
+			wantCount: listPageSize + 50,
+			wantCode:  codes.OK,
+		},
+		{
+			name: "ListSongs success without songs",
+			req:  &pb.ListSongsRequest{},
+			pages: []models.SongsAPI{
+				{Songs: []models.SongAPI{}},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "ListSongs error on second page",
+			req:  &pb.ListSongsRequest{},
+			pages: []models.SongsAPI{
+				{Songs: songs(1, listPageSize), Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 2}},
+			},
+			err:       errUnexpected,
+			wantCount: listPageSize,
+			wantCode:  codes.Internal,
+		},
+		{
+			name:     "ListSongs error invalid link status",
+			req:      &pb.ListSongsRequest{Filter: &pb.SongsFilter{LinkStatus: "dead"}},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ss := mocks.NewSongService(t)
+			for i, page := range tt.pages {
+				ss.
+					On("SearchSongs", mock.Anything, mock.Anything, models.Pagination{PageNumber: uint64(i + 1), PageSize: listPageSize}, false).
+					Once().
+					Return(page, nil)
+			}
+			if tt.err != nil {
+				ss.
+					On("SearchSongs", mock.Anything, mock.Anything, models.Pagination{PageNumber: uint64(len(tt.pages) + 1), PageSize: listPageSize}, false).
+					Once().
+					Return(models.SongsAPI{}, tt.err)
+			}
+
+			stream, err := newTestClient(t, ss).ListSongs(context.Background(), tt.req)
+			require.NoError(t, err)
+
+			var count int
+			for {
+				song, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					err = nil
+				}
+				if err != nil || song == nil {
+					assert.Equalf(t, tt.wantCode, status.Code(err), "SongService.ListSongs() error = %v, wantCode %v", err, tt.wantCode)
+					break
+				}
+
+				count++
+				assert.Equal(t, uint64(count), song.GetId())
+			}
+			assert.Equal(t, tt.wantCount, count)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// Package validation содержит проверку параметров gRPC-запросов по тем же правилам, что и у REST-API.
+package validation
+
+import (
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validate проверяет структуры по тегам binding, как и валидатор REST-API.
+var validate = func() *validator.Validate {
+	v := validator.New()
+	v.SetTagName("binding")
+
+	return v
+}()
+
+// Struct проверяет структуру по тегам binding.
+// Возвращает ошибку со статусом InvalidArgument, если структура не прошла проверку.
+func Struct(v any) error {
+	if err := validate.Struct(v); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
+// Var проверяет значение по определенному правилу в формате тега binding.
+// Возвращает ошибку со статусом InvalidArgument, если значение не прошло проверку.
+func Var(name string, v any, tag string) error {
+	if err := validate.Var(v, tag); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s: %s", name, err.Error())
+	}
+
+	return nil
+}
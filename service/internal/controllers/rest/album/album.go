@@ -0,0 +1,48 @@
+package albumrest
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// AlbumService описывает поведение объекта, который обеспечивает бизнес-логику работы с альбомами.
+type AlbumService interface {
+	// GetAlbum возвращает данные определенного альбома вместе с упорядоченным списком композиций.
+	GetAlbum(ctx context.Context, id uint64) (models.AlbumWithTracksAPI, error)
+	// SearchAlbums выполняет поиск альбомов по определенным параметрам. Альбомы упорядочены по названию.
+	SearchAlbums(ctx context.Context, attrs models.Album, p models.Pagination) (models.AlbumsAPI, error)
+	// CreateAlbum добавляет новый альбом.
+	CreateAlbum(ctx context.Context, a models.Album) (models.AlbumWithTracksAPI, error)
+	// ChangeAlbum обновляет данные определенного альбома.
+	// Если список композиций не nil, то он полностью заменяет текущий список композиций альбома.
+	ChangeAlbum(ctx context.Context, a models.Album) (models.AlbumWithTracksAPI, error)
+	// RemoveAlbum удаляет определенный альбом.
+	RemoveAlbum(ctx context.Context, id uint64) (models.AlbumIDAPI, error)
+}
+
+// Endpoints это конечные точки сервиса альбомов.
+type Endpoints struct {
+	albumService AlbumService
+}
+
+// New создает новый объект конечных точек сервиса альбомов.
+func New(s AlbumService) *Endpoints {
+	return &Endpoints{
+		albumService: s,
+	}
+}
+
+// BindTo привязывает конечные точки к определенной группе маршрутов.
+func (e *Endpoints) BindTo(router *gin.RouterGroup) {
+	albumRouter := router.Group("/albums")
+	{
+		albumRouter.GET("/:album-id", e.getAlbumHandler)
+		albumRouter.GET("/", e.searchAlbumsHandler)
+		albumRouter.POST("/", e.createAlbumHandler)
+		albumRouter.PATCH("/:album-id", e.changeAlbumHandler)
+		albumRouter.DELETE("/:album-id", e.removeAlbumHandler)
+	}
+}
@@ -0,0 +1,50 @@
+package albumrest
+
+import "github.com/sedonn/song-library-service/internal/domain/models"
+
+type GetAlbumRequest models.AlbumIDAPI
+
+type GetAlbumResponse models.AlbumWithTracksAPI
+
+type SearchAlbumsRequest struct {
+	AlbumsFilter
+	Pagination models.Pagination
+}
+
+// AlbumsFilter это параметры поиска альбомов.
+type AlbumsFilter struct {
+	Title string `form:"title"`
+	// ArtistName ищет по подстроке названия или любого псевдонима исполнителя альбома.
+	ArtistName string `form:"artistName"`
+	Type       string `form:"type" binding:"omitempty,oneof=album single ep compilation"`
+}
+
+type SearchAlbumsResponse models.AlbumsAPI
+
+type CreateAlbumRequest struct {
+	models.AlbumAttributesAPI
+	Artist models.ArtistIDAPI               `json:"artist"`
+	Tracks []models.AlbumTrackAttributesAPI `json:"tracks" binding:"omitempty,dive"`
+}
+
+type CreateAlbumResponse models.AlbumWithTracksAPI
+
+type ChangeAlbumRequest struct {
+	ChangeAlbumRequestPath
+	ChangeAlbumRequestBody
+}
+
+type ChangeAlbumRequestPath models.AlbumIDAPI
+
+type ChangeAlbumRequestBody struct {
+	models.AlbumOptionalAttributesAPI
+	Artist models.ArtistIDAPI `json:"artist" binding:"omitempty"`
+	// Tracks полностью заменяет список композиций альбома, если передан.
+	Tracks []models.AlbumTrackAttributesAPI `json:"tracks" binding:"omitempty,dive"`
+}
+
+type ChangeAlbumResponse models.AlbumWithTracksAPI
+
+type RemoveAlbumRequest models.AlbumIDAPI
+
+type RemoveAlbumResponse models.AlbumIDAPI
@@ -0,0 +1,198 @@
+package albumrest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// getAlbumHandler это хендлер, который возвращает определенный альбом вместе со списком композиций.
+//
+//	@Summary		Получить данные определенного альбома.
+//	@Description	Получить данные определенного альбома вместе со списком композиций, упорядоченным по номеру диска и номеру композиции.
+//	@Tags			album
+//	@Accept			json
+//	@Produce		json
+//	@Param			album-id	path		GetAlbumRequest	true	"ID альбома"
+//	@Success		200			{object}	GetAlbumResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/albums/{album-id} [get]
+func (e *Endpoints) getAlbumHandler(ctx *gin.Context) {
+	var req GetAlbumRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	a, err := e.albumService.GetAlbum(ctx, req.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetAlbumResponse(a))
+}
+
+// searchAlbumsHandler это хендлер, который выполняет поиск альбомов по определенным параметрам.
+//
+//	@Summary		Поиск альбомов.
+//	@Description	Поиск альбомов по подстроке названия, подстроке названия или псевдонима исполнителя и типу альбома.
+//	@Description	Альбомы возвращаются без списков композиций и упорядочены по названию.
+//	@Tags			album
+//	@Accept			json
+//	@Produce		json
+//	@Param			album	query		SearchAlbumsRequest	true	"Настройки поиска."
+//	@Success		200		{object}	SearchAlbumsResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/albums/ [get]
+func (e *Endpoints) searchAlbumsHandler(ctx *gin.Context) {
+	var req SearchAlbumsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	albums, err := e.albumService.SearchAlbums(ctx, models.Album{
+		Title:  req.Title,
+		Artist: models.Artist{Name: req.ArtistName},
+		Type:   req.Type,
+	}, req.Pagination)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, SearchAlbumsResponse(albums))
+}
+
+// createAlbumHandler это хендлер, который добавляет новые альбомы.
+//
+//	@Summary		Добавить новый альбом.
+//	@Description	Добавить новый альбом вместе со списком композиций. Номер композиции должен быть уникальным в пределах диска.
+//	@Tags			album
+//	@Accept			json
+//	@Produce		json
+//	@Param			album	body		CreateAlbumRequest	true	"Данные нового альбома"
+//	@Success		200		{object}	CreateAlbumResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		409		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/albums/ [post]
+func (e *Endpoints) createAlbumHandler(ctx *gin.Context) {
+	var req CreateAlbumRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	a, err := e.albumService.CreateAlbum(ctx, models.Album{
+		Title:       req.Title,
+		ArtistID:    req.Artist.ID,
+		ReleaseDate: req.ReleaseDate,
+		Type:        req.Type,
+		Tracks:      models.AlbumTracksFromAPI(req.Tracks),
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, CreateAlbumResponse(a))
+}
+
+// changeAlbumHandler это хендлер, который обновляет данные альбомов.
+//
+//	@Summary		Изменить данные альбома.
+//	@Description	Изменить данные альбома. Переданный список композиций полностью заменяет текущий.
+//	@Tags			album
+//	@Accept			json
+//	@Produce		json
+//	@Param			album-id	path		ChangeAlbumRequestPath	true	"ID альбома"
+//	@Param			album		body		ChangeAlbumRequestBody	true	"Новые данные альбома"
+//	@Success		200			{object}	ChangeAlbumResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		409			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/albums/{album-id} [patch]
+func (e *Endpoints) changeAlbumHandler(ctx *gin.Context) {
+	var req ChangeAlbumRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	a, err := e.albumService.ChangeAlbum(ctx, models.Album{
+		ID:          req.ID,
+		Title:       req.Title,
+		ArtistID:    req.Artist.ID,
+		ReleaseDate: req.ReleaseDate,
+		Type:        req.Type,
+		Tracks:      models.AlbumTracksFromAPI(req.Tracks),
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ChangeAlbumResponse(a))
+}
+
+// removeAlbumHandler это хендлер, который удаляет определенный альбом.
+//
+//	@Summary		Удалить данные альбома.
+//	@Description	Удалить данные альбома. Песни альбома не удаляются.
+//	@Tags			album
+//	@Accept			json
+//	@Produce		json
+//	@Param			album-id	path		RemoveAlbumRequest	true	"ID альбома"
+//	@Success		200			{object}	RemoveAlbumResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/albums/{album-id} [delete]
+func (e *Endpoints) removeAlbumHandler(ctx *gin.Context) {
+	var req RemoveAlbumRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	id, err := e.albumService.RemoveAlbum(ctx, req.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, RemoveAlbumResponse(id))
+}
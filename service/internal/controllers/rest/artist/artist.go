@@ -11,12 +11,32 @@ import (
 type ArtistService interface {
 	// GetArtist получает данные определенного исполнителя.
 	GetArtist(ctx context.Context, id uint64) (models.ArtistAPI, error)
+	// GetArtistByISNI возвращает исполнителя с определенным кодом ISNI. Код может содержать пробелы.
+	GetArtistByISNI(ctx context.Context, isni string) (models.ArtistAPI, error)
+	// GetArtistByMBID возвращает исполнителя с определенным идентификатором MusicBrainz.
+	GetArtistByMBID(ctx context.Context, mbid string) (models.ArtistAPI, error)
+	// SearchArtists выполняет поиск исполнителей по определенным параметрам.
+	// Исполнители упорядочены по названию для сортировки.
+	SearchArtists(ctx context.Context, attrs models.Artist, p models.Pagination) (models.ArtistsAPI, error)
 	// CreateArtist добавляет нового исполнителя.
+	// Если название для сортировки не задано, то оно строится из названия исполнителя.
 	CreateArtist(ctx context.Context, a models.Artist) (models.ArtistAPI, error)
 	// ChangeArtist обновляет данные определенного исполнителя.
+	// Если название исполнителя изменяется без названия для сортировки, то название для сортировки строится заново.
 	ChangeArtist(ctx context.Context, a models.Artist) (models.ArtistAPI, error)
 	// RemoveArtist удаляет определенного исполнителя.
 	RemoveArtist(ctx context.Context, id uint64) (models.ArtistIDAPI, error)
+	// MergeArtists сливает исполнителя-дубликата с определенным исполнителем.
+	// Песни, альбомы, участие в создании песен и псевдонимы дубликата переносятся на исполнителя, после чего дубликат удаляется.
+	// Название дубликата становится псевдонимом исполнителя.
+	MergeArtists(ctx context.Context, id, duplicateID uint64) (models.ArtistAPI, error)
+	// GetArtistAliases возвращает псевдонимы определенного исполнителя.
+	GetArtistAliases(ctx context.Context, artistID uint64) (models.ArtistAliasesAPI, error)
+	// AddArtistAlias добавляет новый псевдоним исполнителя.
+	// Псевдоним не может совпадать с названием или псевдонимом какого-либо исполнителя без учета регистра и лишних пробелов.
+	AddArtistAlias(ctx context.Context, alias models.ArtistAlias) (models.ArtistAliasAPI, error)
+	// RemoveArtistAlias удаляет определенный псевдоним определенного исполнителя.
+	RemoveArtistAlias(ctx context.Context, artistID, aliasID uint64) (models.ArtistAliasIDAPI, error)
 }
 
 // Endpoints это конечные точки сервиса исполнителей.
@@ -36,8 +56,15 @@ func (e *Endpoints) BindTo(router *gin.RouterGroup) {
 	artistRouter := router.Group("/artists")
 	{
 		artistRouter.GET("/:artist-id", e.getArtistHandler)
+		artistRouter.GET("/", e.searchArtistsHandler)
+		artistRouter.GET("/by-isni/:isni", e.getArtistByISNIHandler)
+		artistRouter.GET("/by-mbid/:mbid", e.getArtistByMBIDHandler)
 		artistRouter.POST("/", e.createArtistHandler)
 		artistRouter.PATCH("/:artist-id", e.changeArtistHandler)
 		artistRouter.DELETE("/:artist-id", e.removeArtistHandler)
+		artistRouter.POST("/:artist-id/merge", e.mergeArtistsHandler)
+		artistRouter.GET("/:artist-id/aliases", e.getArtistAliasesHandler)
+		artistRouter.POST("/:artist-id/aliases", e.addArtistAliasHandler)
+		artistRouter.DELETE("/:artist-id/aliases/:alias-id", e.removeArtistAliasHandler)
 	}
 }
@@ -6,6 +6,29 @@ type GetArtistRequest models.ArtistIDAPI
 
 type GetArtistResponse models.ArtistAPI
 
+type GetArtistByISNIRequest struct {
+	ISNI string `uri:"isni" json:"-" binding:"required,lte=19"`
+}
+
+type GetArtistByMBIDRequest struct {
+	MBID string `uri:"mbid" json:"-" binding:"required,lte=36"`
+}
+
+type SearchArtistsRequest struct {
+	ArtistsFilter
+	Pagination models.Pagination
+}
+
+// ArtistsFilter это параметры поиска исполнителей.
+type ArtistsFilter struct {
+	// Name ищет по подстроке названия или любого псевдонима исполнителя.
+	Name    string `form:"name"`
+	Country string `form:"country" binding:"omitempty,iso3166_1_alpha2"`
+	Type    string `form:"type" binding:"omitempty,oneof=person group"`
+}
+
+type SearchArtistsResponse models.ArtistsAPI
+
 type CreateArtistRequest models.ArtistAttributesAPI
 
 type CreateArtistResponse models.ArtistAPI
@@ -24,3 +47,37 @@ type ChangeArtistResponse models.ArtistAPI
 type RemoveArtistRequest models.ArtistIDAPI
 
 type RemoveArtistResponse models.ArtistIDAPI
+
+type MergeArtistsRequest struct {
+	MergeArtistsRequestPath
+	MergeArtistsRequestBody
+}
+
+type MergeArtistsRequestPath models.ArtistIDAPI
+
+type MergeArtistsRequestBody models.ArtistMergeAttributesAPI
+
+type MergeArtistsResponse models.ArtistAPI
+
+type GetArtistAliasesRequest models.ArtistIDAPI
+
+type GetArtistAliasesResponse models.ArtistAliasesAPI
+
+type AddArtistAliasRequest struct {
+	AddArtistAliasRequestPath
+	AddArtistAliasRequestBody
+}
+
+type AddArtistAliasRequestPath models.ArtistIDAPI
+
+type AddArtistAliasRequestBody models.ArtistAliasAttributesAPI
+
+type AddArtistAliasResponse models.ArtistAliasAPI
+
+// RemoveArtistAliasRequest это путь к определенному псевдониму определенного исполнителя.
+type RemoveArtistAliasRequest struct {
+	ArtistID uint64 `uri:"artist-id" json:"-" binding:"required,number"`
+	AliasID  uint64 `uri:"alias-id" json:"-" binding:"required,number"`
+}
+
+type RemoveArtistAliasResponse models.ArtistAliasIDAPI
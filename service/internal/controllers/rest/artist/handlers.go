@@ -1,12 +1,10 @@
 package artistrest
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sedonn/song-library-service/internal/domain/models"
-	"github.com/sedonn/song-library-service/internal/services"
 )
 
 // getArtistHandler это хендлер, который возвращает определенного исполнителя.
@@ -18,57 +16,174 @@ import (
 //	@Produce		json
 //	@Param			artist-id	path		GetArtistRequest	true	"ID исполнителя"
 //	@Success		200			{object}	GetArtistResponse
-//	@Failure		400			{object}	mwerror.ErrorResponse
-//	@Failure		404			{object}	mwerror.ErrorResponse
-//	@Failure		500			{object}	mwerror.ErrorResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
 //	@Router			/artists/{artist-id} [get]
 func (e *Endpoints) getArtistHandler(ctx *gin.Context) {
 	var req GetArtistRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
 	a, err := e.artistService.GetArtist(ctx, req.ID)
 	if err != nil {
-		if errors.Is(err, services.ErrArtistNotFound) {
-			_ = ctx.AbortWithError(http.StatusNotFound, err)
-			return
-		}
-		_ = ctx.AbortWithError(http.StatusInternalServerError, err)
+		_ = ctx.Error(err)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, GetArtistResponse(a))
 }
 
+// getArtistByISNIHandler это хендлер, который возвращает исполнителя по коду ISNI.
+//
+//	@Summary		Найти исполнителя по ISNI.
+//	@Description	Найти исполнителя по коду ISNI. Код может содержать пробелы, например 0000 0001 2281 955X.
+//	@Tags			artist
+//	@Accept			json
+//	@Produce		json
+//	@Param			isni	path		string	true	"Код ISNI"
+//	@Success		200		{object}	GetArtistResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/artists/by-isni/{isni} [get]
+func (e *Endpoints) getArtistByISNIHandler(ctx *gin.Context) {
+	var req GetArtistByISNIRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	a, err := e.artistService.GetArtistByISNI(ctx, req.ISNI)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetArtistResponse(a))
+}
+
+// getArtistByMBIDHandler это хендлер, который возвращает исполнителя по идентификатору MusicBrainz.
+//
+//	@Summary		Найти исполнителя по MusicBrainz ID.
+//	@Description	Найти исполнителя по идентификатору MusicBrainz (UUID).
+//	@Tags			artist
+//	@Accept			json
+//	@Produce		json
+//	@Param			mbid	path		string	true	"MusicBrainz ID"
+//	@Success		200		{object}	GetArtistResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/artists/by-mbid/{mbid} [get]
+func (e *Endpoints) getArtistByMBIDHandler(ctx *gin.Context) {
+	var req GetArtistByMBIDRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	a, err := e.artistService.GetArtistByMBID(ctx, req.MBID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetArtistResponse(a))
+}
+
+// searchArtistsHandler это хендлер, который выполняет поиск исполнителей по определенным параметрам.
+//
+//	@Summary		Поиск исполнителей.
+//	@Description	Поиск исполнителей по подстроке названия или псевдонима, стране и типу исполнителя.
+//	@Description	Исполнители упорядочены по названию для сортировки.
+//	@Tags			artist
+//	@Accept			json
+//	@Produce		json
+//	@Param			artist	query		SearchArtistsRequest	true	"Настройки поиска."
+//	@Success		200		{object}	SearchArtistsResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/artists/ [get]
+func (e *Endpoints) searchArtistsHandler(ctx *gin.Context) {
+	var req SearchArtistsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	artists, err := e.artistService.SearchArtists(ctx, models.Artist{
+		Name:    req.Name,
+		Country: req.Country,
+		Type:    req.Type,
+	}, req.Pagination)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, SearchArtistsResponse(artists))
+}
+
 // createArtistHandler это хендлер, который добавляет новых исполнителей.
 //
 //	@Summary		Добавить нового исполнителя.
-//	@Description	Добавить нового исполнителя. Название исполнителя должно быть уникальным.
+//	@Description	Добавить нового исполнителя. Название исполнителя должно быть уникальным без учета регистра и лишних пробелов среди названий и псевдонимов исполнителей.
+//	@Description	Если название для сортировки не задано, то артикль в начале названия переносится в конец: "The Beatles" - "Beatles, The".
+//	@Description	Год распада не может быть раньше года основания.
+//	@Description	Коды ISNI и MBID проверяются по формату, ISNI - также по контрольной сумме. Оба кода должны быть уникальными.
 //	@Tags			artist
 //	@Accept			json
 //	@Produce		json
 //	@Param			artist	body		CreateArtistRequest	true	"Данные нового исполнителя"
 //	@Success		200		{object}	CreateArtistResponse
-//	@Failure		400		{object}	mwerror.ErrorResponse
-//	@Failure		500		{object}	mwerror.ErrorResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		409		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
 //	@Router			/artists/ [post]
 func (e *Endpoints) createArtistHandler(ctx *gin.Context) {
 	var req CreateArtistRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
-	a, err := e.artistService.CreateArtist(ctx, models.Artist{Name: req.Name})
+	a, err := e.artistService.CreateArtist(ctx, models.Artist{
+		Name:          req.Name,
+		SortName:      req.SortName,
+		Country:       req.Country,
+		Type:          req.Type,
+		FormedYear:    req.FormedYear,
+		DisbandedYear: req.DisbandedYear,
+		Bio:           req.Bio,
+		Links:         req.Links,
+		ISNI:          req.ISNI,
+		MBID:          req.MBID,
+	})
 	if err != nil {
-		if errors.Is(err, services.ErrArtistExists) {
-			_ = ctx.AbortWithError(http.StatusBadRequest, err)
-			return
-		}
-
-		_ = ctx.AbortWithError(http.StatusInternalServerError, err)
+		_ = ctx.Error(err)
 		return
 	}
 
@@ -78,43 +193,49 @@ func (e *Endpoints) createArtistHandler(ctx *gin.Context) {
 // changeArtistHandler это хендлер, который обновляет данные исполнителей.
 //
 //	@Summary		Изменить данные исполнителя.
-//	@Description	Изменить данные исполнителя.
+//	@Description	Изменить данные исполнителя. Переданные поля заменяют текущие значения, список ссылок заменяется полностью.
+//	@Description	Если название изменяется без названия для сортировки, то название для сортировки строится заново.
 //	@Tags			artist
 //	@Accept			json
 //	@Produce		json
 //	@Param			artist-id	path		ChangeArtistRequestPath	true	"ID исполнителя"
 //	@Param			artist		body		ChangeArtistRequestBody	true	"Новые данные исполнителя"
 //	@Success		200			{object}	ChangeArtistResponse
-//	@Failure		400			{object}	mwerror.ErrorResponse
-//	@Failure		404			{object}	mwerror.ErrorResponse
-//	@Failure		500			{object}	mwerror.ErrorResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		409			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
 //	@Router			/artists/{artist-id} [patch]
 func (e *Endpoints) changeArtistHandler(ctx *gin.Context) {
 	var req ChangeArtistRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
 	a, err := e.artistService.ChangeArtist(ctx, models.Artist{
-		ID:   req.ID,
-		Name: req.Name,
+		ID:            req.ID,
+		Name:          req.Name,
+		SortName:      req.SortName,
+		Country:       req.Country,
+		Type:          req.Type,
+		FormedYear:    req.FormedYear,
+		DisbandedYear: req.DisbandedYear,
+		Bio:           req.Bio,
+		Links:         req.Links,
+		ISNI:          req.ISNI,
+		MBID:          req.MBID,
 	})
 	if err != nil {
-		switch {
-		case errors.Is(err, services.ErrArtistNotFound):
-			_ = ctx.AbortWithError(http.StatusNotFound, err)
-
-		case errors.Is(err, services.ErrArtistExists):
-			_ = ctx.AbortWithError(http.StatusBadRequest, err)
-
-		default:
-			_ = ctx.AbortWithError(http.StatusInternalServerError, err)
-		}
+		_ = ctx.Error(err)
 		return
 	}
 
@@ -130,27 +251,173 @@ func (e *Endpoints) changeArtistHandler(ctx *gin.Context) {
 //	@Produce		json
 //	@Param			artist-id	path		RemoveArtistRequest	true	"ID исполнителя"
 //	@Success		200			{object}	RemoveArtistResponse
-//	@Failure		400			{object}	mwerror.ErrorResponse
-//	@Failure		404			{object}	mwerror.ErrorResponse
-//	@Failure		500			{object}	mwerror.ErrorResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
 //	@Router			/artists/{artist-id} [delete]
 func (e *Endpoints) removeArtistHandler(ctx *gin.Context) {
 	var req RemoveArtistRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
 	id, err := e.artistService.RemoveArtist(ctx, req.ID)
 	if err != nil {
-		if errors.Is(err, services.ErrArtistNotFound) {
-			_ = ctx.AbortWithError(http.StatusNotFound, err)
-			return
-		}
-
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, RemoveArtistResponse(id))
 }
+
+// mergeArtistsHandler это хендлер, который сливает исполнителя-дубликата с определенным исполнителем.
+//
+//	@Summary		Слить исполнителя-дубликата с исполнителем.
+//	@Description	Перенести песни, альбомы, участие в создании песен и псевдонимы исполнителя-дубликата на исполнителя и удалить дубликат. Название дубликата становится псевдонимом исполнителя. Данные самого исполнителя не изменяются.
+//	@Tags			artist
+//	@Accept			json
+//	@Produce		json
+//	@Param			artist-id	path		MergeArtistsRequestPath	true	"ID исполнителя, который остается"
+//	@Param			duplicate	body		MergeArtistsRequestBody	true	"Исполнитель-дубликат"
+//	@Success		200			{object}	MergeArtistsResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/artists/{artist-id}/merge [post]
+func (e *Endpoints) mergeArtistsHandler(ctx *gin.Context) {
+	var req MergeArtistsRequest
+	if err := ctx.ShouldBindUri(&req.MergeArtistsRequestPath); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req.MergeArtistsRequestBody); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	a, err := e.artistService.MergeArtists(ctx, req.MergeArtistsRequestPath.ID, req.Duplicate.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, MergeArtistsResponse(a))
+}
+
+// getArtistAliasesHandler это хендлер, который возвращает псевдонимы определенного исполнителя.
+//
+//	@Summary		Получить псевдонимы исполнителя.
+//	@Description	Получить альтернативные названия исполнителя, по которым он находится при поиске песен.
+//	@Tags			artist
+//	@Accept			json
+//	@Produce		json
+//	@Param			artist-id	path		GetArtistAliasesRequest	true	"ID исполнителя"
+//	@Success		200			{object}	GetArtistAliasesResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/artists/{artist-id}/aliases [get]
+func (e *Endpoints) getArtistAliasesHandler(ctx *gin.Context) {
+	var req GetArtistAliasesRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	aliases, err := e.artistService.GetArtistAliases(ctx, req.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetArtistAliasesResponse(aliases))
+}
+
+// addArtistAliasHandler это хендлер, который добавляет новый псевдоним исполнителя.
+//
+//	@Summary		Добавить псевдоним исполнителя.
+//	@Description	Добавить альтернативное название исполнителя. Псевдоним должен быть уникальным без учета регистра и лишних пробелов среди названий и псевдонимов исполнителей.
+//	@Tags			artist
+//	@Accept			json
+//	@Produce		json
+//	@Param			artist-id	path		AddArtistAliasRequestPath	true	"ID исполнителя"
+//	@Param			alias		body		AddArtistAliasRequestBody	true	"Псевдоним исполнителя"
+//	@Success		200			{object}	AddArtistAliasResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		409			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/artists/{artist-id}/aliases [post]
+func (e *Endpoints) addArtistAliasHandler(ctx *gin.Context) {
+	var req AddArtistAliasRequest
+	if err := ctx.ShouldBindUri(&req.AddArtistAliasRequestPath); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req.AddArtistAliasRequestBody); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	alias, err := e.artistService.AddArtistAlias(ctx, models.ArtistAlias{
+		ArtistID: req.ID,
+		Name:     req.Name,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, AddArtistAliasResponse(alias))
+}
+
+// removeArtistAliasHandler это хендлер, который удаляет псевдоним исполнителя.
+//
+//	@Summary		Удалить псевдоним исполнителя.
+//	@Description	Удалить альтернативное название исполнителя.
+//	@Tags			artist
+//	@Accept			json
+//	@Produce		json
+//	@Param			artist-id	path		int	true	"ID исполнителя"
+//	@Param			alias-id	path		int	true	"ID псевдонима"
+//	@Success		200			{object}	RemoveArtistAliasResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/artists/{artist-id}/aliases/{alias-id} [delete]
+func (e *Endpoints) removeArtistAliasHandler(ctx *gin.Context) {
+	var req RemoveArtistAliasRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	id, err := e.artistService.RemoveArtistAlias(ctx, req.ArtistID, req.AliasID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, RemoveArtistAliasResponse(id))
+}
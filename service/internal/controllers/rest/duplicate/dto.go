@@ -0,0 +1,9 @@
+package duplicaterest
+
+import "github.com/sedonn/song-library-service/internal/domain/models"
+
+type FindDuplicatesRequest models.Pagination
+
+type FindDuplicateSongsResponse models.DuplicateSongsAPI
+
+type FindDuplicateArtistsResponse models.DuplicateArtistsAPI
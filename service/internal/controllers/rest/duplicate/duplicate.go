@@ -0,0 +1,39 @@
+package duplicaterest
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// DuplicateService описывает поведение объекта, который обеспечивает бизнес-логику поиска дубликатов.
+type DuplicateService interface {
+	// FindDuplicateSongs возвращает группы песен одного исполнителя с похожими названиями или текстами.
+	// Для каждой песни группы вычисляется сходство текста с текстом первой песни группы.
+	FindDuplicateSongs(ctx context.Context, p models.Pagination) (models.DuplicateSongsAPI, error)
+	// FindDuplicateArtists возвращает группы исполнителей с похожими названиями.
+	FindDuplicateArtists(ctx context.Context, p models.Pagination) (models.DuplicateArtistsAPI, error)
+}
+
+// Endpoints это конечные точки сервиса поиска дубликатов.
+type Endpoints struct {
+	duplicateService DuplicateService
+}
+
+// New создает новый объект конечных точек сервиса поиска дубликатов.
+func New(s DuplicateService) *Endpoints {
+	return &Endpoints{
+		duplicateService: s,
+	}
+}
+
+// BindTo привязывает конечные точки к определенной группе маршрутов.
+func (e *Endpoints) BindTo(router *gin.RouterGroup) {
+	duplicateRouter := router.Group("/duplicates")
+	{
+		duplicateRouter.GET("/songs", e.findDuplicateSongsHandler)
+		duplicateRouter.GET("/artists", e.findDuplicateArtistsHandler)
+	}
+}
@@ -0,0 +1,73 @@
+package duplicaterest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// findDuplicateSongsHandler это хендлер, который возвращает группы песен-дубликатов.
+//
+//	@Summary		Найти дубликаты песен.
+//	@Description	Найти песни одного исполнителя с одинаковыми названиями или похожими текстами. Названия песен и исполнителей сравниваются без учета регистра, знаков препинания, пробелов и артикля the в начале. Тексты считаются похожими при сходстве от 0.8. Для каждой песни группы указано сходство текста с текстом первой песни группы от 0 до 1. Пагинация выполняется по группам.
+//	@Tags			duplicate
+//	@Accept			json
+//	@Produce		json
+//	@Param			pagination	query		FindDuplicatesRequest	true	"Настройки пагинации"
+//	@Success		200			{object}	FindDuplicateSongsResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/duplicates/songs [get]
+func (e *Endpoints) findDuplicateSongsHandler(ctx *gin.Context) {
+	var req FindDuplicatesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	d, err := e.duplicateService.FindDuplicateSongs(ctx, models.Pagination(req))
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, FindDuplicateSongsResponse(d))
+}
+
+// findDuplicateArtistsHandler это хендлер, который возвращает группы исполнителей-дубликатов.
+//
+//	@Summary		Найти дубликаты исполнителей.
+//	@Description	Найти исполнителей с одинаковым названием без учета регистра, знаков препинания, пробелов и артикля the в начале. Пагинация выполняется по группам.
+//	@Tags			duplicate
+//	@Accept			json
+//	@Produce		json
+//	@Param			pagination	query		FindDuplicatesRequest	true	"Настройки пагинации"
+//	@Success		200			{object}	FindDuplicateArtistsResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/duplicates/artists [get]
+func (e *Endpoints) findDuplicateArtistsHandler(ctx *gin.Context) {
+	var req FindDuplicatesRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	d, err := e.duplicateService.FindDuplicateArtists(ctx, models.Pagination(req))
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, FindDuplicateArtistsResponse(d))
+}
@@ -0,0 +1,21 @@
+package jobrest
+
+import "github.com/sedonn/song-library-service/internal/domain/models"
+
+type GetJobRequest models.JobIDAPI
+
+type GetJobResponse models.JobAPI
+
+type SearchJobsRequest struct {
+	JobsFilter
+	Pagination models.Pagination
+}
+
+// JobsFilter это параметры поиска фоновых задач.
+type JobsFilter struct {
+	SongID uint64 `form:"songId" binding:"omitempty,number"`
+	Type   string `form:"type" binding:"omitempty,oneof=enrich_song validate_link"`
+	Status string `form:"status" binding:"omitempty,oneof=pending running done dead"`
+}
+
+type SearchJobsResponse models.JobsAPI
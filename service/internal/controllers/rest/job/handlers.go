@@ -0,0 +1,79 @@
+package jobrest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// getJobHandler это хендлер, который возвращает фоновую задачу.
+//
+//	@Summary		Получить фоновую задачу.
+//	@Description	Получить статус фоновой задачи. Ожидающая задача (pending) выполняется в runAt, неудачные попытки повторяются с экспоненциальной паузой.
+//	@Description	Задача со статусом dead не выполнена за допустимое количество попыток, причина указана в lastError.
+//	@Tags			job
+//	@Accept			json
+//	@Produce		json
+//	@Param			job-id	path		int	true	"ID задачи"
+//	@Success		200		{object}	GetJobResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/jobs/{job-id} [get]
+func (e *Endpoints) getJobHandler(ctx *gin.Context) {
+	var req GetJobRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	j, err := e.jobService.GetJob(ctx, req.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetJobResponse(j))
+}
+
+// searchJobsHandler это хендлер, который выполняет поиск фоновых задач.
+//
+//	@Summary		Поиск фоновых задач.
+//	@Description	Поиск фоновых задач по песне, виду и статусу задачи. Новые задачи идут первыми.
+//	@Tags			job
+//	@Accept			json
+//	@Produce		json
+//	@Param			job	query		SearchJobsRequest	true	"Настройки поиска."
+//	@Success		200	{object}	SearchJobsResponse
+//	@Failure		400	{object}	mwerror.Problem
+//	@Failure		401	{object}	mwerror.Problem
+//	@Failure		403	{object}	mwerror.Problem
+//	@Failure		500	{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/jobs/ [get]
+func (e *Endpoints) searchJobsHandler(ctx *gin.Context) {
+	var req SearchJobsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	jobs, err := e.jobService.SearchJobs(ctx, models.Job{
+		SongID: req.SongID,
+		Type:   req.Type,
+		Status: req.Status,
+	}, req.Pagination)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, SearchJobsResponse(jobs))
+}
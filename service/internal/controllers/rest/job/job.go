@@ -0,0 +1,38 @@
+package jobrest
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// JobService описывает поведение объекта, который обеспечивает бизнес-логику фоновых задач.
+type JobService interface {
+	// GetJob возвращает определенную задачу.
+	GetJob(ctx context.Context, id uint64) (models.JobAPI, error)
+	// SearchJobs выполняет поиск задач по песне, виду и статусу задачи.
+	SearchJobs(ctx context.Context, attrs models.Job, p models.Pagination) (models.JobsAPI, error)
+}
+
+// Endpoints это конечные точки сервиса фоновых задач.
+type Endpoints struct {
+	jobService JobService
+}
+
+// New создает новый объект конечных точек сервиса фоновых задач.
+func New(s JobService) *Endpoints {
+	return &Endpoints{
+		jobService: s,
+	}
+}
+
+// BindTo привязывает конечные точки к определенной группе маршрутов.
+func (e *Endpoints) BindTo(router *gin.RouterGroup) {
+	jobRouter := router.Group("/jobs")
+	{
+		jobRouter.GET("/:job-id", e.getJobHandler)
+		jobRouter.GET("/", e.searchJobsHandler)
+	}
+}
@@ -0,0 +1,70 @@
+// Package mwauth содержит middleware для аутентификации клиентов REST-API по API-ключу или JWT.
+package mwauth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// APIKeyHeader это заголовок запроса с API-ключом.
+const APIKeyHeader = "X-API-Key"
+
+// Authenticator описывает поведение объекта, который проверяет учетные данные клиентов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=Authenticator
+type Authenticator interface {
+	// AuthenticateAPIKey проверяет API-ключ и возвращает клиента, которому он принадлежит.
+	AuthenticateAPIKey(ctx context.Context, key string) (principal.Principal, error)
+	// AuthenticateToken проверяет JWT и возвращает клиента, для которого он выпущен.
+	AuthenticateToken(ctx context.Context, token string) (principal.Principal, error)
+}
+
+// New создает middleware, которое пропускает только запросы с принятым API-ключом в заголовке X-API-Key
+// или JWT в заголовке Authorization: Bearer. Аутентифицированный клиент добавляется в контекст запроса.
+func New(a Authenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var (
+			p   principal.Principal
+			err error
+		)
+
+		switch token, ok := bearerToken(c.GetHeader("Authorization")); {
+		case ok:
+			p, err = a.AuthenticateToken(c, token)
+
+		case c.GetHeader(APIKeyHeader) != "":
+			p, err = a.AuthenticateAPIKey(c, c.GetHeader(APIKeyHeader))
+
+		default:
+			err = services.ErrUnauthenticated
+		}
+
+		if err != nil {
+			c.Header("WWW-Authenticate", `Bearer realm="song-library-service"`)
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(principal.NewContext(c.Request.Context(), p))
+
+		c.Next()
+	}
+}
+
+// bearerToken возвращает токен из значения заголовка Authorization со схемой Bearer.
+func bearerToken(authorization string) (string, bool) {
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
@@ -0,0 +1,109 @@
+package mwauth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sedonn/song-library-service/internal/controllers/rest/middleware/auth/mocks"
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+func TestNew(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name          string
+		headers       map[string]string
+		authenticator func(t *testing.T) Authenticator
+		wantStatus    int
+		wantActor     string
+	}{
+		{
+			name:    "Bearer token",
+			headers: map[string]string{"Authorization": "Bearer token-1"},
+			authenticator: func(t *testing.T) Authenticator {
+				a := mocks.NewAuthenticator(t)
+				a.
+					On("AuthenticateToken", mock.Anything, "token-1").
+					Once().
+					Return(principal.Principal{Subject: "user-1", Method: principal.MethodJWT}, nil)
+
+				return a
+			},
+			wantStatus: http.StatusOK,
+			wantActor:  "jwt:user-1",
+		},
+		{
+			name:    "API key",
+			headers: map[string]string{APIKeyHeader: "key-1"},
+			authenticator: func(t *testing.T) Authenticator {
+				a := mocks.NewAuthenticator(t)
+				a.
+					On("AuthenticateAPIKey", mock.Anything, "key-1").
+					Once().
+					Return(principal.Principal{Subject: "importer", Method: principal.MethodAPIKey}, nil)
+
+				return a
+			},
+			wantStatus: http.StatusOK,
+			wantActor:  "api_key:importer",
+		},
+		{
+			name:    "Rejected credentials",
+			headers: map[string]string{APIKeyHeader: "key-2"},
+			authenticator: func(t *testing.T) Authenticator {
+				a := mocks.NewAuthenticator(t)
+				a.
+					On("AuthenticateAPIKey", mock.Anything, "key-2").
+					Once().
+					Return(principal.Principal{}, services.ErrUnauthenticated)
+
+				return a
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "No credentials",
+			headers:       map[string]string{"Authorization": "Basic dXNlcjpwYXNz"},
+			authenticator: func(t *testing.T) Authenticator { return mocks.NewAuthenticator(t) },
+			wantStatus:    http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Next()
+				if len(c.Errors) > 0 {
+					c.Status(http.StatusUnauthorized)
+				}
+			})
+			router.GET("/", New(tt.authenticator(t)), func(c *gin.Context) {
+				p, _ := principal.FromContext(c.Request.Context())
+				c.String(http.StatusOK, p.String())
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantActor, w.Body.String())
+			} else {
+				assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
@@ -1,30 +1,235 @@
+// Package mwerror содержит middleware для глобальной обработки ошибок REST-API.
+// Ошибки возвращаются в формате application/problem+json (RFC 7807) со стабильным машиночитаемым кодом.
 package mwerror
 
 import (
+	"context"
+	"errors"
 	"net/http"
+	"reflect"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	mwrequestid "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/requestid"
+	"github.com/sedonn/song-library-service/internal/pkg/i18n"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// ContentType это тип содержимого ответа с ошибкой.
+const ContentType = "application/problem+json"
+
+// Коды ошибок, которые не соответствуют ошибкам бизнес-логики.
+const (
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodeBadRequest       = "BAD_REQUEST"
+	CodeRequestTooLarge  = "REQUEST_TOO_LARGE"
+	CodeInternal         = "INTERNAL_ERROR"
 )
 
-// ErrorResponse хранит данные ошибки для API ответа.
-type ErrorResponse struct {
-	Err string `json:"error"`
+// Problem хранит данные ошибки для API ответа в формате RFC 7807.
+type Problem struct {
+	Type     string       `json:"type" example:"about:blank"`
+	Title    string       `json:"title" example:"Not Found"`
+	Status   int          `json:"status" example:"404"`
+	Detail   string       `json:"detail,omitempty" example:"song not found"`
+	Instance string       `json:"instance,omitempty" example:"3f2c7a9e1b4d5c6e8f0a1b2c3d4e5f60"`
+	Code     string       `json:"code" example:"SONG_NOT_FOUND"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError хранит ошибку проверки определенного поля запроса.
+type FieldError struct {
+	Field   string `json:"field" example:"artist.id"`
+	Rule    string `json:"rule" example:"required"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message" example:"id is a required field"`
+}
+
+// errorStatuses сопоставляет ошибки бизнес-логики кодам статуса HTTP и кодам ошибок.
+var errorStatuses = []struct {
+	err    error
+	status int
+	code   string
+}{
+	{services.ErrUnauthenticated, http.StatusUnauthorized, "UNAUTHENTICATED"},
+	{services.ErrForbidden, http.StatusForbidden, "FORBIDDEN"},
+	{services.ErrTenantForbidden, http.StatusForbidden, "TENANT_FORBIDDEN"},
+
+	{services.ErrSongNotFound, http.StatusNotFound, "SONG_NOT_FOUND"},
+	{services.ErrArtistNotFound, http.StatusNotFound, "ARTIST_NOT_FOUND"},
+	{services.ErrArtistAliasNotFound, http.StatusNotFound, "ARTIST_ALIAS_NOT_FOUND"},
+	{services.ErrAlbumNotFound, http.StatusNotFound, "ALBUM_NOT_FOUND"},
+	{services.ErrGenreNotFound, http.StatusNotFound, "GENRE_NOT_FOUND"},
+	{services.ErrTagNotFound, http.StatusNotFound, "TAG_NOT_FOUND"},
+	{services.ErrPlaylistNotFound, http.StatusNotFound, "PLAYLIST_NOT_FOUND"},
+	{services.ErrPlaylistItemNotFound, http.StatusNotFound, "PLAYLIST_ITEM_NOT_FOUND"},
+	{services.ErrSongRelationNotFound, http.StatusNotFound, "SONG_RELATION_NOT_FOUND"},
+	{services.ErrJobNotFound, http.StatusNotFound, "JOB_NOT_FOUND"},
+
+	{services.ErrArtistExists, http.StatusConflict, "ARTIST_EXISTS"},
+	{services.ErrArtistAliasExists, http.StatusConflict, "ARTIST_ALIAS_EXISTS"},
+	{services.ErrAlbumTrackConflict, http.StatusConflict, "ALBUM_TRACK_CONFLICT"},
+	{services.ErrGenreExists, http.StatusConflict, "GENRE_EXISTS"},
+	{services.ErrTagExists, http.StatusConflict, "TAG_EXISTS"},
+	{services.ErrPlaylistItemExists, http.StatusConflict, "PLAYLIST_ITEM_EXISTS"},
+	{services.ErrSongRelationExists, http.StatusConflict, "SONG_RELATION_EXISTS"},
+	{services.ErrSongRelationCycle, http.StatusConflict, "SONG_RELATION_CYCLE"},
+	{services.ErrIdentifierExists, http.StatusConflict, "IDENTIFIER_EXISTS"},
+	{services.ErrSongLinkExists, http.StatusConflict, "SONG_LINK_EXISTS"},
+
+	{services.ErrArtistActiveYearsInvalid, http.StatusBadRequest, "ARTIST_ACTIVE_YEARS_INVALID"},
+	{services.ErrInvalidPlaylistFile, http.StatusBadRequest, "INVALID_PLAYLIST_FILE"},
+	{services.ErrMergeIntoItself, http.StatusBadRequest, "MERGE_INTO_ITSELF"},
+	{services.ErrInvalidIdentifier, http.StatusBadRequest, "INVALID_IDENTIFIER"},
+	{services.ErrInvalidSongLink, http.StatusBadRequest, "INVALID_SONG_LINK"},
+	{services.ErrPageNumberOutOfRange, http.StatusBadRequest, "PAGE_NUMBER_OUT_OF_RANGE"},
+	{services.ErrInvalidTenant, http.StatusBadRequest, "INVALID_TENANT"},
+	{playlistfmt.ErrUnsupportedFormat, http.StatusBadRequest, "UNSUPPORTED_PLAYLIST_FORMAT"},
+
+	{services.ErrRateLimited, http.StatusTooManyRequests, "RATE_LIMITED"},
+	{services.ErrQuotaExceeded, http.StatusTooManyRequests, "QUOTA_EXCEEDED"},
+
+	{services.ErrUnknownJobType, http.StatusInternalServerError, "UNKNOWN_JOB_TYPE"},
+	{context.DeadlineExceeded, http.StatusGatewayTimeout, "DEADLINE_EXCEEDED"},
 }
 
 // New создает middleware для глобальной обработки ошибок.
-func New() gin.HandlerFunc {
+// Хендлеры добавляют ошибку в контекст запроса, а код статуса ответа определяется по ошибке.
+// Ошибки привязки параметров запроса должны иметь тип gin.ErrorTypeBind.
+// Сообщения ошибок переводятся на язык из заголовка Accept-Language. Паникует, если язык по умолчанию не поддерживается.
+func New(cfg *config.I18nConfig) gin.HandlerFunc {
+	catalog, err := i18n.New(cfg.FallbackLocale, messages)
+	if err != nil {
+		panic("failed to create error message catalog: " + err.Error())
+	}
+
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(fieldName)
+		if err := catalog.RegisterValidatorTranslations(v); err != nil {
+			panic("failed to register validation messages: " + err.Error())
+		}
+	}
+
 	return func(c *gin.Context) {
 		c.Next()
 
-		if len(c.Errors) == 0 {
+		if len(c.Errors) == 0 || c.Writer.Written() {
 			return
 		}
 
-		if c.Writer.Status() == http.StatusInternalServerError {
-			c.JSON(-1, ErrorResponse{Err: "internal server error"})
-			return
+		locale := catalog.Negotiate(c.GetHeader("Accept-Language"))
+
+		p := newProblem(catalog, locale, c.Errors[0])
+		p.Instance = mwrequestid.Get(c)
+
+		c.Header("Content-Type", ContentType)
+		c.Header("Content-Language", locale)
+		c.Header("Vary", "Accept-Language")
+		c.JSON(p.Status, p)
+	}
+}
+
+// newProblem создает описание ошибки по ошибке обработки запроса на определенном языке.
+// Текст неизвестных ошибок скрывается.
+func newProblem(catalog *i18n.Catalog, locale string, err *gin.Error) Problem {
+	var verrs validator.ValidationErrors
+	if errors.As(err.Err, &verrs) {
+		p := problem(http.StatusBadRequest, CodeValidationFailed, catalog.Message(locale, CodeValidationFailed))
+		p.Errors = fieldErrors(catalog, locale, verrs)
+
+		return p
+	}
+
+	var maxErr *http.MaxBytesError
+	if errors.As(err.Err, &maxErr) {
+		return problem(http.StatusRequestEntityTooLarge, CodeRequestTooLarge, catalog.Message(locale, CodeRequestTooLarge))
+	}
+
+	for _, v := range errorStatuses {
+		if errors.Is(err.Err, v.err) {
+			return problem(v.status, v.code, detail(catalog.Message(locale, v.code), err.Error(), v.err.Error()))
 		}
+	}
+
+	if err.IsType(gin.ErrorTypeBind) {
+		return problem(http.StatusBadRequest, CodeBadRequest, catalog.Message(locale, CodeBadRequest)+": "+err.Error())
+	}
+
+	return problem(http.StatusInternalServerError, CodeInternal, catalog.Message(locale, CodeInternal))
+}
+
+// problem создает описание ошибки без отдельного типа проблемы.
+func problem(status int, code, detail string) Problem {
+	return Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
 
-		c.JSON(-1, ErrorResponse{Err: c.Errors[0].Error()})
+// detail дополняет переведенное сообщение ошибки подробностями, которые добавлены к тексту ошибки бизнес-логики.
+func detail(msg, errText, baseText string) string {
+	if rest, ok := strings.CutPrefix(errText, baseText); ok {
+		return msg + rest
 	}
+
+	return msg
+}
+
+// fieldErrors преобразует ошибки валидатора в ошибки полей запроса с сообщениями на определенном языке.
+// Имя поля в сообщении заменяется его названием из каталога.
+func fieldErrors(catalog *i18n.Catalog, locale string, verrs validator.ValidationErrors) []FieldError {
+	errs := make([]FieldError, 0, len(verrs))
+	for _, fe := range verrs {
+		msg := fe.Translate(catalog.Translator(locale))
+		if label, ok := catalog.Lookup(locale, fieldKeyPrefix+fe.Field()); ok {
+			msg = strings.Replace(msg, fe.Field(), label, 1)
+		}
+
+		errs = append(errs, FieldError{
+			Field:   fieldPath(fe),
+			Rule:    fe.Tag(),
+			Param:   fe.Param(),
+			Message: msg,
+		})
+	}
+
+	return errs
+}
+
+// fieldPath возвращает путь к полю из имен полей в запросе без имени корневой структуры.
+// Встроенные структуры не имеют имени в запросе, поэтому пропускаются сегменты, имя которых
+// совпадает с именем поля в Go.
+func fieldPath(fe validator.FieldError) string {
+	names := strings.Split(fe.Namespace(), ".")
+	goNames := strings.Split(fe.StructNamespace(), ".")
+
+	path := make([]string, 0, len(names))
+	for i := 1; i < len(names) && i < len(goNames); i++ {
+		if names[i] == goNames[i] && i < len(names)-1 {
+			continue
+		}
+		path = append(path, names[i])
+	}
+
+	return strings.Join(path, ".")
+}
+
+// fieldName возвращает имя поля структуры в запросе по первому заданному тегу из json, form и uri.
+func fieldName(f reflect.StructField) string {
+	for _, tag := range []string{"json", "form", "uri"} {
+		name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+
+	return f.Name
 }
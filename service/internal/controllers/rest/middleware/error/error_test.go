@@ -0,0 +1,245 @@
+package mwerror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	mwrequestid "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/requestid"
+	"github.com/sedonn/song-library-service/internal/pkg/i18n"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// testConfig это конфигурация перевода сообщений в тестах.
+var testConfig = &config.I18nConfig{FallbackLocale: i18n.LocaleEN}
+
+// testPagination это встроенная структура запроса.
+type testPagination struct {
+	PageSize int `json:"pageSize" binding:"omitempty,min=1,max=100"`
+}
+
+// testRequest это тело запроса тестового хендлера.
+type testRequest struct {
+	Name   string `json:"name" binding:"required"`
+	Artist struct {
+		ID uint64 `json:"id" binding:"required"`
+	} `json:"artist"`
+	testPagination
+}
+
+func TestNew(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(mwrequestid.New(), New(testConfig))
+	router.POST("/", func(ctx *gin.Context) {
+		var req testRequest
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+			return
+		}
+
+		switch req.Name {
+		case "not found":
+			_ = ctx.Error(fmt.Errorf("get song: %w", services.ErrSongNotFound))
+		case "invalid isrc":
+			_ = ctx.Error(fmt.Errorf("%w: isrc: bad checksum", services.ErrInvalidIdentifier))
+		case "exists":
+			_ = ctx.Error(services.ErrArtistExists)
+		case "unexpected":
+			_ = ctx.Error(errors.New("connection refused"))
+		default:
+			ctx.Status(http.StatusNoContent)
+		}
+	})
+
+	tests := []struct {
+		name       string
+		body       string
+		language   string
+		wantStatus int
+		wantCode   string
+		wantDetail string
+		wantFields []string
+		wantLocale string
+		// wantMessage это сообщение ошибки первого поля.
+		wantMessage string
+	}{
+		{
+			name:        "Validation errors",
+			body:        `{"pageSize": 1000}`,
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    CodeValidationFailed,
+			wantDetail:  "request validation failed",
+			wantFields:  []string{"name", "artist.id", "pageSize"},
+			wantLocale:  i18n.LocaleEN,
+			wantMessage: "name is a required field",
+		},
+		{
+			name:        "Validation errors in russian",
+			body:        `{"artist": {"id": 1}}`,
+			language:    "ru-RU,ru;q=0.9,en;q=0.8",
+			wantStatus:  http.StatusBadRequest,
+			wantCode:    CodeValidationFailed,
+			wantDetail:  "запрос не прошел проверку",
+			wantFields:  []string{"name"},
+			wantLocale:  i18n.LocaleRU,
+			wantMessage: "Название обязательное поле",
+		},
+		{
+			name:       "Malformed body",
+			body:       `{"name": `,
+			wantStatus: http.StatusBadRequest,
+			wantCode:   CodeBadRequest,
+			wantDetail: "malformed request: unexpected EOF",
+			wantLocale: i18n.LocaleEN,
+		},
+		{
+			name:       "Wrapped service error",
+			body:       `{"name": "not found", "artist": {"id": 1}}`,
+			wantStatus: http.StatusNotFound,
+			wantCode:   "SONG_NOT_FOUND",
+			wantDetail: "song not found",
+			wantLocale: i18n.LocaleEN,
+		},
+		{
+			name:       "Service error with details in russian",
+			body:       `{"name": "invalid isrc", "artist": {"id": 1}}`,
+			language:   "ru",
+			wantStatus: http.StatusBadRequest,
+			wantCode:   "INVALID_IDENTIFIER",
+			wantDetail: "некорректный идентификатор: isrc: bad checksum",
+			wantLocale: i18n.LocaleRU,
+		},
+		{
+			name:       "Service error",
+			body:       `{"name": "exists", "artist": {"id": 1}}`,
+			wantStatus: http.StatusConflict,
+			wantCode:   "ARTIST_EXISTS",
+			wantDetail: "artist already exists",
+			language:   "de, fr;q=0.5",
+			wantLocale: i18n.LocaleEN,
+		},
+		{
+			name:       "Unexpected error",
+			body:       `{"name": "unexpected", "artist": {"id": 1}}`,
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   CodeInternal,
+			wantDetail: "внутренняя ошибка сервера",
+			language:   "en;q=0.1, ru",
+			wantLocale: i18n.LocaleRU,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			req.Header.Set(mwrequestid.Header, "request-1")
+			req.Header.Set("Accept-Language", tt.language)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, ContentType, w.Header().Get("Content-Type"))
+			assert.Equal(t, tt.wantLocale, w.Header().Get("Content-Language"))
+
+			var p Problem
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &p))
+
+			fields := make([]string, 0, len(p.Errors))
+			for _, fe := range p.Errors {
+				fields = append(fields, fe.Field)
+			}
+
+			assert.Equal(t, tt.wantStatus, p.Status)
+			assert.Equal(t, http.StatusText(tt.wantStatus), p.Title)
+			assert.Equal(t, tt.wantCode, p.Code)
+			assert.Equal(t, tt.wantDetail, p.Detail)
+			assert.Equal(t, "request-1", p.Instance)
+			assert.ElementsMatch(t, tt.wantFields, fields)
+			if tt.wantMessage != "" {
+				assert.Equal(t, tt.wantMessage, p.Errors[0].Message)
+			}
+		})
+	}
+}
+
+func TestNew_NoError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(mwrequestid.New(), New(testConfig))
+	router.GET("/", func(ctx *gin.Context) {
+		ctx.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.Len(t, w.Header().Get(mwrequestid.Header), 32)
+}
+
+func TestMessages(t *testing.T) {
+	// Ошибки бизнес-логики берутся из исходного кода пакета services, чтобы новая ошибка
+	// не осталась без кода ошибки и перевода.
+	f, err := parser.ParseFile(token.NewFileSet(), "../../../../services/errors.go", nil, 0)
+	require.NoError(t, err)
+
+	codes := make(map[string]string, len(errorStatuses))
+	for _, v := range errorStatuses {
+		codes[v.err.Error()] = v.code
+	}
+
+	var checked int
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+
+		for i, name := range spec.Names {
+			call, ok := spec.Values[i].(*ast.CallExpr)
+			require.Truef(t, ok, "%s is not created by errors.New", name.Name)
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			require.Truef(t, ok, "%s has no literal message", name.Name)
+
+			code, ok := codes[strings.Trim(lit.Value, `"`)]
+			if !assert.Truef(t, ok, "services.%s has no error code", name.Name) {
+				continue
+			}
+
+			for _, locale := range i18n.Locales {
+				assert.NotEmptyf(t, messages[locale][code], "services.%s (%s) has no %s message", name.Name, code, locale)
+			}
+			checked++
+		}
+
+		return false
+	})
+	assert.NotZero(t, checked)
+
+	allCodes := []string{CodeValidationFailed, CodeBadRequest, CodeRequestTooLarge, CodeInternal}
+	for _, v := range errorStatuses {
+		allCodes = append(allCodes, v.code)
+	}
+	for _, code := range allCodes {
+		for _, locale := range i18n.Locales {
+			assert.NotEmptyf(t, messages[locale][code], "%s has no %s message", code, locale)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package mwerror
+
+import "github.com/sedonn/song-library-service/internal/pkg/i18n"
+
+// fieldKeyPrefix это префикс ключа названия поля запроса в каталоге сообщений.
+// Поле определяется по имени в запросе из тегов json, form и uri.
+const fieldKeyPrefix = "field."
+
+// messages это сообщения ошибок по кодам ошибок и названия полей запросов.
+// Если названия поля нет на языке ответа, то используется имя поля в запросе.
+var messages = i18n.Messages{
+	i18n.LocaleEN: {
+		CodeValidationFailed: "request validation failed",
+		CodeBadRequest:       "malformed request",
+		CodeRequestTooLarge:  "request body too large",
+		CodeInternal:         "internal server error",
+
+		"UNAUTHENTICATED":             "valid API key or bearer token required",
+		"FORBIDDEN":                   "operation not permitted for client roles",
+		"TENANT_FORBIDDEN":            "client is bound to another tenant",
+		"SONG_NOT_FOUND":              "song not found",
+		"ARTIST_NOT_FOUND":            "artist not found",
+		"ARTIST_ALIAS_NOT_FOUND":      "artist alias not found",
+		"ALBUM_NOT_FOUND":             "album not found",
+		"GENRE_NOT_FOUND":             "genre not found",
+		"TAG_NOT_FOUND":               "tag not found",
+		"PLAYLIST_NOT_FOUND":          "playlist not found",
+		"PLAYLIST_ITEM_NOT_FOUND":     "song not found in playlist",
+		"SONG_RELATION_NOT_FOUND":     "song relation not found",
+		"JOB_NOT_FOUND":               "job not found",
+		"ARTIST_EXISTS":               "artist already exists",
+		"ARTIST_ALIAS_EXISTS":         "artist alias already exists",
+		"ALBUM_TRACK_CONFLICT":        "album track conflicts with existing track",
+		"GENRE_EXISTS":                "genre already exists",
+		"TAG_EXISTS":                  "tag already exists",
+		"PLAYLIST_ITEM_EXISTS":        "song already exists in playlist",
+		"SONG_RELATION_EXISTS":        "song relation already exists",
+		"SONG_RELATION_CYCLE":         "song relation creates a cycle",
+		"IDENTIFIER_EXISTS":           "identifier already assigned",
+		"SONG_LINK_EXISTS":            "song link already attached to another song",
+		"ARTIST_ACTIVE_YEARS_INVALID": "artist disbanded year precedes formed year",
+		"INVALID_PLAYLIST_FILE":       "invalid playlist file",
+		"MERGE_INTO_ITSELF":           "cannot merge record into itself",
+		"INVALID_IDENTIFIER":          "invalid identifier",
+		"INVALID_SONG_LINK":           "invalid song link",
+		"PAGE_NUMBER_OUT_OF_RANGE":    "page number out of range",
+		"INVALID_TENANT":              "invalid tenant",
+		"UNSUPPORTED_PLAYLIST_FORMAT": "unsupported playlist format",
+		"RATE_LIMITED":                "too many requests, retry later",
+		"QUOTA_EXCEEDED":              "daily request quota exceeded",
+		"UNKNOWN_JOB_TYPE":            "unknown job type",
+		"DEADLINE_EXCEEDED":           "request timed out",
+	},
+	i18n.LocaleRU: {
+		CodeValidationFailed: "запрос не прошел проверку",
+		CodeBadRequest:       "некорректный запрос",
+		CodeRequestTooLarge:  "слишком большое тело запроса",
+		CodeInternal:         "внутренняя ошибка сервера",
+
+		"UNAUTHENTICATED":             "требуется действительный API-ключ или токен",
+		"FORBIDDEN":                   "операция не разрешена ролям клиента",
+		"TENANT_FORBIDDEN":            "клиент привязан к другой библиотеке",
+		"SONG_NOT_FOUND":              "песня не найдена",
+		"ARTIST_NOT_FOUND":            "исполнитель не найден",
+		"ARTIST_ALIAS_NOT_FOUND":      "псевдоним исполнителя не найден",
+		"ALBUM_NOT_FOUND":             "альбом не найден",
+		"GENRE_NOT_FOUND":             "жанр не найден",
+		"TAG_NOT_FOUND":               "метка не найдена",
+		"PLAYLIST_NOT_FOUND":          "плейлист не найден",
+		"PLAYLIST_ITEM_NOT_FOUND":     "песни нет в плейлисте",
+		"SONG_RELATION_NOT_FOUND":     "связь между песнями не найдена",
+		"JOB_NOT_FOUND":               "задача не найдена",
+		"ARTIST_EXISTS":               "исполнитель уже существует",
+		"ARTIST_ALIAS_EXISTS":         "псевдоним совпадает с названием или псевдонимом другого исполнителя",
+		"ALBUM_TRACK_CONFLICT":        "композиция уже есть в альбоме или ее позиция занята",
+		"GENRE_EXISTS":                "жанр уже существует",
+		"TAG_EXISTS":                  "метка уже существует",
+		"PLAYLIST_ITEM_EXISTS":        "песня уже есть в плейлисте",
+		"SONG_RELATION_EXISTS":        "связь между песнями уже существует",
+		"SONG_RELATION_CYCLE":         "связь делает песню оригиналом самой себя",
+		"IDENTIFIER_EXISTS":           "идентификатор уже присвоен другой записи",
+		"SONG_LINK_EXISTS":            "ссылка уже есть у другой песни",
+		"ARTIST_ACTIVE_YEARS_INVALID": "год распада исполнителя раньше года основания",
+		"INVALID_PLAYLIST_FILE":       "файл плейлиста не соответствует формату",
+		"MERGE_INTO_ITSELF":           "запись нельзя слить саму с собой",
+		"INVALID_IDENTIFIER":          "некорректный идентификатор",
+		"INVALID_SONG_LINK":           "некорректная ссылка песни",
+		"PAGE_NUMBER_OUT_OF_RANGE":    "номер страницы вне допустимого диапазона",
+		"INVALID_TENANT":              "некорректный идентификатор библиотеки",
+		"UNSUPPORTED_PLAYLIST_FORMAT": "неподдерживаемый формат плейлиста",
+		"RATE_LIMITED":                "слишком много запросов, повторите позже",
+		"QUOTA_EXCEEDED":              "суточная квота запросов исчерпана",
+		"UNKNOWN_JOB_TYPE":            "неизвестный вид задачи",
+		"DEADLINE_EXCEEDED":           "истекло время обработки запроса",
+
+		fieldKeyPrefix + "album-id":      "ID альбома",
+		fieldKeyPrefix + "alias-id":      "ID псевдонима",
+		fieldKeyPrefix + "artist":        "Исполнитель",
+		fieldKeyPrefix + "artist-id":     "ID исполнителя",
+		fieldKeyPrefix + "bio":           "Биография",
+		fieldKeyPrefix + "country":       "Страна",
+		fieldKeyPrefix + "credits":       "Участники",
+		fieldKeyPrefix + "description":   "Описание",
+		fieldKeyPrefix + "disbandedYear": "Год распада",
+		fieldKeyPrefix + "discNumber":    "Номер диска",
+		fieldKeyPrefix + "format":        "Формат",
+		fieldKeyPrefix + "formedYear":    "Год основания",
+		fieldKeyPrefix + "genre":         "Жанр",
+		fieldKeyPrefix + "genres":        "Жанры",
+		fieldKeyPrefix + "id":            "ID",
+		fieldKeyPrefix + "isni":          "ISNI",
+		fieldKeyPrefix + "isrc":          "ISRC",
+		fieldKeyPrefix + "iswc":          "ISWC",
+		fieldKeyPrefix + "job-id":        "ID задачи",
+		fieldKeyPrefix + "lang":          "Язык",
+		fieldKeyPrefix + "link":          "Ссылка",
+		fieldKeyPrefix + "linkStatus":    "Статус ссылки",
+		fieldKeyPrefix + "links":         "Ссылки",
+		fieldKeyPrefix + "mbid":          "MBID",
+		fieldKeyPrefix + "name":          "Название",
+		fieldKeyPrefix + "original-id":   "ID оригинала",
+		fieldKeyPrefix + "pageNumber":    "Номер страницы",
+		fieldKeyPrefix + "pageSize":      "Размер страницы",
+		fieldKeyPrefix + "playlist-id":   "ID плейлиста",
+		fieldKeyPrefix + "position":      "Позиция",
+		fieldKeyPrefix + "relation-type": "Вид связи",
+		fieldKeyPrefix + "releaseDate":   "Дата выхода",
+		fieldKeyPrefix + "role":          "Роль",
+		fieldKeyPrefix + "song-id":       "ID песни",
+		fieldKeyPrefix + "songId":        "ID песни",
+		fieldKeyPrefix + "sortName":      "Название для сортировки",
+		fieldKeyPrefix + "status":        "Статус",
+		fieldKeyPrefix + "tag":           "Метка",
+		fieldKeyPrefix + "tag-id":        "ID метки",
+		fieldKeyPrefix + "tags":          "Метки",
+		fieldKeyPrefix + "text":          "Текст",
+		fieldKeyPrefix + "title":         "Название",
+		fieldKeyPrefix + "trackNumber":   "Номер композиции",
+		fieldKeyPrefix + "tracks":        "Композиции",
+		fieldKeyPrefix + "type":          "Тип",
+	},
+}
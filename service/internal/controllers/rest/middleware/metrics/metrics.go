@@ -0,0 +1,34 @@
+// Package mwmetrics содержит middleware, которое собирает метрики запросов REST-API.
+package mwmetrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/pkg/metrics"
+)
+
+// unmatchedRoute это маршрут в метриках запросов, которые не соответствуют ни одному маршруту.
+// Пути таких запросов не записываются, чтобы количество значений метки не зависело от клиентов.
+const unmatchedRoute = "unmatched"
+
+// New создает middleware, которое учитывает количество и длительность обработки запросов по методу,
+// шаблону маршрута и коду статуса ответа. Должно выполняться первым, чтобы учитывать ответы других middleware.
+func New() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+
+		labels := []string{c.Request.Method, route, strconv.Itoa(c.Writer.Status())}
+		metrics.HTTPRequests.WithLabelValues(labels...).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+	}
+}
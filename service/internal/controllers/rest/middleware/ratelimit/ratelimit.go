@@ -0,0 +1,123 @@
+// Package mwratelimit содержит middleware для ограничения частоты запросов и суточных квот клиентов REST-API.
+package mwratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/pkg/ratelimit"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// Заголовки ответа с состоянием ограничений клиента.
+const (
+	LimitHeader          = "RateLimit-Limit"
+	RemainingHeader      = "RateLimit-Remaining"
+	ResetHeader          = "RateLimit-Reset"
+	PolicyHeader         = "RateLimit-Policy"
+	RetryAfterHeader     = "Retry-After"
+	QuotaLimitHeader     = "X-Quota-Limit"
+	QuotaRemainingHeader = "X-Quota-Remaining"
+	QuotaResetHeader     = "X-Quota-Reset"
+)
+
+// QuotaService описывает поведение объекта, который учитывает запросы клиентов в суточных квотах.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=QuotaService
+type QuotaService interface {
+	// Consume учитывает запрос клиента в его суточной квоте.
+	Consume(ctx context.Context, client string) (models.Quota, error)
+}
+
+// New создает middleware, которое ограничивает частоту запросов каждого клиента хранилищем корзин токенов
+// и учитывает разрешенные запросы в суточных квотах, если передан сервис квот. Клиент определяется по
+// аутентифицированному клиенту из контекста, а если его нет - по IP-адресу, поэтому middleware должно
+// выполняться после аутентификации. Запросы GET, HEAD и OPTIONS и запросы к маршрутам readRoutes
+// ограничиваются как запросы на чтение, остальные - как запросы на изменение.
+func New(cfg *config.RateLimitConfig, l ratelimit.Limiter, qs QuotaService, readRoutes ...string) gin.HandlerFunc {
+	read := ratelimit.Limit{Rate: cfg.ReadRate, Burst: cfg.ReadBurst}
+	write := ratelimit.Limit{Rate: cfg.WriteRate, Burst: cfg.WriteBurst}
+
+	readOnly := make(map[string]struct{}, len(readRoutes))
+	for _, r := range readRoutes {
+		readOnly[r] = struct{}{}
+	}
+
+	return func(c *gin.Context) {
+		client := clientKey(c)
+
+		class, limit := "write", write
+		if _, ok := readOnly[c.FullPath()]; ok || isRead(c.Request.Method) {
+			class, limit = "read", read
+		}
+
+		d, err := l.Take(c, class+":"+client, limit)
+		if err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+
+		if limit.Rate > 0 && limit.Burst > 0 {
+			c.Header(LimitHeader, strconv.Itoa(limit.Burst))
+			c.Header(RemainingHeader, strconv.Itoa(d.Remaining))
+			c.Header(ResetHeader, seconds(d.Reset))
+			c.Header(PolicyHeader, strconv.Itoa(limit.Burst)+";w="+seconds(limit.Window()))
+		}
+
+		if !d.Allowed {
+			c.Header(RetryAfterHeader, seconds(d.RetryAfter))
+			_ = c.Error(services.ErrRateLimited)
+			c.Abort()
+			return
+		}
+
+		if qs != nil {
+			q, err := qs.Consume(c, client)
+			if q.Limit > 0 {
+				c.Header(QuotaLimitHeader, strconv.FormatUint(q.Limit, 10))
+				c.Header(QuotaRemainingHeader, strconv.FormatUint(q.Remaining, 10))
+				c.Header(QuotaResetHeader, seconds(time.Until(q.Reset)))
+			}
+			if err != nil {
+				if errors.Is(err, services.ErrQuotaExceeded) {
+					c.Header(RetryAfterHeader, seconds(time.Until(q.Reset)))
+				}
+				_ = c.Error(err)
+				c.Abort()
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// clientKey возвращает идентификатор клиента, запросы которого ограничиваются вместе:
+// аутентифицированного клиента в формате способ:субъект или IP-адрес в формате ip:адрес.
+func clientKey(c *gin.Context) string {
+	if p, ok := principal.FromContext(c.Request.Context()); ok {
+		return p.String()
+	}
+
+	return "ip:" + c.ClientIP()
+}
+
+// isRead проверяет, что метод запроса не изменяет данные.
+func isRead(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// seconds возвращает длительность в целых секундах с округлением вверх.
+func seconds(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(max(d, 0).Seconds())), 10)
+}
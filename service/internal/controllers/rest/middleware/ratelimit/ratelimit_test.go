@@ -0,0 +1,242 @@
+package mwratelimit
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/controllers/rest/middleware/ratelimit/mocks"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/pkg/ratelimit"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+var cfg = &config.RateLimitConfig{ReadRate: 1, ReadBurst: 2, WriteRate: 0.5, WriteBurst: 1}
+
+// newRouter создает маршрутизатор с middleware. Клиент запроса задается заголовком X-Subject,
+// а ответ на отклоненный запрос имеет статус 429. Маршрут /query только читает данные.
+func newRouter(qs QuotaService) *gin.Engine {
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Next()
+		if len(c.Errors) > 0 {
+			if err := c.Errors.Last().Err; errors.Is(err, services.ErrRateLimited) || errors.Is(err, services.ErrQuotaExceeded) {
+				c.Status(http.StatusTooManyRequests)
+			} else {
+				c.Status(http.StatusInternalServerError)
+			}
+		}
+	})
+	router.Use(func(c *gin.Context) {
+		if subject := c.GetHeader("X-Subject"); subject != "" {
+			p := principal.Principal{Subject: subject, Method: principal.MethodAPIKey}
+			c.Request = c.Request.WithContext(principal.NewContext(c.Request.Context(), p))
+		}
+	})
+	router.Use(New(cfg, ratelimit.NewMemory(), qs, "/query"))
+	router.Any("/", func(c *gin.Context) { c.Status(http.StatusOK) })
+	router.POST("/query", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	return router
+}
+
+// serve выполняет запрос клиента к маршрутизатору.
+func serve(router *gin.Engine, method, subject string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/", nil)
+	if subject != "" {
+		req.Header.Set("X-Subject", subject)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w
+}
+
+func TestNew_RateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := newRouter(nil)
+
+	w := serve(router, http.MethodGet, "client-a")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2", w.Header().Get(LimitHeader))
+	assert.Equal(t, "1", w.Header().Get(RemainingHeader))
+	assert.Equal(t, "1", w.Header().Get(ResetHeader))
+	assert.Equal(t, "2;w=2", w.Header().Get(PolicyHeader))
+
+	assert.Equal(t, http.StatusOK, serve(router, http.MethodGet, "client-a").Code)
+
+	w = serve(router, http.MethodGet, "client-a")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code, "read burst must be exhausted")
+	assert.Equal(t, "0", w.Header().Get(RemainingHeader))
+	assert.Equal(t, "1", w.Header().Get(RetryAfterHeader))
+
+	assert.Equal(t, http.StatusOK, serve(router, http.MethodGet, "client-b").Code, "clients must be limited separately")
+	assert.Equal(t, http.StatusOK, serve(router, http.MethodGet, "").Code, "anonymous clients must be limited by IP")
+
+	w = serve(router, http.MethodPost, "client-a")
+	assert.Equal(t, http.StatusOK, w.Code, "write requests must be limited separately from read requests")
+	assert.Equal(t, "1", w.Header().Get(LimitHeader))
+
+	w = serve(router, http.MethodDelete, "client-a")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get(RetryAfterHeader))
+}
+
+func TestNew_ReadRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := newRouter(nil)
+	serveQuery := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/query", nil)
+		req.Header.Set("X-Subject", "client-a")
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		return w
+	}
+
+	w := serveQuery()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2", w.Header().Get(LimitHeader), "POST to read route must be limited as read request")
+	assert.Equal(t, http.StatusOK, serveQuery().Code)
+	assert.Equal(t, http.StatusTooManyRequests, serveQuery().Code)
+
+	assert.Equal(t, http.StatusOK, serve(router, http.MethodPost, "client-a").Code,
+		"read routes must not consume write limit")
+}
+
+func TestNew_Quota(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	reset := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		subject        string
+		quotaService   func(t *testing.T) QuotaService
+		wantStatus     int
+		wantRemaining  string
+		wantRetryAfter bool
+	}{
+		{
+			name:    "Quota consumed",
+			subject: "client-a",
+			quotaService: func(t *testing.T) QuotaService {
+				qs := mocks.NewQuotaService(t)
+				qs.
+					On("Consume", mock.Anything, "api_key:client-a").
+					Once().
+					Return(models.Quota{Limit: 100, Remaining: 99, Reset: reset}, nil)
+
+				return qs
+			},
+			wantStatus:    http.StatusOK,
+			wantRemaining: "99",
+		},
+		{
+			name: "Anonymous client",
+			quotaService: func(t *testing.T) QuotaService {
+				qs := mocks.NewQuotaService(t)
+				qs.
+					On("Consume", mock.Anything, "ip:192.0.2.1").
+					Once().
+					Return(models.Quota{}, nil)
+
+				return qs
+			},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:    "Quota exceeded",
+			subject: "client-a",
+			quotaService: func(t *testing.T) QuotaService {
+				qs := mocks.NewQuotaService(t)
+				qs.
+					On("Consume", mock.Anything, "api_key:client-a").
+					Once().
+					Return(models.Quota{Limit: 100, Reset: reset}, services.ErrQuotaExceeded)
+
+				return qs
+			},
+			wantStatus:     http.StatusTooManyRequests,
+			wantRemaining:  "0",
+			wantRetryAfter: true,
+		},
+		{
+			name:    "Quota storage failure",
+			subject: "client-a",
+			quotaService: func(t *testing.T) QuotaService {
+				qs := mocks.NewQuotaService(t)
+				qs.
+					On("Consume", mock.Anything, "api_key:client-a").
+					Once().
+					Return(models.Quota{}, errors.New("db error"))
+
+				return qs
+			},
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serve(newRouter(tt.quotaService(t)), http.MethodGet, tt.subject)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			assert.Equal(t, tt.wantRemaining, w.Header().Get(QuotaRemainingHeader))
+			if tt.wantRetryAfter {
+				assert.Equal(t, "3600", w.Header().Get(RetryAfterHeader))
+			} else {
+				assert.Empty(t, w.Header().Get(RetryAfterHeader))
+			}
+		})
+	}
+}
+
+func TestNew_ForwardedFor(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	// serveForwarded выполняет запрос анонимного клиента 192.0.2.1 с определенным заголовком X-Forwarded-For.
+	serveForwarded := func(router *gin.Engine, forwardedFor string) int {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		return w.Code
+	}
+
+	t.Run("Untrusted client", func(t *testing.T) {
+		router := newRouter(nil)
+		require.NoError(t, router.SetTrustedProxies(nil))
+
+		assert.Equal(t, http.StatusOK, serveForwarded(router, "198.51.100.1"))
+		assert.Equal(t, http.StatusOK, serveForwarded(router, "198.51.100.2"))
+		assert.Equal(t, http.StatusTooManyRequests, serveForwarded(router, "198.51.100.3"),
+			"rotated X-Forwarded-For must not give client a new bucket")
+	})
+
+	t.Run("Trusted proxy", func(t *testing.T) {
+		router := newRouter(nil)
+		require.NoError(t, router.SetTrustedProxies([]string{"192.0.2.1"}))
+
+		assert.Equal(t, http.StatusOK, serveForwarded(router, "198.51.100.1"))
+		assert.Equal(t, http.StatusOK, serveForwarded(router, "198.51.100.1"))
+		assert.Equal(t, http.StatusOK, serveForwarded(router, "198.51.100.2"),
+			"clients behind trusted proxy must be limited separately")
+		assert.Equal(t, http.StatusTooManyRequests, serveForwarded(router, "198.51.100.1"))
+	})
+}
@@ -0,0 +1,47 @@
+// Package mwrequestid содержит middleware, которое присваивает запросам идентификаторы.
+package mwrequestid
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Header это заголовок запроса и ответа с идентификатором запроса.
+const Header = "X-Request-ID"
+
+// maxLength это максимальная длина идентификатора, переданного клиентом.
+const maxLength = 128
+
+// contextKey это ключ идентификатора запроса в контексте gin.
+const contextKey = "requestID"
+
+// New создает middleware, которое присваивает запросу идентификатор и возвращает его в заголовке ответа.
+// Идентификатор из заголовка запроса используется, если он не слишком длинный.
+func New() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(Header)
+		if id == "" || len(id) > maxLength {
+			id = newID()
+		}
+
+		c.Set(contextKey, id)
+		c.Header(Header, id)
+
+		c.Next()
+	}
+}
+
+// Get возвращает идентификатор запроса. Возвращает пустую строку, если идентификатор не присвоен.
+func Get(c *gin.Context) string {
+	return c.GetString(contextKey)
+}
+
+// newID создает случайный идентификатор запроса.
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,30 @@
+// Package mwtenant содержит middleware, которое определяет библиотеку, с которой работает клиент REST-API.
+package mwtenant
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// Header это заголовок запроса с идентификатором библиотеки.
+const Header = "X-Tenant-ID"
+
+// New создает middleware, которое добавляет в контекст запроса библиотеку клиента.
+// Клиент, привязанный к библиотеке, работает с ней, клиенты с правом выбора библиотеки выбирают ее заголовком X-Tenant-ID,
+// остальные клиенты работают с библиотекой по умолчанию.
+// Должно выполняться после аутентификации клиента.
+func New() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, err := services.ResolveTenant(c.Request.Context(), c.GetHeader(Header))
+		if err != nil {
+			_ = c.Error(err)
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,128 @@
+package mwtenant
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/pkg/tenant"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+func TestNew(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		principal  *principal.Principal
+		header     string
+		wantStatus int
+		wantTenant string
+	}{
+		{
+			name:       "Default tenant",
+			wantStatus: http.StatusOK,
+			wantTenant: tenant.Default,
+		},
+		{
+			name:       "Tenant from header",
+			principal:  &principal.Principal{Subject: "admin", Grants: []string{rbac.TenantsSwitch}},
+			header:     "team-a",
+			wantStatus: http.StatusOK,
+			wantTenant: "team-a",
+		},
+		{
+			name:       "Default tenant from header",
+			header:     tenant.Default,
+			wantStatus: http.StatusOK,
+			wantTenant: tenant.Default,
+		},
+		{
+			name:       "Unbound principal without grant",
+			principal:  &principal.Principal{Subject: "viewer", Grants: []string{"*:read"}},
+			header:     "team-a",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Anonymous client",
+			header:     "team-a",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Tenant from principal",
+			principal:  &principal.Principal{Subject: "importer", Tenant: "team-b"},
+			wantStatus: http.StatusOK,
+			wantTenant: "team-b",
+		},
+		{
+			name:       "Matching header",
+			principal:  &principal.Principal{Subject: "importer", Tenant: "team-b"},
+			header:     "team-b",
+			wantStatus: http.StatusOK,
+			wantTenant: "team-b",
+		},
+		{
+			name:       "Other tenant",
+			principal:  &principal.Principal{Subject: "importer", Tenant: "team-b"},
+			header:     "team-a",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "Invalid tenant",
+			header:     "Team A",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "Invalid tenant from principal",
+			principal:  &principal.Principal{Subject: "importer", Tenant: "Team B"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				if tt.principal != nil {
+					c.Request = c.Request.WithContext(principal.NewContext(c.Request.Context(), *tt.principal))
+				}
+
+				c.Next()
+
+				if len(c.Errors) == 0 {
+					return
+				}
+
+				switch err := c.Errors.Last().Err; {
+				case errors.Is(err, services.ErrTenantForbidden):
+					c.Status(http.StatusForbidden)
+				case errors.Is(err, services.ErrInvalidTenant):
+					c.Status(http.StatusBadRequest)
+				}
+			})
+			router.GET("/", New(), func(c *gin.Context) {
+				id, _ := tenant.FromContext(c.Request.Context())
+				c.String(http.StatusOK, id)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(Header, tt.header)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, tt.wantTenant, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Package mwtracing содержит middleware, которое создает спаны OpenTelemetry для запросов REST-API.
+package mwtracing
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	mwrequestid "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/requestid"
+)
+
+// tracerName это название трассировщика запросов REST-API.
+const tracerName = "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/tracing"
+
+// New создает middleware, которое создает спан для каждого запроса. Спан продолжает трассировку из заголовков
+// W3C Trace Context запроса и добавляется в контекст запроса. Ответы со статусом 5xx отмечаются как ошибки.
+// Должно выполняться первым, чтобы спан охватывал обработку запроса другими middleware.
+func New(tp trace.TracerProvider) gin.HandlerFunc {
+	tracer := tp.Tracer(tracerName)
+	propagator := otel.GetTextMapPropagator()
+
+	return func(c *gin.Context) {
+		ctx := propagator.Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+
+		route := c.FullPath()
+		name := c.Request.Method + " " + route
+		if route == "" {
+			name = c.Request.Method
+		}
+
+		ctx, span := tracer.Start(ctx, name,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(c.Request.Method),
+				semconv.HTTPRoute(route),
+				semconv.URLPath(c.Request.URL.Path),
+				semconv.ClientAddress(c.ClientIP()),
+			),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(
+			semconv.HTTPResponseStatusCode(status),
+			attribute.String("http.request.id", mwrequestid.Get(c)),
+		)
+		for _, err := range c.Errors {
+			span.RecordError(err.Err)
+		}
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
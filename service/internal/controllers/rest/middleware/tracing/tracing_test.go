@@ -0,0 +1,111 @@
+package mwtracing
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNew(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	const (
+		traceID      = "4bf92f3577b34da6a3ce929d0e0e4736"
+		parentSpanID = "00f067aa0ba902b7"
+	)
+
+	tests := []struct {
+		name        string
+		path        string
+		traceparent string
+		wantName    string
+		wantStatus  codes.Code
+		wantParent  bool
+	}{
+		{
+			name:        "Continues incoming trace",
+			path:        "/songs/1",
+			traceparent: "00-" + traceID + "-" + parentSpanID + "-01",
+			wantName:    "GET /songs/:id",
+			wantStatus:  codes.Unset,
+			wantParent:  true,
+		},
+		{
+			name:       "Starts new trace",
+			path:       "/songs/2",
+			wantName:   "GET /songs/:id",
+			wantStatus: codes.Unset,
+		},
+		{
+			name:       "Server error",
+			path:       "/fail",
+			wantName:   "GET /fail",
+			wantStatus: codes.Error,
+		},
+		{
+			name:       "Unmatched route",
+			path:       "/unknown",
+			wantName:   "GET",
+			wantStatus: codes.Unset,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sr := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+
+			var handlerSpan trace.SpanContext
+			router := gin.New()
+			router.Use(New(tp))
+			router.GET("/songs/:id", func(c *gin.Context) {
+				handlerSpan = trace.SpanContextFromContext(c.Request.Context())
+				c.Status(http.StatusOK)
+			})
+			router.GET("/fail", func(c *gin.Context) {
+				_ = c.Error(errors.New("db error"))
+				c.Status(http.StatusInternalServerError)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			spans := sr.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+
+			assert.Equal(t, tt.wantName, span.Name())
+			assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+			assert.Equal(t, tt.wantStatus, span.Status().Code)
+			assert.Contains(t, span.Attributes(), attribute.String("http.request.method", http.MethodGet))
+
+			if tt.wantParent {
+				assert.Equal(t, traceID, span.SpanContext().TraceID().String())
+				assert.Equal(t, parentSpanID, span.Parent().SpanID().String())
+				assert.True(t, span.Parent().IsRemote())
+			} else {
+				assert.False(t, span.Parent().IsValid())
+			}
+
+			if tt.wantName == "GET /songs/:id" {
+				assert.Equal(t, span.SpanContext(), handlerSpan, "span must be added to request context")
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package playlistrest
+
+import "github.com/sedonn/song-library-service/internal/domain/models"
+
+type GetPlaylistRequest models.PlaylistIDAPI
+
+type GetPlaylistResponse models.PlaylistAPI
+
+type SearchPlaylistsRequest struct {
+	PlaylistsFilter
+	Pagination models.Pagination
+}
+
+// PlaylistsFilter это параметры поиска плейлистов.
+type PlaylistsFilter struct {
+	// Name ищет по подстроке названия плейлиста.
+	Name string `form:"name"`
+}
+
+type SearchPlaylistsResponse models.PlaylistsAPI
+
+type GetPlaylistSongsRequest struct {
+	Playlist   GetPlaylistSongsRequestPath
+	Pagination GetPlaylistSongsRequestQuery
+}
+
+type GetPlaylistSongsRequestPath models.PlaylistIDAPI
+
+type GetPlaylistSongsRequestQuery models.Pagination
+
+type GetPlaylistSongsResponse models.PlaylistSongsAPI
+
+type ExportPlaylistRequest struct {
+	Playlist ExportPlaylistRequestPath
+	Format   ExportPlaylistRequestQuery
+}
+
+type ExportPlaylistRequestPath models.PlaylistIDAPI
+
+type ExportPlaylistRequestQuery models.PlaylistFormatAPI
+
+type ImportPlaylistRequest struct {
+	models.PlaylistFormatAPI
+	// Name это название нового плейлиста. По умолчанию используется название из файла.
+	Name string `form:"name" binding:"omitempty,lte=130"`
+}
+
+type ImportPlaylistResponse models.PlaylistImportAPI
+
+type CreatePlaylistRequest models.PlaylistAttributesAPI
+
+type CreatePlaylistResponse models.PlaylistAPI
+
+type ChangePlaylistRequest struct {
+	ChangePlaylistRequestPath
+	ChangePlaylistRequestBody
+}
+
+type ChangePlaylistRequestPath models.PlaylistIDAPI
+
+type ChangePlaylistRequestBody models.PlaylistOptionalAttributesAPI
+
+type ChangePlaylistResponse models.PlaylistAPI
+
+type RemovePlaylistRequest models.PlaylistIDAPI
+
+type RemovePlaylistResponse models.PlaylistIDAPI
+
+type AddPlaylistSongRequest struct {
+	AddPlaylistSongRequestPath
+	AddPlaylistSongRequestBody
+}
+
+type AddPlaylistSongRequestPath models.PlaylistIDAPI
+
+type AddPlaylistSongRequestBody models.PlaylistItemAttributesAPI
+
+type AddPlaylistSongResponse models.PlaylistItemAPI
+
+type MovePlaylistSongRequest struct {
+	MovePlaylistSongRequestPath
+	MovePlaylistSongRequestBody
+}
+
+type MovePlaylistSongRequestPath PlaylistSongRequestPath
+
+type MovePlaylistSongRequestBody models.PlaylistItemPositionAPI
+
+type MovePlaylistSongResponse models.PlaylistItemAPI
+
+type RemovePlaylistSongRequest PlaylistSongRequestPath
+
+type RemovePlaylistSongResponse models.SongIDAPI
+
+// PlaylistSongRequestPath это путь к определенной песне определенного плейлиста.
+type PlaylistSongRequestPath struct {
+	PlaylistID uint64 `uri:"playlist-id" json:"-" binding:"required,number"`
+	SongID     uint64 `uri:"song-id" json:"-" binding:"required,number"`
+}
@@ -0,0 +1,429 @@
+package playlistrest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
+)
+
+// getPlaylistHandler это хендлер, который возвращает определенный плейлист.
+//
+//	@Summary		Получить данные определенного плейлиста.
+//	@Description	Получить название и описание определенного плейлиста.
+//	@Tags			playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			playlist-id	path		GetPlaylistRequest	true	"ID плейлиста"
+//	@Success		200			{object}	GetPlaylistResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/{playlist-id} [get]
+func (e *Endpoints) getPlaylistHandler(ctx *gin.Context) {
+	var req GetPlaylistRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	p, err := e.playlistService.GetPlaylist(ctx, req.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetPlaylistResponse(p))
+}
+
+// searchPlaylistsHandler это хендлер, который выполняет поиск плейлистов по определенным параметрам.
+//
+//	@Summary		Поиск плейлистов.
+//	@Description	Поиск плейлистов по подстроке названия.
+//	@Description	Плейлисты возвращаются без песен и упорядочены по названию.
+//	@Tags			playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			playlist	query		SearchPlaylistsRequest	true	"Настройки поиска."
+//	@Success		200			{object}	SearchPlaylistsResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/ [get]
+func (e *Endpoints) searchPlaylistsHandler(ctx *gin.Context) {
+	var req SearchPlaylistsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	playlists, err := e.playlistService.SearchPlaylists(ctx, models.Playlist{Name: req.Name}, req.Pagination)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, SearchPlaylistsResponse(playlists))
+}
+
+// getPlaylistSongsHandler это хендлер, который возвращает песни определенного плейлиста.
+//
+//	@Summary		Получить песни плейлиста.
+//	@Description	Получить песни определенного плейлиста в порядке их следования с пагинацией.
+//	@Tags			playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			playlist-id	path		GetPlaylistSongsRequestPath		true	"ID плейлиста"
+//	@Param			pagination	query		GetPlaylistSongsRequestQuery	true	"Настройки пагинации"
+//	@Success		200			{object}	GetPlaylistSongsResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/{playlist-id}/songs [get]
+func (e *Endpoints) getPlaylistSongsHandler(ctx *gin.Context) {
+	var req GetPlaylistSongsRequest
+	if err := ctx.ShouldBindUri(&req.Playlist); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindQuery(&req.Pagination); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	songs, err := e.playlistService.GetPlaylistSongs(ctx, req.Playlist.ID, models.Pagination(req.Pagination))
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetPlaylistSongsResponse(songs))
+}
+
+// exportPlaylistHandler это хендлер, который экспортирует определенный плейлист.
+//
+//	@Summary		Экспорт плейлиста.
+//	@Description	Экспорт песен плейлиста в порядке их следования в формате M3U8, XSPF или JSPF.
+//	@Description	Ссылка песни используется как расположение записи плейлиста.
+//	@Tags			playlist
+//	@Accept			json
+//	@Produce		application/vnd.apple.mpegurl,application/xspf+xml,application/json
+//	@Param			playlist-id	path		ExportPlaylistRequestPath	true	"ID плейлиста"
+//	@Param			format		query		ExportPlaylistRequestQuery	true	"Формат плейлиста"
+//	@Success		200			{file}		file
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/{playlist-id}/export [get]
+func (e *Endpoints) exportPlaylistHandler(ctx *gin.Context) {
+	var req ExportPlaylistRequest
+	if err := ctx.ShouldBindUri(&req.Playlist); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindQuery(&req.Format); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	f := playlistfmt.Format(req.Format.Format)
+	data, err := e.playlistService.ExportPlaylist(ctx, req.Playlist.ID, f)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="playlist-%d.%s"`, req.Playlist.ID, f))
+	ctx.Data(http.StatusOK, f.ContentType(), data)
+}
+
+// importPlaylistHandler это хендлер, который создает плейлист из файла плейлиста.
+//
+//	@Summary		Импорт плейлиста.
+//	@Description	Создать плейлист из файла в формате M3U8, XSPF или JSPF, переданного в теле запроса.
+//	@Description	Записи файла сопоставляются с песнями библиотеки по ссылке или по названию песни и исполнителя.
+//	@Description	Несопоставленные записи возвращаются в ответе. Размер файла не должен превышать 1 МБ.
+//	@Tags			playlist
+//	@Accept			plain
+//	@Produce		json
+//	@Param			options		query		ImportPlaylistRequest	true	"Формат и название плейлиста"
+//	@Param			playlist	body		string					true	"Файл плейлиста"
+//	@Success		200			{object}	ImportPlaylistResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		413			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/import [post]
+func (e *Endpoints) importPlaylistHandler(ctx *gin.Context) {
+	var req ImportPlaylistRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxImportSize))
+	if err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	result, err := e.playlistService.ImportPlaylist(ctx, req.Name, playlistfmt.Format(req.Format), data)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ImportPlaylistResponse(result))
+}
+
+// createPlaylistHandler это хендлер, который добавляет новые плейлисты.
+//
+//	@Summary		Добавить новый плейлист.
+//	@Description	Добавить новый пустой плейлист.
+//	@Tags			playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			playlist	body		CreatePlaylistRequest	true	"Данные нового плейлиста"
+//	@Success		200			{object}	CreatePlaylistResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/ [post]
+func (e *Endpoints) createPlaylistHandler(ctx *gin.Context) {
+	var req CreatePlaylistRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	p, err := e.playlistService.CreatePlaylist(ctx, models.Playlist{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, CreatePlaylistResponse(p))
+}
+
+// changePlaylistHandler это хендлер, который обновляет данные плейлистов.
+//
+//	@Summary		Изменить данные плейлиста.
+//	@Description	Изменить название или описание плейлиста.
+//	@Tags			playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			playlist-id	path		ChangePlaylistRequestPath	true	"ID плейлиста"
+//	@Param			playlist	body		ChangePlaylistRequestBody	true	"Новые данные плейлиста"
+//	@Success		200			{object}	ChangePlaylistResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/{playlist-id} [patch]
+func (e *Endpoints) changePlaylistHandler(ctx *gin.Context) {
+	var req ChangePlaylistRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	p, err := e.playlistService.ChangePlaylist(ctx, models.Playlist{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ChangePlaylistResponse(p))
+}
+
+// removePlaylistHandler это хендлер, который удаляет определенный плейлист.
+//
+//	@Summary		Удалить плейлист.
+//	@Description	Удалить плейлист. Песни плейлиста не удаляются.
+//	@Tags			playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			playlist-id	path		RemovePlaylistRequest	true	"ID плейлиста"
+//	@Success		200			{object}	RemovePlaylistResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/{playlist-id} [delete]
+func (e *Endpoints) removePlaylistHandler(ctx *gin.Context) {
+	var req RemovePlaylistRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	id, err := e.playlistService.RemovePlaylist(ctx, req.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, RemovePlaylistResponse(id))
+}
+
+// addPlaylistSongHandler это хендлер, который добавляет песню в плейлист.
+//
+//	@Summary		Добавить песню в плейлист.
+//	@Description	Добавить песню в определенную позицию плейлиста, начиная с 1. Без позиции песня добавляется в конец плейлиста.
+//	@Tags			playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			playlist-id	path		AddPlaylistSongRequestPath	true	"ID плейлиста"
+//	@Param			item		body		AddPlaylistSongRequestBody	true	"Песня и ее позиция"
+//	@Success		200			{object}	AddPlaylistSongResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		409			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/{playlist-id}/songs [post]
+func (e *Endpoints) addPlaylistSongHandler(ctx *gin.Context) {
+	var req AddPlaylistSongRequest
+	if err := ctx.ShouldBindUri(&req.AddPlaylistSongRequestPath); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req.AddPlaylistSongRequestBody); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	item, err := e.playlistService.AddPlaylistSong(ctx, models.PlaylistItem{
+		PlaylistID: req.ID,
+		SongID:     req.Song.ID,
+		Position:   req.Position,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, AddPlaylistSongResponse(item))
+}
+
+// movePlaylistSongHandler это хендлер, который перемещает песню плейлиста.
+//
+//	@Summary		Переместить песню плейлиста.
+//	@Description	Переместить песню в определенную позицию плейлиста, начиная с 1. Позиция больше количества песен перемещает песню в конец плейлиста.
+//	@Tags			playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			playlist-id	path		int							true	"ID плейлиста"
+//	@Param			song-id		path		int							true	"ID песни"
+//	@Param			position	body		MovePlaylistSongRequestBody	true	"Новая позиция песни"
+//	@Success		200			{object}	MovePlaylistSongResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/{playlist-id}/songs/{song-id} [patch]
+func (e *Endpoints) movePlaylistSongHandler(ctx *gin.Context) {
+	var req MovePlaylistSongRequest
+	if err := ctx.ShouldBindUri(&req.MovePlaylistSongRequestPath); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req.MovePlaylistSongRequestBody); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	item, err := e.playlistService.MovePlaylistSong(ctx, models.PlaylistItem{
+		PlaylistID: req.PlaylistID,
+		SongID:     req.SongID,
+		Position:   req.Position,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, MovePlaylistSongResponse(item))
+}
+
+// removePlaylistSongHandler это хендлер, который удаляет песню из плейлиста.
+//
+//	@Summary		Удалить песню из плейлиста.
+//	@Description	Удалить песню из плейлиста. Порядок остальных песен не изменяется.
+//	@Tags			playlist
+//	@Accept			json
+//	@Produce		json
+//	@Param			playlist-id	path		int	true	"ID плейлиста"
+//	@Param			song-id		path		int	true	"ID песни"
+//	@Success		200			{object}	RemovePlaylistSongResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/playlists/{playlist-id}/songs/{song-id} [delete]
+func (e *Endpoints) removePlaylistSongHandler(ctx *gin.Context) {
+	var req RemovePlaylistSongRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	id, err := e.playlistService.RemovePlaylistSong(ctx, req.PlaylistID, req.SongID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, RemovePlaylistSongResponse(id))
+}
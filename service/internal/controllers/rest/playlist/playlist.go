@@ -0,0 +1,71 @@
+package playlistrest
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
+)
+
+// PlaylistService описывает поведение объекта, который обеспечивает бизнес-логику работы с плейлистами.
+type PlaylistService interface {
+	// GetPlaylist возвращает данные определенного плейлиста.
+	GetPlaylist(ctx context.Context, id uint64) (models.PlaylistAPI, error)
+	// SearchPlaylists выполняет поиск плейлистов по подстроке названия. Плейлисты упорядочены по названию.
+	SearchPlaylists(ctx context.Context, attrs models.Playlist, p models.Pagination) (models.PlaylistsAPI, error)
+	// GetPlaylistSongs возвращает песни определенного плейлиста в порядке их следования с пагинацией.
+	GetPlaylistSongs(ctx context.Context, id uint64, p models.Pagination) (models.PlaylistSongsAPI, error)
+	// CreatePlaylist добавляет новый плейлист.
+	CreatePlaylist(ctx context.Context, p models.Playlist) (models.PlaylistAPI, error)
+	// ChangePlaylist обновляет данные определенного плейлиста.
+	ChangePlaylist(ctx context.Context, p models.Playlist) (models.PlaylistAPI, error)
+	// RemovePlaylist удаляет определенный плейлист.
+	RemovePlaylist(ctx context.Context, id uint64) (models.PlaylistIDAPI, error)
+	// ExportPlaylist экспортирует песни определенного плейлиста в порядке их следования в определенном формате.
+	ExportPlaylist(ctx context.Context, id uint64, f playlistfmt.Format) ([]byte, error)
+	// ImportPlaylist создает новый плейлист из файла плейлиста определенного формата.
+	// Возвращает созданный плейлист и записи файла, для которых не найдены песни библиотеки.
+	ImportPlaylist(ctx context.Context, name string, f playlistfmt.Format, data []byte) (models.PlaylistImportAPI, error)
+	// AddPlaylistSong добавляет песню в определенную позицию плейлиста.
+	// Нулевая позиция означает добавление в конец плейлиста.
+	AddPlaylistSong(ctx context.Context, item models.PlaylistItem) (models.PlaylistItemAPI, error)
+	// MovePlaylistSong перемещает песню плейлиста в определенную позицию.
+	MovePlaylistSong(ctx context.Context, item models.PlaylistItem) (models.PlaylistItemAPI, error)
+	// RemovePlaylistSong удаляет песню из определенного плейлиста.
+	RemovePlaylistSong(ctx context.Context, playlistID, songID uint64) (models.SongIDAPI, error)
+}
+
+// maxImportSize это максимальный размер импортируемого файла плейлиста в байтах.
+const maxImportSize = 1 << 20
+
+// Endpoints это конечные точки сервиса плейлистов.
+type Endpoints struct {
+	playlistService PlaylistService
+}
+
+// New создает новый объект конечных точек сервиса плейлистов.
+func New(s PlaylistService) *Endpoints {
+	return &Endpoints{
+		playlistService: s,
+	}
+}
+
+// BindTo привязывает конечные точки к определенной группе маршрутов.
+func (e *Endpoints) BindTo(router *gin.RouterGroup) {
+	playlistRouter := router.Group("/playlists")
+	{
+		playlistRouter.GET("/:playlist-id", e.getPlaylistHandler)
+		playlistRouter.GET("/", e.searchPlaylistsHandler)
+		playlistRouter.POST("/", e.createPlaylistHandler)
+		playlistRouter.PATCH("/:playlist-id", e.changePlaylistHandler)
+		playlistRouter.DELETE("/:playlist-id", e.removePlaylistHandler)
+		playlistRouter.GET("/:playlist-id/songs", e.getPlaylistSongsHandler)
+		playlistRouter.GET("/:playlist-id/export", e.exportPlaylistHandler)
+		playlistRouter.POST("/import", e.importPlaylistHandler)
+		playlistRouter.POST("/:playlist-id/songs", e.addPlaylistSongHandler)
+		playlistRouter.PATCH("/:playlist-id/songs/:song-id", e.movePlaylistSongHandler)
+		playlistRouter.DELETE("/:playlist-id/songs/:song-id", e.removePlaylistSongHandler)
+	}
+}
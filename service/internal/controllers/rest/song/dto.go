@@ -14,17 +14,54 @@ type GetSongRequestQuery models.Pagination
 type GetSongResponse models.SongWithCoupletPaginationAPI
 
 type SearchSongsRequest struct {
+	SongsFilter
+	Pagination models.Pagination
+	// Facets включает в ответ количество найденных песен по каждому жанру и каждой метке.
+	Facets bool `form:"facets"`
+}
+
+// SongsFilter это параметры поиска песен.
+type SongsFilter struct {
 	Name       string `form:"name"`
 	ArtistName string `form:"artistName"`
-	Link       string `form:"link"`
-	Pagination models.Pagination
+	// CreditedArtistName ищет по основному исполнителю или любому участнику создания песни.
+	CreditedArtistName string `form:"creditedArtistName"`
+	Link               string `form:"link"`
+	Language           string `form:"lang" binding:"omitempty,lte=8"`
+	// Genres и Tags ищут песни, у которых есть все указанные жанры и метки.
+	Genres []string `form:"genre" binding:"omitempty,dive,lte=64"`
+	Tags   []string `form:"tag" binding:"omitempty,dive,lte=64"`
+	// LinkStatus ищет песни со ссылками по результату последней проверки доступности ссылки.
+	LinkStatus string `form:"linkStatus" binding:"omitempty,oneof=ok broken unchecked"`
 }
 
 type SearchSongsResponse models.SongsAPI
 
+type ExportSongsRequest struct {
+	SongsFilter
+	models.PlaylistFormatAPI
+}
+
+type GetLinkReportRequest models.Pagination
+
+type GetLinkReportResponse models.LinkReportAPI
+
+type GetSongByISRCRequest struct {
+	ISRC string `uri:"isrc" json:"-" binding:"required,lte=15"`
+}
+
+type GetSongByISRCResponse models.SongAPI
+
+type GetSongsByISWCRequest struct {
+	ISWC string `uri:"iswc" json:"-" binding:"required,lte=15"`
+}
+
+type GetSongsByISWCResponse models.WorkSongsAPI
+
 type CreateSongRequest struct {
 	models.SongAttributesAPI
-	Artist models.ArtistIDAPI `json:"artist"`
+	Artist  models.ArtistIDAPI               `json:"artist"`
+	Credits []models.SongCreditAttributesAPI `json:"credits" binding:"omitempty,dive"`
 }
 
 type CreateSongResponse models.SongAPI
@@ -39,6 +76,8 @@ type ChangeSongRequestPath models.SongIDAPI
 type ChangeSongRequestBody struct {
 	models.SongOptionalAttributesAPI
 	Artist models.ArtistIDAPI `json:"artist" binding:"omitempty"`
+	// Credits полностью заменяет список участников создания песни, если передан.
+	Credits []models.SongCreditAttributesAPI `json:"credits" binding:"omitempty,dive"`
 }
 
 type ChangeSongResponse models.SongAPI
@@ -46,3 +85,60 @@ type ChangeSongResponse models.SongAPI
 type RemoveSongRequest models.SongIDAPI
 
 type RemoveSongResponse models.SongIDAPI
+
+type ChangeSongGenresRequest struct {
+	ChangeSongTagsRequestPath
+	ChangeSongGenresRequestBody
+}
+
+type ChangeSongGenresRequestBody struct {
+	Genres []string `json:"genres" binding:"required,dive,required,lte=64"`
+}
+
+type ChangeSongTagsRequest struct {
+	ChangeSongTagsRequestPath
+	ChangeSongTagsRequestBody
+}
+
+type ChangeSongTagsRequestPath models.SongIDAPI
+
+type ChangeSongTagsRequestBody struct {
+	Tags []string `json:"tags" binding:"required,dive,required,lte=64"`
+}
+
+type ChangeSongTagsResponse models.SongAPI
+
+type GetRelatedSongsRequest models.SongIDAPI
+
+type GetRelatedSongsResponse models.RelatedSongsAPI
+
+type LinkSongsRequest struct {
+	LinkSongsRequestPath
+	LinkSongsRequestBody
+}
+
+type LinkSongsRequestPath models.SongIDAPI
+
+type LinkSongsRequestBody models.SongRelationAttributesAPI
+
+type LinkSongsResponse models.SongRelationAPI
+
+// UnlinkSongsRequest это путь к определенной связи производной песни с оригиналом.
+type UnlinkSongsRequest struct {
+	SongID     uint64 `uri:"song-id" json:"-" binding:"required,number"`
+	Type       string `uri:"relation-type" json:"-" binding:"required,oneof=cover remix live sample"`
+	OriginalID uint64 `uri:"original-id" json:"-" binding:"required,number"`
+}
+
+type UnlinkSongsResponse models.SongRelationAPI
+
+type MergeSongsRequest struct {
+	MergeSongsRequestPath
+	MergeSongsRequestBody
+}
+
+type MergeSongsRequestPath models.SongIDAPI
+
+type MergeSongsRequestBody models.SongMergeAttributesAPI
+
+type MergeSongsResponse models.SongAPI
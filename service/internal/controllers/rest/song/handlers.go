@@ -1,13 +1,13 @@
 package songrest
 
 import (
-	"errors"
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/sedonn/song-library-service/internal/domain/models"
-	"github.com/sedonn/song-library-service/internal/services"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
 )
 
 // getSongCoupletsHandler это хендлер, который возвращает определенную песню с пагинацией по куплетам.
@@ -20,34 +20,28 @@ import (
 //	@Param			song-id		path		GetSongRequestPath	true	"ID песни"
 //	@Param			pagination	query		GetSongRequestQuery	true	"Настройки пагинации. pageSize игнорируется."
 //	@Success		200			{object}	GetSongResponse
-//	@Failure		400			{object}	mwerror.ErrorResponse
-//	@Failure		404			{object}	mwerror.ErrorResponse
-//	@Failure		500			{object}	mwerror.ErrorResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
 //	@Router			/songs/{song-id}/couplets [get]
 func (e *Endpoints) getSongCoupletsHandler(ctx *gin.Context) {
 	var req GetSongRequest
 	if err := ctx.ShouldBindUri(&req.Song); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 	if err := ctx.ShouldBindQuery(&req.Pagination); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
 	s, err := e.songService.GetSongWithCoupletPagination(ctx, req.Song.ID, models.Pagination(req.Pagination))
 	if err != nil {
-		switch {
-		case errors.Is(err, services.ErrSongNotFound):
-			_ = ctx.AbortWithError(http.StatusNotFound, err)
-
-		case errors.Is(err, services.ErrPageNumberOutOfRange):
-			_ = ctx.AbortWithError(http.StatusBadRequest, err)
-
-		default:
-			_ = ctx.AbortWithError(http.StatusInternalServerError, err)
-		}
-
+		_ = ctx.Error(err)
 		return
 	}
 
@@ -58,56 +52,199 @@ func (e *Endpoints) getSongCoupletsHandler(ctx *gin.Context) {
 //
 //	@Summary		Поиск определенной песни.
 //	@Description	Поиск определенной песни по всем атрибутам.
+//	@Description	При facets=true ответ содержит количество найденных песен по каждому жанру и каждой метке.
+//	@Description	Фильтр linkStatus отбирает песни по результату последней проверки ссылки.
 //	@Tags			song
 //	@Accept			json
 //	@Produce		json
 //	@Param			song	query		SearchSongsRequest	true	"Настройки поиска."
 //	@Success		200		{object}	SearchSongsResponse
-//	@Failure		400		{object}	mwerror.ErrorResponse
-//	@Failure		500		{object}	mwerror.ErrorResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
 //	@Router			/songs/ [get]
 func (e *Endpoints) searchSongsHandler(ctx *gin.Context) {
 	var req SearchSongsRequest
 	if err := ctx.ShouldBindQuery(&req); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
-	songs, err := e.songService.SearchSongs(ctx,
-		models.Song{
-			Name: req.Name,
-			Artist: models.Artist{
-				Name: req.ArtistName,
-			},
-			Link: req.Link,
-		},
-		req.Pagination,
-	)
+	songs, err := e.songService.SearchSongs(ctx, req.SongsFilter.attrs(), req.Pagination, req.Facets)
 	if err != nil {
-		_ = ctx.AbortWithError(http.StatusInternalServerError, err)
+		_ = ctx.Error(err)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, SearchSongsResponse(songs))
 }
 
+// exportSongsHandler это хендлер, который экспортирует найденные песни как плейлист.
+//
+//	@Summary		Экспорт найденных песен.
+//	@Description	Экспорт песен, найденных по тем же параметрам, что и в поиске, как плейлиста в формате M3U8, XSPF или JSPF.
+//	@Description	Ссылка песни используется как расположение записи плейлиста. Экспортируются не более 10000 первых песен.
+//	@Tags			song
+//	@Accept			json
+//	@Produce		application/vnd.apple.mpegurl,application/xspf+xml,application/json
+//	@Param			song	query		ExportSongsRequest	true	"Настройки поиска и формат плейлиста."
+//	@Success		200		{file}		file
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/songs/export [get]
+func (e *Endpoints) exportSongsHandler(ctx *gin.Context) {
+	var req ExportSongsRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	f := playlistfmt.Format(req.Format)
+	data, err := e.songService.ExportSongs(ctx, req.SongsFilter.attrs(), f)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="songs.%s"`, f))
+	ctx.Data(http.StatusOK, f.ContentType(), data)
+}
+
+// getSongByISRCHandler это хендлер, который возвращает песню по коду ISRC.
+//
+//	@Summary		Найти песню по ISRC.
+//	@Description	Найти песню по коду записи ISRC. Код может содержать дефисы и быть в любом регистре.
+//	@Tags			song
+//	@Accept			json
+//	@Produce		json
+//	@Param			isrc	path		string	true	"Код ISRC"
+//	@Success		200		{object}	GetSongByISRCResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/songs/by-isrc/{isrc} [get]
+func (e *Endpoints) getSongByISRCHandler(ctx *gin.Context) {
+	var req GetSongByISRCRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	s, err := e.songService.GetSongByISRC(ctx, req.ISRC)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetSongByISRCResponse(s))
+}
+
+// getSongsByISWCHandler это хендлер, который возвращает все записи произведения по коду ISWC.
+//
+//	@Summary		Найти записи произведения по ISWC.
+//	@Description	Найти все песни, которые являются записями произведения с кодом ISWC, упорядоченные по дате выхода.
+//	@Description	Код может содержать разделители, например T-034.524.680-1.
+//	@Tags			song
+//	@Accept			json
+//	@Produce		json
+//	@Param			iswc	path		string	true	"Код ISWC"
+//	@Success		200		{object}	GetSongsByISWCResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/songs/by-iswc/{iswc} [get]
+func (e *Endpoints) getSongsByISWCHandler(ctx *gin.Context) {
+	var req GetSongsByISWCRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	songs, err := e.songService.GetSongsByISWC(ctx, req.ISWC)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetSongsByISWCResponse(songs))
+}
+
+// getLinkReportHandler это хендлер, который возвращает отчет о доступности ссылок песен.
+//
+//	@Summary		Отчет о доступности ссылок песен.
+//	@Description	Количество песен со ссылками по статусу ссылки и песни с недоступными ссылками.
+//	@Description	Ссылки проверяются периодически. Ссылка недоступна, если при последней проверке ответ не получен или имеет код статуса 4xx или 5xx.
+//	@Tags			song
+//	@Accept			json
+//	@Produce		json
+//	@Param			pagination	query		GetLinkReportRequest	true	"Настройки пагинации"
+//	@Success		200			{object}	GetLinkReportResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/songs/link-report [get]
+func (e *Endpoints) getLinkReportHandler(ctx *gin.Context) {
+	var req GetLinkReportRequest
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	r, err := e.songService.GetLinkReport(ctx, models.Pagination(req))
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetLinkReportResponse(r))
+}
+
 // createSongHandler это хендлер, который добавляет новые песни.
 //
 //	@Summary		Добавить новую песню.
 //	@Description	Добавление новой песни. Для разделения куплетов необходимо использовать '\n\n'.
+//	@Description	Поле artist задает основного исполнителя, credits - остальных участников создания песни.
+//	@Description	Коды ISRC и ISWC проверяются по формату и контрольной сумме, ISRC должен быть уникальным.
+//	@Description	Если дата выхода, текст или ссылка не указаны, то они заполняются из внешнего сервиса информации о песнях в фоне.
+//	@Description	Ссылка проверяется на доступность в фоне. Статусы фоновых задач песни доступны в /jobs/?songId={id}.
+//	@Description	Ссылка приводится к каноническому виду без параметров отслеживания, поддерживаются схемы http и https.
+//	@Description	Ссылка на запись известного музыкального сервиса (YouTube, Spotify, Apple Music, Deezer, SoundCloud,
+//	@Description	Яндекс Музыка) не может быть у нескольких песен.
 //	@Tags			song
 //	@Accept			json
 //	@Produce		json
 //	@Param			song	body		CreateSongRequest	true	"Данные новой песни"
 //	@Success		200		{object}	CreateSongResponse
-//	@Failure		400		{object}	mwerror.ErrorResponse
-//	@Failure		404		{object}	mwerror.ErrorResponse
-//	@Failure		500		{object}	mwerror.ErrorResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		409		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
 //	@Router			/songs/ [post]
 func (e *Endpoints) createSongHandler(ctx *gin.Context) {
 	var req CreateSongRequest
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
@@ -117,14 +254,12 @@ func (e *Endpoints) createSongHandler(ctx *gin.Context) {
 		ReleaseDate: req.ReleaseDate,
 		Text:        req.Text,
 		Link:        req.Link,
+		ISRC:        req.ISRC,
+		ISWC:        req.ISWC,
+		Credits:     models.SongCreditsFromAPI(req.Credits),
 	})
 	if err != nil {
-		if errors.Is(err, services.ErrArtistNotFound) {
-			_ = ctx.AbortWithError(http.StatusNotFound, err)
-			return
-		}
-
-		_ = ctx.AbortWithError(http.StatusInternalServerError, err)
+		_ = ctx.Error(err)
 		return
 	}
 	ctx.JSON(http.StatusOK, CreateSongResponse(s))
@@ -134,24 +269,31 @@ func (e *Endpoints) createSongHandler(ctx *gin.Context) {
 //
 //	@Summary		Изменить данные песни.
 //	@Description	Изменить данные песни. Для разделения куплетов необходимо использовать '\n\n'.
+//	@Description	Переданный список credits полностью заменяет текущий список участников.
+//	@Description	Новая ссылка приводится к каноническому виду так же, как при добавлении песни.
 //	@Tags			song
 //	@Accept			json
 //	@Produce		json
-//	@Param			song-id	path		ChangeSongRequestPath true	"ID песни"
+//	@Param			song-id	path		ChangeSongRequestPath	true	"ID песни"
 //	@Param			song	body		ChangeSongRequestBody	true	"Новые данные песни"
 //	@Success		200		{object}	ChangeSongResponse
-//	@Failure		400		{object}	mwerror.ErrorResponse
-//	@Failure		404		{object}	mwerror.ErrorResponse
-//	@Failure		500		{object}	mwerror.ErrorResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		409		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
 //	@Router			/songs/{song-id} [patch]
 func (e *Endpoints) changeSongHandler(ctx *gin.Context) {
 	var req ChangeSongRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
@@ -162,18 +304,12 @@ func (e *Endpoints) changeSongHandler(ctx *gin.Context) {
 		ReleaseDate: req.ReleaseDate,
 		Text:        req.Text,
 		Link:        req.Link,
+		ISRC:        req.ISRC,
+		ISWC:        req.ISWC,
+		Credits:     models.SongCreditsFromAPI(req.Credits),
 	})
 	if err != nil {
-		switch {
-		case errors.Is(err, services.ErrArtistNotFound):
-			_ = ctx.AbortWithError(http.StatusNotFound, err)
-
-		case errors.Is(err, services.ErrSongNotFound):
-			_ = ctx.AbortWithError(http.StatusBadRequest, err)
-
-		default:
-			_ = ctx.AbortWithError(http.StatusInternalServerError, err)
-		}
+		_ = ctx.Error(err)
 		return
 	}
 
@@ -189,27 +325,273 @@ func (e *Endpoints) changeSongHandler(ctx *gin.Context) {
 //	@Produce		json
 //	@Param			song-id	path		RemoveSongRequest	true	"ID песни"
 //	@Success		200		{object}	RemoveSongResponse
-//	@Failure		400		{object}	mwerror.ErrorResponse
-//	@Failure		404		{object}	mwerror.ErrorResponse
-//	@Failure		500		{object}	mwerror.ErrorResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
 //	@Router			/songs/{song-id} [delete]
 func (e *Endpoints) removeSongHandler(ctx *gin.Context) {
 	var req RemoveSongRequest
 	if err := ctx.ShouldBindUri(&req); err != nil {
-		_ = ctx.AbortWithError(http.StatusBadRequest, err)
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
 		return
 	}
 
 	s, err := e.songService.RemoveSong(ctx, req.ID)
 	if err != nil {
-		if errors.Is(err, services.ErrSongNotFound) {
-			_ = ctx.AbortWithError(http.StatusNotFound, err)
-			return
-		}
-
-		_ = ctx.AbortWithError(http.StatusInternalServerError, err)
+		_ = ctx.Error(err)
 		return
 	}
 
 	ctx.JSON(http.StatusOK, RemoveSongResponse(s))
 }
+
+// changeSongGenresHandler это хендлер, который заменяет жанры определенной песни.
+//
+//	@Summary		Изменить жанры песни.
+//	@Description	Полностью заменить жанры песни. Жанры должны существовать в словаре жанров. Пустой список отвязывает все жанры.
+//	@Tags			song
+//	@Accept			json
+//	@Produce		json
+//	@Param			song-id	path		ChangeSongTagsRequestPath	true	"ID песни"
+//	@Param			genres	body		ChangeSongGenresRequestBody	true	"Новые жанры песни"
+//	@Success		200		{object}	ChangeSongTagsResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/songs/{song-id}/genres [put]
+func (e *Endpoints) changeSongGenresHandler(ctx *gin.Context) {
+	var req ChangeSongGenresRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	e.changeSongTags(ctx, req.ID, models.TagKindGenre, req.Genres)
+}
+
+// changeSongTagsHandler это хендлер, который заменяет свободные метки определенной песни.
+//
+//	@Summary		Изменить метки песни.
+//	@Description	Полностью заменить свободные метки песни. Отсутствующие метки создаются автоматически. Пустой список отвязывает все метки.
+//	@Tags			song
+//	@Accept			json
+//	@Produce		json
+//	@Param			song-id	path		ChangeSongTagsRequestPath	true	"ID песни"
+//	@Param			tags	body		ChangeSongTagsRequestBody	true	"Новые метки песни"
+//	@Success		200		{object}	ChangeSongTagsResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/songs/{song-id}/tags [put]
+func (e *Endpoints) changeSongTagsHandler(ctx *gin.Context) {
+	var req ChangeSongTagsRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	e.changeSongTags(ctx, req.ID, models.TagKindTag, req.Tags)
+}
+
+// getRelatedSongsHandler это хендлер, который возвращает песни, связанные с определенной песней.
+//
+//	@Summary		Получить связанные песни.
+//	@Description	Получить оригиналы песни и производные от нее песни: каверы, ремиксы, концертные версии и семплы. Песни сгруппированы по типу связи.
+//	@Tags			song
+//	@Accept			json
+//	@Produce		json
+//	@Param			song-id	path		GetRelatedSongsRequest	true	"ID песни"
+//	@Success		200		{object}	GetRelatedSongsResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/songs/{song-id}/related [get]
+func (e *Endpoints) getRelatedSongsHandler(ctx *gin.Context) {
+	var req GetRelatedSongsRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	related, err := e.songService.GetRelatedSongs(ctx, req.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetRelatedSongsResponse(related))
+}
+
+// linkSongsHandler это хендлер, который связывает производную песню с оригиналом.
+//
+//	@Summary		Связать песню с оригиналом.
+//	@Description	Отметить песню как кавер, ремикс, концертную версию или семпл другой песни. Песня не может прямо или через другие песни оказаться оригиналом самой себя.
+//	@Tags			song
+//	@Accept			json
+//	@Produce		json
+//	@Param			song-id		path		LinkSongsRequestPath	true	"ID производной песни"
+//	@Param			relation	body		LinkSongsRequestBody	true	"Тип связи и оригинал"
+//	@Success		200			{object}	LinkSongsResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		409			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/songs/{song-id}/relations [post]
+func (e *Endpoints) linkSongsHandler(ctx *gin.Context) {
+	var req LinkSongsRequest
+	if err := ctx.ShouldBindUri(&req.LinkSongsRequestPath); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req.LinkSongsRequestBody); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	rel, err := e.songService.LinkSongs(ctx, models.SongRelation{
+		SongID:     req.LinkSongsRequestPath.ID,
+		OriginalID: req.Original.ID,
+		Type:       req.Type,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, LinkSongsResponse(rel))
+}
+
+// unlinkSongsHandler это хендлер, который удаляет связь производной песни с оригиналом.
+//
+//	@Summary		Удалить связь песни с оригиналом.
+//	@Description	Удалить связь определенного типа между производной песней и оригиналом. Сами песни не удаляются.
+//	@Tags			song
+//	@Accept			json
+//	@Produce		json
+//	@Param			song-id			path		int		true	"ID производной песни"
+//	@Param			relation-type	path		string	true	"Тип связи"	Enums(cover, remix, live, sample)
+//	@Param			original-id		path		int		true	"ID оригинала"
+//	@Success		200				{object}	UnlinkSongsResponse
+//	@Failure		400				{object}	mwerror.Problem
+//	@Failure		401				{object}	mwerror.Problem
+//	@Failure		403				{object}	mwerror.Problem
+//	@Failure		404				{object}	mwerror.Problem
+//	@Failure		500				{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/songs/{song-id}/relations/{relation-type}/{original-id} [delete]
+func (e *Endpoints) unlinkSongsHandler(ctx *gin.Context) {
+	var req UnlinkSongsRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	rel, err := e.songService.UnlinkSongs(ctx, models.SongRelation{
+		SongID:     req.SongID,
+		OriginalID: req.OriginalID,
+		Type:       req.Type,
+	})
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, UnlinkSongsResponse(rel))
+}
+
+// mergeSongsHandler это хендлер, который сливает песню-дубликат с определенной песней.
+//
+//	@Summary		Слить песню-дубликат с песней.
+//	@Description	Перенести участников, метки, места в альбомах и плейлистах и связи песни-дубликата на песню и удалить дубликат. Данные самой песни не изменяются. Если песня уже есть в альбоме или плейлисте, место дубликата удаляется.
+//	@Tags			song
+//	@Accept			json
+//	@Produce		json
+//	@Param			song-id		path		MergeSongsRequestPath	true	"ID песни, которая остается"
+//	@Param			duplicate	body		MergeSongsRequestBody	true	"Песня-дубликат"
+//	@Success		200			{object}	MergeSongsResponse
+//	@Failure		400			{object}	mwerror.Problem
+//	@Failure		401			{object}	mwerror.Problem
+//	@Failure		403			{object}	mwerror.Problem
+//	@Failure		404			{object}	mwerror.Problem
+//	@Failure		409			{object}	mwerror.Problem
+//	@Failure		500			{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/songs/{song-id}/merge [post]
+func (e *Endpoints) mergeSongsHandler(ctx *gin.Context) {
+	var req MergeSongsRequest
+	if err := ctx.ShouldBindUri(&req.MergeSongsRequestPath); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req.MergeSongsRequestBody); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	s, err := e.songService.MergeSongs(ctx, req.MergeSongsRequestPath.ID, req.Duplicate.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, MergeSongsResponse(s))
+}
+
+// changeSongTags заменяет метки определенного вида у определенной песни и отправляет ответ.
+func (e *Endpoints) changeSongTags(ctx *gin.Context, id uint64, kind string, names []string) {
+	s, err := e.songService.ChangeSongTags(ctx, id, kind, names)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ChangeSongTagsResponse(s))
+}
+
+// attrs создает параметры поиска песен.
+func (f SongsFilter) attrs() models.Song {
+	return models.Song{
+		Name: f.Name,
+		Artist: models.Artist{
+			Name: f.ArtistName,
+		},
+		Link:           f.Link,
+		LinkHealth:     models.LinkHealth{Status: f.LinkStatus},
+		Language:       f.Language,
+		CreditedArtist: f.CreditedArtistName,
+		Tags: append(
+			models.TagsFromNames(models.TagKindGenre, f.Genres),
+			models.TagsFromNames(models.TagKindTag, f.Tags)...,
+		),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
 )
 
 // SongService описывает поведение объекта, который обеспечивает бизнес-логику работы с песнями.
@@ -14,14 +15,37 @@ type SongService interface {
 	// Текст разбивается на куплеты по \n\n символам.
 	GetSongWithCoupletPagination(ctx context.Context, id uint64, p models.Pagination) (models.SongWithCoupletPaginationAPI, error)
 	// SearchSongs выполняет поиск песен по определенным параметрам.
-	// Поиск выполняется по подстроке каждого указанного поля.
-	SearchSongs(ctx context.Context, attrs models.Song, p models.Pagination) (models.SongsAPI, error)
-	// CreateSong добавляют новую песню.
+	// Поиск выполняется по подстроке каждого указанного поля, язык текста сравнивается точно.
+	// Результат содержит количество найденных песен по каждому жанру и каждой метке.
+	SearchSongs(ctx context.Context, attrs models.Song, p models.Pagination, withFacets bool) (models.SongsAPI, error)
+	// ExportSongs экспортирует найденные по определенным параметрам песни как плейлист определенного формата.
+	ExportSongs(ctx context.Context, attrs models.Song, f playlistfmt.Format) ([]byte, error)
+	// CreateSong добавляют новую песню. Язык и статистика текста вычисляются автоматически.
+	// Незаполненные дата выхода, текст и ссылка заполняются из внешнего сервиса информации о песнях,
+	// а ссылка проверяется на доступность фоновыми задачами.
 	CreateSong(ctx context.Context, s models.Song) (models.SongAPI, error)
-	// ChangeSong обновляет данные определенной песни.
+	// GetSongByISRC возвращает песню с определенным кодом ISRC. Код может содержать разделители.
+	GetSongByISRC(ctx context.Context, isrc string) (models.SongAPI, error)
+	// GetSongsByISWC возвращает все записи произведения с определенным кодом ISWC. Код может содержать разделители.
+	GetSongsByISWC(ctx context.Context, iswc string) (models.WorkSongsAPI, error)
+	// GetLinkReport возвращает количество песен со ссылками по статусу ссылки и песни с недоступными ссылками.
+	GetLinkReport(ctx context.Context, p models.Pagination) (models.LinkReportAPI, error)
+	// ChangeSong обновляет данные определенной песни. Новая ссылка проверяется на доступность фоновой задачей.
 	ChangeSong(ctx context.Context, s models.Song) (models.SongAPI, error)
-	// RemoveSong удаляет определенную песню.
+	// RemoveSong удаляет определенную песню. Песня также удаляется из всех плейлистов и альбомов.
 	RemoveSong(ctx context.Context, id uint64) (models.SongIDAPI, error)
+	// ChangeSongTags полностью заменяет жанры или свободные метки определенной песни.
+	ChangeSongTags(ctx context.Context, id uint64, kind string, names []string) (models.SongAPI, error)
+	// GetRelatedSongs возвращает песни, связанные с определенной песней, сгруппированные по типу и направлению связи.
+	GetRelatedSongs(ctx context.Context, id uint64) (models.RelatedSongsAPI, error)
+	// LinkSongs связывает производную песню с оригиналом.
+	// Песня не может прямо или через другие песни оказаться оригиналом самой себя.
+	LinkSongs(ctx context.Context, rel models.SongRelation) (models.SongRelationAPI, error)
+	// UnlinkSongs удаляет связь производной песни с оригиналом.
+	UnlinkSongs(ctx context.Context, rel models.SongRelation) (models.SongRelationAPI, error)
+	// MergeSongs сливает песню-дубликат с определенной песней. Участники, метки, места в альбомах и плейлистах
+	// и связи дубликата переносятся на песню, после чего дубликат удаляется.
+	MergeSongs(ctx context.Context, id, duplicateID uint64) (models.SongAPI, error)
 }
 
 // Endpoints это конечные точки сервиса песен.
@@ -42,8 +66,18 @@ func (e *Endpoints) BindTo(router *gin.RouterGroup) {
 	{
 		songRouter.GET("/:song-id/couplets", e.getSongCoupletsHandler)
 		songRouter.GET("/", e.searchSongsHandler)
+		songRouter.GET("/export", e.exportSongsHandler)
+		songRouter.GET("/by-isrc/:isrc", e.getSongByISRCHandler)
+		songRouter.GET("/by-iswc/:iswc", e.getSongsByISWCHandler)
+		songRouter.GET("/link-report", e.getLinkReportHandler)
 		songRouter.POST("/", e.createSongHandler)
 		songRouter.PATCH("/:song-id", e.changeSongHandler)
 		songRouter.DELETE("/:song-id", e.removeSongHandler)
+		songRouter.PUT("/:song-id/genres", e.changeSongGenresHandler)
+		songRouter.PUT("/:song-id/tags", e.changeSongTagsHandler)
+		songRouter.GET("/:song-id/related", e.getRelatedSongsHandler)
+		songRouter.POST("/:song-id/relations", e.linkSongsHandler)
+		songRouter.DELETE("/:song-id/relations/:relation-type/:original-id", e.unlinkSongsHandler)
+		songRouter.POST("/:song-id/merge", e.mergeSongsHandler)
 	}
 }
@@ -0,0 +1,24 @@
+package tagrest
+
+import "github.com/sedonn/song-library-service/internal/domain/models"
+
+type GetTagsResponse models.TagsAPI
+
+type CreateTagRequest models.TagAttributesAPI
+
+type CreateTagResponse models.TagAPI
+
+type ChangeTagRequest struct {
+	ChangeTagRequestPath
+	ChangeTagRequestBody
+}
+
+type ChangeTagRequestPath models.TagIDAPI
+
+type ChangeTagRequestBody models.TagAttributesAPI
+
+type ChangeTagResponse models.TagAPI
+
+type RemoveTagRequest models.TagIDAPI
+
+type RemoveTagResponse models.TagIDAPI
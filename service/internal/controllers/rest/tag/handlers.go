@@ -0,0 +1,156 @@
+package tagrest
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getTagsHandler это хендлер, который возвращает все метки словаря.
+//
+//	@Summary		Получить словарь жанров или меток.
+//	@Description	Получить все жанры или все свободные метки, упорядоченные по названию.
+//	@Tags			tag
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	GetTagsResponse
+//	@Failure		401	{object}	mwerror.Problem
+//	@Failure		403	{object}	mwerror.Problem
+//	@Failure		401	{object}	mwerror.Problem
+//	@Failure		403	{object}	mwerror.Problem
+//	@Failure		500	{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/genres/ [get]
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/tags/ [get]
+func (e *Endpoints) getTagsHandler(ctx *gin.Context) {
+	tags, err := e.tagService.GetTags(ctx)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, GetTagsResponse(tags))
+}
+
+// createTagHandler это хендлер, который добавляет новые метки.
+//
+//	@Summary		Добавить новый жанр или метку.
+//	@Description	Добавить новый жанр или метку. Название должно быть уникальным в пределах словаря.
+//	@Tags			tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			tag	body		CreateTagRequest	true	"Данные новой метки"
+//	@Success		200	{object}	CreateTagResponse
+//	@Failure		400	{object}	mwerror.Problem
+//	@Failure		401	{object}	mwerror.Problem
+//	@Failure		403	{object}	mwerror.Problem
+//	@Failure		401	{object}	mwerror.Problem
+//	@Failure		403	{object}	mwerror.Problem
+//	@Failure		409	{object}	mwerror.Problem
+//	@Failure		500	{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/genres/ [post]
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/tags/ [post]
+func (e *Endpoints) createTagHandler(ctx *gin.Context) {
+	var req CreateTagRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	t, err := e.tagService.CreateTag(ctx, req.Name)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, CreateTagResponse(t))
+}
+
+// changeTagHandler это хендлер, который переименовывает метки.
+//
+//	@Summary		Переименовать жанр или метку.
+//	@Description	Переименовать жанр или метку. Привязки к песням сохраняются.
+//	@Tags			tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			tag-id	path		ChangeTagRequestPath	true	"ID метки"
+//	@Param			tag		body		ChangeTagRequestBody	true	"Новые данные метки"
+//	@Success		200		{object}	ChangeTagResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		409		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/genres/{tag-id} [patch]
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/tags/{tag-id} [patch]
+func (e *Endpoints) changeTagHandler(ctx *gin.Context) {
+	var req ChangeTagRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	t, err := e.tagService.ChangeTag(ctx, req.ID, req.Name)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ChangeTagResponse(t))
+}
+
+// removeTagHandler это хендлер, который удаляет определенную метку.
+//
+//	@Summary		Удалить жанр или метку.
+//	@Description	Удалить жанр или метку. Метка также отвязывается от всех песен.
+//	@Tags			tag
+//	@Accept			json
+//	@Produce		json
+//	@Param			tag-id	path		RemoveTagRequest	true	"ID метки"
+//	@Success		200		{object}	RemoveTagResponse
+//	@Failure		400		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		401		{object}	mwerror.Problem
+//	@Failure		403		{object}	mwerror.Problem
+//	@Failure		404		{object}	mwerror.Problem
+//	@Failure		500		{object}	mwerror.Problem
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/genres/{tag-id} [delete]
+//	@Security		ApiKeyAuth
+//	@Security		BearerAuth
+//	@Router			/tags/{tag-id} [delete]
+func (e *Endpoints) removeTagHandler(ctx *gin.Context) {
+	var req RemoveTagRequest
+	if err := ctx.ShouldBindUri(&req); err != nil {
+		_ = ctx.Error(err).SetType(gin.ErrorTypeBind)
+		return
+	}
+
+	id, err := e.tagService.RemoveTag(ctx, req.ID)
+	if err != nil {
+		_ = ctx.Error(err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, RemoveTagResponse(id))
+}
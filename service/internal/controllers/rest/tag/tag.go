@@ -0,0 +1,46 @@
+package tagrest
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// TagService описывает поведение объекта, который обеспечивает бизнес-логику работы со словарем меток.
+type TagService interface {
+	// GetTags возвращает все метки, упорядоченные по названию.
+	GetTags(ctx context.Context) (models.TagsAPI, error)
+	// CreateTag добавляет новую метку.
+	CreateTag(ctx context.Context, name string) (models.TagAPI, error)
+	// ChangeTag переименовывает определенную метку.
+	ChangeTag(ctx context.Context, id uint64, name string) (models.TagAPI, error)
+	// RemoveTag удаляет определенную метку вместе с ее привязками к песням.
+	RemoveTag(ctx context.Context, id uint64) (models.TagIDAPI, error)
+}
+
+// Endpoints это конечные точки сервиса меток.
+type Endpoints struct {
+	tagService TagService
+	path       string
+}
+
+// New создает новый объект конечных точек сервиса меток, доступных по определенному пути.
+func New(s TagService, path string) *Endpoints {
+	return &Endpoints{
+		tagService: s,
+		path:       path,
+	}
+}
+
+// BindTo привязывает конечные точки к определенной группе маршрутов.
+func (e *Endpoints) BindTo(router *gin.RouterGroup) {
+	tagRouter := router.Group(e.path)
+	{
+		tagRouter.GET("/", e.getTagsHandler)
+		tagRouter.POST("/", e.createTagHandler)
+		tagRouter.PATCH("/:tag-id", e.changeTagHandler)
+		tagRouter.DELETE("/:tag-id", e.removeTagHandler)
+	}
+}
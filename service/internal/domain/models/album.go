@@ -0,0 +1,149 @@
+package models
+
+import "time"
+
+// Все поддерживаемые типы альбомов.
+const (
+	AlbumTypeAlbum       = "album"
+	AlbumTypeSingle      = "single"
+	AlbumTypeEP          = "ep"
+	AlbumTypeCompilation = "compilation"
+)
+
+type Album struct {
+	ID          uint64      `gorm:"column:id;primaryKey;uniqueIndex:idx_albums_tenant,priority:2"`
+	TenantID    string      `gorm:"column:tenant_id;not null;default:default;size:64;uniqueIndex:idx_albums_tenant,priority:1"`
+	Title       string      `gorm:"column:title;index;size:130"`
+	ArtistID    uint64      `gorm:"column:artist_id"`
+	Artist      Artist      `gorm:"foreignKey:TenantID,ArtistID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
+	ReleaseDate time.Time   `gorm:"column:release_date"`
+	Type        string      `gorm:"column:type;size:16"`
+	Tracks      AlbumTracks `gorm:"foreignKey:TenantID,AlbumID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
+}
+
+// API трансформирует модель БД в модель API.
+func (a Album) API() AlbumAPI {
+	return AlbumAPI{
+		AlbumIDAPI: AlbumIDAPI{ID: a.ID},
+		AlbumAttributesAPI: AlbumAttributesAPI{
+			Title:       a.Title,
+			ReleaseDate: a.ReleaseDate,
+			Type:        a.Type,
+		},
+		Artist: a.Artist.API(),
+	}
+}
+
+// WithTracksAPI трансформирует модель БД в модель API вместе со списком композиций.
+func (a Album) WithTracksAPI() AlbumWithTracksAPI {
+	return AlbumWithTracksAPI{
+		AlbumAPI: a.API(),
+		Tracks:   a.Tracks.API(),
+	}
+}
+
+type Albums []Album
+
+// API трансформирует слайс моделей БД в слайс моделей API.
+func (a Albums) API() []AlbumAPI {
+	albumsAPI := make([]AlbumAPI, len(a))
+	for i, v := range a {
+		albumsAPI[i] = v.API()
+	}
+
+	return albumsAPI
+}
+
+// AlbumTrack это композиция альбома.
+type AlbumTrack struct {
+	TenantID    string `gorm:"column:tenant_id;not null;default:default;size:64"`
+	AlbumID     uint64 `gorm:"column:album_id;primaryKey;uniqueIndex:idx_album_tracks_position,priority:1"`
+	SongID      uint64 `gorm:"column:song_id;primaryKey"`
+	Song        Song   `gorm:"foreignKey:TenantID,SongID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
+	DiscNumber  uint32 `gorm:"column:disc_number;uniqueIndex:idx_album_tracks_position,priority:2"`
+	TrackNumber uint32 `gorm:"column:track_number;uniqueIndex:idx_album_tracks_position,priority:3"`
+}
+
+// API трансформирует модель БД в модель API.
+func (t AlbumTrack) API() AlbumTrackAPI {
+	return AlbumTrackAPI{
+		DiscNumber:  t.DiscNumber,
+		TrackNumber: t.TrackNumber,
+		Song:        t.Song.API(),
+	}
+}
+
+type AlbumTracks []AlbumTrack
+
+// API трансформирует слайс моделей БД в слайс моделей API.
+func (t AlbumTracks) API() []AlbumTrackAPI {
+	tracksAPI := make([]AlbumTrackAPI, len(t))
+	for i, v := range t {
+		tracksAPI[i] = v.API()
+	}
+
+	return tracksAPI
+}
+
+type AlbumAPI struct {
+	AlbumIDAPI
+	AlbumAttributesAPI
+	Artist ArtistAPI `json:"artist"`
+}
+
+type AlbumsAPI struct {
+	Albums     []AlbumAPI            `json:"albums"`
+	Pagination PaginationMetadataAPI `json:"pagination"`
+}
+
+type AlbumWithTracksAPI struct {
+	AlbumAPI
+	Tracks []AlbumTrackAPI `json:"tracks"`
+}
+
+type AlbumTrackAPI struct {
+	DiscNumber  uint32  `json:"discNumber"`
+	TrackNumber uint32  `json:"trackNumber"`
+	Song        SongAPI `json:"song"`
+}
+
+type AlbumIDAPI struct {
+	ID uint64 `uri:"album-id" json:"id" binding:"required,number"`
+}
+
+type AlbumAttributesAPI struct {
+	Title       string    `json:"title" binding:"required,lte=130"`
+	ReleaseDate time.Time `json:"releaseDate" binding:"required"`
+	Type        string    `json:"type" binding:"required,oneof=album single ep compilation"`
+}
+
+type AlbumOptionalAttributesAPI struct {
+	Title       string    `json:"title" binding:"omitempty,lte=130"`
+	ReleaseDate time.Time `json:"releaseDate" binding:"omitempty"`
+	Type        string    `json:"type" binding:"omitempty,oneof=album single ep compilation"`
+}
+
+type AlbumTrackAttributesAPI struct {
+	Song        SongIDAPI `json:"song"`
+	DiscNumber  uint32    `json:"discNumber" binding:"required,gte=1"`
+	TrackNumber uint32    `json:"trackNumber" binding:"required,gte=1"`
+}
+
+// AlbumTracksFromAPI трансформирует слайс моделей API в слайс моделей БД.
+// Сохраняет nil, чтобы отличать отсутствие списка композиций от пустого списка.
+func AlbumTracksFromAPI(tracks []AlbumTrackAttributesAPI) AlbumTracks {
+	if tracks == nil {
+		return nil
+	}
+
+	albumTracks := make(AlbumTracks, len(tracks))
+	for i, t := range tracks {
+		albumTracks[i] = AlbumTrack{
+			SongID:      t.Song.ID,
+			DiscNumber:  t.DiscNumber,
+			TrackNumber: t.TrackNumber,
+		}
+	}
+
+	return albumTracks
+}
@@ -0,0 +1,52 @@
+package models
+
+// ArtistAlias это альтернативное название исполнителя, которое при поиске разрешается в самого исполнителя.
+type ArtistAlias struct {
+	ID       uint64 `gorm:"column:id;primaryKey"`
+	TenantID string `gorm:"column:tenant_id;not null;default:default;size:64;uniqueIndex:idx_artist_aliases_normalized_name,priority:1"`
+	ArtistID uint64 `gorm:"column:artist_id;index"`
+	Name     string `gorm:"column:name;size:130"`
+	// NormalizedName уникально среди названий всех исполнителей и всех псевдонимов библиотеки.
+	NormalizedName string `gorm:"column:normalized_name;uniqueIndex:idx_artist_aliases_normalized_name,priority:2;size:130"`
+}
+
+// API трансформирует модель БД в модель API.
+func (a ArtistAlias) API() ArtistAliasAPI {
+	return ArtistAliasAPI{
+		ArtistAliasIDAPI:         ArtistAliasIDAPI{ID: a.ID},
+		ArtistAliasAttributesAPI: ArtistAliasAttributesAPI{Name: a.Name},
+	}
+}
+
+type ArtistAliases []ArtistAlias
+
+// API трансформирует слайс моделей БД в модель API.
+func (a ArtistAliases) API(artistID uint64) ArtistAliasesAPI {
+	aliasesAPI := make([]ArtistAliasAPI, len(a))
+	for i, v := range a {
+		aliasesAPI[i] = v.API()
+	}
+
+	return ArtistAliasesAPI{
+		Artist:  ArtistIDAPI{ID: artistID},
+		Aliases: aliasesAPI,
+	}
+}
+
+type ArtistAliasAPI struct {
+	ArtistAliasIDAPI
+	ArtistAliasAttributesAPI
+}
+
+type ArtistAliasIDAPI struct {
+	ID uint64 `json:"id"`
+}
+
+type ArtistAliasAttributesAPI struct {
+	Name string `json:"name" binding:"required,lte=130"`
+}
+
+type ArtistAliasesAPI struct {
+	Artist  ArtistIDAPI      `json:"artist"`
+	Aliases []ArtistAliasAPI `json:"aliases"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// APIKey это API-ключ клиента REST-API. Хранится только SHA-256 от ключа.
+// Отозванный ключ не принимается. Ключ без ролей проходит аутентификацию, но не имеет прав доступа.
+type APIKey struct {
+	ID    uint64   `gorm:"column:id;primaryKey"`
+	Name  string   `gorm:"column:name;unique;size:64"`
+	Hash  string   `gorm:"column:hash;unique;size:64"`
+	Roles []string `gorm:"column:roles;type:jsonb;serializer:json"`
+	// BoundTenant это библиотека, к которой привязан ключ. Сами ключи не принадлежат библиотекам
+	// и ищутся до того, как определена библиотека запроса, поэтому поле не называется TenantID
+	// и запросы к ключам не ограничиваются библиотекой.
+	BoundTenant string     `gorm:"column:tenant_id;size:64"`
+	CreatedAt   time.Time  `gorm:"column:created_at"`
+	RevokedAt   *time.Time `gorm:"column:revoked_at"`
+}
@@ -1,15 +1,63 @@
 package models
 
+// Все поддерживаемые типы исполнителей.
+const (
+	ArtistTypePerson = "person"
+	ArtistTypeGroup  = "group"
+)
+
 type Artist struct {
-	ID   uint64 `gorm:"column:id;primaryKey"`
-	Name string `gorm:"column:name;uniqueIndex;size:130"`
+	ID uint64 `gorm:"column:id;primaryKey;uniqueIndex:idx_artists_tenant,priority:2"`
+	// TenantID это библиотека, которой принадлежит исполнитель. Названия и идентификаторы исполнителей
+	// уникальны внутри библиотеки, в разных библиотеках могут быть исполнители с одинаковыми названиями.
+	TenantID string `gorm:"column:tenant_id;not null;default:default;size:64;uniqueIndex:idx_artists_tenant,priority:1;uniqueIndex:idx_artists_normalized_name,priority:1;uniqueIndex:idx_artists_isni,priority:1;uniqueIndex:idx_artists_mbid,priority:1"`
+	Name     string `gorm:"column:name;index;size:130"`
+	// NormalizedName это название в нижнем регистре без лишних пробелов, которое обеспечивает уникальность названий.
+	NormalizedName string `gorm:"column:normalized_name;uniqueIndex:idx_artists_normalized_name,priority:2;size:130"`
+	// SortName это название, по которому исполнитель упорядочивается в списках, например "Beatles, The".
+	SortName string `gorm:"column:sort_name;index;size:130"`
+	// Country это код страны по ISO 3166-1 alpha-2.
+	Country string `gorm:"column:country;index;size:2"`
+	Type    string `gorm:"column:type;index;size:16"`
+	// FormedYear это год основания группы или год рождения исполнителя, DisbandedYear - год распада группы
+	// или год смерти исполнителя. Нулевое значение означает, что год неизвестен или исполнитель активен.
+	FormedYear    uint32        `gorm:"column:formed_year"`
+	DisbandedYear uint32        `gorm:"column:disbanded_year;check:chk_artists_active_years,disbanded_year = 0 OR disbanded_year >= formed_year"`
+	Bio           string        `gorm:"column:bio;type:text"`
+	Links         []string      `gorm:"column:links;type:jsonb;serializer:json"`
+	ISNI          string        `gorm:"column:isni;uniqueIndex:idx_artists_isni,priority:2,where:isni <> '';size:16"`
+	MBID          string        `gorm:"column:mbid;uniqueIndex:idx_artists_mbid,priority:2,where:mbid <> '';size:36"`
+	Aliases       ArtistAliases `gorm:"foreignKey:TenantID,ArtistID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
 }
 
 func (a Artist) API() ArtistAPI {
 	return ArtistAPI{
-		ArtistIDAPI:         ArtistIDAPI{ID: a.ID},
-		ArtistAttributesAPI: ArtistAttributesAPI{Name: a.Name},
+		ArtistIDAPI: ArtistIDAPI{ID: a.ID},
+		ArtistAttributesAPI: ArtistAttributesAPI{
+			Name:          a.Name,
+			SortName:      a.SortName,
+			Country:       a.Country,
+			Type:          a.Type,
+			FormedYear:    a.FormedYear,
+			DisbandedYear: a.DisbandedYear,
+			Bio:           a.Bio,
+			Links:         a.Links,
+			ISNI:          a.ISNI,
+			MBID:          a.MBID,
+		},
+	}
+}
+
+type Artists []Artist
+
+// API трансформирует слайс моделей БД в слайс моделей API.
+func (a Artists) API() []ArtistAPI {
+	artistsAPI := make([]ArtistAPI, len(a))
+	for i, v := range a {
+		artistsAPI[i] = v.API()
 	}
+
+	return artistsAPI
 }
 
 type ArtistAPI struct {
@@ -21,10 +69,37 @@ type ArtistIDAPI struct {
 	ID uint64 `uri:"artist-id" json:"id" binding:"required,number"`
 }
 
+type ArtistsAPI struct {
+	Artists    []ArtistAPI           `json:"artists"`
+	Pagination PaginationMetadataAPI `json:"pagination"`
+}
+
 type ArtistAttributesAPI struct {
 	Name string `json:"name" binding:"required,lte=130"`
+	// SortName по умолчанию строится из названия переносом артикля в конец: "The Beatles" - "Beatles, The".
+	SortName string `json:"sortName" binding:"omitempty,lte=130"`
+	Country  string `json:"country" binding:"omitempty,iso3166_1_alpha2"`
+	Type     string `json:"type" binding:"omitempty,oneof=person group"`
+	// FormedYear это год основания группы или год рождения исполнителя.
+	FormedYear uint32 `json:"formedYear" binding:"omitempty,gte=1000,lte=9999"`
+	// DisbandedYear это год распада группы или год смерти исполнителя.
+	DisbandedYear uint32   `json:"disbandedYear" binding:"omitempty,gte=1000,lte=9999"`
+	Bio           string   `json:"bio" binding:"omitempty,lte=10000"`
+	Links         []string `json:"links" binding:"omitempty,lte=10,dive,url,lte=150"`
+	// ISNI и MBID (идентификатор MusicBrainz) могут содержать разделители и любой регистр, но хранятся и возвращаются в каноническом виде.
+	ISNI string `json:"isni" binding:"omitempty,lte=19"`
+	MBID string `json:"mbid" binding:"omitempty,lte=36"`
 }
 
 type ArtistOptionalAttributesAPI struct {
-	Name string `json:"name" binding:"omitempty,lte=130"`
+	Name          string   `json:"name" binding:"omitempty,lte=130"`
+	SortName      string   `json:"sortName" binding:"omitempty,lte=130"`
+	Country       string   `json:"country" binding:"omitempty,iso3166_1_alpha2"`
+	Type          string   `json:"type" binding:"omitempty,oneof=person group"`
+	FormedYear    uint32   `json:"formedYear" binding:"omitempty,gte=1000,lte=9999"`
+	DisbandedYear uint32   `json:"disbandedYear" binding:"omitempty,gte=1000,lte=9999"`
+	Bio           string   `json:"bio" binding:"omitempty,lte=10000"`
+	Links         []string `json:"links" binding:"omitempty,lte=10,dive,url,lte=150"`
+	ISNI          string   `json:"isni" binding:"omitempty,lte=19"`
+	MBID          string   `json:"mbid" binding:"omitempty,lte=36"`
 }
@@ -0,0 +1,66 @@
+package models
+
+// Все поддерживаемые роли участников создания песни.
+const (
+	CreditRoleFeatured = "featured"
+	CreditRoleProducer = "producer"
+	CreditRoleComposer = "composer"
+	CreditRoleLyricist = "lyricist"
+)
+
+// SongCredit это участие исполнителя в создании песни в определенной роли.
+type SongCredit struct {
+	TenantID string `gorm:"column:tenant_id;not null;default:default;size:64"`
+	SongID   uint64 `gorm:"column:song_id;primaryKey"`
+	ArtistID uint64 `gorm:"column:artist_id;primaryKey;index"`
+	Artist   Artist `gorm:"foreignKey:TenantID,ArtistID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
+	Role     string `gorm:"column:role;primaryKey;size:16"`
+}
+
+// API трансформирует модель БД в модель API.
+func (c SongCredit) API() SongCreditAPI {
+	return SongCreditAPI{
+		Role:   c.Role,
+		Artist: c.Artist.API(),
+	}
+}
+
+type SongCredits []SongCredit
+
+// API трансформирует слайс моделей БД в слайс моделей API.
+func (c SongCredits) API() []SongCreditAPI {
+	creditsAPI := make([]SongCreditAPI, len(c))
+	for i, v := range c {
+		creditsAPI[i] = v.API()
+	}
+
+	return creditsAPI
+}
+
+type SongCreditAPI struct {
+	Role   string    `json:"role"`
+	Artist ArtistAPI `json:"artist"`
+}
+
+type SongCreditAttributesAPI struct {
+	Artist ArtistIDAPI `json:"artist"`
+	Role   string      `json:"role" binding:"required,oneof=featured producer composer lyricist"`
+}
+
+// SongCreditsFromAPI трансформирует слайс моделей API в слайс моделей БД.
+// Сохраняет nil, чтобы отличать отсутствие списка участников от пустого списка.
+func SongCreditsFromAPI(credits []SongCreditAttributesAPI) SongCredits {
+	if credits == nil {
+		return nil
+	}
+
+	songCredits := make(SongCredits, len(credits))
+	for i, c := range credits {
+		songCredits[i] = SongCredit{
+			ArtistID: c.Artist.ID,
+			Role:     c.Role,
+		}
+	}
+
+	return songCredits
+}
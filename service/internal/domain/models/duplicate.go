@@ -0,0 +1,46 @@
+package models
+
+// DuplicateSongCandidate это песня, которая может оказаться дубликатом другой песни того же исполнителя.
+type DuplicateSongCandidate struct {
+	ID uint64
+	// NameKey и ArtistKey это ключи дубликатов названий песни и исполнителя.
+	NameKey   string
+	ArtistKey string
+	Text      string
+}
+
+type DuplicateSongsAPI struct {
+	Groups     []DuplicateSongGroupAPI `json:"groups"`
+	Pagination PaginationMetadataAPI   `json:"pagination"`
+}
+
+// DuplicateSongGroupAPI это группа песен одного исполнителя с похожими названиями или текстами.
+type DuplicateSongGroupAPI struct {
+	Songs []DuplicateSongAPI `json:"songs"`
+}
+
+type DuplicateSongAPI struct {
+	SongAPI
+	// LyricsSimilarity это сходство текста песни с текстом первой песни группы от 0 до 1.
+	LyricsSimilarity float64 `json:"lyricsSimilarity"`
+}
+
+type DuplicateArtistsAPI struct {
+	Groups     []DuplicateArtistGroupAPI `json:"groups"`
+	Pagination PaginationMetadataAPI     `json:"pagination"`
+}
+
+// DuplicateArtistGroupAPI это группа исполнителей с похожими названиями.
+type DuplicateArtistGroupAPI struct {
+	Artists []ArtistAPI `json:"artists"`
+}
+
+type SongMergeAttributesAPI struct {
+	// Duplicate это песня, которая сливается с основной песней и удаляется.
+	Duplicate SongIDAPI `json:"duplicate"`
+}
+
+type ArtistMergeAttributesAPI struct {
+	// Duplicate это исполнитель, который сливается с основным исполнителем и удаляется.
+	Duplicate ArtistIDAPI `json:"duplicate"`
+}
@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+const (
+	// JobTypeEnrichSong заполняет незаполненные данные песни из внешнего сервиса информации о песнях.
+	JobTypeEnrichSong = "enrich_song"
+	// JobTypeValidateLink проверяет доступность ссылки песни.
+	JobTypeValidateLink = "validate_link"
+)
+
+const (
+	// JobStatusPending задача ожидает выполнения, в том числе повторной попытки.
+	JobStatusPending = "pending"
+	// JobStatusRunning задача выполняется одним из обработчиков.
+	JobStatusRunning = "running"
+	// JobStatusDone задача успешно выполнена.
+	JobStatusDone = "done"
+	// JobStatusDead задача не выполнена за допустимое количество попыток и больше не выполняется.
+	JobStatusDead = "dead"
+)
+
+// Job это задача фоновой обработки песни.
+// Ожидающая задача берется в работу, когда наступает время RunAt.
+// У выполняемой задачи RunAt это время, после которого задача считается брошенной и снова берется в работу.
+type Job struct {
+	ID        uint64    `gorm:"column:id;primaryKey"`
+	TenantID  string    `gorm:"column:tenant_id;not null;default:default;size:64"`
+	Type      string    `gorm:"column:type;index;size:32"`
+	SongID    uint64    `gorm:"column:song_id;index"`
+	Song      Song      `gorm:"foreignKey:TenantID,SongID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
+	Status    string    `gorm:"column:status;index:idx_jobs_claim,priority:1;size:16"`
+	RunAt     time.Time `gorm:"column:run_at;index:idx_jobs_claim,priority:2"`
+	Attempts  uint32    `gorm:"column:attempts"`
+	LastError string    `gorm:"column:last_error;type:text"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// API трансформирует модель БД в модель API.
+func (j Job) API() JobAPI {
+	return JobAPI{
+		JobIDAPI:  JobIDAPI{ID: j.ID},
+		Type:      j.Type,
+		Song:      SongIDAPI{ID: j.SongID},
+		Status:    j.Status,
+		RunAt:     j.RunAt,
+		Attempts:  j.Attempts,
+		LastError: j.LastError,
+		CreatedAt: j.CreatedAt,
+		UpdatedAt: j.UpdatedAt,
+	}
+}
+
+type Jobs []Job
+
+// API трансформирует слайс моделей БД в слайс моделей API.
+func (j Jobs) API() []JobAPI {
+	jobsAPI := make([]JobAPI, len(j))
+	for i, v := range j {
+		jobsAPI[i] = v.API()
+	}
+
+	return jobsAPI
+}
+
+type JobAPI struct {
+	JobIDAPI
+	Type   string    `json:"type"`
+	Song   SongIDAPI `json:"song"`
+	Status string    `json:"status"`
+	// RunAt это время следующей попытки ожидающей задачи.
+	RunAt     time.Time `json:"runAt"`
+	Attempts  uint32    `json:"attempts"`
+	LastError string    `json:"lastError"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type JobIDAPI struct {
+	ID uint64 `uri:"job-id" json:"id" binding:"number,required"`
+}
+
+type JobsAPI struct {
+	Jobs       []JobAPI              `json:"jobs"`
+	Pagination PaginationMetadataAPI `json:"pagination"`
+}
@@ -0,0 +1,85 @@
+package models
+
+import "time"
+
+const (
+	// LinkStatusUnchecked ссылка песни еще не проверялась.
+	LinkStatusUnchecked = "unchecked"
+	// LinkStatusOK ссылка песни была доступна при последней проверке.
+	LinkStatusOK = "ok"
+	// LinkStatusBroken ссылка песни была недоступна при последней проверке.
+	LinkStatusBroken = "broken"
+)
+
+// LinkHealth хранит результат последней проверки доступности ссылки песни.
+type LinkHealth struct {
+	// StatusCode это код статуса ответа по ссылке. 0, если ответ не получен.
+	StatusCode int `gorm:"column:link_status_code;not null;default:0"`
+	// CheckedAt это время последней проверки. nil, если ссылка еще не проверялась.
+	CheckedAt *time.Time `gorm:"column:link_checked_at;index"`
+	// FailureStreak это количество неудачных проверок подряд.
+	FailureStreak uint32 `gorm:"column:link_failure_streak;index;not null;default:0"`
+	// Status это статус ссылки для поиска песен. Не хранится в БД.
+	Status string `gorm:"-"`
+}
+
+// status возвращает статус ссылки по результату последней проверки.
+func (h LinkHealth) status() string {
+	switch {
+	case h.CheckedAt == nil:
+		return LinkStatusUnchecked
+
+	case h.FailureStreak > 0:
+		return LinkStatusBroken
+
+	default:
+		return LinkStatusOK
+	}
+}
+
+// API трансформирует модель БД в модель API.
+func (h LinkHealth) API() LinkHealthAPI {
+	return LinkHealthAPI{
+		Status:        h.status(),
+		StatusCode:    h.StatusCode,
+		CheckedAt:     h.CheckedAt,
+		FailureStreak: h.FailureStreak,
+	}
+}
+
+// LinkStats хранит количество песен со ссылками по статусу ссылки.
+type LinkStats struct {
+	OK        uint64 `gorm:"column:ok"`
+	Broken    uint64 `gorm:"column:broken"`
+	Unchecked uint64 `gorm:"column:unchecked"`
+}
+
+// API трансформирует модель БД в модель API.
+func (s LinkStats) API() LinkStatsAPI {
+	return LinkStatsAPI{
+		OK:        s.OK,
+		Broken:    s.Broken,
+		Unchecked: s.Unchecked,
+	}
+}
+
+type LinkHealthAPI struct {
+	Status        string     `json:"status"`
+	StatusCode    int        `json:"statusCode"`
+	CheckedAt     *time.Time `json:"checkedAt"`
+	FailureStreak uint32     `json:"failureStreak"`
+}
+
+type LinkStatsAPI struct {
+	OK        uint64 `json:"ok"`
+	Broken    uint64 `json:"broken"`
+	Unchecked uint64 `json:"unchecked"`
+}
+
+// LinkReportAPI это отчет о доступности ссылок песен: количество песен по статусу ссылки
+// и песни с недоступными ссылками.
+type LinkReportAPI struct {
+	Stats       LinkStatsAPI          `json:"stats"`
+	BrokenSongs []SongAPI             `json:"brokenSongs"`
+	Pagination  PaginationMetadataAPI `json:"pagination"`
+}
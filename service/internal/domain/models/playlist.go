@@ -0,0 +1,132 @@
+package models
+
+type Playlist struct {
+	ID          uint64        `gorm:"column:id;primaryKey;uniqueIndex:idx_playlists_tenant,priority:2"`
+	TenantID    string        `gorm:"column:tenant_id;not null;default:default;size:64;uniqueIndex:idx_playlists_tenant,priority:1"`
+	Name        string        `gorm:"column:name;index;size:130"`
+	Description string        `gorm:"column:description;size:1000"`
+	Items       PlaylistItems `gorm:"foreignKey:TenantID,PlaylistID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
+}
+
+// API трансформирует модель БД в модель API.
+func (p Playlist) API() PlaylistAPI {
+	return PlaylistAPI{
+		PlaylistIDAPI: PlaylistIDAPI{ID: p.ID},
+		PlaylistAttributesAPI: PlaylistAttributesAPI{
+			Name:        p.Name,
+			Description: p.Description,
+		},
+	}
+}
+
+type Playlists []Playlist
+
+// API трансформирует слайс моделей БД в слайс моделей API.
+func (p Playlists) API() []PlaylistAPI {
+	playlistsAPI := make([]PlaylistAPI, len(p))
+	for i, v := range p {
+		playlistsAPI[i] = v.API()
+	}
+
+	return playlistsAPI
+}
+
+// PlaylistItem это песня в плейлисте.
+// Порядок песен задается рангом: ранги соседних песен разделены промежутком,
+// поэтому вставка и перемещение песни не изменяют ранги остальных песен.
+type PlaylistItem struct {
+	TenantID   string `gorm:"column:tenant_id;not null;default:default;size:64"`
+	PlaylistID uint64 `gorm:"column:playlist_id;primaryKey;index:idx_playlist_items_rank,priority:1"`
+	SongID     uint64 `gorm:"column:song_id;primaryKey;index"`
+	Song       Song   `gorm:"foreignKey:TenantID,SongID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
+	Rank       int64  `gorm:"column:rank;index:idx_playlist_items_rank,priority:2"`
+	// Position это позиция песни в плейлисте, начиная с 1. Не хранится в БД.
+	Position uint32 `gorm:"-"`
+}
+
+// API трансформирует модель БД в модель API.
+func (i PlaylistItem) API() PlaylistItemAPI {
+	return PlaylistItemAPI{
+		PlaylistID: i.PlaylistID,
+		Song:       SongIDAPI{ID: i.SongID},
+		Position:   i.Position,
+	}
+}
+
+type PlaylistItems []PlaylistItem
+
+// Ranks возвращает ранги песен плейлиста.
+func (i PlaylistItems) Ranks() []int64 {
+	ranks := make([]int64, len(i))
+	for k, v := range i {
+		ranks[k] = v.Rank
+	}
+
+	return ranks
+}
+
+type PlaylistAPI struct {
+	PlaylistIDAPI
+	PlaylistAttributesAPI
+}
+
+type PlaylistsAPI struct {
+	Playlists  []PlaylistAPI         `json:"playlists"`
+	Pagination PaginationMetadataAPI `json:"pagination"`
+}
+
+type PlaylistItemAPI struct {
+	PlaylistID uint64    `json:"playlistId"`
+	Song       SongIDAPI `json:"song"`
+	Position   uint32    `json:"position"`
+}
+
+type PlaylistSongsAPI struct {
+	Songs      []SongAPI             `json:"songs"`
+	Pagination PaginationMetadataAPI `json:"pagination"`
+}
+
+type PlaylistImportAPI struct {
+	Playlist     PlaylistAPI `json:"playlist"`
+	MatchedCount uint32      `json:"matchedCount"`
+	// Unmatched это записи файла, для которых не найдены песни библиотеки.
+	Unmatched []PlaylistEntryAPI `json:"unmatched"`
+}
+
+// PlaylistEntryAPI это запись файла плейлиста.
+type PlaylistEntryAPI struct {
+	// Position это позиция записи в файле, начиная с 1.
+	Position uint32 `json:"position"`
+	Location string `json:"location"`
+	Title    string `json:"title"`
+	Creator  string `json:"creator"`
+}
+
+type PlaylistIDAPI struct {
+	ID uint64 `uri:"playlist-id" json:"id" binding:"required,number"`
+}
+
+type PlaylistAttributesAPI struct {
+	Name        string `json:"name" binding:"required,lte=130"`
+	Description string `json:"description" binding:"omitempty,lte=1000"`
+}
+
+type PlaylistOptionalAttributesAPI struct {
+	Name        string `json:"name" binding:"omitempty,lte=130"`
+	Description string `json:"description" binding:"omitempty,lte=1000"`
+}
+
+type PlaylistItemAttributesAPI struct {
+	Song SongIDAPI `json:"song"`
+	// Position это позиция песни в плейлисте, начиная с 1. По умолчанию песня добавляется в конец плейлиста.
+	Position uint32 `json:"position" binding:"omitempty,gte=1"`
+}
+
+type PlaylistItemPositionAPI struct {
+	// Position это новая позиция песни в плейлисте, начиная с 1.
+	Position uint32 `json:"position" binding:"required,gte=1"`
+}
+
+type PlaylistFormatAPI struct {
+	Format string `form:"format" binding:"required,oneof=m3u8 xspf jspf"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// QuotaUsage это количество запросов клиента API за сутки (UTC).
+type QuotaUsage struct {
+	Client string    `gorm:"column:client;primaryKey;size:255"`
+	Day    time.Time `gorm:"column:day;primaryKey;type:date"`
+	Used   uint64    `gorm:"column:used;not null"`
+}
+
+// Quota это состояние суточной квоты запросов клиента.
+type Quota struct {
+	// Limit это количество запросов, которые клиент может выполнить за сутки.
+	Limit uint64
+	// Remaining это количество запросов, которые клиент еще может выполнить до Reset.
+	Remaining uint64
+	// Reset это момент обновления квоты.
+	Reset time.Time
+}
@@ -0,0 +1,67 @@
+package models
+
+// Все поддерживаемые типы связей между песнями. Связь направлена от производной песни к оригиналу.
+const (
+	SongRelationCover  = "cover"
+	SongRelationRemix  = "remix"
+	SongRelationLive   = "live"
+	SongRelationSample = "sample"
+)
+
+// SongRelation это связь производной песни с оригиналом: кавер, ремикс, концертная версия или семпл.
+type SongRelation struct {
+	TenantID   string `gorm:"column:tenant_id;not null;default:default;size:64"`
+	SongID     uint64 `gorm:"column:song_id;primaryKey"`
+	Song       Song   `gorm:"foreignKey:TenantID,SongID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
+	OriginalID uint64 `gorm:"column:original_id;primaryKey;index"`
+	Original   Song   `gorm:"foreignKey:TenantID,OriginalID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
+	Type       string `gorm:"column:type;primaryKey;size:16"`
+}
+
+// API трансформирует модель БД в модель API.
+func (r SongRelation) API() SongRelationAPI {
+	return SongRelationAPI{
+		Type:     r.Type,
+		Song:     SongIDAPI{ID: r.SongID},
+		Original: SongIDAPI{ID: r.OriginalID},
+	}
+}
+
+type SongRelations []SongRelation
+
+// RelatedAPI группирует связанные с определенной песней песни по типу и направлению связи.
+func (r SongRelations) RelatedAPI(songID uint64) RelatedSongsAPI {
+	related := RelatedSongsAPI{
+		Song:        SongIDAPI{ID: songID},
+		Originals:   make(map[string][]SongAPI),
+		Derivatives: make(map[string][]SongAPI),
+	}
+	for _, v := range r {
+		if v.SongID == songID {
+			related.Originals[v.Type] = append(related.Originals[v.Type], v.Original.API())
+		} else {
+			related.Derivatives[v.Type] = append(related.Derivatives[v.Type], v.Song.API())
+		}
+	}
+
+	return related
+}
+
+type SongRelationAPI struct {
+	Type     string    `json:"type"`
+	Song     SongIDAPI `json:"song"`
+	Original SongIDAPI `json:"original"`
+}
+
+type SongRelationAttributesAPI struct {
+	Type     string    `json:"type" binding:"required,oneof=cover remix live sample"`
+	Original SongIDAPI `json:"original"`
+}
+
+type RelatedSongsAPI struct {
+	Song SongIDAPI `json:"song"`
+	// Originals это песни, производной от которых является песня, сгруппированные по типу связи.
+	Originals map[string][]SongAPI `json:"originals"`
+	// Derivatives это песни, производные от песни, сгруппированные по типу связи.
+	Derivatives map[string][]SongAPI `json:"derivatives"`
+}
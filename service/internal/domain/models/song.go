@@ -1,15 +1,55 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
+)
 
 type Song struct {
-	ID          uint64    `gorm:"column:id;primaryKey"`
+	ID uint64 `gorm:"column:id;primaryKey;uniqueIndex:idx_songs_tenant,priority:2"`
+	// TenantID это библиотека, которой принадлежит песня. Песня связана только с записями своей библиотеки.
+	TenantID    string    `gorm:"column:tenant_id;not null;default:default;size:64;uniqueIndex:idx_songs_tenant,priority:1;uniqueIndex:idx_songs_link_media,priority:1;uniqueIndex:idx_songs_isrc,priority:1"`
 	Name        string    `gorm:"column:name;index;size:130"`
 	ArtistID    uint64    `gorm:"column:artist_id"`
-	Artist      Artist    `gorm:"foreignKey:ArtistID;constraint:OnDelete:CASCADE"`
+	Artist      Artist    `gorm:"foreignKey:TenantID,ArtistID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
 	ReleaseDate time.Time `gorm:"column:release_date"`
 	Text        string    `gorm:"column:text;type:text"`
 	Link        string    `gorm:"column:link;size:150"`
+	// LinkProvider и LinkMediaID это музыкальный сервис, на который указывает ссылка, и идентификатор записи в нем.
+	// Одна и та же запись сервиса не может быть ссылкой нескольких песен одной библиотеки.
+	LinkProvider string      `gorm:"column:link_provider;uniqueIndex:idx_songs_link_media,priority:2,where:link_media_id <> '';size:16"`
+	LinkMediaID  string      `gorm:"column:link_media_id;uniqueIndex:idx_songs_link_media,priority:3;size:64"`
+	LinkHealth   LinkHealth  `gorm:"embedded"`
+	ISRC         string      `gorm:"column:isrc;uniqueIndex:idx_songs_isrc,priority:2,where:isrc <> '';size:12"`
+	ISWC         string      `gorm:"column:iswc;index;size:11"`
+	Language     string      `gorm:"column:language;index;size:8"`
+	LyricsStats  LyricsStats `gorm:"embedded"`
+	Credits      SongCredits `gorm:"foreignKey:TenantID,SongID;references:TenantID,ID;constraint:OnDelete:CASCADE"`
+	Tags         Tags        `gorm:"many2many:song_tags;constraint:OnDelete:CASCADE"`
+	// CreditedArtist это подстрока названия основного исполнителя или любого участника создания песни
+	// для поиска песен. Не хранится в БД.
+	CreditedArtist string `gorm:"-"`
+}
+
+// LyricsStats хранит статистику текста песни.
+type LyricsStats struct {
+	LineCount       uint32  `gorm:"column:line_count"`
+	CoupletCount    uint32  `gorm:"column:couplet_count"`
+	WordCount       uint32  `gorm:"column:word_count"`
+	UniqueWordRatio float64 `gorm:"column:unique_word_ratio"`
+	ReadingTime     uint32  `gorm:"column:reading_time"`
+}
+
+// API трансформирует модель БД в модель API.
+func (s LyricsStats) API() LyricsStatsAPI {
+	return LyricsStatsAPI{
+		LineCount:       s.LineCount,
+		CoupletCount:    s.CoupletCount,
+		WordCount:       s.WordCount,
+		UniqueWordRatio: s.UniqueWordRatio,
+		ReadingTime:     s.ReadingTime,
+	}
 }
 
 // API трансформирует модель БД в модель API.
@@ -21,8 +61,18 @@ func (s Song) API() SongAPI {
 			ReleaseDate: s.ReleaseDate,
 			Text:        s.Text,
 			Link:        s.Link,
+			ISRC:        s.ISRC,
+			ISWC:        s.ISWC,
 		},
-		Artist: s.Artist.API(),
+		Artist:       s.Artist.API(),
+		LinkProvider: s.LinkProvider,
+		LinkMediaID:  s.LinkMediaID,
+		Credits:      s.Credits.API(),
+		Genres:       s.Tags.Names(TagKindGenre),
+		Tags:         s.Tags.Names(TagKindTag),
+		Language:     s.Language,
+		Stats:        s.LyricsStats.API(),
+		LinkHealth:   s.LinkHealth.API(),
 	}
 }
 
@@ -38,15 +88,50 @@ func (s Songs) API() []SongAPI {
 	return songsAPI
 }
 
+// Tracks трансформирует слайс моделей БД в записи плейлиста. Ссылка песни используется как расположение записи.
+func (s Songs) Tracks() []playlistfmt.Track {
+	tracks := make([]playlistfmt.Track, len(s))
+	for i, v := range s {
+		tracks[i] = playlistfmt.Track{
+			Location: v.Link,
+			Title:    v.Name,
+			Creator:  v.Artist.Name,
+		}
+	}
+
+	return tracks
+}
+
 type SongAPI struct {
 	SongIDAPI
 	SongAttributesAPI
 	Artist ArtistAPI `json:"artist"`
+	// LinkProvider и LinkMediaID заполняются, если ссылка указывает на запись известного музыкального сервиса.
+	LinkProvider string          `json:"linkProvider,omitempty"`
+	LinkMediaID  string          `json:"linkMediaId,omitempty"`
+	Credits      []SongCreditAPI `json:"credits"`
+	Genres       []string        `json:"genres"`
+	Tags         []string        `json:"tags"`
+	Language     string          `json:"language"`
+	Stats        LyricsStatsAPI  `json:"stats"`
+	// LinkHealth это результат последней проверки доступности ссылки.
+	LinkHealth LinkHealthAPI `json:"linkHealth"`
+}
+
+type LyricsStatsAPI struct {
+	LineCount       uint32  `json:"lineCount"`
+	CoupletCount    uint32  `json:"coupletCount"`
+	WordCount       uint32  `json:"wordCount"`
+	UniqueWordRatio float64 `json:"uniqueWordRatio"`
+	// ReadingTime примерное время чтения текста в секундах.
+	ReadingTime uint32 `json:"readingTime"`
 }
 
 type SongsAPI struct {
 	Songs      []SongAPI             `json:"songs"`
 	Pagination PaginationMetadataAPI `json:"pagination"`
+	// Facets заполняется только по запросу клиента.
+	Facets *SongFacetsAPI `json:"facets,omitempty"`
 }
 
 type SongWithCoupletPaginationAPI struct {
@@ -59,10 +144,15 @@ type SongIDAPI struct {
 }
 
 type SongAttributesAPI struct {
-	Name        string    `json:"name" binding:"required,lte=130"`
-	ReleaseDate time.Time `json:"releaseDate" binding:"required"`
-	Text        string    `json:"text" binding:"required"`
-	Link        string    `json:"link" binding:"required,url"`
+	Name string `json:"name" binding:"required,lte=130"`
+	// ReleaseDate, Text и Link новой песни можно не указывать: они заполняются из внешнего сервиса информации о песнях.
+	ReleaseDate time.Time `json:"releaseDate" binding:"omitempty"`
+	Text        string    `json:"text" binding:"omitempty"`
+	Link        string    `json:"link" binding:"omitempty,url"`
+	// ISRC это код записи, уникальный среди песен. ISWC это код произведения, общий для всех его записей.
+	// Коды могут содержать разделители, но хранятся и возвращаются в каноническом виде.
+	ISRC string `json:"isrc" binding:"omitempty,lte=15"`
+	ISWC string `json:"iswc" binding:"omitempty,lte=15"`
 }
 
 type SongOptionalAttributesAPI struct {
@@ -70,4 +160,11 @@ type SongOptionalAttributesAPI struct {
 	ReleaseDate time.Time `json:"releaseDate" binding:"omitempty"`
 	Text        string    `json:"text" binding:"omitempty"`
 	Link        string    `json:"link" binding:"omitempty,url"`
+	ISRC        string    `json:"isrc" binding:"omitempty,lte=15"`
+	ISWC        string    `json:"iswc" binding:"omitempty,lte=15"`
+}
+
+type WorkSongsAPI struct {
+	ISWC  string    `json:"iswc"`
+	Songs []SongAPI `json:"songs"`
 }
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// SongInfo это данные песни, полученные из внешнего сервиса информации о песнях.
+// Незаполненные поля означают, что сервису они неизвестны.
+type SongInfo struct {
+	ReleaseDate time.Time
+	Text        string
+	Link        string
+}
@@ -0,0 +1,113 @@
+package models
+
+// Все поддерживаемые виды меток.
+const (
+	// TagKindGenre жанр. Жанры образуют управляемый словарь.
+	TagKindGenre = "genre"
+	// TagKindTag свободная метка. Метки создаются автоматически при привязке к песне.
+	TagKindTag = "tag"
+)
+
+// Tag это метка песни: жанр или свободная метка.
+type Tag struct {
+	ID       uint64 `gorm:"column:id;primaryKey"`
+	TenantID string `gorm:"column:tenant_id;not null;default:default;size:64;uniqueIndex:idx_tags_kind_name,priority:1"`
+	Kind     string `gorm:"column:kind;size:16;uniqueIndex:idx_tags_kind_name,priority:2"`
+	Name     string `gorm:"column:name;size:64;uniqueIndex:idx_tags_kind_name,priority:3"`
+}
+
+// API трансформирует модель БД в модель API.
+func (t Tag) API() TagAPI {
+	return TagAPI{
+		TagIDAPI:         TagIDAPI{ID: t.ID},
+		TagAttributesAPI: TagAttributesAPI{Name: t.Name},
+	}
+}
+
+type Tags []Tag
+
+// API трансформирует слайс моделей БД в слайс моделей API.
+func (t Tags) API() []TagAPI {
+	tagsAPI := make([]TagAPI, len(t))
+	for i, v := range t {
+		tagsAPI[i] = v.API()
+	}
+
+	return tagsAPI
+}
+
+// Names возвращает названия меток определенного вида.
+func (t Tags) Names(kind string) []string {
+	names := make([]string, 0, len(t))
+	for _, v := range t {
+		if v.Kind == kind {
+			names = append(names, v.Name)
+		}
+	}
+
+	return names
+}
+
+// TagsFromNames создает слайс меток определенного вида по их названиям.
+func TagsFromNames(kind string, names []string) Tags {
+	tags := make(Tags, len(names))
+	for i, n := range names {
+		tags[i] = Tag{Kind: kind, Name: n}
+	}
+
+	return tags
+}
+
+type TagAPI struct {
+	TagIDAPI
+	TagAttributesAPI
+}
+
+type TagIDAPI struct {
+	ID uint64 `uri:"tag-id" json:"id" binding:"required,number"`
+}
+
+type TagAttributesAPI struct {
+	Name string `json:"name" binding:"required,lte=64"`
+}
+
+// Facet это количество песен с определенной меткой.
+type Facet struct {
+	Kind  string `gorm:"column:kind"`
+	Name  string `gorm:"column:name"`
+	Count uint64 `gorm:"column:count"`
+}
+
+type Facets []Facet
+
+// API трансформирует слайс моделей БД в модель API, группируя метки по видам.
+func (f Facets) API() SongFacetsAPI {
+	facetsAPI := SongFacetsAPI{
+		Genres: make([]FacetAPI, 0),
+		Tags:   make([]FacetAPI, 0),
+	}
+	for _, v := range f {
+		switch v.Kind {
+		case TagKindGenre:
+			facetsAPI.Genres = append(facetsAPI.Genres, FacetAPI{Name: v.Name, Count: v.Count})
+		case TagKindTag:
+			facetsAPI.Tags = append(facetsAPI.Tags, FacetAPI{Name: v.Name, Count: v.Count})
+		}
+	}
+
+	return facetsAPI
+}
+
+type FacetAPI struct {
+	Name  string `json:"name"`
+	Count uint64 `json:"count"`
+}
+
+type SongFacetsAPI struct {
+	Genres []FacetAPI `json:"genres"`
+	Tags   []FacetAPI `json:"tags"`
+}
+
+type TagsAPI struct {
+	Tags []TagAPI `json:"tags"`
+}
@@ -0,0 +1,78 @@
+// Package dataloader содержит загрузчик, который объединяет загрузку данных по отдельным ключам
+// в одну пакетную загрузку.
+package dataloader
+
+import (
+	"context"
+	"sync"
+)
+
+// BatchFunc загружает значения по всем переданным ключам за один раз.
+// Ключи, для которых значения нет, можно не включать в результат.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// result это загруженное значение или ошибка пакетной загрузки.
+type result[V any] struct {
+	value V
+	err   error
+}
+
+// Loader откладывает загрузку значений по ключам до тех пор, пока значение одного из ключей не понадобится,
+// а затем загружает значения всех отложенных ключей одним пакетом. Загруженные значения кэшируются,
+// поэтому загрузчик создается отдельно для каждого запроса.
+type Loader[K comparable, V any] struct {
+	batch BatchFunc[K, V]
+
+	mu      sync.Mutex
+	pending []K
+	queued  map[K]struct{}
+	results map[K]result[V]
+}
+
+// New создает новый загрузчик с определенной функцией пакетной загрузки.
+func New[K comparable, V any](batch BatchFunc[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{
+		batch:   batch,
+		queued:  make(map[K]struct{}),
+		results: make(map[K]result[V]),
+	}
+}
+
+// Load откладывает загрузку значения по определенному ключу.
+// Возвращает функцию, которая возвращает значение ключа и при необходимости загружает все отложенные ключи.
+// Для ключа без значения возвращается нулевое значение без ошибки.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) func() (V, error) {
+	l.mu.Lock()
+	if _, ok := l.results[key]; !ok {
+		if _, ok := l.queued[key]; !ok {
+			l.queued[key] = struct{}{}
+			l.pending = append(l.pending, key)
+		}
+	}
+	l.mu.Unlock()
+
+	return func() (V, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if _, ok := l.results[key]; !ok {
+			l.dispatch(ctx)
+		}
+
+		r := l.results[key]
+
+		return r.value, r.err
+	}
+}
+
+// dispatch загружает значения всех отложенных ключей. Вызывается под блокировкой.
+func (l *Loader[K, V]) dispatch(ctx context.Context) {
+	keys := l.pending
+	l.pending = nil
+	clear(l.queued)
+
+	values, err := l.batch(ctx, keys)
+	for _, k := range keys {
+		l.results[k] = result[V]{value: values[k], err: err}
+	}
+}
@@ -0,0 +1,99 @@
+package dataloader
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoader_Load(t *testing.T) {
+	t.Parallel()
+
+	errBatch := errors.New("batch error")
+
+	tests := []struct {
+		name        string
+		err         error
+		keys        []int
+		wantBatches [][]int
+		want        []string
+		wantErr     error
+	}{
+		{
+			name:        "Load batches pending keys",
+			keys:        []int{1, 2, 1, 3},
+			wantBatches: [][]int{{1, 2, 3}},
+			want:        []string{"1", "2", "1", ""},
+		},
+		{
+			name:        "Load returns batch error for every key",
+			err:         errBatch,
+			keys:        []int{1, 2},
+			wantBatches: [][]int{{1, 2}},
+			want:        []string{"", ""},
+			wantErr:     errBatch,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var batches [][]int
+			l := New(func(_ context.Context, keys []int) (map[int]string, error) {
+				batches = append(batches, keys)
+				if tt.err != nil {
+					return nil, tt.err
+				}
+
+				return map[int]string{1: "1", 2: "2"}, nil
+			})
+
+			thunks := make([]func() (string, error), len(tt.keys))
+			for i, k := range tt.keys {
+				thunks[i] = l.Load(context.Background(), k)
+			}
+
+			for i, thunk := range thunks {
+				got, err := thunk()
+				assert.Equal(t, tt.want[i], got)
+				assert.ErrorIsf(t, err, tt.wantErr, "Loader.Load() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			assert.Equal(t, tt.wantBatches, batches)
+		})
+	}
+}
+
+func TestLoader_Load_Cached(t *testing.T) {
+	t.Parallel()
+
+	var batches [][]int
+	l := New(func(_ context.Context, keys []int) (map[int]int, error) {
+		batches = append(batches, keys)
+
+		values := make(map[int]int, len(keys))
+		for _, k := range keys {
+			values[k] = k * 10
+		}
+
+		return values, nil
+	})
+
+	first := l.Load(context.Background(), 1)
+	got, err := first()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, got)
+
+	second, third := l.Load(context.Background(), 1), l.Load(context.Background(), 2)
+	got, err = second()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, got)
+	got, err = third()
+	assert.NoError(t, err)
+	assert.Equal(t, 20, got)
+
+	assert.Equal(t, [][]int{{1}, {2}}, batches)
+}
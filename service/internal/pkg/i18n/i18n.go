@@ -0,0 +1,152 @@
+// Package i18n содержит каталог сообщений на нескольких языках и выбор языка по заголовку Accept-Language.
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/ru"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	entranslations "github.com/go-playground/validator/v10/translations/en"
+	rutranslations "github.com/go-playground/validator/v10/translations/ru"
+)
+
+// Поддерживаемые языки.
+const (
+	LocaleEN = "en"
+	LocaleRU = "ru"
+)
+
+// Locales это все поддерживаемые языки.
+var Locales = []string{LocaleEN, LocaleRU}
+
+// ErrUnsupportedLocale язык не поддерживается.
+var ErrUnsupportedLocale = errors.New("unsupported locale")
+
+// Messages хранит сообщения по языку и ключу сообщения.
+type Messages map[string]map[string]string
+
+// Catalog это каталог сообщений на поддерживаемых языках.
+// Если сообщения нет на запрошенном языке, то используется язык по умолчанию.
+type Catalog struct {
+	fallback string
+	messages Messages
+	uni      *ut.UniversalTranslator
+}
+
+// New создает новый каталог сообщений с определенным языком по умолчанию.
+func New(fallback string, messages Messages) (*Catalog, error) {
+	if !slices.Contains(Locales, fallback) {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedLocale, fallback)
+	}
+
+	for locale := range messages {
+		if !slices.Contains(Locales, locale) {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedLocale, locale)
+		}
+	}
+
+	enLocale := en.New()
+
+	return &Catalog{
+		fallback: fallback,
+		messages: messages,
+		uni:      ut.New(enLocale, enLocale, ru.New()),
+	}, nil
+}
+
+// Fallback возвращает язык по умолчанию.
+func (c *Catalog) Fallback() string {
+	return c.fallback
+}
+
+// Negotiate выбирает язык ответа по значению заголовка Accept-Language с учетом весов языков.
+// Региональные варианты языка сводятся к основному языку. Возвращает язык по умолчанию,
+// если ни один из запрошенных языков не поддерживается.
+func (c *Catalog) Negotiate(acceptLanguage string) string {
+	type weighted struct {
+		locale string
+		q      float64
+	}
+
+	var ranges []weighted
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" {
+			continue
+		}
+
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+
+		primary, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+		ranges = append(ranges, weighted{locale: strings.ToLower(primary), q: q})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	for _, r := range ranges {
+		if r.locale == "*" {
+			return c.fallback
+		}
+		if slices.Contains(Locales, r.locale) {
+			return r.locale
+		}
+	}
+
+	return c.fallback
+}
+
+// Lookup возвращает сообщение по ключу только на определенном языке.
+func (c *Catalog) Lookup(locale, key string) (string, bool) {
+	msg, ok := c.messages[locale][key]
+
+	return msg, ok
+}
+
+// Message возвращает сообщение по ключу на определенном языке или на языке по умолчанию.
+// Возвращает ключ, если сообщения нет ни на одном из этих языков.
+func (c *Catalog) Message(locale, key string) string {
+	if msg, ok := c.Lookup(locale, key); ok {
+		return msg
+	}
+	if msg, ok := c.Lookup(c.fallback, key); ok {
+		return msg
+	}
+
+	return key
+}
+
+// RegisterValidatorTranslations добавляет в валидатор переводы сообщений встроенных правил на все поддерживаемые языки.
+func (c *Catalog) RegisterValidatorTranslations(v *validator.Validate) error {
+	if err := entranslations.RegisterDefaultTranslations(v, c.Translator(LocaleEN)); err != nil {
+		return fmt.Errorf("%s: %w", LocaleEN, err)
+	}
+	if err := rutranslations.RegisterDefaultTranslations(v, c.Translator(LocaleRU)); err != nil {
+		return fmt.Errorf("%s: %w", LocaleRU, err)
+	}
+
+	return nil
+}
+
+// Translator возвращает переводчик сообщений валидатора на определенный язык.
+func (c *Catalog) Translator(locale string) ut.Translator {
+	t, _ := c.uni.GetTranslator(locale)
+
+	return t
+}
@@ -0,0 +1,93 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		fallback string
+		messages Messages
+		wantErr  error
+	}{
+		{
+			name:     "Supported locales",
+			fallback: LocaleRU,
+			messages: Messages{LocaleEN: {}, LocaleRU: {}},
+		},
+		{
+			name:     "Unsupported fallback locale",
+			fallback: "de",
+			wantErr:  ErrUnsupportedLocale,
+		},
+		{
+			name:     "Unsupported messages locale",
+			fallback: LocaleEN,
+			messages: Messages{"fr": {}},
+			wantErr:  ErrUnsupportedLocale,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(tt.fallback, tt.messages)
+			assert.ErrorIsf(t, err, tt.wantErr, "New() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestCatalog_Negotiate(t *testing.T) {
+	t.Parallel()
+
+	c, err := New(LocaleEN, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           string
+	}{
+		{name: "Empty header", acceptLanguage: "", want: LocaleEN},
+		{name: "Exact locale", acceptLanguage: "ru", want: LocaleRU},
+		{name: "Regional variant", acceptLanguage: "ru-RU", want: LocaleRU},
+		{name: "Underscore and upper case", acceptLanguage: "RU_ru", want: LocaleRU},
+		{name: "Weights", acceptLanguage: "en;q=0.5, ru;q=0.8", want: LocaleRU},
+		{name: "Unsupported locale skipped", acceptLanguage: "de-DE, ru;q=0.3", want: LocaleRU},
+		{name: "Zero weight", acceptLanguage: "ru;q=0, de", want: LocaleEN},
+		{name: "Invalid weight skipped", acceptLanguage: "ru;q=abc, en;q=0.1", want: LocaleEN},
+		{name: "Wildcard", acceptLanguage: "*, ru;q=0.5", want: LocaleEN},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, c.Negotiate(tt.acceptLanguage))
+		})
+	}
+}
+
+func TestCatalog_Message(t *testing.T) {
+	t.Parallel()
+
+	c, err := New(LocaleEN, Messages{
+		LocaleEN: {"greeting": "hello", "farewell": "bye"},
+		LocaleRU: {"greeting": "привет"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "привет", c.Message(LocaleRU, "greeting"))
+	assert.Equal(t, "bye", c.Message(LocaleRU, "farewell"))
+	assert.Equal(t, "unknown", c.Message(LocaleRU, "unknown"))
+
+	_, ok := c.Lookup(LocaleRU, "farewell")
+	assert.False(t, ok)
+}
@@ -0,0 +1,98 @@
+// Package identifiers содержит проверку и приведение к каноническому виду стандартных идентификаторов
+// музыкальных записей, произведений и исполнителей: ISRC, ISWC, ISNI и MusicBrainz ID.
+package identifiers
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrInvalidFormat идентификатор не соответствует формату.
+	ErrInvalidFormat = errors.New("invalid identifier format")
+
+	// ErrInvalidChecksum контрольный символ идентификатора не совпадает с вычисленным.
+	ErrInvalidChecksum = errors.New("invalid identifier checksum")
+)
+
+var (
+	isrcRegexp = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{3}[0-9]{7}$`)
+	iswcRegexp = regexp.MustCompile(`^T[0-9]{10}$`)
+	isniRegexp = regexp.MustCompile(`^[0-9]{15}[0-9X]$`)
+	mbidRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+)
+
+// NormalizeISRC проверяет код ISRC записи и возвращает его канонический вид: 12 символов без дефисов
+// в верхнем регистре, например "USRC17607839". У ISRC нет контрольного символа.
+func NormalizeISRC(isrc string) (string, error) {
+	isrc = compact(isrc, "-")
+	if !isrcRegexp.MatchString(isrc) {
+		return "", ErrInvalidFormat
+	}
+
+	return isrc, nil
+}
+
+// NormalizeISWC проверяет код ISWC произведения и возвращает его канонический вид: буква T и 10 цифр
+// без разделителей, например "T0345246801". Последняя цифра является контрольной.
+func NormalizeISWC(iswc string) (string, error) {
+	iswc = compact(iswc, "-.")
+	if !iswcRegexp.MatchString(iswc) {
+		return "", ErrInvalidFormat
+	}
+
+	sum := 1
+	for i, c := range iswc[1:10] {
+		sum += (i + 1) * int(c-'0')
+	}
+	if check := (10 - sum%10) % 10; check != int(iswc[10]-'0') {
+		return "", ErrInvalidChecksum
+	}
+
+	return iswc, nil
+}
+
+// NormalizeISNI проверяет код ISNI исполнителя и возвращает его канонический вид: 16 символов без пробелов,
+// например "000000012281955X". Последний символ является контрольным по ISO 7064 MOD 11-2.
+func NormalizeISNI(isni string) (string, error) {
+	isni = compact(isni, " -")
+	if !isniRegexp.MatchString(isni) {
+		return "", ErrInvalidFormat
+	}
+
+	sum := 0
+	for _, c := range isni[:15] {
+		sum = (sum + int(c-'0')) * 2
+	}
+	check := byte('0' + (12-sum%11)%11)
+	if check == '0'+10 {
+		check = 'X'
+	}
+	if check != isni[15] {
+		return "", ErrInvalidChecksum
+	}
+
+	return isni, nil
+}
+
+// NormalizeMBID проверяет идентификатор MusicBrainz и возвращает его канонический вид: UUID в нижнем регистре.
+func NormalizeMBID(mbid string) (string, error) {
+	mbid = strings.ToLower(strings.TrimSpace(mbid))
+	if !mbidRegexp.MatchString(mbid) {
+		return "", ErrInvalidFormat
+	}
+
+	return mbid, nil
+}
+
+// compact приводит идентификатор к верхнему регистру и удаляет из него пробелы по краям и определенные разделители.
+func compact(id, separators string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(separators, r) {
+			return -1
+		}
+
+		return r
+	}, strings.ToUpper(strings.TrimSpace(id)))
+}
@@ -0,0 +1,89 @@
+package identifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		normalize func(string) (string, error)
+		in        string
+		want      string
+		wantErr   error
+	}{
+		{
+			name:      "NormalizeISRC with hyphens",
+			normalize: NormalizeISRC,
+			in:        "us-rc1-76-07839",
+			want:      "USRC17607839",
+		},
+		{
+			name:      "NormalizeISRC error format",
+			normalize: NormalizeISRC,
+			in:        "USRC1760783",
+			wantErr:   ErrInvalidFormat,
+		},
+		{
+			name:      "NormalizeISWC with separators",
+			normalize: NormalizeISWC,
+			in:        "T-034.524.680-1",
+			want:      "T0345246801",
+		},
+		{
+			name:      "NormalizeISWC error checksum",
+			normalize: NormalizeISWC,
+			in:        "T-034.524.680-2",
+			wantErr:   ErrInvalidChecksum,
+		},
+		{
+			name:      "NormalizeISWC error format",
+			normalize: NormalizeISWC,
+			in:        "034.524.680-1",
+			wantErr:   ErrInvalidFormat,
+		},
+		{
+			name:      "NormalizeISNI with spaces",
+			normalize: NormalizeISNI,
+			in:        "0000 0001 2103 2683",
+			want:      "0000000121032683",
+		},
+		{
+			name:      "NormalizeISNI with X check character",
+			normalize: NormalizeISNI,
+			in:        "0000 0001 2281 955x",
+			want:      "000000012281955X",
+		},
+		{
+			name:      "NormalizeISNI error checksum",
+			normalize: NormalizeISNI,
+			in:        "0000000121032684",
+			wantErr:   ErrInvalidChecksum,
+		},
+		{
+			name:      "NormalizeMBID upper case",
+			normalize: NormalizeMBID,
+			in:        "B10BBBFC-CF9E-42E0-BE17-E2C3E1D2600D",
+			want:      "b10bbbfc-cf9e-42e0-be17-e2c3e1d2600d",
+		},
+		{
+			name:      "NormalizeMBID error format",
+			normalize: NormalizeMBID,
+			in:        "b10bbbfccf9e42e0be17e2c3e1d2600d",
+			wantErr:   ErrInvalidFormat,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.normalize(tt.in)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Package jwt содержит проверку JSON Web Token (RFC 7519) с алгоритмами подписи HS256 и RS256
+// по локально заданным ключам.
+package jwt
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Поддерживаемые алгоритмы подписи.
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+)
+
+var (
+	// ErrMalformedToken токен не соответствует формату JWS Compact Serialization.
+	ErrMalformedToken = errors.New("malformed token")
+
+	// ErrUnsupportedAlgorithm алгоритм подписи токена не поддерживается.
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+
+	// ErrInvalidSignature подпись токена не подходит ни к одному ключу.
+	ErrInvalidSignature = errors.New("invalid token signature")
+
+	// ErrMissingExpiry у токена нет срока действия.
+	ErrMissingExpiry = errors.New("token has no expiration time")
+
+	// ErrTokenExpired срок действия токена истек.
+	ErrTokenExpired = errors.New("token expired")
+
+	// ErrTokenNotYetValid срок действия токена еще не наступил.
+	ErrTokenNotYetValid = errors.New("token not yet valid")
+
+	// ErrInvalidIssuer токен выпущен другим издателем.
+	ErrInvalidIssuer = errors.New("invalid token issuer")
+
+	// ErrInvalidAudience токен выпущен для другого получателя.
+	ErrInvalidAudience = errors.New("invalid token audience")
+)
+
+// Key это ключ проверки подписи токенов.
+type Key struct {
+	// ID это идентификатор ключа, который сравнивается с заголовком kid токена. Пустой ID подходит для любого токена.
+	ID string
+	// Secret это ключ HS256.
+	Secret []byte
+	// PublicKey это ключ RS256.
+	PublicKey *rsa.PublicKey
+}
+
+// alg возвращает алгоритм подписи, для которого подходит ключ.
+func (k Key) alg() string {
+	if k.PublicKey != nil {
+		return AlgRS256
+	}
+
+	return AlgHS256
+}
+
+// Claims это проверенные утверждения токена.
+type Claims struct {
+	Subject   string
+	Issuer    string
+	Audience  []string
+	ExpiresAt time.Time
+	NotBefore time.Time
+	// Raw содержит все утверждения токена.
+	Raw map[string]any
+}
+
+// Options это параметры проверки токенов.
+type Options struct {
+	// Issuer это ожидаемый издатель токена. Если не задан, то издатель не проверяется.
+	Issuer string
+	// Audience это ожидаемый получатель токена. Если не задан, то получатель не проверяется.
+	Audience string
+	// Leeway это допустимое расхождение часов при проверке сроков действия токена.
+	Leeway time.Duration
+}
+
+// Verifier проверяет подпись и утверждения токенов.
+type Verifier struct {
+	keys []Key
+	opts Options
+	now  func() time.Time
+}
+
+// NewVerifier создает объект проверки токенов по определенным ключам.
+func NewVerifier(keys []Key, opts Options) *Verifier {
+	return &Verifier{
+		keys: keys,
+		opts: opts,
+		now:  time.Now,
+	}
+}
+
+// header это заголовок JOSE токена.
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+// claims это зарегистрированные утверждения токена.
+type claims struct {
+	Subject   string   `json:"sub"`
+	Issuer    string   `json:"iss"`
+	Audience  audience `json:"aud"`
+	ExpiresAt *float64 `json:"exp"`
+	NotBefore *float64 `json:"nbf"`
+}
+
+// audience это утверждение aud, которое может быть строкой или массивом строк.
+type audience []string
+
+// UnmarshalJSON разбирает утверждение aud из строки или массива строк.
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*a = audience{s}
+		return nil
+	}
+
+	var ss []string
+	if err := json.Unmarshal(data, &ss); err != nil {
+		return err
+	}
+	*a = ss
+
+	return nil
+}
+
+// Verify проверяет подпись, срок действия, издателя и получателя токена и возвращает его утверждения.
+// Токены без утверждения exp не принимаются, потому что их нельзя отозвать.
+func (v *Verifier) Verify(token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, ErrMalformedToken
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return Claims{}, fmt.Errorf("%w: header: %w", ErrMalformedToken, err)
+	}
+	if h.Alg != AlgHS256 && h.Alg != AlgRS256 {
+		return Claims{}, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, h.Alg)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: signature: %w", ErrMalformedToken, err)
+	}
+	if !v.verifySignature(h, []byte(parts[0]+"."+parts[1]), sig) {
+		return Claims{}, ErrInvalidSignature
+	}
+
+	var c claims
+	if err := decodeSegment(parts[1], &c); err != nil {
+		return Claims{}, fmt.Errorf("%w: claims: %w", ErrMalformedToken, err)
+	}
+	var raw map[string]any
+	if err := decodeSegment(parts[1], &raw); err != nil {
+		return Claims{}, fmt.Errorf("%w: claims: %w", ErrMalformedToken, err)
+	}
+
+	result := Claims{
+		Subject:  c.Subject,
+		Issuer:   c.Issuer,
+		Audience: c.Audience,
+		Raw:      raw,
+	}
+
+	if c.ExpiresAt == nil {
+		return Claims{}, ErrMissingExpiry
+	}
+
+	now := v.now()
+	result.ExpiresAt = time.Unix(int64(*c.ExpiresAt), 0)
+	if !now.Before(result.ExpiresAt.Add(v.opts.Leeway)) {
+		return Claims{}, ErrTokenExpired
+	}
+	if c.NotBefore != nil {
+		result.NotBefore = time.Unix(int64(*c.NotBefore), 0)
+		if now.Add(v.opts.Leeway).Before(result.NotBefore) {
+			return Claims{}, ErrTokenNotYetValid
+		}
+	}
+
+	if v.opts.Issuer != "" && c.Issuer != v.opts.Issuer {
+		return Claims{}, ErrInvalidIssuer
+	}
+	if v.opts.Audience != "" && !slices.Contains(c.Audience, v.opts.Audience) {
+		return Claims{}, ErrInvalidAudience
+	}
+
+	return result, nil
+}
+
+// verifySignature проверяет подпись токена ключами, которые подходят по алгоритму и идентификатору.
+// Алгоритм ключа должен совпадать с алгоритмом из заголовка, чтобы открытый ключ RS256 нельзя было
+// использовать как секрет HS256.
+func (v *Verifier) verifySignature(h header, signed, sig []byte) bool {
+	for _, k := range v.keys {
+		if k.alg() != h.Alg || (k.ID != "" && h.Kid != "" && k.ID != h.Kid) {
+			continue
+		}
+
+		switch h.Alg {
+		case AlgHS256:
+			mac := hmac.New(sha256.New, k.Secret)
+			mac.Write(signed)
+			if hmac.Equal(mac.Sum(nil), sig) {
+				return true
+			}
+
+		case AlgRS256:
+			digest := sha256.Sum256(signed)
+			if rsa.VerifyPKCS1v15(k.PublicKey, crypto.SHA256, digest[:], sig) == nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// decodeSegment декодирует сегмент токена в формате base64url без дополнения и разбирает JSON.
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,321 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testSecret = []byte("secret")
+	testNow    = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+)
+
+// sign создает токен с определенным заголовком и утверждениями, подписанный секретом HS256 или ключом RS256.
+func sign(t *testing.T, h header, c map[string]any, secret []byte, key *rsa.PrivateKey) string {
+	t.Helper()
+
+	hb, err := json.Marshal(h)
+	require.NoError(t, err)
+	cb, err := json.Marshal(c)
+	require.NoError(t, err)
+
+	signed := base64.RawURLEncoding.EncodeToString(hb) + "." + base64.RawURLEncoding.EncodeToString(cb)
+
+	var sig []byte
+	switch h.Alg {
+	case AlgRS256:
+		digest := sha256.Sum256([]byte(signed))
+		sig, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	default:
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(signed))
+		sig = mac.Sum(nil)
+	}
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherRSAKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	keys := []Key{
+		{Secret: testSecret},
+		{ID: "rsa-1", PublicKey: &rsaKey.PublicKey},
+	}
+	opts := Options{Issuer: "issuer", Audience: "song-library", Leeway: time.Minute}
+
+	valid := func() map[string]any {
+		return map[string]any{
+			"sub": "user-1",
+			"iss": "issuer",
+			"aud": "song-library",
+			"exp": testNow.Add(time.Hour).Unix(),
+			"nbf": testNow.Add(-time.Hour).Unix(),
+		}
+	}
+	with := func(k string, v any) map[string]any {
+		c := valid()
+		if v == nil {
+			delete(c, k)
+		} else {
+			c[k] = v
+		}
+
+		return c
+	}
+
+	hs256 := header{Alg: AlgHS256}
+	rs256 := header{Alg: AlgRS256, Kid: "rsa-1"}
+
+	tests := []struct {
+		name        string
+		token       string
+		wantSubject string
+		wantErr     error
+	}{
+		{
+			name:        "HS256",
+			token:       sign(t, hs256, valid(), testSecret, nil),
+			wantSubject: "user-1",
+		},
+		{
+			name:        "RS256",
+			token:       sign(t, rs256, valid(), nil, rsaKey),
+			wantSubject: "user-1",
+		},
+		{
+			name:        "RS256 without kid",
+			token:       sign(t, header{Alg: AlgRS256}, valid(), nil, rsaKey),
+			wantSubject: "user-1",
+		},
+		{
+			name:        "Audience array",
+			token:       sign(t, hs256, with("aud", []string{"other", "song-library"}), testSecret, nil),
+			wantSubject: "user-1",
+		},
+		{
+			name:        "Expired within leeway",
+			token:       sign(t, hs256, with("exp", testNow.Add(-30*time.Second).Unix()), testSecret, nil),
+			wantSubject: "user-1",
+		},
+		{
+			name:    "Malformed token",
+			token:   "not-a-token",
+			wantErr: ErrMalformedToken,
+		},
+		{
+			name:    "Unsupported algorithm",
+			token:   sign(t, header{Alg: "none"}, valid(), testSecret, nil),
+			wantErr: ErrUnsupportedAlgorithm,
+		},
+		{
+			name:    "Wrong HS256 secret",
+			token:   sign(t, hs256, valid(), []byte("other"), nil),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "Wrong RS256 key",
+			token:   sign(t, rs256, valid(), nil, otherRSAKey),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "Unknown kid",
+			token:   sign(t, header{Alg: AlgRS256, Kid: "rsa-2"}, valid(), nil, rsaKey),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "RS256 public key as HS256 secret",
+			token: sign(t, hs256, valid(), func() []byte {
+				der, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+				require.NoError(t, err)
+
+				return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+			}(), nil),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "Expired",
+			token:   sign(t, hs256, with("exp", testNow.Add(-time.Hour).Unix()), testSecret, nil),
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name:    "Missing expiry",
+			token:   sign(t, hs256, with("exp", nil), testSecret, nil),
+			wantErr: ErrMissingExpiry,
+		},
+		{
+			name:    "Not yet valid",
+			token:   sign(t, hs256, with("nbf", testNow.Add(time.Hour).Unix()), testSecret, nil),
+			wantErr: ErrTokenNotYetValid,
+		},
+		{
+			name:    "Invalid issuer",
+			token:   sign(t, hs256, with("iss", "other"), testSecret, nil),
+			wantErr: ErrInvalidIssuer,
+		},
+		{
+			name:    "Invalid audience",
+			token:   sign(t, hs256, with("aud", nil), testSecret, nil),
+			wantErr: ErrInvalidAudience,
+		},
+	}
+
+	v := NewVerifier(keys, opts)
+	v.now = func() time.Time { return testNow }
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := v.Verify(tt.token)
+			assert.ErrorIsf(t, err, tt.wantErr, "Verifier.Verify() error = %v, wantErr %v", err, tt.wantErr)
+			assert.Equal(t, tt.wantSubject, got.Subject)
+		})
+	}
+}
+
+func TestParseJWKS(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	n := base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes())
+	e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes())
+	k := base64.RawURLEncoding.EncodeToString(testSecret)
+
+	tests := []struct {
+		name    string
+		data    string
+		wantIDs []string
+		wantErr error
+	}{
+		{
+			name: "RSA and oct keys",
+			data: fmt.Sprintf(`{"keys": [
+				{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": %q, "e": %q},
+				{"kty": "oct", "kid": "oct-1", "k": %q},
+				{"kty": "RSA", "kid": "enc-1", "use": "enc", "n": %q, "e": %q},
+				{"kty": "EC", "kid": "ec-1"}
+			]}`, n, e, k, n, e),
+			wantIDs: []string{"rsa-1", "oct-1"},
+		},
+		{
+			name:    "No usable keys",
+			data:    `{"keys": [{"kty": "EC", "kid": "ec-1"}]}`,
+			wantErr: ErrInvalidKey,
+		},
+		{
+			name:    "Malformed JSON",
+			data:    `{"keys": `,
+			wantErr: ErrInvalidKey,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseJWKS([]byte(tt.data))
+			assert.ErrorIsf(t, err, tt.wantErr, "ParseJWKS() error = %v, wantErr %v", err, tt.wantErr)
+
+			ids := make([]string, 0, len(got))
+			for _, key := range got {
+				ids = append(ids, key.ID)
+			}
+			assert.Equal(t, tt.wantIDs, nilIfEmpty(ids))
+		})
+	}
+
+	t.Run("Parsed RSA key verifies tokens", func(t *testing.T) {
+		t.Parallel()
+
+		keys, err := ParseJWKS([]byte(fmt.Sprintf(`{"keys": [{"kty": "RSA", "kid": "rsa-1", "n": %q, "e": %q}]}`, n, e)))
+		require.NoError(t, err)
+
+		v := NewVerifier(keys, Options{})
+		claims := map[string]any{"sub": "user-1", "exp": time.Now().Add(time.Hour).Unix()}
+		got, err := v.Verify(sign(t, header{Alg: AlgRS256, Kid: "rsa-1"}, claims, nil, rsaKey))
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", got.Subject)
+	})
+}
+
+func TestParseRSAPublicKeyPEM(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	pkix, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr error
+	}{
+		{
+			name: "PUBLIC KEY",
+			data: pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix}),
+		},
+		{
+			name: "RSA PUBLIC KEY",
+			data: pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)}),
+		},
+		{
+			name:    "Not PEM",
+			data:    []byte("key"),
+			wantErr: ErrInvalidKey,
+		},
+		{
+			name:    "Private key",
+			data:    pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+			wantErr: ErrInvalidKey,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseRSAPublicKeyPEM(tt.data)
+			assert.ErrorIsf(t, err, tt.wantErr, "ParseRSAPublicKeyPEM() error = %v, wantErr %v", err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.True(t, rsaKey.PublicKey.Equal(got))
+			}
+		})
+	}
+}
+
+// nilIfEmpty возвращает nil вместо пустого среза, чтобы сравнивать результат с незаданным ожиданием.
+func nilIfEmpty(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	return s
+}
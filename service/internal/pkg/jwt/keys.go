@@ -0,0 +1,115 @@
+package jwt
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrInvalidKey ключ не соответствует формату или не подходит для проверки подписи.
+var ErrInvalidKey = errors.New("invalid key")
+
+// ParseRSAPublicKeyPEM разбирает открытый ключ RSA в формате PEM (PUBLIC KEY или RSA PUBLIC KEY).
+func ParseRSAPublicKeyPEM(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%w: no PEM block", ErrInvalidKey)
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+		}
+
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("%w: not an RSA key", ErrInvalidKey)
+		}
+
+		return rsaKey, nil
+
+	case "RSA PUBLIC KEY":
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+		}
+
+		return key, nil
+
+	default:
+		return nil, fmt.Errorf("%w: unexpected PEM block %q", ErrInvalidKey, block.Type)
+	}
+}
+
+// jwk это ключ в формате JSON Web Key (RFC 7517).
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	K   string `json:"k"`
+}
+
+// ParseJWKS разбирает набор ключей в формате JSON Web Key Set.
+// Поддерживаются ключи RSA для RS256 и симметричные ключи для HS256. Ключи других типов, ключи для шифрования
+// и ключи для других алгоритмов пропускаются.
+func ParseJWKS(data []byte) ([]Key, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+
+	keys := make([]Key, 0, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		switch {
+		case k.Kty == "RSA" && (k.Alg == "" || k.Alg == AlgRS256):
+			n, err := base64.RawURLEncoding.DecodeString(k.N)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: n: %w", ErrInvalidKey, k.Kid, err)
+			}
+			e, err := base64.RawURLEncoding.DecodeString(k.E)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: e: %w", ErrInvalidKey, k.Kid, err)
+			}
+
+			exp := new(big.Int).SetBytes(e)
+			if len(n) == 0 || !exp.IsInt64() || exp.Int64() < 3 {
+				return nil, fmt.Errorf("%w: %s: invalid RSA modulus or exponent", ErrInvalidKey, k.Kid)
+			}
+
+			keys = append(keys, Key{
+				ID:        k.Kid,
+				PublicKey: &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())},
+			})
+
+		case k.Kty == "oct" && (k.Alg == "" || k.Alg == AlgHS256):
+			secret, err := base64.RawURLEncoding.DecodeString(k.K)
+			if err != nil || len(secret) == 0 {
+				return nil, fmt.Errorf("%w: %s: invalid symmetric key", ErrInvalidKey, k.Kid)
+			}
+
+			keys = append(keys, Key{ID: k.Kid, Secret: secret})
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("%w: no supported keys in key set", ErrInvalidKey)
+	}
+
+	return keys, nil
+}
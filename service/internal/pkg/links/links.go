@@ -0,0 +1,259 @@
+// Package links содержит приведение ссылок на песни к каноническому виду и распознавание
+// музыкальных сервисов, на которые они указывают.
+package links
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Провайдеры ссылок.
+const (
+	ProviderYouTube     = "youtube"
+	ProviderSpotify     = "spotify"
+	ProviderAppleMusic  = "apple_music"
+	ProviderDeezer      = "deezer"
+	ProviderSoundCloud  = "soundcloud"
+	ProviderYandexMusic = "yandex_music"
+)
+
+var (
+	// ErrUnsupportedScheme схема ссылки не поддерживается.
+	ErrUnsupportedScheme = errors.New("unsupported link scheme")
+
+	// ErrInvalidLink ссылка не является корректным абсолютным адресом.
+	ErrInvalidLink = errors.New("invalid link")
+)
+
+var (
+	youtubeIDRegexp    = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+	spotifyIDRegexp    = regexp.MustCompile(`^[A-Za-z0-9]{22}$`)
+	numericIDRegexp    = regexp.MustCompile(`^[0-9]+$`)
+	soundCloudIDRegexp = regexp.MustCompile(`^[a-z0-9_-]+$`)
+	countryRegexp      = regexp.MustCompile(`^[a-z]{2}$`)
+)
+
+// trackingParams это параметры запроса, которые используются только для отслеживания переходов.
+var trackingParams = map[string]struct{}{
+	"fbclid":  {},
+	"gclid":   {},
+	"yclid":   {},
+	"igshid":  {},
+	"si":      {},
+	"feature": {},
+	"ref":     {},
+	"ref_src": {},
+	"mc_cid":  {},
+	"mc_eid":  {},
+	"_hsenc":  {},
+	"_hsmi":   {},
+}
+
+// soundCloudReservedPaths это разделы SoundCloud, которые не являются профилями пользователей.
+var soundCloudReservedPaths = map[string]struct{}{
+	"discover": {},
+	"search":   {},
+	"stream":   {},
+	"charts":   {},
+	"you":      {},
+	"upload":   {},
+}
+
+// Link это ссылка в каноническом виде.
+// Для ссылок на известные музыкальные сервисы также заполнены провайдер и идентификатор записи в нем.
+type Link struct {
+	URL      string
+	Provider string
+	MediaID  string
+}
+
+// Normalize приводит ссылку к каноническому виду.
+// Ссылки на записи известных музыкальных сервисов, в том числе короткие, мобильные и URI Spotify,
+// перестраиваются в единый вид без лишних параметров. У остальных ссылок удаляются параметры отслеживания,
+// фрагмент и порт по умолчанию, а схема и хост приводятся к нижнему регистру.
+// Поддерживаются только схемы http и https.
+func Normalize(raw string) (Link, error) {
+	raw = strings.TrimSpace(raw)
+
+	if l, ok := spotifyURI(raw); ok {
+		return l, nil
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return Link{}, ErrInvalidLink
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return Link{}, ErrUnsupportedScheme
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" || u.User != nil {
+		return Link{}, ErrInvalidLink
+	}
+
+	if l, ok := recognize(host, u); ok {
+		return l, nil
+	}
+
+	if port := u.Port(); port != "" && !(u.Scheme == "http" && port == "80") && !(u.Scheme == "https" && port == "443") {
+		host = net.JoinHostPort(host, port)
+	}
+	u.Host = host
+	u.Fragment, u.RawFragment = "", ""
+	u.RawQuery = stripTrackingParams(u.Query()).Encode()
+	u.ForceQuery = false
+
+	return Link{URL: u.String()}, nil
+}
+
+// recognize распознает ссылку на запись известного музыкального сервиса.
+func recognize(host string, u *url.URL) (Link, bool) {
+	host = strings.TrimPrefix(host, "www.")
+	path := pathSegments(u.Path)
+
+	switch host {
+	case "youtube.com", "m.youtube.com", "music.youtube.com", "youtube-nocookie.com":
+		return youtube(path, u.Query())
+
+	case "youtu.be":
+		if len(path) == 1 {
+			return youtubeVideo(path[0])
+		}
+
+	case "open.spotify.com", "play.spotify.com":
+		// Ссылки могут содержать раздел локализации, например /intl-de/track/...
+		if len(path) == 3 && strings.HasPrefix(path[0], "intl-") {
+			path = path[1:]
+		}
+		if len(path) == 2 && path[0] == "track" {
+			return spotifyTrack(path[1])
+		}
+
+	case "music.apple.com":
+		return appleMusic(path, u.Query())
+
+	case "deezer.com":
+		if len(path) == 3 && countryRegexp.MatchString(path[0]) {
+			path = path[1:]
+		}
+		if len(path) == 2 && path[0] == "track" && numericIDRegexp.MatchString(path[1]) {
+			return Link{URL: "https://www.deezer.com/track/" + path[1], Provider: ProviderDeezer, MediaID: path[1]}, true
+		}
+
+	case "soundcloud.com", "m.soundcloud.com":
+		return soundCloud(path)
+
+	case "music.yandex.ru", "music.yandex.com", "music.yandex.by", "music.yandex.kz":
+		if len(path) == 4 && path[0] == "album" && path[2] == "track" &&
+			numericIDRegexp.MatchString(path[1]) && numericIDRegexp.MatchString(path[3]) {
+			return Link{
+				URL:      "https://music.yandex.ru/album/" + path[1] + "/track/" + path[3],
+				Provider: ProviderYandexMusic,
+				MediaID:  path[3],
+			}, true
+		}
+	}
+
+	return Link{}, false
+}
+
+// youtube распознает ссылку на видео YouTube: /watch?v=ID, /shorts/ID, /embed/ID, /v/ID и /live/ID.
+func youtube(path []string, q url.Values) (Link, bool) {
+	switch {
+	case len(path) == 1 && path[0] == "watch":
+		return youtubeVideo(q.Get("v"))
+
+	case len(path) == 2 && (path[0] == "shorts" || path[0] == "embed" || path[0] == "v" || path[0] == "live"):
+		return youtubeVideo(path[1])
+
+	default:
+		return Link{}, false
+	}
+}
+
+// youtubeVideo возвращает каноническую ссылку на видео YouTube.
+func youtubeVideo(id string) (Link, bool) {
+	if !youtubeIDRegexp.MatchString(id) {
+		return Link{}, false
+	}
+
+	return Link{URL: "https://www.youtube.com/watch?v=" + id, Provider: ProviderYouTube, MediaID: id}, true
+}
+
+// spotifyURI распознает URI трека Spotify вида spotify:track:ID.
+func spotifyURI(raw string) (Link, bool) {
+	parts := strings.Split(raw, ":")
+	if len(parts) != 3 || !strings.EqualFold(parts[0], "spotify") || parts[1] != "track" {
+		return Link{}, false
+	}
+
+	return spotifyTrack(parts[2])
+}
+
+// spotifyTrack возвращает каноническую ссылку на трек Spotify.
+func spotifyTrack(id string) (Link, bool) {
+	if !spotifyIDRegexp.MatchString(id) {
+		return Link{}, false
+	}
+
+	return Link{URL: "https://open.spotify.com/track/" + id, Provider: ProviderSpotify, MediaID: id}, true
+}
+
+// appleMusic распознает ссылку на песню Apple Music: /{страна}/song/{название}/{ID}
+// или ссылку на песню в альбоме /{страна}/album/{название}/{ID альбома}?i={ID}.
+func appleMusic(path []string, q url.Values) (Link, bool) {
+	if len(path) < 3 || !countryRegexp.MatchString(path[0]) {
+		return Link{}, false
+	}
+
+	var id string
+	switch path[1] {
+	case "song":
+		id = path[len(path)-1]
+	case "album":
+		id = q.Get("i")
+	}
+
+	if !numericIDRegexp.MatchString(id) {
+		return Link{}, false
+	}
+
+	return Link{URL: "https://music.apple.com/" + path[0] + "/song/" + id, Provider: ProviderAppleMusic, MediaID: id}, true
+}
+
+// soundCloud распознает ссылку на трек SoundCloud: /{пользователь}/{трек}.
+func soundCloud(path []string) (Link, bool) {
+	if len(path) != 2 {
+		return Link{}, false
+	}
+
+	user, track := strings.ToLower(path[0]), strings.ToLower(path[1])
+	if _, ok := soundCloudReservedPaths[user]; ok || track == "sets" || track == "tracks" ||
+		!soundCloudIDRegexp.MatchString(user) || !soundCloudIDRegexp.MatchString(track) {
+		return Link{}, false
+	}
+
+	return Link{URL: "https://soundcloud.com/" + user + "/" + track, Provider: ProviderSoundCloud, MediaID: user + "/" + track}, true
+}
+
+// pathSegments разбивает путь ссылки на непустые сегменты.
+func pathSegments(path string) []string {
+	return strings.FieldsFunc(path, func(r rune) bool { return r == '/' })
+}
+
+// stripTrackingParams удаляет параметры отслеживания из параметров запроса.
+func stripTrackingParams(q url.Values) url.Values {
+	for k := range q {
+		if _, ok := trackingParams[strings.ToLower(k)]; ok || strings.HasPrefix(strings.ToLower(k), "utm_") {
+			q.Del(k)
+		}
+	}
+
+	return q
+}
@@ -0,0 +1,110 @@
+package links
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		in      string
+		want    Link
+		wantErr error
+	}{
+		{
+			name: "Normalize YouTube short link",
+			in:   "https://youtu.be/Xsp3_a-PMTw?si=abc123&t=42",
+			want: Link{URL: "https://www.youtube.com/watch?v=Xsp3_a-PMTw", Provider: ProviderYouTube, MediaID: "Xsp3_a-PMTw"},
+		},
+		{
+			name: "Normalize YouTube mobile link with tracking params",
+			in:   "http://m.youtube.com/watch?feature=share&v=Xsp3_a-PMTw&utm_source=tg",
+			want: Link{URL: "https://www.youtube.com/watch?v=Xsp3_a-PMTw", Provider: ProviderYouTube, MediaID: "Xsp3_a-PMTw"},
+		},
+		{
+			name: "Normalize YouTube Music link",
+			in:   "https://music.youtube.com/watch?v=Xsp3_a-PMTw&list=RDAMVM",
+			want: Link{URL: "https://www.youtube.com/watch?v=Xsp3_a-PMTw", Provider: ProviderYouTube, MediaID: "Xsp3_a-PMTw"},
+		},
+		{
+			name: "Normalize YouTube shorts link",
+			in:   "https://www.youtube.com/shorts/Xsp3_a-PMTw",
+			want: Link{URL: "https://www.youtube.com/watch?v=Xsp3_a-PMTw", Provider: ProviderYouTube, MediaID: "Xsp3_a-PMTw"},
+		},
+		{
+			name: "Normalize Spotify URI",
+			in:   "spotify:track:3lPr8ghNDBLc2uZovNyLs9",
+			want: Link{URL: "https://open.spotify.com/track/3lPr8ghNDBLc2uZovNyLs9", Provider: ProviderSpotify, MediaID: "3lPr8ghNDBLc2uZovNyLs9"},
+		},
+		{
+			name: "Normalize Spotify localized link",
+			in:   "https://open.spotify.com/intl-de/track/3lPr8ghNDBLc2uZovNyLs9?si=0f1e2d",
+			want: Link{URL: "https://open.spotify.com/track/3lPr8ghNDBLc2uZovNyLs9", Provider: ProviderSpotify, MediaID: "3lPr8ghNDBLc2uZovNyLs9"},
+		},
+		{
+			name: "Normalize Apple Music album track link",
+			in:   "https://music.apple.com/us/album/black-holes-and-revelations/1440847466?i=1440847783",
+			want: Link{URL: "https://music.apple.com/us/song/1440847783", Provider: ProviderAppleMusic, MediaID: "1440847783"},
+		},
+		{
+			name: "Normalize Deezer link",
+			in:   "https://www.deezer.com/en/track/3135556?utm_campaign=share",
+			want: Link{URL: "https://www.deezer.com/track/3135556", Provider: ProviderDeezer, MediaID: "3135556"},
+		},
+		{
+			name: "Normalize SoundCloud mobile link",
+			in:   "https://m.soundcloud.com/Muse/Supermassive-Black-Hole?in=muse/sets/best",
+			want: Link{URL: "https://soundcloud.com/muse/supermassive-black-hole", Provider: ProviderSoundCloud, MediaID: "muse/supermassive-black-hole"},
+		},
+		{
+			name: "Normalize Yandex Music link",
+			in:   "https://music.yandex.com/album/3123/track/28392",
+			want: Link{URL: "https://music.yandex.ru/album/3123/track/28392", Provider: ProviderYandexMusic, MediaID: "28392"},
+		},
+		{
+			name: "Normalize unknown provider link",
+			in:   "HTTPS://Example.COM:443/songs/1?utm_medium=email&b=2&a=1#lyrics",
+			want: Link{URL: "https://example.com/songs/1?a=1&b=2"},
+		},
+		{
+			name: "Normalize YouTube playlist as unknown provider link",
+			in:   "https://www.youtube.com/playlist?list=PL123&si=abc",
+			want: Link{URL: "https://www.youtube.com/playlist?list=PL123"},
+		},
+		{
+			name:    "Normalize error unsupported scheme",
+			in:      "ftp://example.com/song.mp3",
+			wantErr: ErrUnsupportedScheme,
+		},
+		{
+			name:    "Normalize error javascript scheme",
+			in:      "javascript:alert(1)",
+			wantErr: ErrUnsupportedScheme,
+		},
+		{
+			name:    "Normalize error without scheme",
+			in:      "youtu.be/Xsp3_a-PMTw",
+			wantErr: ErrUnsupportedScheme,
+		},
+		{
+			name:    "Normalize error without host",
+			in:      "https:///songs/1",
+			wantErr: ErrInvalidLink,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Normalize(tt.in)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Normalize() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
@@ -1,8 +1,45 @@
 package logger
 
-import "log/slog"
+import (
+	"context"
+	"log/slog"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+)
 
 // ErrorString создает аттрибут slog для вывода текста ошибки.
 func ErrorString(err error) slog.Attr {
 	return slog.String("error", err.Error())
 }
+
+// Actor создает аттрибут slog для вывода аутентифицированного клиента, который выполняет операцию.
+// Если клиента нет в контексте, то операция выполняется анонимно.
+func Actor(ctx context.Context) slog.Attr {
+	if ctx == nil {
+		return slog.String("actor", "anonymous")
+	}
+
+	p, ok := principal.FromContext(ctx)
+	if !ok {
+		return slog.String("actor", "anonymous")
+	}
+
+	return slog.String("actor", p.String())
+}
+
+// Trace создает аттрибут slog для вывода идентификатора трассировки, в которой выполняется операция.
+// Если в контексте нет трассировки, то аттрибут пустой и не выводится.
+func Trace(ctx context.Context) slog.Attr {
+	if ctx == nil {
+		return slog.Attr{}
+	}
+
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return slog.Attr{}
+	}
+
+	return slog.String("trace_id", sc.TraceID().String())
+}
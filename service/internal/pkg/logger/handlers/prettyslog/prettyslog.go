@@ -7,6 +7,7 @@ import (
 	"log"
 	"log/slog"
 	"runtime"
+	"slices"
 	"time"
 
 	"github.com/fatih/color"
@@ -33,7 +34,7 @@ func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
 	return &Handler{
 		Handler: h.Handler.WithAttrs(attrs),
 		l:       h.l,
-		attrs:   attrs,
+		attrs:   append(slices.Clip(h.attrs), attrs...),
 	}
 }
 
@@ -66,12 +67,16 @@ func (h *Handler) Handle(_ context.Context, r slog.Record) error {
 	attrs[slog.MessageKey] = r.Message
 	attrs[slog.SourceKey] = recordSource(r)
 	r.Attrs(func(a slog.Attr) bool {
-		attrs[a.Key] = a.Value.Any()
+		if !a.Equal(slog.Attr{}) {
+			attrs[a.Key] = a.Value.Any()
+		}
 
 		return true
 	})
 	for _, a := range h.attrs {
-		attrs[a.Key] = a.Value.Any()
+		if !a.Equal(slog.Attr{}) {
+			attrs[a.Key] = a.Value.Any()
+		}
 	}
 
 	b, err := json.MarshalIndent(attrs, "", "  ")
@@ -0,0 +1,210 @@
+package lyrics
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+const (
+	// minLetterCount минимальное количество букв, необходимое для определения языка.
+	minLetterCount = 3
+	// minScore минимальная оценка совпадения с профилем языка, при которой язык считается определенным.
+	minScore = 0.05
+	// maxLetterBonus максимальная прибавка к оценке за характерные для языка буквы.
+	maxLetterBonus = 0.5
+)
+
+// trigramProfile это нормированный вектор частот символьных триграмм.
+type trigramProfile map[string]float64
+
+// languageModel это подготовленный профиль языка.
+type languageModel struct {
+	profile
+	trigrams trigramProfile
+	words    map[string]struct{}
+}
+
+// languageModels содержит подготовленные профили языков.
+var languageModels = buildModels(profiles)
+
+// DetectLanguage определяет язык текста песни без обращения к внешним сервисам.
+// Сначала определяется преобладающая письменность текста, затем, если письменность используется
+// несколькими языками, язык выбирается по символьным триграммам, частотным словам и характерным буквам.
+//
+// Возвращает код языка ISO 639-1 или пустую строку, если язык определить не удалось.
+func DetectLanguage(text string) string {
+	text = strings.ToLower(text)
+
+	script, letters := dominantScript(text)
+	if letters < minLetterCount {
+		return ""
+	}
+
+	if script != unicode.Latin && script != unicode.Cyrillic {
+		return scriptLanguage(script)
+	}
+
+	words := Words(text)
+	doc := newTrigramProfile(words)
+
+	var (
+		bestLang  string
+		bestScore float64
+	)
+	for _, m := range languageModels {
+		if m.script != script {
+			continue
+		}
+
+		score := doc.similarity(m.trigrams) + m.wordShare(words) + m.letterBonus(text, letters)
+		if score > bestScore {
+			bestLang, bestScore = m.lang, score
+		}
+	}
+
+	if bestScore < minScore {
+		return ""
+	}
+
+	return bestLang
+}
+
+// dominantScript возвращает письменность, которой написано большинство букв текста, и общее количество букв.
+func dominantScript(text string) (*unicode.RangeTable, int) {
+	scripts := []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic}
+	for _, sl := range scriptLanguages {
+		scripts = append(scripts, sl.script)
+	}
+
+	counts := make(map[*unicode.RangeTable]int, len(scripts))
+	letters := 0
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+
+		for _, s := range scripts {
+			if unicode.Is(s, r) {
+				counts[s]++
+				break
+			}
+		}
+	}
+
+	var (
+		best      *unicode.RangeTable
+		bestCount int
+	)
+	for _, s := range scripts {
+		if counts[s] > bestCount {
+			best, bestCount = s, counts[s]
+		}
+	}
+
+	// Японский текст обычно содержит иероглифы вперемешку с каной.
+	if best == unicode.Han && counts[unicode.Hiragana]+counts[unicode.Katakana] > 0 {
+		best = unicode.Hiragana
+	}
+
+	return best, letters
+}
+
+// scriptLanguage возвращает язык, однозначно определяемый письменностью.
+func scriptLanguage(script *unicode.RangeTable) string {
+	for _, sl := range scriptLanguages {
+		if sl.script == script {
+			return sl.lang
+		}
+	}
+
+	return ""
+}
+
+// buildModels подготавливает профили языков.
+func buildModels(profiles []profile) []languageModel {
+	models := make([]languageModel, len(profiles))
+	for i, p := range profiles {
+		words := strings.Fields(p.words)
+
+		set := make(map[string]struct{}, len(words))
+		for _, w := range words {
+			set[w] = struct{}{}
+		}
+
+		models[i] = languageModel{
+			profile:  p,
+			trigrams: newTrigramProfile(words),
+			words:    set,
+		}
+	}
+
+	return models
+}
+
+// wordShare возвращает долю слов текста, которые являются частотными словами языка.
+func (m languageModel) wordShare(words []string) float64 {
+	if len(words) == 0 {
+		return 0
+	}
+
+	hits := 0
+	for _, w := range words {
+		if _, ok := m.words[w]; ok {
+			hits++
+		}
+	}
+
+	return float64(hits) / float64(len(words))
+}
+
+// letterBonus возвращает прибавку к оценке за характерные для языка буквы в тексте.
+func (m languageModel) letterBonus(text string, letters int) float64 {
+	if m.letters == "" || letters == 0 {
+		return 0
+	}
+
+	hits := 0
+	for _, r := range text {
+		if strings.ContainsRune(m.letters, r) {
+			hits++
+		}
+	}
+
+	return math.Min(float64(hits)/float64(letters)*10, maxLetterBonus)
+}
+
+// newTrigramProfile строит нормированный профиль триграмм по словам.
+// Слова дополняются пробелами, чтобы учитывать начало и конец слова.
+func newTrigramProfile(words []string) trigramProfile {
+	p := make(trigramProfile)
+	for _, w := range words {
+		runes := []rune(" " + w + " ")
+		for i := 0; i+3 <= len(runes); i++ {
+			p[string(runes[i:i+3])]++
+		}
+	}
+
+	var norm float64
+	for _, v := range p {
+		norm += v * v
+	}
+	norm = math.Sqrt(norm)
+
+	for k, v := range p {
+		p[k] = v / norm
+	}
+
+	return p
+}
+
+// similarity возвращает косинусное сходство двух профилей.
+func (p trigramProfile) similarity(other trigramProfile) float64 {
+	var sum float64
+	for k, v := range p {
+		sum += v * other[k]
+	}
+
+	return sum
+}
@@ -0,0 +1,85 @@
+// Package lyrics содержит офлайн-анализ текстов песен: определение языка и подсчет статистики.
+package lyrics
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// CoupletSeparator это разделитель куплетов в тексте песни.
+const CoupletSeparator = "\n\n"
+
+// wordsPerMinute это средняя скорость чтения, используемая для оценки времени чтения текста.
+const wordsPerMinute = 180
+
+// Stats хранит статистику текста песни.
+type Stats struct {
+	// LineCount количество непустых строк.
+	LineCount uint32
+	// CoupletCount количество непустых куплетов.
+	CoupletCount uint32
+	// WordCount количество слов.
+	WordCount uint32
+	// UniqueWordRatio доля уникальных слов от общего количества слов.
+	UniqueWordRatio float64
+	// ReadingTime примерное время чтения текста в секундах.
+	ReadingTime uint32
+}
+
+// Analyze подсчитывает статистику текста песни.
+func Analyze(text string) Stats {
+	var stats Stats
+
+	for _, couplet := range strings.Split(normalizeNewlines(text), CoupletSeparator) {
+		if strings.TrimSpace(couplet) == "" {
+			continue
+		}
+		stats.CoupletCount++
+
+		for _, line := range strings.Split(couplet, "\n") {
+			if strings.TrimSpace(line) != "" {
+				stats.LineCount++
+			}
+		}
+	}
+
+	words := Words(text)
+	stats.WordCount = uint32(len(words))
+	if len(words) == 0 {
+		return stats
+	}
+
+	unique := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		unique[w] = struct{}{}
+	}
+
+	stats.UniqueWordRatio = math.Round(float64(len(unique))/float64(len(words))*1000) / 1000
+	stats.ReadingTime = uint32(math.Ceil(float64(len(words)) * 60 / wordsPerMinute))
+
+	return stats
+}
+
+// Words разбивает текст на слова в нижнем регистре.
+// Словом считается последовательность букв, цифр и апострофов внутри слова.
+func Words(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r) && !unicode.Is(unicode.Mn, r) && r != '\'' && r != '’'
+	})
+
+	words := make([]string, 0, len(fields))
+	for _, f := range fields {
+		f = strings.Trim(f, "'’")
+		if f != "" {
+			words = append(words, f)
+		}
+	}
+
+	return words
+}
+
+// normalizeNewlines приводит переводы строк к виду \n.
+func normalizeNewlines(text string) string {
+	return strings.ReplaceAll(text, "\r\n", "\n")
+}
@@ -0,0 +1,169 @@
+package lyrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want Stats
+	}{
+		{
+			name: "Analyze empty text",
+			text: "",
+			want: Stats{},
+		},
+		{
+			name: "Analyze two couplets",
+			text: "Hello, hello, baby\nYou called, I can't hear a thing\n\nI have got no service\nIn the club, you say",
+			want: Stats{
+				LineCount:       4,
+				CoupletCount:    2,
+				WordCount:       20,
+				UniqueWordRatio: 0.85,
+				ReadingTime:     7,
+			},
+		},
+		{
+			name: "Analyze ignores empty couplets and CRLF",
+			text: "Раз, два, три\r\n\r\n\r\n\r\nРаз, два",
+			want: Stats{
+				LineCount:       2,
+				CoupletCount:    2,
+				WordCount:       5,
+				UniqueWordRatio: 0.6,
+				ReadingTime:     2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, Analyze(tt.text))
+		})
+	}
+}
+
+func TestDetectLanguage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "DetectLanguage empty text",
+			text: "",
+			want: "",
+		},
+		{
+			name: "DetectLanguage english",
+			text: "Is this the real life? Is this just fantasy?\nCaught in a landslide, no escape from reality",
+			want: "en",
+		},
+		{
+			name: "DetectLanguage russian",
+			text: "Группа крови на рукаве, мой порядковый номер на рукаве.\nПожелай мне удачи в бою, пожелай мне",
+			want: "ru",
+		},
+		{
+			name: "DetectLanguage ukrainian",
+			text: "Ой у лузі червона калина похилилася,\nЧогось наша славна Україна зажурилася",
+			want: "uk",
+		},
+		{
+			name: "DetectLanguage german",
+			text: "Du hast mich gefragt und ich hab nichts gesagt.\nWillst du bis der Tod euch scheidet treu ihr sein für alle Tage?",
+			want: "de",
+		},
+		{
+			name: "DetectLanguage french",
+			text: "Non, rien de rien, non, je ne regrette rien.\nNi le bien qu'on m'a fait, ni le mal, tout ça m'est bien égal",
+			want: "fr",
+		},
+		{
+			name: "DetectLanguage spanish",
+			text: "Despacito, quiero respirar tu cuello despacito.\nDeja que te diga cosas al oído para que te acuerdes si no estás conmigo",
+			want: "es",
+		},
+		{
+			name: "DetectLanguage italian",
+			text: "Nel blu dipinto di blu, felice di stare lassù.\nE volavo, volavo felice più in alto del sole ed ancora più su",
+			want: "it",
+		},
+		{
+			name: "DetectLanguage japanese",
+			text: "上を向いて歩こう 涙がこぼれないように",
+			want: "ja",
+		},
+		{
+			name: "DetectLanguage korean",
+			text: "오빤 강남스타일 강남스타일",
+			want: "ko",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, DetectLanguage(tt.text))
+		})
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want float64
+	}{
+		{
+			name: "Similarity empty text",
+			a:    "",
+			b:    "Hello, hello, baby",
+			want: 0,
+		},
+		{
+			name: "Similarity ignores case, punctuation and line breaks",
+			a:    "Hello, hello, baby\nYou called",
+			b:    "hello hello BABY you called!",
+			want: 1,
+		},
+		{
+			name: "Similarity same words in different order",
+			a:    "one two three four",
+			b:    "four three two one",
+			want: 0,
+		},
+		{
+			name: "Similarity partial overlap",
+			a:    "one two three four",
+			b:    "one two three five",
+			want: 0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, Similarity(tt.a, tt.b))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package lyrics
+
+import "unicode"
+
+// profile описывает язык, который может быть определен по n-граммам.
+type profile struct {
+	// lang код языка ISO 639-1.
+	lang string
+	// script письменность, которой пользуется язык.
+	script *unicode.RangeTable
+	// words наиболее частотные слова языка в порядке убывания частоты.
+	// Из них строится профиль символьных триграмм.
+	words string
+	// letters буквы, характерные для языка и редко встречающиеся в остальных языках той же письменности.
+	letters string
+}
+
+// profiles содержит профили языков, которые различаются по n-граммам.
+var profiles = []profile{
+	{
+		lang:   "en",
+		script: unicode.Latin,
+		words: "the you and to i it me my a in of that is your on be all love we for what don't " +
+			"know this with just so no can oh but like when now baby up get down got go never " +
+			"one want let yeah time can't out feel heart there are night way away make tonight",
+	},
+	{
+		lang:   "de",
+		script: unicode.Latin,
+		words: "ich und die du der nicht das es ist in mich mir sie wir ein zu mit dich auf den " +
+			"so dir was ein auch wenn noch nur mein bin wie sich für hab kann doch einen alles " +
+			"immer wieder keine liebe nacht herz will sein über dass aus",
+		letters: "äöüß",
+	},
+	{
+		lang:   "fr",
+		script: unicode.Latin,
+		words: "je de la le et tu les pas que un moi des qui est une en me mon dans pour toi on " +
+			"ne ma plus il elle nous vous mais comme sur tout avec ce te ça suis j'ai c'est " +
+			"cœur amour jamais rien encore toujours quand",
+		letters: "àâæçèéêëîïôœùûÿ",
+	},
+	{
+		lang:   "es",
+		script: unicode.Latin,
+		words: "que de la y el me no en te mi a lo tu un por es se una los yo las con más mis " +
+			"para como pero si ya sin amor corazón vida quiero todo cuando esta eres siempre " +
+			"nada noche estoy donde tengo",
+		letters: "ñáíóú¿¡",
+	},
+	{
+		lang:   "it",
+		script: unicode.Latin,
+		words: "e di che la il non mi ti un a per è io tu me una sei ma le come con si del lo " +
+			"più se ci sono ho della anche amore cuore sempre questa tutto niente ancora " +
+			"quando perché voglio vita notte",
+		letters: "àèéìòù",
+	},
+	{
+		lang:   "pt",
+		script: unicode.Latin,
+		words: "que de não e o a eu me você é um uma do da em te meu minha se com por pra mais " +
+			"mas tu os as no na seu sua quando amor coração vida tudo nada sem quero sempre " +
+			"ainda também então estou",
+		letters: "ãõçâêôáéíóú",
+	},
+	{
+		lang:   "nl",
+		script: unicode.Latin,
+		words: "ik de je het een en van dat niet is in op me mij zijn maar wat met voor er ze " +
+			"we jij mijn als nog zo dan wil ben weet heb naar hart liefde nooit altijd " +
+			"alles niets meer wel",
+		letters: "ĳ",
+	},
+	{
+		lang:   "pl",
+		script: unicode.Latin,
+		words: "nie i w się to na że z jak mi mnie ja co ty jest do tak ale o już tylko czy " +
+			"mój moja tego jeszcze dla ten kiedy bo bez gdy serce miłość nigdy zawsze " +
+			"wszystko nic chcę jestem",
+		letters: "ąćęłńśźż",
+	},
+	{
+		lang:   "tr",
+		script: unicode.Latin,
+		words: "bir ve bu ben sen ne da de çok gibi için her o ama mi var yok seni beni benim " +
+			"senin aşk gönül kalp hiç daha kadar bana sana şimdi hep artık neden olmaz gel " +
+			"git yine",
+		letters: "ğışçöü",
+	},
+	{
+		lang:   "ru",
+		script: unicode.Cyrillic,
+		words: "я и не в ты на что с меня мне а как все это но так же по мы ты тебя тебе мой " +
+			"моя только было если он она нет за от когда уже ещё все любовь сердце никогда " +
+			"всегда ночь снова здесь себя чтобы",
+		letters: "ыэё",
+	},
+	{
+		lang:   "uk",
+		script: unicode.Cyrillic,
+		words: "і я не в та на що ти з мене мені а як все це але так же по ми тебе тобі мій " +
+			"моя тільки було якщо він вона ні за від коли вже ще любов серце ніколи " +
+			"завжди ніч знову тут себе щоб",
+		letters: "іїєґ",
+	},
+	{
+		lang:   "be",
+		script: unicode.Cyrillic,
+		words: "і я не ў ты на што з мяне мне а як усё гэта але так жа па мы цябе табе мой " +
+			"мая толькі было калі ён яна няма за ад ужо яшчэ каханне сэрца ніколі " +
+			"заўсёды ноч зноў тут сябе каб",
+		letters: "ўі",
+	},
+	{
+		lang:   "bg",
+		script: unicode.Cyrillic,
+		words: "и не да се на в аз ти е с че за ме като от но ще си то съм го тя той ни всичко " +
+			"само когато вече още там тук сърце любов никога винаги нощ отново мен теб " +
+			"искам няма",
+		letters: "ъ",
+	},
+	{
+		lang:   "sr",
+		script: unicode.Cyrillic,
+		words: "и је да у не се на ја ти са ме то за ми од али као што сам си ће све још кад " +
+			"ни само он она где ту срце љубав никад увек ноћ опет мене тебе хоћу нема",
+		letters: "ђјљњћџ",
+	},
+}
+
+// scriptLanguages сопоставляет письменности, однозначно определяющие язык, с кодом языка.
+var scriptLanguages = []struct {
+	lang   string
+	script *unicode.RangeTable
+}{
+	{lang: "el", script: unicode.Greek},
+	{lang: "ko", script: unicode.Hangul},
+	{lang: "ja", script: unicode.Hiragana},
+	{lang: "ja", script: unicode.Katakana},
+	{lang: "zh", script: unicode.Han},
+	{lang: "ar", script: unicode.Arabic},
+	{lang: "he", script: unicode.Hebrew},
+	{lang: "hi", script: unicode.Devanagari},
+	{lang: "th", script: unicode.Thai},
+	{lang: "ka", script: unicode.Georgian},
+	{lang: "hy", script: unicode.Armenian},
+}
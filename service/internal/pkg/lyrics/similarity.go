@@ -0,0 +1,38 @@
+package lyrics
+
+import "math"
+
+// Similarity возвращает сходство двух текстов от 0 до 1 как коэффициент Жаккара по парам соседних слов.
+// Пары слов учитывают порядок слов, поэтому разные песни с общей лексикой получают низкое сходство.
+// Текст из одного слова сравнивается по самому слову. Пустой текст не похож ни на какой другой.
+func Similarity(a, b string) float64 {
+	left, right := shingles(Words(a)), shingles(Words(b))
+	if len(left) == 0 || len(right) == 0 {
+		return 0
+	}
+
+	var common int
+	for s := range left {
+		if _, ok := right[s]; ok {
+			common++
+		}
+	}
+
+	union := len(left) + len(right) - common
+
+	return math.Round(float64(common)/float64(union)*1000) / 1000
+}
+
+// shingles возвращает множество пар соседних слов.
+func shingles(words []string) map[string]struct{} {
+	if len(words) == 1 {
+		return map[string]struct{}{words[0]: {}}
+	}
+
+	set := make(map[string]struct{}, len(words))
+	for i := 0; i+1 < len(words); i++ {
+		set[words[i]+" "+words[i+1]] = struct{}{}
+	}
+
+	return set
+}
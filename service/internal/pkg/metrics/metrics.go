@@ -0,0 +1,88 @@
+// Package metrics содержит метрики микросервиса в формате Prometheus. Метрики регистрируются в реестре Registry,
+// который отдается обработчиком Handler, и обновляются слоями микросервиса напрямую.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace это префикс названий метрик микросервиса.
+const namespace = "song_library"
+
+// Registry это реестр метрик микросервиса. Кроме метрик микросервиса содержит метрики среды выполнения Go и процесса.
+var Registry = newRegistry()
+
+// factory создает метрики, зарегистрированные в реестре Registry.
+var factory = promauto.With(Registry)
+
+var (
+	// HTTPRequests это количество обработанных запросов REST-API по методу, маршруту и коду статуса ответа.
+	HTTPRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of handled REST-API requests by method, route and response status.",
+	}, []string{"method", "route", "status"})
+
+	// HTTPRequestDuration это длительность обработки запросов REST-API по методу, маршруту и коду статуса ответа.
+	HTTPRequestDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "REST-API request handling duration by method, route and response status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// DBQueryDuration это длительность запросов к БД по операции и таблице.
+	DBQueryDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Database query duration by operation and table.",
+		Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "table"})
+
+	// SongsCreated это количество созданных песен.
+	SongsCreated = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "songs_created_total",
+		Help:      "Number of created songs.",
+	})
+
+	// SongsDeleted это количество песен, удаленных запросами удаления и слияния дубликатов. Песни, удаленные
+	// вместе с исполнителем, не учитываются.
+	SongsDeleted = factory.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "songs_deleted_total",
+		Help:      "Number of deleted songs, including merged duplicates.",
+	})
+
+	// SearchResults это количество найденных записей при поиске по виду записей.
+	SearchResults = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "search_results",
+		Help:      "Number of records found by search requests by resource.",
+		Buckets:   []float64{0, 1, 5, 10, 50, 100, 500, 1000, 5000, 10000},
+	}, []string{"resource"})
+)
+
+// newRegistry создает реестр с метриками среды выполнения Go и процесса.
+func newRegistry() *prometheus.Registry {
+	r := prometheus.NewRegistry()
+	r.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	return r
+}
+
+// Handler возвращает обработчик HTTP-запросов, который отдает метрики реестра Registry.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
@@ -0,0 +1,37 @@
+// Package names содержит нормализацию названий песен и исполнителей для сравнения без учета регистра и поиска дубликатов,
+// а также построение названий для сортировки.
+package names
+
+import "strings"
+
+// Normalize приводит название к нижнему регистру, удаляет пробелы по краям и схлопывает пробелы внутри.
+// Результат совпадает с SQL-выражением NormalizeSQL.
+func Normalize(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// sortArticles это артикли, которые переносятся в конец названия для сортировки.
+var sortArticles = map[string]bool{"the": true, "a": true, "an": true}
+
+// SortName возвращает название для сортировки: артикль в начале названия переносится в конец через запятую.
+// Например, "The Beatles" становится "Beatles, The". Пробелы схлопываются так же, как в Normalize.
+func SortName(name string) string {
+	words := strings.Fields(name)
+	if len(words) > 1 && sortArticles[strings.ToLower(words[0])] {
+		return strings.Join(words[1:], " ") + ", " + words[0]
+	}
+
+	return strings.Join(words, " ")
+}
+
+// NormalizeSQL возвращает SQL-выражение PostgreSQL, которое нормализует значение определенного столбца так же, как Normalize.
+func NormalizeSQL(column string) string {
+	return `LOWER(TRIM(REGEXP_REPLACE(` + column + `, '\s+', ' ', 'g')))`
+}
+
+// DuplicateKeySQL возвращает SQL-выражение PostgreSQL, которое строит ключ поиска дубликатов по значению определенного столбца:
+// название в нижнем регистре без артикля the в начале, знаков препинания и пробелов.
+// Например, "The Beatles" и "beatles", "AC/DC" и "ACDC" имеют одинаковый ключ.
+func DuplicateKeySQL(column string) string {
+	return `REGEXP_REPLACE(REGEXP_REPLACE(LOWER(` + column + `), '^\s*the\s+', ''), '[[:punct:][:space:]]+', '', 'g')`
+}
@@ -0,0 +1,78 @@
+package names
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "Normalize lowers case",
+			in:   "AC/DC",
+			want: "ac/dc",
+		},
+		{
+			name: "Normalize collapses whitespace",
+			in:   "  Guns\tN'\n  Roses ",
+			want: "guns n' roses",
+		},
+		{
+			name: "Normalize cyrillic",
+			in:   "Кино",
+			want: "кино",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, Normalize(tt.in))
+		})
+	}
+}
+
+func TestSortName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "SortName moves article to the end",
+			in:   "The Beatles",
+			want: "Beatles, The",
+		},
+		{
+			name: "SortName keeps article case",
+			in:   "a  Tribe Called Quest",
+			want: "Tribe Called Quest, a",
+		},
+		{
+			name: "SortName keeps single word",
+			in:   " The ",
+			want: "The",
+		},
+		{
+			name: "SortName keeps name without article",
+			in:   "Theatre of Tragedy",
+			want: "Theatre of Tragedy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, SortName(tt.in))
+		})
+	}
+}
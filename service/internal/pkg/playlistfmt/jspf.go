@@ -0,0 +1,62 @@
+package playlistfmt
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type jspfDocument struct {
+	Playlist jspfPlaylist `json:"playlist"`
+}
+
+type jspfPlaylist struct {
+	Title  string      `json:"title,omitempty"`
+	Tracks []jspfTrack `json:"track"`
+}
+
+type jspfTrack struct {
+	Locations []string `json:"location,omitempty"`
+	Title     string   `json:"title,omitempty"`
+	Creator   string   `json:"creator,omitempty"`
+}
+
+// encodeJSPF записывает плейлист в формате JSPF.
+func encodeJSPF(w io.Writer, p Playlist) error {
+	doc := jspfDocument{
+		Playlist: jspfPlaylist{
+			Title:  p.Title,
+			Tracks: make([]jspfTrack, len(p.Tracks)),
+		},
+	}
+	for i, t := range p.Tracks {
+		doc.Playlist.Tracks[i] = jspfTrack{Title: t.Title, Creator: t.Creator}
+		if t.Location != "" {
+			doc.Playlist.Tracks[i].Locations = []string{t.Location}
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(doc)
+}
+
+// decodeJSPF читает плейлист в формате JSPF.
+// Из нескольких ссылок записи используется первая.
+func decodeJSPF(r io.Reader) (Playlist, error) {
+	var doc jspfDocument
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return Playlist{}, fmt.Errorf("%w: %w", ErrMalformedPlaylist, err)
+	}
+
+	p := Playlist{
+		Title:  doc.Playlist.Title,
+		Tracks: make([]Track, len(doc.Playlist.Tracks)),
+	}
+	for i, t := range doc.Playlist.Tracks {
+		p.Tracks[i] = Track{Location: firstLocation(t.Locations), Title: t.Title, Creator: t.Creator}
+	}
+
+	return p, nil
+}
@@ -0,0 +1,90 @@
+package playlistfmt
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	m3u8Header     = "#EXTM3U"
+	m3u8Playlist   = "#PLAYLIST:"
+	m3u8Info       = "#EXTINF:"
+	m3u8TitleSplit = " - "
+)
+
+// encodeM3U8 записывает плейлист в формате M3U8.
+// Записи без ссылки пропускаются, так как в M3U8 ссылка является обязательной частью записи.
+func encodeM3U8(w io.Writer, p Playlist) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, m3u8Header)
+	if p.Title != "" {
+		fmt.Fprintln(bw, m3u8Playlist+oneLine(p.Title))
+	}
+
+	for _, t := range p.Tracks {
+		if t.Location == "" {
+			continue
+		}
+
+		info := oneLine(t.Title)
+		if t.Creator != "" {
+			info = oneLine(t.Creator) + m3u8TitleSplit + info
+		}
+		fmt.Fprintf(bw, "%s-1,%s\n%s\n", m3u8Info, info, oneLine(t.Location))
+	}
+
+	return bw.Flush()
+}
+
+// decodeM3U8 читает плейлист в формате M3U8. Также поддерживается простой M3U без директив.
+func decodeM3U8(r io.Reader) (Playlist, error) {
+	var (
+		p       Playlist
+		pending Track
+	)
+
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimSpace(strings.TrimPrefix(sc.Text(), "\ufeff"))
+
+		switch {
+		case line == "":
+			continue
+
+		case strings.HasPrefix(line, m3u8Playlist):
+			p.Title = strings.TrimSpace(strings.TrimPrefix(line, m3u8Playlist))
+
+		case strings.HasPrefix(line, m3u8Info):
+			_, info, ok := strings.Cut(strings.TrimPrefix(line, m3u8Info), ",")
+			if !ok {
+				return Playlist{}, fmt.Errorf("%w: invalid %s directive %q", ErrMalformedPlaylist, m3u8Info, line)
+			}
+
+			pending = Track{Title: strings.TrimSpace(info)}
+			if creator, title, ok := strings.Cut(info, m3u8TitleSplit); ok {
+				pending = Track{Creator: strings.TrimSpace(creator), Title: strings.TrimSpace(title)}
+			}
+
+		case strings.HasPrefix(line, "#"):
+			continue
+
+		default:
+			pending.Location = line
+			p.Tracks = append(p.Tracks, pending)
+			pending = Track{}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return Playlist{}, err
+	}
+
+	return p, nil
+}
+
+// oneLine заменяет переводы строк пробелами, так как M3U8 является построчным форматом.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,85 @@
+// Package playlistfmt содержит кодирование и декодирование плейлистов в форматах M3U8, XSPF и JSPF.
+package playlistfmt
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Format это формат файла плейлиста.
+type Format string
+
+// Все поддерживаемые форматы плейлистов.
+const (
+	// M3U8 это расширенный M3U в кодировке UTF-8.
+	M3U8 Format = "m3u8"
+	// XSPF это XML Shareable Playlist Format.
+	XSPF Format = "xspf"
+	// JSPF это JSON-представление XSPF.
+	JSPF Format = "jspf"
+)
+
+// ErrUnsupportedFormat формат плейлиста не поддерживается.
+var ErrUnsupportedFormat = errors.New("unsupported playlist format")
+
+// ErrMalformedPlaylist файл плейлиста не соответствует формату.
+var ErrMalformedPlaylist = errors.New("malformed playlist")
+
+// Playlist это плейлист, независимый от формата файла.
+type Playlist struct {
+	Title  string
+	Tracks []Track
+}
+
+// Track это запись плейлиста.
+type Track struct {
+	// Location это ссылка на песню.
+	Location string
+	// Title это название песни.
+	Title string
+	// Creator это исполнитель песни.
+	Creator string
+}
+
+// ContentType возвращает MIME-тип файла плейлиста определенного формата.
+func (f Format) ContentType() string {
+	switch f {
+	case M3U8:
+		return "application/vnd.apple.mpegurl"
+	case XSPF:
+		return "application/xspf+xml"
+	case JSPF:
+		return "application/json"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// Encode записывает плейлист в определенном формате.
+func Encode(w io.Writer, f Format, p Playlist) error {
+	switch f {
+	case M3U8:
+		return encodeM3U8(w, p)
+	case XSPF:
+		return encodeXSPF(w, p)
+	case JSPF:
+		return encodeJSPF(w, p)
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedFormat, f)
+	}
+}
+
+// Decode читает плейлист в определенном формате.
+func Decode(r io.Reader, f Format) (Playlist, error) {
+	switch f {
+	case M3U8:
+		return decodeM3U8(r)
+	case XSPF:
+		return decodeXSPF(r)
+	case JSPF:
+		return decodeJSPF(r)
+	default:
+		return Playlist{}, fmt.Errorf("%w: %q", ErrUnsupportedFormat, f)
+	}
+}
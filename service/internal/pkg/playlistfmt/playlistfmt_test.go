@@ -0,0 +1,130 @@
+package playlistfmt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedPlaylist = Playlist{
+	Title: "Road trip",
+	Tracks: []Track{
+		{Location: "https://example.com/1", Title: "Supermassive Black Hole", Creator: "Muse"},
+		{Location: "https://example.com/2", Title: "Song - With Dash", Creator: "Artist"},
+	},
+}
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	for _, f := range []Format{M3U8, XSPF, JSPF} {
+		f := f
+		t.Run(string(f), func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			require.NoError(t, Encode(&buf, f, expectedPlaylist))
+
+			got, err := Decode(&buf, f)
+			require.NoError(t, err)
+			assert.Equal(t, expectedPlaylist, got)
+		})
+	}
+}
+
+func TestEncode_M3U8(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	err := Encode(&buf, M3U8, Playlist{
+		Title: "Mix",
+		Tracks: []Track{
+			{Location: "https://example.com/1", Title: "One", Creator: "A"},
+			{Title: "Without link", Creator: "B"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "#EXTM3U\n#PLAYLIST:Mix\n#EXTINF:-1,A - One\nhttps://example.com/1\n", buf.String())
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		format  Format
+		data    string
+		want    Playlist
+		wantErr error
+	}{
+		{
+			name:   "Decode plain M3U",
+			format: M3U8,
+			data:   "\ufeff/music/one.mp3\r\n\r\n# comment\r\n/music/two.mp3\r\n",
+			want: Playlist{Tracks: []Track{
+				{Location: "/music/one.mp3"},
+				{Location: "/music/two.mp3"},
+			}},
+		},
+		{
+			name:   "Decode M3U8 info without creator",
+			format: M3U8,
+			data:   "#EXTM3U\n#EXTINF:215 tvg-id=\"x\",Only title\nhttps://example.com/1\n",
+			want:   Playlist{Tracks: []Track{{Location: "https://example.com/1", Title: "Only title"}}},
+		},
+		{
+			name:    "Decode M3U8 invalid info",
+			format:  M3U8,
+			data:    "#EXTM3U\n#EXTINF:215\nhttps://example.com/1\n",
+			wantErr: ErrMalformedPlaylist,
+		},
+		{
+			name:   "Decode XSPF without namespace and with several locations",
+			format: XSPF,
+			data: `<playlist version="1"><trackList><track>
+				<location>https://example.com/1</location><location>https://example.com/mirror</location>
+				<title>One</title><creator>A</creator></track></trackList></playlist>`,
+			want: Playlist{Tracks: []Track{{Location: "https://example.com/1", Title: "One", Creator: "A"}}},
+		},
+		{
+			name:    "Decode malformed XSPF",
+			format:  XSPF,
+			data:    "<playlist><trackList>",
+			wantErr: ErrMalformedPlaylist,
+		},
+		{
+			name:   "Decode JSPF without location",
+			format: JSPF,
+			data:   `{"playlist":{"title":"T","track":[{"title":"One","creator":"A"}]}}`,
+			want:   Playlist{Title: "T", Tracks: []Track{{Title: "One", Creator: "A"}}},
+		},
+		{
+			name:    "Decode malformed JSPF",
+			format:  JSPF,
+			data:    `{"playlist":`,
+			wantErr: ErrMalformedPlaylist,
+		},
+		{
+			name:    "Decode unsupported format",
+			format:  Format("pls"),
+			data:    "[playlist]",
+			wantErr: ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Decode(strings.NewReader(tt.data), tt.format)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
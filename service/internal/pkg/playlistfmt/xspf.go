@@ -0,0 +1,82 @@
+package playlistfmt
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// xspfNamespace это пространство имен XML формата XSPF.
+const xspfNamespace = "http://xspf.org/ns/0/"
+
+type xspfPlaylist struct {
+	XMLName xml.Name    `xml:"playlist"`
+	Version string      `xml:"version,attr"`
+	Xmlns   string      `xml:"xmlns,attr,omitempty"`
+	Title   string      `xml:"title,omitempty"`
+	Tracks  []xspfTrack `xml:"trackList>track"`
+}
+
+type xspfTrack struct {
+	Locations []string `xml:"location"`
+	Title     string   `xml:"title,omitempty"`
+	Creator   string   `xml:"creator,omitempty"`
+}
+
+// encodeXSPF записывает плейлист в формате XSPF.
+func encodeXSPF(w io.Writer, p Playlist) error {
+	xp := xspfPlaylist{
+		Version: "1",
+		Xmlns:   xspfNamespace,
+		Title:   p.Title,
+		Tracks:  make([]xspfTrack, len(p.Tracks)),
+	}
+	for i, t := range p.Tracks {
+		xp.Tracks[i] = xspfTrack{Title: t.Title, Creator: t.Creator}
+		if t.Location != "" {
+			xp.Tracks[i].Locations = []string{t.Location}
+		}
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(xp); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
+
+// decodeXSPF читает плейлист в формате XSPF.
+// Из нескольких ссылок записи используется первая.
+func decodeXSPF(r io.Reader) (Playlist, error) {
+	var xp xspfPlaylist
+	if err := xml.NewDecoder(r).Decode(&xp); err != nil {
+		return Playlist{}, fmt.Errorf("%w: %w", ErrMalformedPlaylist, err)
+	}
+
+	p := Playlist{
+		Title:  xp.Title,
+		Tracks: make([]Track, len(xp.Tracks)),
+	}
+	for i, t := range xp.Tracks {
+		p.Tracks[i] = Track{Location: firstLocation(t.Locations), Title: t.Title, Creator: t.Creator}
+	}
+
+	return p, nil
+}
+
+// firstLocation возвращает первую ссылку записи плейлиста.
+func firstLocation(locations []string) string {
+	if len(locations) == 0 {
+		return ""
+	}
+
+	return locations[0]
+}
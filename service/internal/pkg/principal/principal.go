@@ -0,0 +1,54 @@
+// Package principal содержит данные аутентифицированного клиента API и их передачу через контекст.
+package principal
+
+import (
+	"context"
+
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+)
+
+// Способы аутентификации клиента.
+const (
+	MethodAPIKey = "api_key"
+	MethodJWT    = "jwt"
+)
+
+// Principal это аутентифицированный клиент API.
+type Principal struct {
+	// Subject это название API-ключа или субъект токена.
+	Subject string
+	// Method это способ аутентификации клиента.
+	Method string
+	// Roles это роли клиента.
+	Roles []string
+	// Grants это шаблоны прав доступа, которые предоставляют роли клиента.
+	Grants []string
+	// Tenant это библиотека, к которой привязан клиент. Клиент без библиотеки работает с библиотекой по умолчанию,
+	// а с правом rbac.TenantsSwitch - с любой библиотекой.
+	Tenant string
+}
+
+// Can проверяет, что клиенту разрешена операция с определенным правом доступа.
+func (p Principal) Can(permission string) bool {
+	return rbac.Allowed(p.Grants, permission)
+}
+
+// String возвращает представление клиента для логов в виде способ:субъект.
+func (p Principal) String() string {
+	return p.Method + ":" + p.Subject
+}
+
+// contextKey это ключ клиента в контексте.
+type contextKey struct{}
+
+// NewContext добавляет в контекст аутентифицированного клиента.
+func NewContext(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext возвращает аутентифицированного клиента из контекста.
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(contextKey{}).(Principal)
+
+	return p, ok
+}
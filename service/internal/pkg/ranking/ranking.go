@@ -0,0 +1,43 @@
+// Package ranking содержит расчет рангов упорядоченных списков с промежутками между соседними рангами.
+// Промежутки позволяют вставлять и перемещать элементы, не изменяя ранги остальных элементов.
+package ranking
+
+// Gap это промежуток между соседними рангами после пересчета рангов.
+const Gap int64 = 1 << 16
+
+// Insert возвращает ранг элемента, который вставляется в определенную позицию списка.
+// ranks это упорядоченные по возрастанию положительные ранги элементов списка, pos это позиция, начиная с 0.
+// Позиция больше длины списка означает вставку в конец списка.
+// Возвращает false, если между соседними рангами нет свободного ранга и ранги списка нужно пересчитать через Spread.
+func Insert(ranks []int64, pos int) (int64, bool) {
+	if pos >= len(ranks) {
+		if len(ranks) == 0 {
+			return Gap, true
+		}
+
+		return ranks[len(ranks)-1] + Gap, true
+	}
+
+	var prev int64
+	if pos > 0 {
+		prev = ranks[pos-1]
+	}
+	next := ranks[pos]
+
+	rank := prev + (next-prev)/2
+	if rank <= prev || rank >= next {
+		return 0, false
+	}
+
+	return rank, true
+}
+
+// Spread возвращает ранги списка из n элементов, распределенные с равными промежутками.
+func Spread(n int) []int64 {
+	ranks := make([]int64, n)
+	for i := range ranks {
+		ranks[i] = int64(i+1) * Gap
+	}
+
+	return ranks
+}
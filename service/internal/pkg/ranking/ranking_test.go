@@ -0,0 +1,83 @@
+package ranking
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		ranks  []int64
+		pos    int
+		want   int64
+		wantOK bool
+	}{
+		{
+			name:   "Insert into empty list",
+			ranks:  nil,
+			pos:    0,
+			want:   Gap,
+			wantOK: true,
+		},
+		{
+			name:   "Insert to the end",
+			ranks:  []int64{Gap, 2 * Gap},
+			pos:    5,
+			want:   3 * Gap,
+			wantOK: true,
+		},
+		{
+			name:   "Insert to the beginning",
+			ranks:  []int64{Gap, 2 * Gap},
+			pos:    0,
+			want:   Gap / 2,
+			wantOK: true,
+		},
+		{
+			name:   "Insert between neighbours",
+			ranks:  []int64{Gap, 2 * Gap},
+			pos:    1,
+			want:   Gap + Gap/2,
+			wantOK: true,
+		},
+		{
+			name:   "Insert without free rank",
+			ranks:  []int64{1, 2},
+			pos:    1,
+			wantOK: false,
+		},
+		{
+			name:   "Insert to the beginning without free rank",
+			ranks:  []int64{1, 2},
+			pos:    0,
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := Insert(tt.ranks, tt.pos)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSpread(t *testing.T) {
+	t.Parallel()
+
+	ranks := Spread(3)
+	assert.Equal(t, []int64{Gap, 2 * Gap, 3 * Gap}, ranks)
+
+	for pos := 0; pos <= len(ranks); pos++ {
+		_, ok := Insert(ranks, pos)
+		assert.Truef(t, ok, "Insert() after Spread() has no free rank at position %d", pos)
+	}
+}
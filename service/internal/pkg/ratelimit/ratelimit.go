@@ -0,0 +1,131 @@
+// Package ratelimit содержит ограничение частоты запросов клиентов по алгоритму token bucket.
+// Каждому ключу (клиенту) соответствует корзина, в которую с постоянной скоростью добавляются токены,
+// а каждый запрос забирает из корзины один токен. Запрос без свободного токена отклоняется.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit это ограничение частоты запросов: в среднем Rate запросов в секунду и не более Burst запросов подряд.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Window возвращает время, за которое полностью заполняется пустая корзина.
+func (l Limit) Window() time.Duration {
+	if l.Rate <= 0 {
+		return 0
+	}
+
+	return time.Duration(float64(l.Burst) / l.Rate * float64(time.Second))
+}
+
+// Decision это результат проверки запроса.
+type Decision struct {
+	// Allowed сообщает, что запрос разрешен.
+	Allowed bool
+	// Remaining это количество запросов, которые можно выполнить сразу после этого запроса.
+	Remaining int
+	// Reset это время до полного заполнения корзины.
+	Reset time.Duration
+	// RetryAfter это время, через которое можно повторить отклоненный запрос.
+	RetryAfter time.Duration
+}
+
+// Limiter описывает поведение хранилища корзин токенов.
+type Limiter interface {
+	// Take забирает токен из корзины ключа, если корзина не пуста, и возвращает результат проверки запроса.
+	Take(ctx context.Context, key string, limit Limit) (Decision, error)
+}
+
+// sweepInterval это пауза между удалениями из памяти корзин, которые заполнились полностью.
+const sweepInterval = time.Minute
+
+// bucket это корзина токенов ключа.
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	// full это момент, когда корзина заполнится полностью при отсутствии запросов.
+	full time.Time
+}
+
+// Memory хранит корзины токенов в памяти процесса. Подходит для одного экземпляра микросервиса:
+// экземпляры с хранилищем Memory ограничивают запросы независимо друг от друга.
+// Заполненные корзины удаляются, так как не отличаются от новых.
+type Memory struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+var _ Limiter = (*Memory)(nil)
+
+// NewMemory создает новое хранилище корзин токенов в памяти.
+func NewMemory() *Memory {
+	return &Memory{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Take забирает токен из корзины ключа. Новая корзина заполнена полностью.
+// Ограничение с неположительной скоростью или размером корзины разрешает все запросы.
+func (m *Memory) Take(_ context.Context, key string, limit Limit) (Decision, error) {
+	if limit.Rate <= 0 || limit.Burst <= 0 {
+		return Decision{Allowed: true, Remaining: math.MaxInt}, nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		m.buckets[key] = b
+	}
+
+	elapsed := now.Sub(b.updated).Seconds()
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+	b.updated = now
+
+	d := Decision{Allowed: b.tokens >= 1}
+	if d.Allowed {
+		b.tokens--
+	} else {
+		d.RetryAfter = seconds((1 - b.tokens) / limit.Rate)
+	}
+
+	d.Remaining = int(b.tokens)
+	d.Reset = seconds((float64(limit.Burst) - b.tokens) / limit.Rate)
+	b.full = now.Add(d.Reset)
+
+	return d, nil
+}
+
+// sweep удаляет корзины, которые уже заполнились полностью.
+func (m *Memory) sweep(now time.Time) {
+	if now.Sub(m.lastSweep) < sweepInterval {
+		return
+	}
+	m.lastSweep = now
+
+	for key, b := range m.buckets {
+		if !now.Before(b.full) {
+			delete(m.buckets, key)
+		}
+	}
+}
+
+// seconds преобразует количество секунд в длительность.
+func seconds(s float64) time.Duration {
+	return time.Duration(math.Ceil(s * float64(time.Second)))
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemory_Take(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC)
+	m := NewMemory()
+	m.now = func() time.Time { return now }
+
+	limit := Limit{Rate: 2, Burst: 3}
+	ctx := context.Background()
+
+	for i := range 3 {
+		d, err := m.Take(ctx, "client", limit)
+		require.NoError(t, err)
+		assert.True(t, d.Allowed)
+		assert.Equal(t, 2-i, d.Remaining)
+	}
+
+	d, err := m.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed, "empty bucket must reject request")
+	assert.Equal(t, 0, d.Remaining)
+	assert.Equal(t, 500*time.Millisecond, d.RetryAfter)
+	assert.Equal(t, 1500*time.Millisecond, d.Reset)
+
+	d, err = m.Take(ctx, "other", limit)
+	require.NoError(t, err)
+	assert.True(t, d.Allowed, "buckets of different keys must be independent")
+
+	now = now.Add(500 * time.Millisecond)
+	d, err = m.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.True(t, d.Allowed, "bucket must refill with time")
+	assert.Equal(t, 0, d.Remaining)
+
+	now = now.Add(time.Hour)
+	d, err = m.Take(ctx, "client", limit)
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+	assert.Equal(t, 2, d.Remaining, "bucket must not exceed burst")
+}
+
+func TestMemory_TakeUnlimited(t *testing.T) {
+	t.Parallel()
+
+	m := NewMemory()
+	for range 10 {
+		d, err := m.Take(context.Background(), "client", Limit{})
+		require.NoError(t, err)
+		assert.True(t, d.Allowed)
+	}
+	assert.Empty(t, m.buckets)
+}
+
+func TestMemory_Sweep(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC)
+	m := NewMemory()
+	m.now = func() time.Time { return now }
+
+	limit := Limit{Rate: 1, Burst: 10}
+	_, _ = m.Take(context.Background(), "idle", limit)
+	now = now.Add(sweepInterval)
+	_, _ = m.Take(context.Background(), "active", limit)
+
+	assert.NotContains(t, m.buckets, "idle", "full bucket must be removed")
+	assert.Contains(t, m.buckets, "active")
+}
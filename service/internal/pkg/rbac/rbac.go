@@ -0,0 +1,122 @@
+// Package rbac содержит декларативную политику доступа к операциям микросервиса на основе ролей.
+// Право доступа записывается в формате ресурс:действие. Роль предоставляет права по шаблонам,
+// в которых вместо ресурса или действия можно указать *, а шаблон * предоставляет все права.
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Роли по умолчанию.
+const (
+	RoleViewer = "viewer"
+	RoleEditor = "editor"
+	RoleAdmin  = "admin"
+)
+
+// Права доступа к операциям.
+const (
+	SongsRead   = "songs:read"
+	SongsWrite  = "songs:write"
+	SongsDelete = "songs:delete"
+
+	ArtistsRead   = "artists:read"
+	ArtistsWrite  = "artists:write"
+	ArtistsDelete = "artists:delete"
+
+	AlbumsRead   = "albums:read"
+	AlbumsWrite  = "albums:write"
+	AlbumsDelete = "albums:delete"
+
+	TagsRead   = "tags:read"
+	TagsWrite  = "tags:write"
+	TagsDelete = "tags:delete"
+
+	PlaylistsRead   = "playlists:read"
+	PlaylistsWrite  = "playlists:write"
+	PlaylistsDelete = "playlists:delete"
+	PlaylistsImport = "playlists:import"
+
+	JobsRead = "jobs:read"
+
+	// TenantsSwitch разрешает клиенту, который не привязан к библиотеке, выбирать любую библиотеку.
+	TenantsSwitch = "tenants:switch"
+)
+
+// wildcard подходит для любого ресурса или действия.
+const wildcard = "*"
+
+// ErrInvalidPolicy политика содержит некорректный шаблон права доступа.
+var ErrInvalidPolicy = errors.New("invalid access policy")
+
+// DefaultRoles это права ролей по умолчанию. Читатель может искать и получать данные, редактор
+// дополнительно создавать и изменять их, а удаление, слияние записей и массовый импорт доступны только администратору.
+var DefaultRoles = map[string][]string{
+	RoleViewer: {"*:read"},
+	RoleEditor: {"*:read", "*:write"},
+	RoleAdmin:  {wildcard},
+}
+
+// Policy это политика доступа, которая сопоставляет ролям шаблоны прав доступа.
+type Policy struct {
+	roles map[string][]string
+}
+
+// NewPolicy создает политику доступа по шаблонам прав доступа каждой роли.
+func NewPolicy(roles map[string][]string) (*Policy, error) {
+	for role, patterns := range roles {
+		for _, pattern := range patterns {
+			if !validPattern(pattern) {
+				return nil, fmt.Errorf("%w: role %s: %q", ErrInvalidPolicy, role, pattern)
+			}
+		}
+	}
+
+	return &Policy{roles: roles}, nil
+}
+
+// validPattern проверяет, что шаблон права доступа это * или ресурс:действие.
+func validPattern(pattern string) bool {
+	if pattern == wildcard {
+		return true
+	}
+
+	resource, action, ok := strings.Cut(pattern, ":")
+
+	return ok && resource != "" && action != "" && !strings.Contains(action, ":")
+}
+
+// Grants возвращает шаблоны прав доступа, которые предоставляют определенные роли. Неизвестные роли пропускаются.
+func (p *Policy) Grants(roles []string) []string {
+	var grants []string
+	for _, role := range roles {
+		for _, pattern := range p.roles[role] {
+			if !slices.Contains(grants, pattern) {
+				grants = append(grants, pattern)
+			}
+		}
+	}
+
+	return grants
+}
+
+// Allowed проверяет, что хотя бы один шаблон предоставляет определенное право доступа.
+func Allowed(grants []string, permission string) bool {
+	resource, action, _ := strings.Cut(permission, ":")
+
+	for _, pattern := range grants {
+		if pattern == wildcard {
+			return true
+		}
+
+		r, a, _ := strings.Cut(pattern, ":")
+		if (r == wildcard || r == resource) && (a == wildcard || a == action) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,113 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPolicy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		roles   map[string][]string
+		wantErr error
+	}{
+		{
+			name:  "Default roles",
+			roles: DefaultRoles,
+		},
+		{
+			name:  "Exact permissions",
+			roles: map[string][]string{"importer": {PlaylistsImport, "songs:*"}},
+		},
+		{
+			name:    "No action",
+			roles:   map[string][]string{"broken": {"songs"}},
+			wantErr: ErrInvalidPolicy,
+		},
+		{
+			name:    "Empty resource",
+			roles:   map[string][]string{"broken": {":read"}},
+			wantErr: ErrInvalidPolicy,
+		},
+		{
+			name:    "Nested action",
+			roles:   map[string][]string{"broken": {"songs:read:all"}},
+			wantErr: ErrInvalidPolicy,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewPolicy(tt.roles)
+			assert.ErrorIsf(t, err, tt.wantErr, "NewPolicy() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestPolicy_DefaultRoles(t *testing.T) {
+	t.Parallel()
+
+	p, err := NewPolicy(DefaultRoles)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		roles      []string
+		permission string
+		want       bool
+	}{
+		{name: "Viewer reads songs", roles: []string{RoleViewer}, permission: SongsRead, want: true},
+		{name: "Viewer cannot change songs", roles: []string{RoleViewer}, permission: SongsWrite},
+		{name: "Editor changes songs", roles: []string{RoleEditor}, permission: SongsWrite, want: true},
+		{name: "Editor cannot delete artists", roles: []string{RoleEditor}, permission: ArtistsDelete},
+		{name: "Editor cannot import playlists", roles: []string{RoleEditor}, permission: PlaylistsImport},
+		{name: "Admin deletes artists", roles: []string{RoleAdmin}, permission: ArtistsDelete, want: true},
+		{name: "Admin imports playlists", roles: []string{RoleAdmin}, permission: PlaylistsImport, want: true},
+		{name: "Roles are combined", roles: []string{RoleViewer, RoleEditor}, permission: TagsWrite, want: true},
+		{name: "Unknown role", roles: []string{"guest"}, permission: SongsRead},
+		{name: "No roles", permission: SongsRead},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, Allowed(p.Grants(tt.roles), tt.permission))
+		})
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		grants     []string
+		permission string
+		want       bool
+	}{
+		{name: "Exact permission", grants: []string{SongsRead}, permission: SongsRead, want: true},
+		{name: "Other resource", grants: []string{SongsRead}, permission: ArtistsRead},
+		{name: "Any action", grants: []string{"songs:*"}, permission: SongsDelete, want: true},
+		{name: "Any resource", grants: []string{"*:delete"}, permission: ArtistsDelete, want: true},
+		{name: "Any permission", grants: []string{"*"}, permission: JobsRead, want: true},
+		{name: "No grants", permission: JobsRead},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, Allowed(tt.grants, tt.permission))
+		})
+	}
+}
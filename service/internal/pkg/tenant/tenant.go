@@ -0,0 +1,40 @@
+// Package tenant содержит идентификаторы библиотек (арендаторов) микросервиса и их передачу через контекст.
+// Данные каждой библиотеки доступны только клиентам, работающим с этой библиотекой.
+package tenant
+
+import (
+	"context"
+	"regexp"
+)
+
+// Default это библиотека, с которой работают клиенты, не выбравшие библиотеку явно.
+const Default = "default"
+
+// idPattern это допустимый формат идентификатора библиотеки.
+var idPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+// Valid проверяет, что идентификатор библиотеки имеет допустимый формат: до 64 строчных латинских букв,
+// цифр, символов "-" и "_", начиная с буквы или цифры.
+func Valid(id string) bool {
+	return idPattern.MatchString(id)
+}
+
+// contextKey это ключ библиотеки в контексте.
+type contextKey struct{}
+
+// NewContext добавляет в контекст библиотеку, с которой работает клиент.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext возвращает библиотеку из контекста. Запросы без библиотеки в контексте выполняются
+// самим микросервисом (фоновые задачи) и не ограничиваются одной библиотекой.
+func FromContext(ctx context.Context) (string, bool) {
+	if ctx == nil {
+		return "", false
+	}
+
+	id, ok := ctx.Value(contextKey{}).(string)
+
+	return id, ok
+}
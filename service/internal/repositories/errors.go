@@ -12,6 +12,57 @@ var (
 	// ErrArtistExists artist_name уже существует.
 	ErrArtistExists = errors.New("artist already exists")
 
+	// ErrArtistActiveYearsInvalid год распада исполнителя раньше года основания.
+	ErrArtistActiveYearsInvalid = errors.New("artist disbanded year precedes formed year")
+
+	// ErrArtistAliasNotFound псевдоним исполнителя не найден.
+	ErrArtistAliasNotFound = errors.New("artist alias not found")
+
+	// ErrArtistAliasExists псевдоним совпадает с названием или псевдонимом какого-либо исполнителя.
+	ErrArtistAliasExists = errors.New("artist alias already exists")
+
+	// ErrAlbumNotFound album_id не найден.
+	ErrAlbumNotFound = errors.New("album not found")
+
+	// ErrAlbumTrackConflict композиция уже есть в альбоме или ее позиция уже занята.
+	ErrAlbumTrackConflict = errors.New("album track conflicts with existing track")
+
+	// ErrTagNotFound tag_id не найден.
+	ErrTagNotFound = errors.New("tag not found")
+
+	// ErrTagExists метка с таким названием уже существует.
+	ErrTagExists = errors.New("tag already exists")
+
+	// ErrPlaylistNotFound playlist_id не найден.
+	ErrPlaylistNotFound = errors.New("playlist not found")
+
+	// ErrPlaylistItemNotFound песни нет в плейлисте.
+	ErrPlaylistItemNotFound = errors.New("song not found in playlist")
+
+	// ErrPlaylistItemExists песня уже есть в плейлисте.
+	ErrPlaylistItemExists = errors.New("song already exists in playlist")
+
+	// ErrSongRelationNotFound связи между песнями не существует.
+	ErrSongRelationNotFound = errors.New("song relation not found")
+
+	// ErrSongRelationExists связь между песнями уже существует.
+	ErrSongRelationExists = errors.New("song relation already exists")
+
+	// ErrSongRelationCycle связь приводит к тому, что песня становится оригиналом самой себя.
+	ErrSongRelationCycle = errors.New("song relation creates a cycle")
+
+	// ErrIdentifierExists стандартный идентификатор уже присвоен другой записи.
+	ErrIdentifierExists = errors.New("identifier already assigned")
+
+	// ErrSongLinkExists ссылка на эту запись музыкального сервиса уже есть у другой песни.
+	ErrSongLinkExists = errors.New("song link already attached to another song")
+
+	// ErrJobNotFound job_id не найден.
+	ErrJobNotFound = errors.New("job not found")
+
+	// ErrAPIKeyNotFound API-ключ не найден.
+	ErrAPIKeyNotFound = errors.New("api key not found")
+
 	// ErrPageNumberOutOfRange номер страницы выходит за границы допустимого диапазона страниц.
 	ErrPageNumberOutOfRange = errors.New("page number out of range")
 )
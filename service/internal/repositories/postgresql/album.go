@@ -0,0 +1,191 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/repositories"
+)
+
+// Album возвращает данные определенного альбома вместе с упорядоченным списком композиций.
+func (r *Repository) Album(ctx context.Context, id uint64) (models.Album, error) {
+	return r.album(r.db.WithContext(ctx), id)
+}
+
+// Albums возвращает альбомы без списков композиций, найденные по определенным параметрам, упорядоченные по названию.
+// Название альбома ищется по подстроке, исполнитель - по подстроке названия или любого псевдонима,
+// тип альбома - по точному совпадению.
+// Возвращает альбомы, общее количество найденных альбомов без учета пагинации, ошибку.
+func (r *Repository) Albums(ctx context.Context, attrs models.Album, p models.Pagination) (models.Albums, uint64, error) {
+	var (
+		albums models.Albums
+		total  int64
+	)
+
+	err := r.db.
+		WithContext(ctx).
+		Model(models.Album{}).
+		InnerJoins("Artist").
+		Scopes(
+			withSearchByStringColumn("albums", "title", attrs.Title),
+			withSearchByArtistName(`"Artist"`, attrs.Artist.Name),
+			withSearchByExactColumn("albums", "type", attrs.Type),
+		).
+		Count(&total).
+		Order(`"albums"."title", "albums"."id"`).
+		Scopes(withPagination(p)).
+		Find(&albums).
+		Error
+	if err != nil {
+		return models.Albums{}, 0, err
+	}
+
+	return albums, uint64(total), nil
+}
+
+// SaveAlbum сохраняет данные нового альбома вместе со списком композиций.
+func (r *Repository) SaveAlbum(ctx context.Context, a models.Album) (models.Album, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tracks := a.Tracks
+		if err := tx.Omit(clause.Associations).Create(&a).Error; err != nil {
+			return err
+		}
+
+		if err := replaceAlbumTracks(tx, a.ID, tracks); err != nil {
+			return err
+		}
+
+		saved, err := r.album(tx, a.ID)
+		if err != nil {
+			return err
+		}
+		a = saved
+
+		return nil
+	})
+	if err != nil {
+		return models.Album{}, albumError(err)
+	}
+
+	return a, nil
+}
+
+// UpdateAlbum обновляет данные определенного альбома.
+// Если список композиций не nil, то он полностью заменяет текущий список композиций альбома.
+func (r *Repository) UpdateAlbum(ctx context.Context, a models.Album) (models.Album, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Select("id").Take(&models.Album{}, a.ID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Album{ID: a.ID}).Omit(clause.Associations).Updates(&a).Error; err != nil {
+			return err
+		}
+
+		if a.Tracks != nil {
+			if err := tx.Where("album_id = ?", a.ID).Delete(&models.AlbumTrack{}).Error; err != nil {
+				return err
+			}
+
+			if err := replaceAlbumTracks(tx, a.ID, a.Tracks); err != nil {
+				return err
+			}
+		}
+
+		updated, err := r.album(tx, a.ID)
+		if err != nil {
+			return err
+		}
+		a = updated
+
+		return nil
+	})
+	if err != nil {
+		return models.Album{}, albumError(err)
+	}
+
+	return a, nil
+}
+
+// DeleteAlbum удаляет данные определенного альбома.
+func (r *Repository) DeleteAlbum(ctx context.Context, id uint64) (uint64, error) {
+	tx := r.db.WithContext(ctx).Delete(models.Album{ID: id})
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return 0, repositories.ErrAlbumNotFound
+	}
+
+	return id, nil
+}
+
+// album возвращает данные определенного альбома в рамках переданного подключения.
+func (r *Repository) album(db *gorm.DB, id uint64) (models.Album, error) {
+	var a models.Album
+	err := db.
+		InnerJoins("Artist").
+		Preload("Tracks", func(db *gorm.DB) *gorm.DB {
+			return db.Order("disc_number, track_number")
+		}).
+		Preload("Tracks.Song.Artist").
+		Preload("Tracks.Song.Credits.Artist").
+		Preload("Tracks.Song.Tags").
+		Take(&a, id).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.Album{}, repositories.ErrAlbumNotFound
+		}
+
+		return models.Album{}, err
+	}
+
+	return a, nil
+}
+
+// replaceAlbumTracks сохраняет композиции определенного альбома.
+func replaceAlbumTracks(tx *gorm.DB, albumID uint64, tracks models.AlbumTracks) error {
+	if len(tracks) == 0 {
+		return nil
+	}
+
+	for i := range tracks {
+		tracks[i].AlbumID = albumID
+	}
+
+	return tx.Omit(clause.Associations).Create(&tracks).Error
+}
+
+// albumError преобразует ошибки базы данных в ошибки слоя данных альбомов.
+func albumError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return repositories.ErrAlbumNotFound
+	}
+
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch {
+	case pgErr.ConstraintName == "fk_albums_artist":
+		return repositories.ErrArtistNotFound
+
+	case pgErr.ConstraintName == "fk_album_tracks_song":
+		return repositories.ErrSongNotFound
+
+	case pgErr.Code == pgerrcode.UniqueViolation:
+		return repositories.ErrAlbumTrackConflict
+
+	default:
+		return err
+	}
+}
@@ -0,0 +1,25 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/repositories"
+)
+
+// APIKeyByHash возвращает API-ключ по SHA-256 от ключа.
+func (r *Repository) APIKeyByHash(ctx context.Context, hash string) (models.APIKey, error) {
+	var k models.APIKey
+	if err := r.db.WithContext(ctx).Where(`"hash" = ?`, hash).Take(&k).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.APIKey{}, repositories.ErrAPIKeyNotFound
+		}
+
+		return models.APIKey{}, err
+	}
+
+	return k, nil
+}
@@ -10,6 +10,8 @@ import (
 	"gorm.io/gorm/clause"
 
 	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/names"
+	"github.com/sedonn/song-library-service/internal/pkg/tenant"
 	"github.com/sedonn/song-library-service/internal/repositories"
 )
 
@@ -27,34 +29,132 @@ func (r *Repository) Artist(ctx context.Context, id uint64) (models.Artist, erro
 	return s, nil
 }
 
+// Artists возвращает исполнителей, найденных по определенным параметрам, упорядоченных по названию для сортировки.
+// Название ищется по подстроке среди названий и псевдонимов, страна и тип исполнителя - по точному совпадению.
+// Возвращает исполнителей, общее количество найденных исполнителей без учета пагинации, ошибку.
+func (r *Repository) Artists(ctx context.Context, attrs models.Artist, p models.Pagination) (models.Artists, uint64, error) {
+	var (
+		artists models.Artists
+		total   int64
+	)
+
+	err := r.db.
+		WithContext(ctx).
+		Model(models.Artist{}).
+		Scopes(
+			withSearchByArtistName(`"artists"`, attrs.Name),
+			withSearchByExactColumn("artists", "country", attrs.Country),
+			withSearchByExactColumn("artists", "type", attrs.Type),
+		).
+		Count(&total).
+		Order(`"artists"."sort_name", "artists"."id"`).
+		Scopes(withPagination(p)).
+		Find(&artists).
+		Error
+	if err != nil {
+		return models.Artists{}, 0, err
+	}
+
+	return artists, uint64(total), nil
+}
+
 // SaveArtist сохраняет данные определенного исполнителя.
+// Название исполнителя должно быть уникальным без учета регистра и лишних пробелов среди названий и псевдонимов исполнителей.
 func (r *Repository) SaveArtist(ctx context.Context, a models.Artist) (models.Artist, error) {
-	if tx := r.db.WithContext(ctx).Clauses(clause.Returning{}).Create(&a); tx.Error != nil {
-		pgErr, ok := tx.Error.(*pgconn.PgError)
-		if ok && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
-			return models.Artist{}, repositories.ErrArtistExists
+	a.NormalizedName = names.Normalize(a.Name)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockArtistName(tx, a.NormalizedName); err != nil {
+			return err
 		}
 
-		return models.Artist{}, tx.Error
+		taken, err := artistAliasExists(tx, a.NormalizedName, 0)
+		if err != nil {
+			return err
+		}
+
+		if taken {
+			return repositories.ErrArtistExists
+		}
+
+		return tx.Clauses(clause.Returning{}).Omit(clause.Associations).Create(&a).Error
+	})
+	if err != nil {
+		switch {
+		case isIdentifierUniqueViolation(err):
+			return models.Artist{}, repositories.ErrIdentifierExists
+
+		case isUniqueViolation(err):
+			return models.Artist{}, repositories.ErrArtistExists
+
+		case isArtistActiveYearsError(err):
+			return models.Artist{}, repositories.ErrArtistActiveYearsInvalid
+
+		default:
+			return models.Artist{}, err
+		}
 	}
 
 	return a, nil
 }
 
 // UpdateArtist обновляет данные определенного исполнителя.
+// Новое название исполнителя может совпадать только с псевдонимами самого исполнителя, такой псевдоним удаляется.
 func (r *Repository) UpdateArtist(ctx context.Context, a models.Artist) (models.Artist, error) {
-	tx := r.db.WithContext(ctx).Clauses(clause.Returning{}).Updates(&a)
-	if tx.Error != nil {
-		pgErr, ok := tx.Error.(*pgconn.PgError)
-		if ok && pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) {
-			return models.Artist{}, repositories.ErrArtistExists
+	if a.Name != "" {
+		a.NormalizedName = names.Normalize(a.Name)
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if a.NormalizedName != "" {
+			if err := lockArtistName(tx, a.NormalizedName); err != nil {
+				return err
+			}
+
+			taken, err := artistAliasExists(tx, a.NormalizedName, a.ID)
+			if err != nil {
+				return err
+			}
+
+			if taken {
+				return repositories.ErrArtistExists
+			}
+
+			// Псевдоним, который стал названием исполнителя, больше не нужен.
+			err = tx.
+				Where("artist_id = ? AND normalized_name = ?", a.ID, a.NormalizedName).
+				Delete(&models.ArtistAlias{}).
+				Error
+			if err != nil {
+				return err
+			}
 		}
 
-		return models.Artist{}, tx.Error
-	}
+		res := tx.Clauses(clause.Returning{}).Omit(clause.Associations).Updates(&a)
+		if res.Error != nil {
+			return res.Error
+		}
 
-	if tx.RowsAffected == 0 {
-		return models.Artist{}, repositories.ErrArtistNotFound
+		if res.RowsAffected == 0 {
+			return repositories.ErrArtistNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case isIdentifierUniqueViolation(err):
+			return models.Artist{}, repositories.ErrIdentifierExists
+
+		case isUniqueViolation(err):
+			return models.Artist{}, repositories.ErrArtistExists
+
+		case isArtistActiveYearsError(err):
+			return models.Artist{}, repositories.ErrArtistActiveYearsInvalid
+
+		default:
+			return models.Artist{}, err
+		}
 	}
 
 	return a, nil
@@ -73,3 +173,115 @@ func (r *Repository) DeleteArtist(ctx context.Context, id uint64) (uint64, error
 
 	return id, nil
 }
+
+// ArtistAliases возвращает псевдонимы определенного исполнителя, упорядоченные по названию.
+func (r *Repository) ArtistAliases(ctx context.Context, artistID uint64) (models.ArtistAliases, error) {
+	var aliases models.ArtistAliases
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Select("id").Take(&models.Artist{}, artistID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repositories.ErrArtistNotFound
+			}
+
+			return err
+		}
+
+		return tx.Where("artist_id = ?", artistID).Order("name, id").Find(&aliases).Error
+	})
+	if err != nil {
+		return models.ArtistAliases{}, err
+	}
+
+	return aliases, nil
+}
+
+// SaveArtistAlias сохраняет новый псевдоним исполнителя.
+// Псевдоним должен быть уникальным без учета регистра и лишних пробелов среди названий и псевдонимов исполнителей.
+func (r *Repository) SaveArtistAlias(ctx context.Context, alias models.ArtistAlias) (models.ArtistAlias, error) {
+	alias.NormalizedName = names.Normalize(alias.Name)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockArtistName(tx, alias.NormalizedName); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&models.Artist{}).Where("normalized_name = ?", alias.NormalizedName).Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return repositories.ErrArtistAliasExists
+		}
+
+		return tx.Clauses(clause.Returning{}).Create(&alias).Error
+	})
+	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return models.ArtistAlias{}, repositories.ErrArtistAliasExists
+
+		case isArtistAliasArtistNotFoundError(err):
+			return models.ArtistAlias{}, repositories.ErrArtistNotFound
+
+		default:
+			return models.ArtistAlias{}, err
+		}
+	}
+
+	return alias, nil
+}
+
+// DeleteArtistAlias удаляет определенный псевдоним определенного исполнителя.
+func (r *Repository) DeleteArtistAlias(ctx context.Context, artistID, aliasID uint64) (uint64, error) {
+	tx := r.db.WithContext(ctx).Where("artist_id = ?", artistID).Delete(&models.ArtistAlias{ID: aliasID})
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return 0, repositories.ErrArtistAliasNotFound
+	}
+
+	return aliasID, nil
+}
+
+// lockArtistName блокирует нормализованное название исполнителя в библиотеке запроса до конца транзакции.
+// Уникальные индексы обеспечивают уникальность названий внутри таблиц исполнителей и псевдонимов,
+// а блокировка упорядочивает конкурентные проверки уникальности между этими таблицами.
+func lockArtistName(tx *gorm.DB, normalizedName string) error {
+	tenantID, _ := tenant.FromContext(tx.Statement.Context)
+
+	return tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "artist:"+tenantID+":"+normalizedName).Error
+}
+
+// artistAliasExists проверяет, есть ли псевдоним с определенным нормализованным названием у исполнителей,
+// кроме исполнителя с определенным ID.
+func artistAliasExists(tx *gorm.DB, normalizedName string, exceptArtistID uint64) (bool, error) {
+	var count int64
+	err := tx.
+		Model(&models.ArtistAlias{}).
+		Where("normalized_name = ? AND artist_id <> ?", normalizedName, exceptArtistID).
+		Count(&count).
+		Error
+
+	return count > 0, err
+}
+
+// isArtistActiveYearsError проверяет, является ли ошибка ошибкой ErrArtistActiveYearsInvalid.
+func isArtistActiveYearsError(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) &&
+		pgErr.Code == pgerrcode.CheckViolation &&
+		pgErr.ConstraintName == "chk_artists_active_years"
+}
+
+// isArtistAliasArtistNotFoundError проверяет, является ли ошибка ошибкой ErrArtistNotFound.
+func isArtistAliasArtistNotFoundError(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) &&
+		pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) &&
+		pgErr.ConstraintName == "fk_artists_aliases"
+}
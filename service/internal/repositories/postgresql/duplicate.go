@@ -0,0 +1,287 @@
+package postgresql
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/names"
+	"github.com/sedonn/song-library-service/internal/repositories"
+)
+
+var (
+	// songNameKey и songArtistKey это ключи дубликатов названий песни и исполнителя.
+	songNameKey   = names.DuplicateKeySQL(`"songs"."name"`)
+	songArtistKey = names.DuplicateKeySQL(`"Artist"."name"`)
+	// artistNameKey это ключ группировки дубликатов исполнителей.
+	artistNameKey = names.DuplicateKeySQL(`"artists"."name"`)
+)
+
+// duplicateRow это запись, которая входит в определенную группу дубликатов.
+type duplicateRow struct {
+	ID       uint64
+	GroupKey string
+}
+
+// DuplicateSongCandidates возвращает песни-кандидаты в дубликаты: песни исполнителей с одинаковым ключом
+// дубликатов названия, если таких песен больше одной. Песни упорядочены по ключу исполнителя и ID.
+func (r *Repository) DuplicateSongCandidates(ctx context.Context) ([]models.DuplicateSongCandidate, error) {
+	db := r.db.WithContext(ctx)
+
+	keys := db.
+		Model(&models.Song{}).
+		Select(songArtistKey + ` AS "artist_key"`).
+		Joins(`INNER JOIN "artists" "Artist" ON "songs"."artist_id" = "Artist"."id"`).
+		Group(`"artist_key"`).
+		Having("COUNT(*) > 1")
+
+	var candidates []models.DuplicateSongCandidate
+	err := db.
+		Model(&models.Song{}).
+		Select(`"songs"."id", `+songNameKey+` AS "name_key", "groups"."artist_key", "songs"."text"`).
+		Joins(`INNER JOIN "artists" "Artist" ON "songs"."artist_id" = "Artist"."id"`).
+		Joins(`INNER JOIN (?) AS "groups" ON "groups"."artist_key" = `+songArtistKey, keys).
+		Order(`"groups"."artist_key", "songs"."id"`).
+		Scan(&candidates).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// DuplicateArtists возвращает группы исполнителей, у которых совпадают ключи дубликатов названий.
+// Пагинация выполняется по группам, исполнители внутри группы упорядочены по ID.
+// Возвращает группы исполнителей, общее количество групп без учета пагинации, ошибку.
+func (r *Repository) DuplicateArtists(ctx context.Context, p models.Pagination) ([]models.Artists, uint64, error) {
+	var (
+		groups []models.Artists
+		total  int64
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		keys := tx.
+			Model(&models.Artist{}).
+			Select(artistNameKey + ` AS "group_key"`).
+			Group(`"group_key"`).
+			Having("COUNT(*) > 1")
+
+		if err := tx.Table(`(?) AS "groups"`, keys).Count(&total).Error; err != nil {
+			return err
+		}
+
+		var rows []duplicateRow
+		err := tx.
+			Model(&models.Artist{}).
+			Select(`"artists"."id", "groups"."group_key"`).
+			Joins(
+				`INNER JOIN (?) AS "groups" ON "groups"."group_key" = `+artistNameKey,
+				keys.Session(&gorm.Session{}).Order(`"group_key"`).Scopes(withPagination(p)),
+			).
+			Order(`"groups"."group_key", "artists"."id"`).
+			Scan(&rows).
+			Error
+		if err != nil || len(rows) == 0 {
+			return err
+		}
+
+		var artists models.Artists
+		if err := tx.Find(&artists, duplicateRowIDs(rows)).Error; err != nil {
+			return err
+		}
+
+		byID := make(map[uint64]models.Artist, len(artists))
+		for _, a := range artists {
+			byID[a.ID] = a
+		}
+		for i, row := range rows {
+			if i == 0 || rows[i-1].GroupKey != row.GroupKey {
+				groups = append(groups, models.Artists{})
+			}
+			groups[len(groups)-1] = append(groups[len(groups)-1], byID[row.ID])
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return groups, uint64(total), nil
+}
+
+// MergeSongs переносит участников, метки, места в альбомах и плейлистах и связи песни-дубликата
+// на основную песню и удаляет дубликат. Данные самой основной песни не изменяются.
+// Если основная песня уже есть в альбоме или плейлисте, место дубликата удаляется.
+// Слияние, после которого песня становится оригиналом самой себя, приводит к ошибке ErrSongRelationCycle.
+func (r *Repository) MergeSongs(ctx context.Context, id, duplicateID uint64) (models.Song, error) {
+	var s models.Song
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		found, err := lockMergedRows(tx, &models.Song{}, id, duplicateID)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return repositories.ErrSongNotFound
+		}
+
+		if err := tx.Exec(`LOCK TABLE "song_relations" IN SHARE ROW EXCLUSIVE MODE`).Error; err != nil {
+			return err
+		}
+
+		statements := []string{
+			`INSERT INTO "song_credits" ("tenant_id", "song_id", "artist_id", "role")
+			SELECT "tenant_id", @id, "artist_id", "role" FROM "song_credits" WHERE "song_id" = @duplicate
+			ON CONFLICT DO NOTHING`,
+			`INSERT INTO "song_tags" ("song_id", "tag_id")
+			SELECT @id, "tag_id" FROM "song_tags" WHERE "song_id" = @duplicate
+			ON CONFLICT DO NOTHING`,
+			`UPDATE "album_tracks" SET "song_id" = @id
+			WHERE "song_id" = @duplicate AND "album_id" NOT IN (SELECT "album_id" FROM "album_tracks" WHERE "song_id" = @id)`,
+			`UPDATE "playlist_items" SET "song_id" = @id
+			WHERE "song_id" = @duplicate AND "playlist_id" NOT IN (SELECT "playlist_id" FROM "playlist_items" WHERE "song_id" = @id)`,
+			`INSERT INTO "song_relations" ("tenant_id", "song_id", "original_id", "type")
+			SELECT "tenant_id", @id, "original_id", "type" FROM "song_relations" WHERE "song_id" = @duplicate AND "original_id" <> @id
+			ON CONFLICT DO NOTHING`,
+			`INSERT INTO "song_relations" ("tenant_id", "song_id", "original_id", "type")
+			SELECT "tenant_id", "song_id", @id, "type" FROM "song_relations" WHERE "original_id" = @duplicate AND "song_id" <> @id
+			ON CONFLICT DO NOTHING`,
+		}
+		if err := execMergeStatements(tx, statements, id, duplicateID); err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&models.Song{ID: duplicateID}).Error; err != nil {
+			return err
+		}
+
+		var originals []uint64
+		if err := tx.Model(&models.SongRelation{}).Where("song_id = ?", id).Pluck("original_id", &originals).Error; err != nil {
+			return err
+		}
+
+		if len(originals) > 0 {
+			cycle, err := originalsContain(tx, originals, id)
+			if err != nil {
+				return err
+			}
+
+			if cycle {
+				return repositories.ErrSongRelationCycle
+			}
+		}
+
+		s, err = r.song(tx, id)
+
+		return err
+	})
+	if err != nil {
+		return models.Song{}, err
+	}
+
+	return s, nil
+}
+
+// MergeArtists переносит песни, альбомы, участие в создании песен и псевдонимы исполнителя-дубликата
+// на основного исполнителя и удаляет дубликат. Название дубликата становится псевдонимом основного исполнителя.
+// Данные самого основного исполнителя не изменяются.
+func (r *Repository) MergeArtists(ctx context.Context, id, duplicateID uint64) (models.Artist, error) {
+	var a models.Artist
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		found, err := lockMergedRows(tx, &models.Artist{}, id, duplicateID)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return repositories.ErrArtistNotFound
+		}
+
+		var duplicate models.Artist
+		if err := tx.Take(&duplicate, duplicateID).Error; err != nil {
+			return err
+		}
+
+		if err := lockArtistName(tx, duplicate.NormalizedName); err != nil {
+			return err
+		}
+
+		statements := []string{
+			`UPDATE "songs" SET "artist_id" = @id WHERE "artist_id" = @duplicate`,
+			`UPDATE "albums" SET "artist_id" = @id WHERE "artist_id" = @duplicate`,
+			`INSERT INTO "song_credits" ("tenant_id", "song_id", "artist_id", "role")
+			SELECT "tenant_id", "song_id", @id, "role" FROM "song_credits" WHERE "artist_id" = @duplicate
+			ON CONFLICT DO NOTHING`,
+			`UPDATE "artist_aliases" SET "artist_id" = @id WHERE "artist_id" = @duplicate`,
+		}
+		if err := execMergeStatements(tx, statements, id, duplicateID); err != nil {
+			return err
+		}
+
+		if err := tx.Delete(&models.Artist{ID: duplicateID}).Error; err != nil {
+			return err
+		}
+
+		alias := models.ArtistAlias{
+			ArtistID:       id,
+			Name:           duplicate.Name,
+			NormalizedName: duplicate.NormalizedName,
+		}
+		if err := tx.Create(&alias).Error; err != nil {
+			return err
+		}
+
+		return tx.Take(&a, id).Error
+	})
+	if err != nil {
+		return models.Artist{}, err
+	}
+
+	return a, nil
+}
+
+// lockMergedRows блокирует строки основной записи и дубликата определенной модели до конца транзакции.
+// Строки блокируются в порядке ID, чтобы встречные слияния не приводили к взаимной блокировке.
+// Возвращает false, если какой-либо из записей не существует. Записи ищутся только в библиотеке запроса,
+// поэтому SQL-запросы слияния выполняются над записями одной библиотеки.
+func lockMergedRows(tx *gorm.DB, model any, id, duplicateID uint64) (bool, error) {
+	var locked []uint64
+	err := tx.
+		Model(model).
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Where("id IN ?", []uint64{id, duplicateID}).
+		Order("id").
+		Pluck("id", &locked).
+		Error
+	if err != nil {
+		return false, err
+	}
+
+	return len(locked) == 2, nil
+}
+
+// execMergeStatements выполняет SQL-запросы слияния с именованными параметрами @id и @duplicate.
+func execMergeStatements(tx *gorm.DB, statements []string, id, duplicateID uint64) error {
+	args := map[string]any{"id": id, "duplicate": duplicateID}
+	for _, stmt := range statements {
+		if err := tx.Exec(stmt, args).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// duplicateRowIDs возвращает ID всех записей групп дубликатов.
+func duplicateRowIDs(rows []duplicateRow) []uint64 {
+	ids := make([]uint64, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	return ids
+}
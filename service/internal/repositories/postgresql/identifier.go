@@ -0,0 +1,93 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/repositories"
+)
+
+// SongByISRC возвращает песню с определенным кодом ISRC в каноническом виде.
+func (r *Repository) SongByISRC(ctx context.Context, isrc string) (models.Song, error) {
+	var s models.Song
+	err := r.db.
+		WithContext(ctx).
+		InnerJoins("Artist").
+		Scopes(withSongAssociations).
+		Where(`"songs"."isrc" = ?`, isrc).
+		Take(&s).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.Song{}, repositories.ErrSongNotFound
+		}
+
+		return models.Song{}, err
+	}
+
+	return s, nil
+}
+
+// SongsByISWC возвращает все записи произведения с определенным кодом ISWC в каноническом виде,
+// упорядоченные по дате выхода.
+func (r *Repository) SongsByISWC(ctx context.Context, iswc string) (models.Songs, error) {
+	var songs models.Songs
+	err := r.db.
+		WithContext(ctx).
+		InnerJoins("Artist").
+		Scopes(withSongAssociations).
+		Where(`"songs"."iswc" = ?`, iswc).
+		Order(`"songs"."release_date", "songs"."id"`).
+		Find(&songs).
+		Error
+	if err != nil {
+		return models.Songs{}, err
+	}
+
+	return songs, nil
+}
+
+// ArtistByISNI возвращает исполнителя с определенным кодом ISNI в каноническом виде.
+func (r *Repository) ArtistByISNI(ctx context.Context, isni string) (models.Artist, error) {
+	return r.artistByIdentifier(ctx, "isni", isni)
+}
+
+// ArtistByMBID возвращает исполнителя с определенным идентификатором MusicBrainz в каноническом виде.
+func (r *Repository) ArtistByMBID(ctx context.Context, mbid string) (models.Artist, error) {
+	return r.artistByIdentifier(ctx, "mbid", mbid)
+}
+
+// artistByIdentifier возвращает исполнителя по значению определенного столбца стандартного идентификатора.
+func (r *Repository) artistByIdentifier(ctx context.Context, column, value string) (models.Artist, error) {
+	var a models.Artist
+	if err := r.db.WithContext(ctx).Where(map[string]any{column: value}).Take(&a).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.Artist{}, repositories.ErrArtistNotFound
+		}
+
+		return models.Artist{}, err
+	}
+
+	return a, nil
+}
+
+// isIdentifierUniqueViolation проверяет, является ли ошибка ошибкой ErrIdentifierExists.
+func isIdentifierUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != pgerrcode.UniqueViolation {
+		return false
+	}
+
+	switch pgErr.ConstraintName {
+	case "idx_songs_isrc", "idx_artists_isni", "idx_artists_mbid":
+		return true
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,151 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/repositories"
+)
+
+// Job возвращает определенную задачу.
+func (r *Repository) Job(ctx context.Context, id uint64) (models.Job, error) {
+	var j models.Job
+	if err := r.db.WithContext(ctx).Take(&j, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.Job{}, repositories.ErrJobNotFound
+		}
+
+		return models.Job{}, err
+	}
+
+	return j, nil
+}
+
+// Jobs выполняет поиск задач по песне, виду и статусу задачи. Новые задачи идут первыми.
+func (r *Repository) Jobs(ctx context.Context, attrs models.Job, p models.Pagination) (models.Jobs, uint64, error) {
+	var (
+		jobs  models.Jobs
+		total int64
+	)
+
+	db := r.db.
+		WithContext(ctx).
+		Model(models.Job{}).
+		Scopes(
+			withSearchByExactColumn("jobs", "type", attrs.Type),
+			withSearchByExactColumn("jobs", "status", attrs.Status),
+		)
+	if attrs.SongID != 0 {
+		db = db.Where(`"jobs"."song_id" = ?`, attrs.SongID)
+	}
+
+	err := db.
+		Count(&total).
+		Order(`"jobs"."id" DESC`).
+		Scopes(withPagination(p)).
+		Find(&jobs).
+		Error
+	if err != nil {
+		return models.Jobs{}, 0, err
+	}
+
+	return jobs, uint64(total), nil
+}
+
+// SaveJobs сохраняет новые задачи. Задачи ожидают выполнения начиная с текущего момента.
+func (r *Repository) SaveJobs(ctx context.Context, jobs models.Jobs) (models.Jobs, error) {
+	now := time.Now()
+	for i := range jobs {
+		jobs[i].Status = models.JobStatusPending
+		jobs[i].RunAt = now
+	}
+
+	if err := r.db.WithContext(ctx).Omit(clause.Associations).Create(&jobs).Error; err != nil {
+		if isJobSongNotFoundError(err) {
+			return models.Jobs{}, repositories.ErrSongNotFound
+		}
+
+		return models.Jobs{}, err
+	}
+
+	return jobs, nil
+}
+
+// ClaimJobs берет в работу не более limit задач, время выполнения которых наступило.
+// Задачи, уже взятые в работу другими обработчиками, пропускаются без ожидания.
+// Взятая в работу задача считается брошенной, если не завершена в течение lease.
+func (r *Repository) ClaimJobs(ctx context.Context, limit int, lease time.Duration) (models.Jobs, error) {
+	var jobs models.Jobs
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		err := tx.
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Where("status IN ? AND run_at <= ?", []string{models.JobStatusPending, models.JobStatusRunning}, now).
+			Order("run_at, id").
+			Limit(limit).
+			Find(&jobs).
+			Error
+		if err != nil {
+			return err
+		}
+
+		if len(jobs) == 0 {
+			return nil
+		}
+
+		ids := make([]uint64, len(jobs))
+		for i := range jobs {
+			jobs[i].Status = models.JobStatusRunning
+			jobs[i].RunAt = now.Add(lease)
+			jobs[i].Attempts++
+			jobs[i].UpdatedAt = now
+			ids[i] = jobs[i].ID
+		}
+
+		return tx.
+			Model(&models.Job{}).
+			Where("id IN ?", ids).
+			Updates(map[string]any{
+				"status":     models.JobStatusRunning,
+				"run_at":     now.Add(lease),
+				"attempts":   gorm.Expr("attempts + 1"),
+				"updated_at": now,
+			}).
+			Error
+	})
+	if err != nil {
+		return models.Jobs{}, err
+	}
+
+	return jobs, nil
+}
+
+// FinishJob сохраняет статус, время следующей попытки и ошибку задачи после попытки выполнения.
+// Если задача уже снова взята в работу как брошенная, то результат попытки не сохраняется.
+func (r *Repository) FinishJob(ctx context.Context, j models.Job) error {
+	return r.db.
+		WithContext(ctx).
+		Model(&models.Job{ID: j.ID}).
+		Where("attempts = ?", j.Attempts).
+		Select("status", "run_at", "last_error", "updated_at").
+		Updates(&j).
+		Error
+}
+
+// isJobSongNotFoundError проверяет, является ли ошибка ошибкой ErrSongNotFound.
+func isJobSongNotFoundError(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) && pgErr.ConstraintName == "fk_jobs_song"
+}
@@ -0,0 +1,107 @@
+package postgresql
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/repositories"
+)
+
+// SongsWithStaleLinks возвращает ID и библиотеки не более limit песен, ссылки которых не проверялись
+// или проверялись до checkedBefore. Песни, проверка ссылок которых уже стоит в очереди, пропускаются.
+// Давно не проверявшиеся ссылки идут первыми.
+func (r *Repository) SongsWithStaleLinks(ctx context.Context, checkedBefore time.Time, limit int) (models.Songs, error) {
+	var songs models.Songs
+	err := r.db.
+		WithContext(ctx).
+		Model(&models.Song{}).
+		Where(`"songs"."link" <> ''`).
+		Where(`"songs"."link_checked_at" IS NULL OR "songs"."link_checked_at" < ?`, checkedBefore).
+		Where(
+			`NOT EXISTS (
+				SELECT 1 FROM "jobs"
+				WHERE "jobs"."song_id" = "songs"."id" AND "jobs"."type" = ? AND "jobs"."status" IN ?
+			)`,
+			models.JobTypeValidateLink, []string{models.JobStatusPending, models.JobStatusRunning},
+		).
+		Order(`"songs"."link_checked_at" NULLS FIRST, "songs"."id"`).
+		Limit(limit).
+		Select(`"songs"."id"`, `"songs"."tenant_id"`).
+		Find(&songs).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return songs, nil
+}
+
+// UpdateSongLinkHealth сохраняет результат проверки доступности ссылки определенной песни.
+// Неудачная проверка увеличивает количество неудачных проверок подряд, успешная - сбрасывает его.
+func (r *Repository) UpdateSongLinkHealth(ctx context.Context, id uint64, statusCode int, broken bool) error {
+	streak := gorm.Expr("0")
+	if broken {
+		streak = gorm.Expr("link_failure_streak + 1")
+	}
+
+	tx := r.db.
+		WithContext(ctx).
+		Model(&models.Song{ID: id}).
+		UpdateColumns(map[string]any{
+			"link_status_code":    statusCode,
+			"link_checked_at":     time.Now(),
+			"link_failure_streak": streak,
+		})
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return repositories.ErrSongNotFound
+	}
+
+	return nil
+}
+
+// SongLinkStats возвращает количество песен со ссылками по статусу ссылки.
+func (r *Repository) SongLinkStats(ctx context.Context) (models.LinkStats, error) {
+	var stats models.LinkStats
+	err := r.db.
+		WithContext(ctx).
+		Model(&models.Song{}).
+		Select(
+			`COUNT(*) FILTER (WHERE "songs"."link_checked_at" IS NOT NULL AND "songs"."link_failure_streak" = 0) AS "ok"`,
+			`COUNT(*) FILTER (WHERE "songs"."link_failure_streak" > 0) AS "broken"`,
+			`COUNT(*) FILTER (WHERE "songs"."link_checked_at" IS NULL) AS "unchecked"`,
+		).
+		Where(`"songs"."link" <> ''`).
+		Scan(&stats).
+		Error
+	if err != nil {
+		return models.LinkStats{}, err
+	}
+
+	return stats, nil
+}
+
+// withSearchByLinkStatus добавляет поиск песен со ссылками по статусу ссылки.
+func withSearchByLinkStatus(status string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		switch status {
+		case models.LinkStatusOK:
+			return db.Where(`"songs"."link" <> '' AND "songs"."link_checked_at" IS NOT NULL AND "songs"."link_failure_streak" = 0`)
+
+		case models.LinkStatusBroken:
+			return db.Where(`"songs"."link" <> '' AND "songs"."link_failure_streak" > 0`)
+
+		case models.LinkStatusUnchecked:
+			return db.Where(`"songs"."link" <> '' AND "songs"."link_checked_at" IS NULL`)
+
+		default:
+			return db
+		}
+	}
+}
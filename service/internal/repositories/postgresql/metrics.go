@@ -0,0 +1,69 @@
+package postgresql
+
+import (
+	"cmp"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/sedonn/song-library-service/internal/pkg/metrics"
+)
+
+// queryStartKey это ключ настройки запроса с моментом начала запроса.
+const queryStartKey = "metrics:start"
+
+// queryMetrics это плагин GORM, который учитывает длительность запросов к БД по операции и таблице.
+// Длительность запроса включает выполнение всех обработчиков GORM, в том числе загрузку связанных записей.
+type queryMetrics struct{}
+
+// Name возвращает название плагина.
+func (queryMetrics) Name() string {
+	return "query_metrics"
+}
+
+// Initialize регистрирует обработчики запросов плагина.
+func (queryMetrics) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+
+	processors := []struct {
+		operation     string
+		before, after func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("*").Register, cb.Create().After("*").Register},
+		{"query", cb.Query().Before("*").Register, cb.Query().After("*").Register},
+		{"update", cb.Update().Before("*").Register, cb.Update().After("*").Register},
+		{"delete", cb.Delete().Before("*").Register, cb.Delete().After("*").Register},
+		{"row", cb.Row().Before("*").Register, cb.Row().After("*").Register},
+		{"raw", cb.Raw().Before("*").Register, cb.Raw().After("*").Register},
+	}
+	for _, p := range processors {
+		if err := p.before("metrics:"+p.operation+":start", startQuery); err != nil {
+			return err
+		}
+		if err := p.after("metrics:"+p.operation+":observe", observeQuery(p.operation)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startQuery запоминает момент начала запроса.
+func startQuery(db *gorm.DB) {
+	db.Statement.Settings.Store(queryStartKey, time.Now())
+}
+
+// observeQuery возвращает обработчик, который учитывает длительность запроса определенной операции.
+func observeQuery(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.Statement.Settings.LoadAndDelete(queryStartKey)
+		if !ok {
+			return
+		}
+
+		start, _ := v.(time.Time)
+		metrics.DBQueryDuration.
+			WithLabelValues(operation, cmp.Or(db.Statement.Table, "unknown")).
+			Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,48 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedonn/song-library-service/internal/pkg/metrics"
+)
+
+func TestQueryMetrics(t *testing.T) {
+	r, _ := newRecordingRepository(t)
+	require.NoError(t, r.db.Use(queryMetrics{}))
+
+	ctx := context.Background()
+	_, _ = r.Song(ctx, 1)
+	_, _ = r.IncrementQuotaUsage(ctx, "api_key:test", time.Now(), 1)
+
+	series := observedSeries(t)
+	assert.Contains(t, series, "query/songs")
+	assert.Contains(t, series, "create/quota_usages")
+}
+
+// observedSeries возвращает операции и таблицы запросов к БД, длительность которых учтена, в формате операция/таблица.
+func observedSeries(t *testing.T) []string {
+	mfs, err := metrics.Registry.Gather()
+	require.NoError(t, err)
+
+	var series []string
+	for _, mf := range mfs {
+		if mf.GetName() != "song_library_db_query_duration_seconds" {
+			continue
+		}
+
+		for _, m := range mf.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			series = append(series, labels["operation"]+"/"+labels["table"])
+		}
+	}
+
+	return series
+}
@@ -0,0 +1,342 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/ranking"
+	"github.com/sedonn/song-library-service/internal/repositories"
+)
+
+// Playlist возвращает данные определенного плейлиста.
+func (r *Repository) Playlist(ctx context.Context, id uint64) (models.Playlist, error) {
+	var p models.Playlist
+	if err := r.db.WithContext(ctx).Take(&p, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.Playlist{}, repositories.ErrPlaylistNotFound
+		}
+
+		return models.Playlist{}, err
+	}
+
+	return p, nil
+}
+
+// Playlists возвращает плейлисты без песен, найденные по подстроке названия, упорядоченные по названию.
+// Возвращает плейлисты, общее количество найденных плейлистов без учета пагинации, ошибку.
+func (r *Repository) Playlists(ctx context.Context, attrs models.Playlist, p models.Pagination) (models.Playlists, uint64, error) {
+	var (
+		playlists models.Playlists
+		total     int64
+	)
+
+	err := r.db.
+		WithContext(ctx).
+		Model(models.Playlist{}).
+		Scopes(withSearchByStringColumn("playlists", "name", attrs.Name)).
+		Count(&total).
+		Order(`"playlists"."name", "playlists"."id"`).
+		Scopes(withPagination(p)).
+		Find(&playlists).
+		Error
+	if err != nil {
+		return models.Playlists{}, 0, err
+	}
+
+	return playlists, uint64(total), nil
+}
+
+// PlaylistSongs возвращает песни определенного плейлиста в порядке их следования.
+// Возвращает песни, общее количество песен плейлиста без учета пагинации, ошибку.
+func (r *Repository) PlaylistSongs(ctx context.Context, id uint64, p models.Pagination) (models.Songs, uint64, error) {
+	var (
+		songs models.Songs
+		total int64
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Select("id").Take(&models.Playlist{}, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repositories.ErrPlaylistNotFound
+			}
+
+			return err
+		}
+
+		return tx.
+			Model(models.Song{}).
+			InnerJoins("Artist").
+			Joins(`INNER JOIN "playlist_items" ON "playlist_items"."song_id" = "songs"."id"`).
+			Where(`"playlist_items"."playlist_id" = ?`, id).
+			Count(&total).
+			Order(`"playlist_items"."rank", "playlist_items"."song_id"`).
+			Scopes(withPagination(p)).
+			Scopes(withSongAssociations).
+			Find(&songs).
+			Error
+	})
+	if err != nil {
+		return models.Songs{}, 0, err
+	}
+
+	return songs, uint64(total), nil
+}
+
+// SavePlaylist сохраняет данные нового плейлиста вместе с песнями в переданном порядке.
+func (r *Repository) SavePlaylist(ctx context.Context, p models.Playlist) (models.Playlist, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(&p).Error; err != nil {
+			return err
+		}
+
+		if len(p.Items) == 0 {
+			return nil
+		}
+
+		ranks := ranking.Spread(len(p.Items))
+		for i := range p.Items {
+			p.Items[i].PlaylistID = p.ID
+			p.Items[i].Rank = ranks[i]
+			p.Items[i].Position = uint32(i + 1)
+		}
+
+		return tx.Omit(clause.Associations).Create(&p.Items).Error
+	})
+	if err != nil {
+		switch {
+		case isPlaylistSongNotFoundError(err):
+			return models.Playlist{}, repositories.ErrSongNotFound
+		case isPlaylistItemUniqueViolation(err):
+			return models.Playlist{}, repositories.ErrPlaylistItemExists
+		}
+
+		return models.Playlist{}, err
+	}
+
+	return p, nil
+}
+
+// UpdatePlaylist обновляет данные определенного плейлиста.
+func (r *Repository) UpdatePlaylist(ctx context.Context, p models.Playlist) (models.Playlist, error) {
+	tx := r.db.WithContext(ctx).
+		Model(&p).
+		Clauses(clause.Returning{}).
+		Omit(clause.Associations).
+		Updates(&p)
+	if tx.Error != nil {
+		return models.Playlist{}, tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return models.Playlist{}, repositories.ErrPlaylistNotFound
+	}
+
+	return p, nil
+}
+
+// DeletePlaylist удаляет данные определенного плейлиста. Песни плейлиста не удаляются.
+func (r *Repository) DeletePlaylist(ctx context.Context, id uint64) (uint64, error) {
+	tx := r.db.WithContext(ctx).Delete(models.Playlist{ID: id})
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return 0, repositories.ErrPlaylistNotFound
+	}
+
+	return id, nil
+}
+
+// AddPlaylistSong добавляет песню в определенную позицию плейлиста.
+// Нулевая позиция или позиция больше количества песен означает добавление в конец плейлиста.
+func (r *Repository) AddPlaylistSong(ctx context.Context, item models.PlaylistItem) (models.PlaylistItem, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		items, err := lockPlaylistItems(tx, item.PlaylistID)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range items {
+			if v.SongID == item.SongID {
+				return repositories.ErrPlaylistItemExists
+			}
+		}
+
+		pos := playlistIndex(item.Position, len(items))
+		item.Rank, err = placePlaylistItem(tx, items, pos)
+		if err != nil {
+			return err
+		}
+		item.Position = uint32(pos + 1)
+
+		return tx.Omit(clause.Associations).Create(&item).Error
+	})
+	if err != nil {
+		switch {
+		case isPlaylistSongNotFoundError(err):
+			return models.PlaylistItem{}, repositories.ErrSongNotFound
+		case isPlaylistItemUniqueViolation(err):
+			return models.PlaylistItem{}, repositories.ErrPlaylistItemExists
+		}
+
+		return models.PlaylistItem{}, err
+	}
+
+	return item, nil
+}
+
+// MovePlaylistSong перемещает песню плейлиста в определенную позицию.
+// Позиция больше количества песен означает перемещение в конец плейлиста.
+func (r *Repository) MovePlaylistSong(ctx context.Context, item models.PlaylistItem) (models.PlaylistItem, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		items, err := lockPlaylistItems(tx, item.PlaylistID)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		others := make(models.PlaylistItems, 0, len(items))
+		for _, v := range items {
+			if v.SongID == item.SongID {
+				found = true
+				continue
+			}
+			others = append(others, v)
+		}
+		if !found {
+			return repositories.ErrPlaylistItemNotFound
+		}
+
+		pos := playlistIndex(item.Position, len(others))
+		item.Rank, err = placePlaylistItem(tx, others, pos)
+		if err != nil {
+			return err
+		}
+		item.Position = uint32(pos + 1)
+
+		return tx.
+			Model(&models.PlaylistItem{}).
+			Where("playlist_id = ? AND song_id = ?", item.PlaylistID, item.SongID).
+			Update("rank", item.Rank).
+			Error
+	})
+	if err != nil {
+		return models.PlaylistItem{}, err
+	}
+
+	return item, nil
+}
+
+// DeletePlaylistSong удаляет песню из определенного плейлиста.
+func (r *Repository) DeletePlaylistSong(ctx context.Context, playlistID, songID uint64) (uint64, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := lockPlaylistItems(tx, playlistID); err != nil {
+			return err
+		}
+
+		res := tx.Where("playlist_id = ? AND song_id = ?", playlistID, songID).Delete(&models.PlaylistItem{})
+		if res.Error != nil {
+			return res.Error
+		}
+
+		if res.RowsAffected == 0 {
+			return repositories.ErrPlaylistItemNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return songID, nil
+}
+
+// lockPlaylistItems блокирует определенный плейлист до конца транзакции и возвращает его песни,
+// упорядоченные по рангу. Блокировка упорядочивает конкурентные изменения состава плейлиста.
+func lockPlaylistItems(tx *gorm.DB, playlistID uint64) (models.PlaylistItems, error) {
+	err := tx.
+		Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+		Select("id").
+		Take(&models.Playlist{}, playlistID).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrPlaylistNotFound
+		}
+
+		return nil, err
+	}
+
+	var items models.PlaylistItems
+	err = tx.
+		Select("playlist_id", "song_id", "rank").
+		Where("playlist_id = ?", playlistID).
+		Order("rank, song_id").
+		Find(&items).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// placePlaylistItem возвращает ранг песни, которая вставляется в определенную позицию плейлиста, начиная с 0.
+// Если свободного ранга нет, то ранги остальных песен плейлиста пересчитываются.
+func placePlaylistItem(tx *gorm.DB, items models.PlaylistItems, pos int) (int64, error) {
+	if rank, ok := ranking.Insert(items.Ranks(), pos); ok {
+		return rank, nil
+	}
+
+	ranks := ranking.Spread(len(items))
+	for i, v := range items {
+		err := tx.
+			Model(&models.PlaylistItem{}).
+			Where("playlist_id = ? AND song_id = ?", v.PlaylistID, v.SongID).
+			Update("rank", ranks[i]).
+			Error
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	rank, _ := ranking.Insert(ranks, pos)
+
+	return rank, nil
+}
+
+// playlistIndex преобразует позицию песни, начиная с 1, в индекс среди count песен плейлиста.
+// Нулевая позиция или позиция больше количества песен означает конец плейлиста.
+func playlistIndex(position uint32, count int) int {
+	if position == 0 || int(position) > count {
+		return count
+	}
+
+	return int(position) - 1
+}
+
+// isPlaylistSongNotFoundError проверяет, является ли ошибка ошибкой ErrSongNotFound.
+func isPlaylistSongNotFoundError(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) &&
+		pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) &&
+		pgErr.ConstraintName == "fk_playlist_items_song"
+}
+
+// isPlaylistItemUniqueViolation проверяет, является ли ошибка ошибкой ErrPlaylistItemExists.
+func isPlaylistItemUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) &&
+		pgErr.Code == pgerrcode.UniqueViolation &&
+		pgErr.ConstraintName == "playlist_items_pkey"
+}
@@ -3,14 +3,24 @@ package postgresql
 import (
 	"fmt"
 
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"go.opentelemetry.io/otel"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
 	"github.com/sedonn/song-library-service/internal/config"
 	"github.com/sedonn/song-library-service/internal/domain/models"
 	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/metrics"
+	"github.com/sedonn/song-library-service/internal/services/album"
 	"github.com/sedonn/song-library-service/internal/services/artist"
+	"github.com/sedonn/song-library-service/internal/services/auth"
+	"github.com/sedonn/song-library-service/internal/services/duplicate"
+	"github.com/sedonn/song-library-service/internal/services/job"
+	"github.com/sedonn/song-library-service/internal/services/playlist"
+	"github.com/sedonn/song-library-service/internal/services/quota"
 	"github.com/sedonn/song-library-service/internal/services/song"
+	"github.com/sedonn/song-library-service/internal/services/tag"
 )
 
 // Repository содержит методы взаимодействия с базой данных PostgreSQL.
@@ -23,11 +33,45 @@ var (
 	_ song.SongSaver    = (*Repository)(nil)
 	_ song.SongUpdater  = (*Repository)(nil)
 	_ song.SongDeleter  = (*Repository)(nil)
+	_ song.SongTagger   = (*Repository)(nil)
+	_ song.SongRelator  = (*Repository)(nil)
+	_ song.SongMerger   = (*Repository)(nil)
+	_ song.JobSaver     = (*Repository)(nil)
 
-	_ artist.ArtistProvider = (*Repository)(nil)
-	_ artist.ArtistSaver    = (*Repository)(nil)
-	_ artist.ArtistUpdater  = (*Repository)(nil)
-	_ artist.ArtistDeleter  = (*Repository)(nil)
+	_ song.SongLinkHealthEditor = (*Repository)(nil)
+
+	_ artist.ArtistProvider    = (*Repository)(nil)
+	_ artist.ArtistSaver       = (*Repository)(nil)
+	_ artist.ArtistUpdater     = (*Repository)(nil)
+	_ artist.ArtistDeleter     = (*Repository)(nil)
+	_ artist.ArtistMerger      = (*Repository)(nil)
+	_ artist.ArtistAliasEditor = (*Repository)(nil)
+
+	_ album.AlbumProvider = (*Repository)(nil)
+	_ album.AlbumSaver    = (*Repository)(nil)
+	_ album.AlbumUpdater  = (*Repository)(nil)
+	_ album.AlbumDeleter  = (*Repository)(nil)
+
+	_ playlist.PlaylistProvider   = (*Repository)(nil)
+	_ playlist.PlaylistSaver      = (*Repository)(nil)
+	_ playlist.PlaylistUpdater    = (*Repository)(nil)
+	_ playlist.PlaylistDeleter    = (*Repository)(nil)
+	_ playlist.PlaylistItemEditor = (*Repository)(nil)
+	_ playlist.SongMatcher        = (*Repository)(nil)
+
+	_ tag.TagProvider = (*Repository)(nil)
+	_ tag.TagSaver    = (*Repository)(nil)
+	_ tag.TagUpdater  = (*Repository)(nil)
+	_ tag.TagDeleter  = (*Repository)(nil)
+
+	_ duplicate.DuplicateProvider = (*Repository)(nil)
+
+	_ job.JobProvider = (*Repository)(nil)
+	_ job.JobQueue    = (*Repository)(nil)
+
+	_ auth.APIKeyProvider = (*Repository)(nil)
+
+	_ quota.QuotaUsageIncrementer = (*Repository)(nil)
 )
 
 // New создает новый объект репозитория.
@@ -42,6 +86,27 @@ func New(cfg *config.Config) (*Repository, error) {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
+	if err := db.Use(tenantScope{}); err != nil {
+		return nil, fmt.Errorf("failed to register tenant scope: %w", err)
+	}
+
+	if err := db.Use(queryMetrics{}); err != nil {
+		return nil, fmt.Errorf("failed to register query metrics: %w", err)
+	}
+
+	if err := db.Use(newQueryTracing(otel.GetTracerProvider())); err != nil {
+		return nil, fmt.Errorf("failed to register query tracing: %w", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database connection pool: %w", err)
+	}
+
+	if err := metrics.Registry.Register(collectors.NewDBStatsCollector(sqlDB, cfg.DB.Database)); err != nil {
+		return nil, fmt.Errorf("failed to register connection pool metrics: %w", err)
+	}
+
 	return &Repository{db: db}, nil
 }
 
@@ -59,7 +124,18 @@ func withSearchByStringColumn(table, column, value string) func(db *gorm.DB) *go
 			return db
 		}
 
-		return db.Where(fmt.Sprintf("%q.%q ILIKE '%%%s%%'", table, column, value))
+		return db.Where(fmt.Sprintf("%q.%q ILIKE ?", table, column), "%"+value+"%")
+	}
+}
+
+// withSearchByExactColumn добавляет поиск по точному совпадению для определенного столбца определенной таблицы.
+func withSearchByExactColumn(table, column, value string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if value == "" {
+			return db
+		}
+
+		return db.Where(fmt.Sprintf("%q.%q = ?", table, column), value)
 	}
 }
 
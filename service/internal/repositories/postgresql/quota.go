@@ -0,0 +1,30 @@
+package postgresql
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+)
+
+// IncrementQuotaUsage увеличивает на n количество запросов клиента за сутки и возвращает новое количество.
+func (r *Repository) IncrementQuotaUsage(ctx context.Context, client string, day time.Time, n uint64) (uint64, error) {
+	u := models.QuotaUsage{Client: client, Day: day, Used: n}
+	err := r.db.WithContext(ctx).
+		Clauses(
+			clause.OnConflict{
+				Columns:   []clause.Column{{Name: "client"}, {Name: "day"}},
+				DoUpdates: clause.Set{{Column: clause.Column{Name: "used"}, Value: gorm.Expr(`"quota_usages"."used" + ?`, n)}},
+			},
+			clause.Returning{Columns: []clause.Column{{Name: "used"}}},
+		).
+		Create(&u).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return u.Used, nil
+}
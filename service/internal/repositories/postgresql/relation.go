@@ -0,0 +1,152 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/repositories"
+)
+
+// RelatedSongs возвращает все связи определенной песни в обоих направлениях вместе со связанными песнями.
+func (r *Repository) RelatedSongs(ctx context.Context, songID uint64) (models.SongRelations, error) {
+	var relations models.SongRelations
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Select("id").Take(&models.Song{}, songID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repositories.ErrSongNotFound
+			}
+
+			return err
+		}
+
+		err := tx.
+			Where("song_id = ? OR original_id = ?", songID, songID).
+			Order("type, song_id, original_id").
+			Find(&relations).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return loadRelatedSongs(tx, relations)
+	})
+	if err != nil {
+		return models.SongRelations{}, err
+	}
+
+	return relations, nil
+}
+
+// SaveSongRelation сохраняет связь производной песни с оригиналом.
+// Связь, после которой песня становится оригиналом самой себя, приводит к ошибке ErrSongRelationCycle.
+func (r *Repository) SaveSongRelation(ctx context.Context, rel models.SongRelation) (models.SongRelation, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Блокировка упорядочивает конкурентное добавление связей, иначе две встречные связи
+		// могут одновременно пройти проверку и образовать цикл. Чтение связей не блокируется.
+		if err := tx.Exec(`LOCK TABLE "song_relations" IN SHARE ROW EXCLUSIVE MODE`).Error; err != nil {
+			return err
+		}
+
+		cycle, err := originalsContain(tx, []uint64{rel.OriginalID}, rel.SongID)
+		if err != nil {
+			return err
+		}
+
+		if cycle {
+			return repositories.ErrSongRelationCycle
+		}
+
+		return tx.Omit(clause.Associations).Create(&rel).Error
+	})
+	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return models.SongRelation{}, repositories.ErrSongRelationExists
+
+		case isSongRelationSongNotFoundError(err):
+			return models.SongRelation{}, repositories.ErrSongNotFound
+
+		default:
+			return models.SongRelation{}, err
+		}
+	}
+
+	return rel, nil
+}
+
+// DeleteSongRelation удаляет связь производной песни с оригиналом.
+func (r *Repository) DeleteSongRelation(ctx context.Context, rel models.SongRelation) (models.SongRelation, error) {
+	tx := r.db.WithContext(ctx).
+		Where("song_id = ? AND original_id = ? AND type = ?", rel.SongID, rel.OriginalID, rel.Type).
+		Delete(&models.SongRelation{})
+	if tx.Error != nil {
+		return models.SongRelation{}, tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return models.SongRelation{}, repositories.ErrSongRelationNotFound
+	}
+
+	return rel, nil
+}
+
+// originalsContain проверяет, входит ли определенная песня в цепочки оригиналов, которые начинаются с переданных песен.
+// Сами переданные песни также входят в цепочки.
+func originalsContain(tx *gorm.DB, from []uint64, songID uint64) (bool, error) {
+	var found bool
+	err := tx.Raw(
+		`WITH RECURSIVE "originals" ("id") AS (
+			SELECT "id" FROM "songs" WHERE "id" IN ?
+			UNION
+			SELECT "song_relations"."original_id" FROM "song_relations"
+			INNER JOIN "originals" ON "song_relations"."song_id" = "originals"."id"
+		)
+		SELECT EXISTS (SELECT 1 FROM "originals" WHERE "id" = ?)`,
+		from, songID,
+	).Scan(&found).Error
+
+	return found, err
+}
+
+// loadRelatedSongs загружает песни с исполнителями и метками для всех переданных связей.
+func loadRelatedSongs(tx *gorm.DB, relations models.SongRelations) error {
+	if len(relations) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(relations)*2)
+	for _, v := range relations {
+		ids = append(ids, v.SongID, v.OriginalID)
+	}
+
+	var songs models.Songs
+	if err := tx.InnerJoins("Artist").Scopes(withSongAssociations).Where(`"songs"."id" IN ?`, ids).Find(&songs).Error; err != nil {
+		return err
+	}
+
+	byID := make(map[uint64]models.Song, len(songs))
+	for _, s := range songs {
+		byID[s.ID] = s
+	}
+	for i := range relations {
+		relations[i].Song = byID[relations[i].SongID]
+		relations[i].Original = byID[relations[i].OriginalID]
+	}
+
+	return nil
+}
+
+// isSongRelationSongNotFoundError проверяет, является ли ошибка ошибкой ErrSongNotFound.
+func isSongRelationSongNotFoundError(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) &&
+		pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) &&
+		(pgErr.ConstraintName == "fk_song_relations_song" || pgErr.ConstraintName == "fk_song_relations_original")
+}
@@ -10,19 +10,13 @@ import (
 	"gorm.io/gorm/clause"
 
 	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/names"
 	"github.com/sedonn/song-library-service/internal/repositories"
 )
 
 // Song возвращает данные определенной песни.
 func (r *Repository) Song(ctx context.Context, id uint64) (models.Song, error) {
-	var s models.Song
-	if err := r.db.WithContext(ctx).InnerJoins("Artist").Take(&s, id).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return models.Song{}, repositories.ErrSongNotFound
-		}
-	}
-
-	return s, nil
+	return r.song(r.db.WithContext(ctx), id)
 }
 
 // SearchSongs выполняет поиск песен по определенным параметрам.
@@ -36,12 +30,11 @@ func (r *Repository) Songs(ctx context.Context, attrs models.Song, p models.Pagi
 		WithContext(ctx).
 		Model(models.Song{}).
 		InnerJoins("Artist").
-		Scopes(
-			withSearchByStringColumn("songs", "name", attrs.Name),
-			withSearchByStringColumn("songs", "link", attrs.Link),
-			withSearchByStringColumn("Artist", "name", attrs.Artist.Name)).
+		Scopes(withSongSearch(attrs)).
 		Count(&total).
+		Order(`"songs"."id"`).
 		Scopes(withPagination(p)).
+		Scopes(withSongAssociations).
 		Find(&songs).
 		Error
 	if err != nil {
@@ -51,42 +44,214 @@ func (r *Repository) Songs(ctx context.Context, attrs models.Song, p models.Pagi
 	return songs, uint64(total), nil
 }
 
-// SaveSong сохраняет данные новой песни.
-func (r *Repository) SaveSong(ctx context.Context, s models.Song) (models.Song, error) {
-	err := r.db.WithContext(ctx).
-		Clauses(clause.Returning{}).
-		Create(&s).
+// SongsByArtists возвращает не более limit первых песен каждого из определенных исполнителей.
+// Песни упорядочены по исполнителю и ID.
+func (r *Repository) SongsByArtists(ctx context.Context, artistIDs []uint64, limit int) (models.Songs, error) {
+	db := r.db.WithContext(ctx)
+
+	ranked := db.
+		Model(models.Song{}).
+		Select(`"id", ROW_NUMBER() OVER (PARTITION BY "artist_id" ORDER BY "id") AS "rank"`).
+		Where(`"artist_id" IN ?`, artistIDs)
+
+	var songs models.Songs
+	err := db.
 		InnerJoins("Artist").
-		Take(&s).
+		Scopes(withSongAssociations).
+		Where(`"songs"."id" IN (?)`, db.Table(`(?) AS "ranked"`, ranked).Select(`"id"`).Where(`"rank" <= ?`, limit)).
+		Order(`"songs"."artist_id", "songs"."id"`).
+		Find(&songs).
 		Error
 	if err != nil {
-		if isSongArtistNotFoundError(err) {
-			return models.Song{}, repositories.ErrArtistNotFound
+		return models.Songs{}, err
+	}
+
+	return songs, nil
+}
+
+// SongsByIDs возвращает песни с определенными ID. Несуществующие песни пропускаются.
+func (r *Repository) SongsByIDs(ctx context.Context, ids []uint64) (models.Songs, error) {
+	var songs models.Songs
+	if err := r.db.WithContext(ctx).InnerJoins("Artist").Scopes(withSongAssociations).Find(&songs, ids).Error; err != nil {
+		return models.Songs{}, err
+	}
+
+	return songs, nil
+}
+
+// MatchSong возвращает песню, которая соответствует записи внешнего плейлиста.
+// Сначала песня ищется по записи музыкального сервиса или по точному совпадению ссылки,
+// затем по названию песни и названию или псевдониму исполнителя без учета регистра и лишних пробелов.
+func (r *Repository) MatchSong(ctx context.Context, attrs models.Song) (models.Song, error) {
+	db := r.db.WithContext(ctx)
+
+	switch {
+	case attrs.LinkMediaID != "":
+		s, err := matchSong(db,
+			`"songs"."link_provider" = ? AND "songs"."link_media_id" = ?`, attrs.LinkProvider, attrs.LinkMediaID,
+		)
+		if !errors.Is(err, repositories.ErrSongNotFound) {
+			return s, err
 		}
 
-		return models.Song{}, err
+	case attrs.Link != "":
+		s, err := matchSong(db, `"songs"."link" = ?`, attrs.Link)
+		if !errors.Is(err, repositories.ErrSongNotFound) {
+			return s, err
+		}
+	}
+
+	if attrs.Name == "" || attrs.Artist.Name == "" {
+		return models.Song{}, repositories.ErrSongNotFound
+	}
+
+	return matchSong(db,
+		names.NormalizeSQL(`"songs"."name"`)+` = @name AND (
+			"Artist"."normalized_name" = @artist OR EXISTS (
+				SELECT 1 FROM "artist_aliases"
+				WHERE "artist_aliases"."artist_id" = "Artist"."id" AND "artist_aliases"."normalized_name" = @artist
+			)
+		)`,
+		map[string]any{"name": names.Normalize(attrs.Name), "artist": names.Normalize(attrs.Artist.Name)},
+	)
+}
+
+// SongFacets возвращает количество найденных песен по каждому жанру и каждой метке.
+// Поиск выполняется по тем же параметрам, что и в Songs.
+func (r *Repository) SongFacets(ctx context.Context, attrs models.Song) (models.Facets, error) {
+	var facets models.Facets
+	err := r.db.
+		WithContext(ctx).
+		Model(models.Song{}).
+		Select(`"tags"."kind", "tags"."name", COUNT(DISTINCT "songs"."id") AS "count"`).
+		Joins(`INNER JOIN "artists" "Artist" ON "songs"."artist_id" = "Artist"."id"`).
+		Joins(`INNER JOIN "song_tags" ON "song_tags"."song_id" = "songs"."id"`).
+		Joins(`INNER JOIN "tags" ON "tags"."id" = "song_tags"."tag_id"`).
+		Scopes(withSongSearch(attrs)).
+		Group(`"tags"."kind", "tags"."name"`).
+		Order(`"count" DESC, "tags"."name"`).
+		Scan(&facets).
+		Error
+	if err != nil {
+		return models.Facets{}, err
+	}
+
+	return facets, nil
+}
+
+// SaveSong сохраняет данные новой песни вместе со списком участников.
+func (r *Repository) SaveSong(ctx context.Context, s models.Song) (models.Song, error) {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		credits := s.Credits
+		if err := tx.Omit(clause.Associations).Create(&s).Error; err != nil {
+			return err
+		}
+
+		if err := saveSongCredits(tx, s.ID, credits); err != nil {
+			return err
+		}
+
+		saved, err := r.song(tx, s.ID)
+		if err != nil {
+			return err
+		}
+		s = saved
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case isSongArtistNotFoundError(err):
+			return models.Song{}, repositories.ErrArtistNotFound
+
+		case isIdentifierUniqueViolation(err):
+			return models.Song{}, repositories.ErrIdentifierExists
+
+		case isSongLinkUniqueViolation(err):
+			return models.Song{}, repositories.ErrSongLinkExists
+
+		default:
+			return models.Song{}, err
+		}
 	}
 
 	return s, nil
 }
 
+// lyricsColumns это столбцы языка и статистики текста песни.
+var lyricsColumns = []string{"language", "line_count", "couplet_count", "word_count", "unique_word_ratio", "reading_time"}
+
 // UpdateSong обновляет данные определенной песни.
+// Если список участников не nil, то он полностью заменяет текущий список участников песни.
+// При смене ссылки провайдер и идентификатор записи заменяются даже пустыми значениями,
+// а результат последней проверки доступности ссылки сбрасывается.
+// При смене текста язык и статистика текста заменяются даже пустыми значениями.
 func (r *Repository) UpdateSong(ctx context.Context, s models.Song) (models.Song, error) {
-	tx := r.db.WithContext(ctx).Updates(&s)
-	if tx.Error != nil {
-		if isSongArtistNotFoundError(tx.Error) {
-			return models.Song{}, repositories.ErrArtistNotFound
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current models.Song
+		if err := tx.Select("id", "link").Take(&current, s.ID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repositories.ErrSongNotFound
+			}
+
+			return err
 		}
 
-		return models.Song{}, tx.Error
-	}
+		if err := tx.Model(&models.Song{ID: s.ID}).Omit(clause.Associations).Updates(&s).Error; err != nil {
+			return err
+		}
 
-	if tx.RowsAffected == 0 {
-		return models.Song{}, repositories.ErrSongNotFound
-	}
+		if s.Text != "" {
+			if err := tx.Model(&models.Song{ID: s.ID}).Select(lyricsColumns).Updates(&s).Error; err != nil {
+				return err
+			}
+		}
+
+		if s.Link != "" && s.Link != current.Link {
+			err := tx.Model(&models.Song{ID: s.ID}).UpdateColumns(map[string]any{
+				"link_provider":       s.LinkProvider,
+				"link_media_id":       s.LinkMediaID,
+				"link_status_code":    0,
+				"link_checked_at":     nil,
+				"link_failure_streak": 0,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if s.Credits != nil {
+			if err := tx.Where("song_id = ?", s.ID).Delete(&models.SongCredit{}).Error; err != nil {
+				return err
+			}
+
+			if err := saveSongCredits(tx, s.ID, s.Credits); err != nil {
+				return err
+			}
+		}
+
+		updated, err := r.song(tx, s.ID)
+		if err != nil {
+			return err
+		}
+		s = updated
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case isSongArtistNotFoundError(err):
+			return models.Song{}, repositories.ErrArtistNotFound
 
-	if err := r.db.WithContext(ctx).InnerJoins("Artist").Take(&s).Error; err != nil {
-		return models.Song{}, nil
+		case isIdentifierUniqueViolation(err):
+			return models.Song{}, repositories.ErrIdentifierExists
+
+		case isSongLinkUniqueViolation(err):
+			return models.Song{}, repositories.ErrSongLinkExists
+
+		default:
+			return models.Song{}, err
+		}
 	}
 
 	return s, nil
@@ -106,6 +271,178 @@ func (r *Repository) DeleteSong(ctx context.Context, id uint64) (uint64, error)
 	return id, nil
 }
 
+// SongsAfter возвращает определенное количество песен, идентификатор которых больше указанного.
+// Песни упорядочены по идентификатору.
+func (r *Repository) SongsAfter(ctx context.Context, id uint64, limit int) (models.Songs, error) {
+	var songs models.Songs
+	err := r.db.WithContext(ctx).
+		Where("id > ?", id).
+		Order("id").
+		Limit(limit).
+		Find(&songs).
+		Error
+	if err != nil {
+		return models.Songs{}, err
+	}
+
+	return songs, nil
+}
+
+// UpdateSongLyricsStats обновляет язык и статистику текста определенной песни.
+func (r *Repository) UpdateSongLyricsStats(ctx context.Context, s models.Song) error {
+	tx := r.db.WithContext(ctx).
+		Model(&models.Song{ID: s.ID}).
+		Select(lyricsColumns).
+		Updates(&s)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return repositories.ErrSongNotFound
+	}
+
+	return nil
+}
+
+// song возвращает данные определенной песни в рамках переданного подключения.
+func (r *Repository) song(db *gorm.DB, id uint64) (models.Song, error) {
+	var s models.Song
+	if err := db.InnerJoins("Artist").Scopes(withSongAssociations).Take(&s, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.Song{}, repositories.ErrSongNotFound
+		}
+
+		return models.Song{}, err
+	}
+
+	return s, nil
+}
+
+// matchSong возвращает первую песню, которая соответствует определенному условию.
+func matchSong(db *gorm.DB, query string, args ...any) (models.Song, error) {
+	var s models.Song
+	if err := db.InnerJoins("Artist").Where(query, args...).Order(`"songs"."id"`).Take(&s).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.Song{}, repositories.ErrSongNotFound
+		}
+
+		return models.Song{}, err
+	}
+
+	return s, nil
+}
+
+// saveSongCredits сохраняет участников создания определенной песни.
+// Повторяющиеся участники с одинаковой ролью игнорируются.
+func saveSongCredits(tx *gorm.DB, songID uint64, credits models.SongCredits) error {
+	if len(credits) == 0 {
+		return nil
+	}
+
+	for i := range credits {
+		credits[i].SongID = songID
+	}
+
+	return tx.Omit(clause.Associations).Clauses(clause.OnConflict{DoNothing: true}).Create(&credits).Error
+}
+
+// withSongAssociations добавляет загрузку участников создания и меток песен.
+func withSongAssociations(db *gorm.DB) *gorm.DB {
+	return db.
+		Preload("Credits", func(db *gorm.DB) *gorm.DB {
+			return db.Order("role, artist_id")
+		}).
+		Preload("Credits.Artist").
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("kind, name")
+		})
+}
+
+// withSongSearch добавляет поиск песен по определенным параметрам.
+// Запрос должен содержать соединение с таблицей исполнителей под псевдонимом "Artist".
+func withSongSearch(attrs models.Song) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Scopes(
+			withSearchByStringColumn("songs", "name", attrs.Name),
+			withSearchByStringColumn("songs", "link", attrs.Link),
+			withSearchByArtistName(`"Artist"`, attrs.Artist.Name),
+			withSearchByExactColumn("songs", "language", attrs.Language),
+			withSearchByCreditedArtist(attrs.CreditedArtist),
+			withSearchByTags(attrs.Tags),
+			withSearchByLinkStatus(attrs.LinkHealth.Status),
+		)
+	}
+}
+
+// withSearchByTags добавляет поиск песен, у которых есть все указанные метки.
+// Названия меток сравниваются без учета регистра.
+func withSearchByTags(tags models.Tags) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		for _, t := range tags {
+			db = db.Where(
+				`EXISTS (
+					SELECT 1 FROM "song_tags"
+					INNER JOIN "tags" ON "tags"."id" = "song_tags"."tag_id"
+					WHERE "song_tags"."song_id" = "songs"."id" AND "tags"."kind" = ? AND LOWER("tags"."name") = LOWER(?)
+				)`,
+				t.Kind, t.Name,
+			)
+		}
+
+		return db
+	}
+}
+
+// withSearchByArtistName добавляет поиск по подстроке названия или любого псевдонима исполнителя из определенной таблицы.
+func withSearchByArtistName(table, name string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if name == "" {
+			return db
+		}
+
+		return db.Where(artistNameMatchSQL(table), map[string]any{"pattern": "%" + name + "%"})
+	}
+}
+
+// withSearchByCreditedArtist добавляет поиск по подстроке названия или псевдонима любого исполнителя песни:
+// основного исполнителя или любого из участников.
+func withSearchByCreditedArtist(name string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if name == "" {
+			return db
+		}
+
+		return db.Where(
+			artistNameMatchSQL(`"Artist"`)+` OR EXISTS (
+				SELECT 1 FROM "song_credits"
+				INNER JOIN "artists" ON "artists"."id" = "song_credits"."artist_id"
+				WHERE "song_credits"."song_id" = "songs"."id" AND `+artistNameMatchSQL(`"artists"`)+`
+			)`,
+			map[string]any{"pattern": "%" + name + "%"},
+		)
+	}
+}
+
+// artistNameMatchSQL возвращает SQL-условие совпадения названия или любого псевдонима исполнителя
+// из определенной таблицы с шаблоном @pattern.
+func artistNameMatchSQL(table string) string {
+	return `(` + table + `."name" ILIKE @pattern OR EXISTS (
+		SELECT 1 FROM "artist_aliases"
+		WHERE "artist_aliases"."artist_id" = ` + table + `."id" AND "artist_aliases"."name" ILIKE @pattern
+	))`
+}
+
+// isSongLinkUniqueViolation проверяет, является ли ошибка ошибкой ErrSongLinkExists.
+func isSongLinkUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+
+	return pgErr.Code == pgerrcode.UniqueViolation && pgErr.ConstraintName == "idx_songs_link_media"
+}
+
 // isSongArtistNotFoundError проверяет, является ли ошибка ошибкой ErrArtistNotFound.
 func isSongArtistNotFoundError(err error) bool {
 	pgErr, ok := err.(*pgconn.PgError)
@@ -113,5 +450,6 @@ func isSongArtistNotFoundError(err error) bool {
 		return false
 	}
 
-	return pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) && pgErr.ConstraintName == "fk_songs_artist"
+	return pgerrcode.IsIntegrityConstraintViolation(pgErr.Code) &&
+		(pgErr.ConstraintName == "fk_songs_artist" || pgErr.ConstraintName == "fk_song_credits_artist")
 }
@@ -0,0 +1,59 @@
+package postgresql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/tenant"
+)
+
+func TestRepository_UpdateSong_LyricsStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		song       models.Song
+		wantLyrics bool
+	}{
+		{
+			name:       "Text changed",
+			song:       models.Song{ID: 1, Text: "1 2 3"},
+			wantLyrics: true,
+		},
+		{
+			name: "Text unchanged",
+			song: models.Song{ID: 1, Name: "song"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, rec := newRecordingRepository(t)
+			rec.found = "songs"
+
+			_, err := r.UpdateSong(tenant.NewContext(context.Background(), otherTenant), tt.song)
+			require.NoError(t, err)
+
+			var lyricsUpdated bool
+			for _, q := range rec.updated("songs") {
+				if !strings.Contains(q, `"language"=`) {
+					continue
+				}
+
+				lyricsUpdated = true
+				for _, column := range lyricsColumns {
+					assert.Containsf(t, q, `"`+column+`"=`, "empty %s must be written", column)
+				}
+			}
+			assert.Equal(t, tt.wantLyrics, lyricsUpdated)
+		})
+	}
+}
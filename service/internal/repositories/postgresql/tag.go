@@ -0,0 +1,161 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/repositories"
+)
+
+// Tags возвращает все метки определенного вида, упорядоченные по названию.
+func (r *Repository) Tags(ctx context.Context, kind string) (models.Tags, error) {
+	var tags models.Tags
+	if err := r.db.WithContext(ctx).Where("kind = ?", kind).Order("name").Find(&tags).Error; err != nil {
+		return models.Tags{}, err
+	}
+
+	return tags, nil
+}
+
+// SaveTag сохраняет данные новой метки.
+func (r *Repository) SaveTag(ctx context.Context, t models.Tag) (models.Tag, error) {
+	if err := r.db.WithContext(ctx).Clauses(clause.Returning{}).Create(&t).Error; err != nil {
+		if isUniqueViolation(err) {
+			return models.Tag{}, repositories.ErrTagExists
+		}
+
+		return models.Tag{}, err
+	}
+
+	return t, nil
+}
+
+// UpdateTag обновляет название определенной метки.
+func (r *Repository) UpdateTag(ctx context.Context, t models.Tag) (models.Tag, error) {
+	tx := r.db.WithContext(ctx).
+		Model(&t).
+		Clauses(clause.Returning{}).
+		Where("kind = ?", t.Kind).
+		Update("name", t.Name)
+	if tx.Error != nil {
+		if isUniqueViolation(tx.Error) {
+			return models.Tag{}, repositories.ErrTagExists
+		}
+
+		return models.Tag{}, tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return models.Tag{}, repositories.ErrTagNotFound
+	}
+
+	return t, nil
+}
+
+// DeleteTag удаляет определенную метку определенного вида вместе с ее привязками к песням.
+func (r *Repository) DeleteTag(ctx context.Context, kind string, id uint64) (uint64, error) {
+	tx := r.db.WithContext(ctx).Where("kind = ?", kind).Delete(&models.Tag{ID: id})
+	if tx.Error != nil {
+		return 0, tx.Error
+	}
+
+	if tx.RowsAffected == 0 {
+		return 0, repositories.ErrTagNotFound
+	}
+
+	return id, nil
+}
+
+// SetSongTags заменяет метки определенного вида у определенной песни.
+// Отсутствующие свободные метки создаются автоматически, отсутствующие жанры приводят к ошибке ErrTagNotFound.
+func (r *Repository) SetSongTags(ctx context.Context, songID uint64, kind string, names []string) (models.Song, error) {
+	var s models.Song
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Select("id").Take(&models.Song{}, songID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repositories.ErrSongNotFound
+			}
+
+			return err
+		}
+
+		tags, err := resolveTags(tx, kind, names)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec(
+			`DELETE FROM "song_tags" WHERE "song_id" = ? AND "tag_id" IN (SELECT "id" FROM "tags" WHERE "kind" = ?)`,
+			songID, kind,
+		).Error
+		if err != nil {
+			return err
+		}
+
+		for _, t := range tags {
+			err := tx.Exec(
+				`INSERT INTO "song_tags" ("song_id", "tag_id") VALUES (?, ?) ON CONFLICT DO NOTHING`,
+				songID, t.ID,
+			).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		s, err = r.song(tx, songID)
+
+		return err
+	})
+	if err != nil {
+		return models.Song{}, err
+	}
+
+	return s, nil
+}
+
+// resolveTags возвращает метки определенного вида по названиям.
+func resolveTags(tx *gorm.DB, kind string, names []string) (models.Tags, error) {
+	if len(names) == 0 {
+		return models.Tags{}, nil
+	}
+
+	if kind == models.TagKindTag {
+		err := tx.
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(models.TagsFromNames(kind, names)).
+			Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var tags models.Tags
+	if err := tx.Where("kind = ? AND name IN ?", kind, names).Find(&tags).Error; err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]struct{}, len(tags))
+	for _, t := range tags {
+		found[t.Name] = struct{}{}
+	}
+	for _, n := range names {
+		if _, ok := found[n]; !ok {
+			return nil, repositories.ErrTagNotFound
+		}
+	}
+
+	return tags, nil
+}
+
+// isUniqueViolation проверяет, является ли ошибка нарушением ограничения уникальности.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation
+}
@@ -0,0 +1,115 @@
+package postgresql
+
+import (
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"github.com/sedonn/song-library-service/internal/pkg/tenant"
+)
+
+// tenantField это поле моделей, которые принадлежат библиотеке.
+const tenantField = "TenantID"
+
+// tenantScopedKey это ключ настройки запроса, которая отмечает, что запрос уже ограничен библиотекой.
+const tenantScopedKey = "tenant:scoped"
+
+// tenantScope это плагин GORM, который ограничивает все запросы к моделям библиотек библиотекой из контекста запроса.
+// Новым записям присваивается библиотека из контекста, а библиотека существующих записей не изменяется.
+// Запросы без библиотеки в контексте не ограничиваются. SQL-запросы, переданные в Raw и Exec,
+// не ограничиваются автоматически и должны выполняться только над записями, уже найденными в библиотеке.
+type tenantScope struct{}
+
+// Name возвращает название плагина.
+func (tenantScope) Name() string {
+	return "tenant_scope"
+}
+
+// Initialize регистрирует обработчики запросов плагина.
+func (tenantScope) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+
+	if err := cb.Create().Before("gorm:create").Register("tenant:assign", assignTenant); err != nil {
+		return err
+	}
+	if err := cb.Query().Before("gorm:query").Register("tenant:query", scopeTenant); err != nil {
+		return err
+	}
+	if err := cb.Row().Before("gorm:row").Register("tenant:row", scopeTenant); err != nil {
+		return err
+	}
+	if err := cb.Update().Before("gorm:update").Register("tenant:update", scopeTenantUpdate); err != nil {
+		return err
+	}
+
+	return cb.Delete().Before("gorm:delete").Register("tenant:delete", scopeTenant)
+}
+
+// statementTenant возвращает библиотеку из контекста запроса и поле библиотеки модели запроса.
+// Возвращает false, если запрос не нужно ограничивать.
+func statementTenant(db *gorm.DB) (string, *schema.Field, bool) {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return "", nil, false
+	}
+
+	field := db.Statement.Schema.LookUpField(tenantField)
+	if field == nil {
+		return "", nil, false
+	}
+
+	id, ok := tenant.FromContext(db.Statement.Context)
+
+	return id, field, ok
+}
+
+// scopeTenant добавляет в запрос условие на библиотеку из контекста запроса.
+func scopeTenant(db *gorm.DB) {
+	id, field, ok := statementTenant(db)
+	if !ok || db.Statement.SQL.Len() > 0 {
+		return
+	}
+
+	if _, scoped := db.Statement.Settings.LoadOrStore(tenantScopedKey, true); scoped {
+		return
+	}
+
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: db.Statement.Table, Name: field.DBName}, Value: id},
+	}})
+}
+
+// scopeTenantUpdate ограничивает изменение записей библиотекой из контекста запроса и исключает
+// библиотеку из изменяемых столбцов.
+func scopeTenantUpdate(db *gorm.DB) {
+	if _, field, ok := statementTenant(db); ok {
+		db.Statement.Omits = append(db.Statement.Omits, field.DBName)
+	}
+
+	scopeTenant(db)
+}
+
+// assignTenant присваивает библиотеку из контекста запроса новым записям, у которых библиотека не указана.
+func assignTenant(db *gorm.DB) {
+	id, field, ok := statementTenant(db)
+	if !ok {
+		return
+	}
+
+	assign := func(v reflect.Value) {
+		if _, zero := field.ValueOf(db.Statement.Context, v); zero {
+			db.AddError(field.Set(db.Statement.Context, v, id))
+		}
+	}
+
+	switch rv := db.Statement.ReflectValue; rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			assign(reflect.Indirect(rv.Index(i)))
+		}
+
+	case reflect.Struct:
+		assign(rv)
+	}
+}
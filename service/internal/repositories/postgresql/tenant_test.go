@@ -0,0 +1,644 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/tenant"
+	"github.com/sedonn/song-library-service/internal/repositories"
+)
+
+// otherTenant это библиотека клиента, который пытается получить доступ к записям чужой библиотеки.
+const otherTenant = "team-b"
+
+// rawStatements это начала SQL-запросов, которые не ограничиваются библиотекой автоматически.
+// Такие запросы выполняются только над записями, уже найденными в библиотеке запроса.
+var rawStatements = []string{
+	"SELECT pg_advisory_xact_lock",
+	`LOCK TABLE "song_relations"`,
+	"WITH RECURSIVE",
+	`DELETE FROM "song_tags"`,
+	`INSERT INTO "song_tags"`,
+	"SAVEPOINT",
+}
+
+// recordedStatement это SQL-запрос, выполненный через recorder.
+type recordedStatement struct {
+	query string
+	args  []any
+}
+
+// scoped проверяет, что запрос ограничен определенной библиотекой.
+func (s recordedStatement) scoped(tenantID string) bool {
+	for _, prefix := range rawStatements {
+		if strings.HasPrefix(s.query, prefix) {
+			return true
+		}
+	}
+
+	if !strings.Contains(s.query, `"tenant_id"`) {
+		return false
+	}
+
+	for _, arg := range s.args {
+		if arg == tenantID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recorder это подключение к БД, которое запоминает SQL-запросы и выполняет их так, будто в БД нет ни одной записи.
+// Таким образом выглядит БД, в которой все записи принадлежат чужой библиотеке.
+type recorder struct {
+	mu         sync.Mutex
+	statements []recordedStatement
+	// found это таблица, запросы к которой возвращают одну запись с идентификатором 1.
+	found string
+	// foundTenant это библиотека записи таблицы found. Если не задана, то запись возвращается без библиотеки.
+	foundTenant string
+}
+
+// newRecordingRepository создает репозиторий, который выполняет запросы через recorder.
+func newRecordingRepository(t *testing.T) (*Repository, *recorder) {
+	rec := &recorder{}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(rec)}), &gorm.Config{
+		SkipDefaultTransaction: true,
+		Logger:                 gormlogger.Discard,
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.Use(tenantScope{}))
+
+	return &Repository{db: db}, rec
+}
+
+// unscoped возвращает запросы, которые не ограничены определенной библиотекой.
+func (r *recorder) unscoped(tenantID string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var queries []string
+	for _, s := range r.statements {
+		if !s.scoped(tenantID) {
+			queries = append(queries, s.query)
+		}
+	}
+
+	return queries
+}
+
+// inserted возвращает аргументы запросов INSERT в определенную таблицу.
+func (r *recorder) inserted(table string) [][]any {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var args [][]any
+	for _, s := range r.statements {
+		if strings.HasPrefix(s.query, `INSERT INTO "`+table+`"`) {
+			args = append(args, s.args)
+		}
+	}
+
+	return args
+}
+
+// updated возвращает запросы UPDATE определенной таблицы.
+func (r *recorder) updated(table string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var queries []string
+	for _, s := range r.statements {
+		if strings.HasPrefix(s.query, `UPDATE "`+table+`"`) {
+			queries = append(queries, s.query)
+		}
+	}
+
+	return queries
+}
+
+func (r *recorder) record(query string, args []driver.NamedValue) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	values := make([]any, len(args))
+	for i, v := range args {
+		values[i] = v.Value
+	}
+
+	r.statements = append(r.statements, recordedStatement{query: strings.TrimSpace(query), args: values})
+}
+
+func (r *recorder) Connect(context.Context) (driver.Conn, error) { return &recorderConn{rec: r}, nil }
+func (r *recorder) Driver() driver.Driver                        { return nil }
+
+// recorderConn это подключение recorder.
+type recorderConn struct {
+	rec *recorder
+}
+
+func (c *recorderConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *recorderConn) Close() error                        { return nil }
+func (c *recorderConn) Begin() (driver.Tx, error)           { return c, nil }
+func (c *recorderConn) Commit() error                       { return nil }
+func (c *recorderConn) Rollback() error                     { return nil }
+
+func (c *recorderConn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+func (c *recorderConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.rec.record(query, args)
+
+	return driver.RowsAffected(0), nil
+}
+
+func (c *recorderConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.rec.record(query, args)
+
+	if c.rec.found != "" && strings.Contains(query, `FROM "`+c.rec.found+`"`) {
+		return &singleRow{tenantID: c.rec.foundTenant}, nil
+	}
+
+	return emptyRows{}, nil
+}
+
+// emptyRows это пустой результат запроса.
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return nil }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
+
+// singleRow это результат запроса из одной записи с идентификатором 1 и, если задана, библиотекой tenantID.
+type singleRow struct {
+	tenantID string
+	done     bool
+}
+
+func (r *singleRow) Columns() []string {
+	if r.tenantID != "" {
+		return []string{"id", "tenant_id"}
+	}
+
+	return []string{"id"}
+}
+
+func (*singleRow) Close() error { return nil }
+
+func (r *singleRow) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	dest[0] = int64(1)
+	if r.tenantID != "" {
+		dest[1] = r.tenantID
+	}
+
+	return nil
+}
+
+func TestRepository_TenantIsolation(t *testing.T) {
+	t.Parallel()
+
+	p := models.Pagination{PageNumber: 1, PageSize: 10}
+
+	tests := []struct {
+		name    string
+		call    func(ctx context.Context, r *Repository) error
+		wantErr error
+	}{
+		{
+			name:    "Song",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.Song(ctx, 1); return err },
+			wantErr: repositories.ErrSongNotFound,
+		},
+		{
+			name: "Songs",
+			call: func(ctx context.Context, r *Repository) error {
+				_, _, err := r.Songs(ctx, models.Song{Name: "song"}, p)
+				return err
+			},
+		},
+		{
+			name: "SongFacets",
+			call: func(ctx context.Context, r *Repository) error { _, err := r.SongFacets(ctx, models.Song{}); return err },
+		},
+		{
+			name: "SongsByArtists",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SongsByArtists(ctx, []uint64{1}, 5)
+				return err
+			},
+		},
+		{
+			name: "MatchSong",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.MatchSong(ctx, models.Song{Name: "song", Link: "https://example.com/1", Artist: models.Artist{Name: "artist"}})
+				return err
+			},
+			wantErr: repositories.ErrSongNotFound,
+		},
+		{
+			name: "SongByISRC",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SongByISRC(ctx, "USRC17607839")
+				return err
+			},
+			wantErr: repositories.ErrSongNotFound,
+		},
+		{
+			name: "SongsByISWC",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SongsByISWC(ctx, "T0345246801")
+				return err
+			},
+		},
+		{
+			name: "UpdateSong",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.UpdateSong(ctx, models.Song{ID: 1, Name: "song"})
+				return err
+			},
+			wantErr: repositories.ErrSongNotFound,
+		},
+		{
+			name: "UpdateSongLyricsStats",
+			call: func(ctx context.Context, r *Repository) error {
+				return r.UpdateSongLyricsStats(ctx, models.Song{ID: 1})
+			},
+			wantErr: repositories.ErrSongNotFound,
+		},
+		{
+			name:    "UpdateSongLinkHealth",
+			call:    func(ctx context.Context, r *Repository) error { return r.UpdateSongLinkHealth(ctx, 1, 404, true) },
+			wantErr: repositories.ErrSongNotFound,
+		},
+		{
+			name: "SongLinkStats",
+			call: func(ctx context.Context, r *Repository) error { _, err := r.SongLinkStats(ctx); return err },
+		},
+		{
+			name:    "DeleteSong",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.DeleteSong(ctx, 1); return err },
+			wantErr: repositories.ErrSongNotFound,
+		},
+		{
+			name: "SetSongTags",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SetSongTags(ctx, 1, models.TagKindTag, []string{"live"})
+				return err
+			},
+			wantErr: repositories.ErrSongNotFound,
+		},
+		{
+			name:    "RelatedSongs",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.RelatedSongs(ctx, 1); return err },
+			wantErr: repositories.ErrSongNotFound,
+		},
+		{
+			name: "DeleteSongRelation",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.DeleteSongRelation(ctx, models.SongRelation{SongID: 1, OriginalID: 2, Type: models.SongRelationCover})
+				return err
+			},
+			wantErr: repositories.ErrSongRelationNotFound,
+		},
+		{
+			name:    "MergeSongs",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.MergeSongs(ctx, 1, 2); return err },
+			wantErr: repositories.ErrSongNotFound,
+		},
+		{
+			name: "DuplicateSongCandidates",
+			call: func(ctx context.Context, r *Repository) error { _, err := r.DuplicateSongCandidates(ctx); return err },
+		},
+		{
+			name: "SongsByIDs",
+			call: func(ctx context.Context, r *Repository) error { _, err := r.SongsByIDs(ctx, []uint64{1}); return err },
+		},
+		{
+			name:    "Artist",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.Artist(ctx, 1); return err },
+			wantErr: repositories.ErrArtistNotFound,
+		},
+		{
+			name: "Artists",
+			call: func(ctx context.Context, r *Repository) error {
+				_, _, err := r.Artists(ctx, models.Artist{Name: "artist"}, p)
+				return err
+			},
+		},
+		{
+			name: "ArtistByISNI",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.ArtistByISNI(ctx, "000000012146438X")
+				return err
+			},
+			wantErr: repositories.ErrArtistNotFound,
+		},
+		{
+			name: "UpdateArtist",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.UpdateArtist(ctx, models.Artist{ID: 1, Name: "artist"})
+				return err
+			},
+			wantErr: repositories.ErrArtistNotFound,
+		},
+		{
+			name:    "DeleteArtist",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.DeleteArtist(ctx, 1); return err },
+			wantErr: repositories.ErrArtistNotFound,
+		},
+		{
+			name:    "ArtistAliases",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.ArtistAliases(ctx, 1); return err },
+			wantErr: repositories.ErrArtistNotFound,
+		},
+		{
+			name:    "DeleteArtistAlias",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.DeleteArtistAlias(ctx, 1, 2); return err },
+			wantErr: repositories.ErrArtistAliasNotFound,
+		},
+		{
+			name:    "MergeArtists",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.MergeArtists(ctx, 1, 2); return err },
+			wantErr: repositories.ErrArtistNotFound,
+		},
+		{
+			name: "DuplicateArtists",
+			call: func(ctx context.Context, r *Repository) error { _, _, err := r.DuplicateArtists(ctx, p); return err },
+		},
+		{
+			name:    "Album",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.Album(ctx, 1); return err },
+			wantErr: repositories.ErrAlbumNotFound,
+		},
+		{
+			name: "Albums",
+			call: func(ctx context.Context, r *Repository) error {
+				_, _, err := r.Albums(ctx, models.Album{Title: "album", Artist: models.Artist{Name: "artist"}}, p)
+				return err
+			},
+		},
+		{
+			name: "UpdateAlbum",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.UpdateAlbum(ctx, models.Album{ID: 1, Title: "album"})
+				return err
+			},
+			wantErr: repositories.ErrAlbumNotFound,
+		},
+		{
+			name:    "DeleteAlbum",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.DeleteAlbum(ctx, 1); return err },
+			wantErr: repositories.ErrAlbumNotFound,
+		},
+		{
+			name:    "Playlist",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.Playlist(ctx, 1); return err },
+			wantErr: repositories.ErrPlaylistNotFound,
+		},
+		{
+			name: "Playlists",
+			call: func(ctx context.Context, r *Repository) error {
+				_, _, err := r.Playlists(ctx, models.Playlist{Name: "playlist"}, p)
+				return err
+			},
+		},
+		{
+			name:    "PlaylistSongs",
+			call:    func(ctx context.Context, r *Repository) error { _, _, err := r.PlaylistSongs(ctx, 1, p); return err },
+			wantErr: repositories.ErrPlaylistNotFound,
+		},
+		{
+			name: "UpdatePlaylist",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.UpdatePlaylist(ctx, models.Playlist{ID: 1, Name: "playlist"})
+				return err
+			},
+			wantErr: repositories.ErrPlaylistNotFound,
+		},
+		{
+			name:    "DeletePlaylist",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.DeletePlaylist(ctx, 1); return err },
+			wantErr: repositories.ErrPlaylistNotFound,
+		},
+		{
+			name: "AddPlaylistSong",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.AddPlaylistSong(ctx, models.PlaylistItem{PlaylistID: 1, SongID: 2})
+				return err
+			},
+			wantErr: repositories.ErrPlaylistNotFound,
+		},
+		{
+			name: "MovePlaylistSong",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.MovePlaylistSong(ctx, models.PlaylistItem{PlaylistID: 1, SongID: 2, Position: 1})
+				return err
+			},
+			wantErr: repositories.ErrPlaylistNotFound,
+		},
+		{
+			name:    "DeletePlaylistSong",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.DeletePlaylistSong(ctx, 1, 2); return err },
+			wantErr: repositories.ErrPlaylistNotFound,
+		},
+		{
+			name: "Tags",
+			call: func(ctx context.Context, r *Repository) error { _, err := r.Tags(ctx, models.TagKindGenre); return err },
+		},
+		{
+			name: "UpdateTag",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.UpdateTag(ctx, models.Tag{ID: 1, Kind: models.TagKindGenre, Name: "rock"})
+				return err
+			},
+			wantErr: repositories.ErrTagNotFound,
+		},
+		{
+			name: "DeleteTag",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.DeleteTag(ctx, models.TagKindGenre, 1)
+				return err
+			},
+			wantErr: repositories.ErrTagNotFound,
+		},
+		{
+			name:    "Job",
+			call:    func(ctx context.Context, r *Repository) error { _, err := r.Job(ctx, 1); return err },
+			wantErr: repositories.ErrJobNotFound,
+		},
+		{
+			name: "Jobs",
+			call: func(ctx context.Context, r *Repository) error {
+				_, _, err := r.Jobs(ctx, models.Job{SongID: 1}, p)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, rec := newRecordingRepository(t)
+
+			err := tt.call(tenant.NewContext(context.Background(), otherTenant), r)
+			assert.ErrorIsf(t, err, tt.wantErr, "Repository.%s() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			assert.Empty(t, rec.unscoped(otherTenant), "queries are not scoped to tenant")
+		})
+	}
+}
+
+func TestRepository_TenantAssignment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		table string
+		call  func(ctx context.Context, r *Repository) error
+	}{
+		{
+			name:  "SaveSong",
+			table: "songs",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SaveSong(ctx, models.Song{Name: "song", ArtistID: 1})
+				return err
+			},
+		},
+		{
+			name:  "SaveArtist",
+			table: "artists",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SaveArtist(ctx, models.Artist{Name: "artist"})
+				return err
+			},
+		},
+		{
+			name:  "SaveAlbum",
+			table: "albums",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SaveAlbum(ctx, models.Album{Title: "album", ArtistID: 1})
+				return err
+			},
+		},
+		{
+			name:  "SavePlaylist",
+			table: "playlists",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SavePlaylist(ctx, models.Playlist{Name: "playlist"})
+				return err
+			},
+		},
+		{
+			name:  "SaveTag",
+			table: "tags",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SaveTag(ctx, models.Tag{Kind: models.TagKindGenre, Name: "rock"})
+				return err
+			},
+		},
+		{
+			name:  "SaveSongRelation",
+			table: "song_relations",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SaveSongRelation(ctx, models.SongRelation{SongID: 1, OriginalID: 2, Type: models.SongRelationCover})
+				return err
+			},
+		},
+		{
+			name:  "SaveJobs",
+			table: "jobs",
+			call: func(ctx context.Context, r *Repository) error {
+				_, err := r.SaveJobs(ctx, models.Jobs{{Type: models.JobTypeEnrichSong, SongID: 1}})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, rec := newRecordingRepository(t)
+
+			_ = tt.call(tenant.NewContext(context.Background(), otherTenant), r)
+
+			inserted := rec.inserted(tt.table)
+			require.Len(t, inserted, 1)
+			assert.Contains(t, inserted[0], otherTenant)
+		})
+	}
+}
+
+func TestRepository_WithoutTenant(t *testing.T) {
+	t.Parallel()
+
+	r, rec := newRecordingRepository(t)
+
+	_, err := r.ClaimJobs(context.Background(), 10, time.Minute)
+	require.NoError(t, err)
+	assert.NotEmpty(t, rec.unscoped(otherTenant), "background queries must not be scoped to tenant")
+}
+
+// TestRepository_APIKeyWithoutTenant проверяет, что API-ключ, привязанный к одной библиотеке,
+// находится в запросе другой библиотеки: ключи ищутся до того, как определена библиотека запроса.
+func TestRepository_APIKeyWithoutTenant(t *testing.T) {
+	t.Parallel()
+
+	const keyTenant = "team-a"
+
+	r, rec := newRecordingRepository(t)
+	rec.found, rec.foundTenant = "api_keys", keyTenant
+
+	k, err := r.APIKeyByHash(tenant.NewContext(context.Background(), otherTenant), "hash")
+	require.NoError(t, err)
+	assert.Equal(t, keyTenant, k.BoundTenant)
+	assert.Len(t, rec.unscoped(otherTenant), 1, "API key queries must not be scoped to tenant")
+}
+
+// TestModels_TenantForeignKeys проверяет, что записи библиотеки могут ссылаться только на записи той же библиотеки.
+func TestModels_TenantForeignKeys(t *testing.T) {
+	t.Parallel()
+
+	tenantModels := []any{
+		&models.Artist{}, &models.ArtistAlias{}, &models.Song{}, &models.SongCredit{}, &models.SongRelation{},
+		&models.Album{}, &models.AlbumTrack{}, &models.Playlist{}, &models.PlaylistItem{}, &models.Tag{}, &models.Job{},
+	}
+
+	cache := &sync.Map{}
+	for _, m := range tenantModels {
+		s, err := schema.Parse(m, cache, schema.NamingStrategy{})
+		require.NoError(t, err)
+		require.NotNilf(t, s.LookUpField(tenantField), "%s has no tenant", s.Table)
+
+		for _, rel := range s.Relationships.Relations {
+			c := rel.ParseConstraint()
+			if c == nil || c.ReferenceSchema.LookUpField(tenantField) == nil || rel.JoinTable != nil {
+				continue
+			}
+
+			require.NotEmptyf(t, c.ForeignKeys, "%s has no foreign keys", c.Name)
+			assert.Equalf(t, "tenant_id", c.ForeignKeys[0].DBName, "%s does not include tenant", c.Name)
+			assert.Equalf(t, "tenant_id", c.References[0].DBName, "%s does not reference tenant", c.Name)
+		}
+	}
+}
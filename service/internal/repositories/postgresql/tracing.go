@@ -0,0 +1,103 @@
+package postgresql
+
+import (
+	"cmp"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// querySpanKey это ключ настройки запроса со спаном запроса.
+const querySpanKey = "tracing:span"
+
+// queryTracerName это название трассировщика запросов к БД.
+const queryTracerName = "github.com/sedonn/song-library-service/internal/repositories/postgresql"
+
+// queryTracing это плагин GORM, который создает спан OpenTelemetry для каждого запроса к БД в трассировке
+// из контекста запроса. Спан содержит текст запроса без значений параметров.
+type queryTracing struct {
+	tracer trace.Tracer
+}
+
+// newQueryTracing создает плагин трассировки запросов к БД, который создает спаны определенным поставщиком.
+func newQueryTracing(tp trace.TracerProvider) queryTracing {
+	return queryTracing{tracer: tp.Tracer(queryTracerName)}
+}
+
+// Name возвращает название плагина.
+func (queryTracing) Name() string {
+	return "query_tracing"
+}
+
+// Initialize регистрирует обработчики запросов плагина.
+func (p queryTracing) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+
+	processors := []struct {
+		operation     string
+		before, after func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("*").Register, cb.Create().After("*").Register},
+		{"query", cb.Query().Before("*").Register, cb.Query().After("*").Register},
+		{"update", cb.Update().Before("*").Register, cb.Update().After("*").Register},
+		{"delete", cb.Delete().Before("*").Register, cb.Delete().After("*").Register},
+		{"row", cb.Row().Before("*").Register, cb.Row().After("*").Register},
+		{"raw", cb.Raw().Before("*").Register, cb.Raw().After("*").Register},
+	}
+	for _, v := range processors {
+		if err := v.before("tracing:"+v.operation+":start", p.startSpan(v.operation)); err != nil {
+			return err
+		}
+		if err := v.after("tracing:"+v.operation+":end", endSpan(v.operation)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startSpan возвращает обработчик, который начинает спан запроса определенной операции.
+func (p queryTracing) startSpan(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if db.Statement.Context == nil {
+			return
+		}
+
+		_, span := p.tracer.Start(db.Statement.Context, operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperationName(operation)),
+		)
+		db.Statement.Settings.Store(querySpanKey, span)
+	}
+}
+
+// endSpan возвращает обработчик, который завершает спан запроса определенной операции.
+// Отсутствие найденных записей не считается ошибкой запроса.
+func endSpan(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.Statement.Settings.LoadAndDelete(querySpanKey)
+		if !ok {
+			return
+		}
+
+		span, _ := v.(trace.Span)
+		defer span.End()
+
+		table := cmp.Or(db.Statement.Table, "unknown")
+		span.SetName(operation + " " + table)
+		span.SetAttributes(
+			semconv.DBCollectionName(table),
+			semconv.DBQueryText(db.Statement.SQL.String()),
+			attribute.Int64("db.rows_affected", db.RowsAffected),
+		)
+
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			span.RecordError(db.Error)
+			span.SetStatus(codes.Error, db.Error.Error())
+		}
+	}
+}
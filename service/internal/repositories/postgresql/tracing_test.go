@@ -0,0 +1,46 @@
+package postgresql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestQueryTracing(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+
+	r, _ := newRecordingRepository(t)
+	require.NoError(t, r.db.Use(newQueryTracing(tp)))
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, _ = r.Song(ctx, 1)
+	_, _ = r.IncrementQuotaUsage(ctx, "api_key:test", time.Now(), 1)
+	parent.End()
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, s := range sr.Ended() {
+		spans[s.Name()] = s
+	}
+	require.Contains(t, spans, "query songs")
+	require.Contains(t, spans, "create quota_usages")
+
+	s := spans["query songs"]
+	assert.Equal(t, trace.SpanKindClient, s.SpanKind())
+	assert.Equal(t, parent.SpanContext().TraceID(), s.SpanContext().TraceID(), "query span must belong to request trace")
+	assert.Equal(t, parent.SpanContext().SpanID(), s.Parent().SpanID())
+
+	attrs := map[string]string{}
+	for _, a := range s.Attributes() {
+		attrs[string(a.Key)] = a.Value.Emit()
+	}
+	assert.Equal(t, "postgresql", attrs["db.system"])
+	assert.Equal(t, "songs", attrs["db.collection.name"])
+	assert.Contains(t, attrs["db.query.text"], `FROM "songs"`)
+}
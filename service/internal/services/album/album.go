@@ -0,0 +1,245 @@
+package album
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+
+	albumrest "github.com/sedonn/song-library-service/internal/controllers/rest/album"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/metrics"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/repositories"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// AlbumProvider описывает поведение объекта слоя данных, который обеспечивает предоставление данных об альбомах.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=AlbumProvider
+type AlbumProvider interface {
+	// Album возвращает данные определенного альбома вместе с упорядоченным списком композиций.
+	Album(ctx context.Context, id uint64) (models.Album, error)
+	// Albums возвращает альбомы, найденные по определенным параметрам, упорядоченные по названию,
+	// и общее количество найденных альбомов без учета пагинации.
+	Albums(ctx context.Context, attrs models.Album, p models.Pagination) (models.Albums, uint64, error)
+}
+
+// AlbumSaver описывает поведение объекта слоя данных, который обеспечивает сохранение данных альбомов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=AlbumSaver
+type AlbumSaver interface {
+	// SaveAlbum сохраняет данные нового альбома вместе со списком композиций.
+	SaveAlbum(ctx context.Context, a models.Album) (models.Album, error)
+}
+
+// AlbumUpdater описывает поведение объекта слоя данных, который обеспечивает обновление данных альбомов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=AlbumUpdater
+type AlbumUpdater interface {
+	// UpdateAlbum обновляет данные определенного альбома.
+	// Если список композиций не nil, то он полностью заменяет текущий список композиций альбома.
+	UpdateAlbum(ctx context.Context, a models.Album) (models.Album, error)
+}
+
+// AlbumDeleter описывает поведение объекта слоя данных, который обеспечивает удаление данных альбомов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=AlbumDeleter
+type AlbumDeleter interface {
+	// DeleteAlbum удаляет данные определенного альбома.
+	DeleteAlbum(ctx context.Context, id uint64) (uint64, error)
+}
+
+// Service предоставляет бизнес-логику работы с альбомами.
+type Service struct {
+	log           *slog.Logger
+	albumProvider AlbumProvider
+	albumSaver    AlbumSaver
+	albumUpdater  AlbumUpdater
+	albumDeleter  AlbumDeleter
+}
+
+var _ albumrest.AlbumService = (*Service)(nil)
+
+// New создает новый объект сервиса альбомов.
+func New(log *slog.Logger, ap AlbumProvider, as AlbumSaver, au AlbumUpdater, ad AlbumDeleter) *Service {
+	return &Service{
+		log:           log,
+		albumProvider: ap,
+		albumSaver:    as,
+		albumUpdater:  au,
+		albumDeleter:  ad,
+	}
+}
+
+// GetAlbum возвращает данные определенного альбома вместе с упорядоченным списком композиций.
+func (s *Service) GetAlbum(ctx context.Context, id uint64) (models.AlbumWithTracksAPI, error) {
+	log := s.log.With(slog.Uint64("id", id))
+
+	log.Info("attempt to get album")
+
+	if err := services.Authorize(ctx, rbac.AlbumsRead); err != nil {
+		log.Warn("failed to get album", logger.ErrorString(err))
+
+		return models.AlbumWithTracksAPI{}, err
+	}
+
+	a, err := s.albumProvider.Album(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAlbumNotFound) {
+			log.Warn("failed to provide album", logger.ErrorString(err))
+
+			return models.AlbumWithTracksAPI{}, services.ErrAlbumNotFound
+		}
+
+		log.Error("failed to get album", logger.ErrorString(err))
+
+		return models.AlbumWithTracksAPI{}, err
+	}
+
+	return a.WithTracksAPI(), nil
+}
+
+// SearchAlbums выполняет поиск альбомов по определенным параметрам. Альбомы упорядочены по названию.
+func (s *Service) SearchAlbums(ctx context.Context, attrs models.Album, p models.Pagination) (models.AlbumsAPI, error) {
+	s.log.Info("attempt to search albums")
+
+	if err := services.Authorize(ctx, rbac.AlbumsRead); err != nil {
+		s.log.Warn("failed to search albums", logger.ErrorString(err))
+
+		return models.AlbumsAPI{}, err
+	}
+
+	albums, total, err := s.albumProvider.Albums(ctx, attrs, p)
+	if err != nil {
+		s.log.Error("failed to search albums", logger.ErrorString(err))
+
+		return models.AlbumsAPI{}, err
+	}
+
+	metrics.SearchResults.WithLabelValues("albums").Observe(float64(total))
+
+	s.log.Info("success to search albums", slog.Uint64("total", total))
+
+	return models.AlbumsAPI{
+		Albums: albums.API(),
+		Pagination: models.PaginationMetadataAPI{
+			CurrentPageNumber: p.PageNumber,
+			PageCount:         uint64(math.Ceil(float64(total) / float64(p.PageSize))),
+			RecordCount:       total,
+			PageSize:          p.PageSize,
+		},
+	}, nil
+}
+
+// CreateAlbum создает новый альбом.
+func (s *Service) CreateAlbum(ctx context.Context, a models.Album) (models.AlbumWithTracksAPI, error) {
+	log := s.log.With(slog.String("title", a.Title), logger.Actor(ctx))
+
+	log.Info("attempt to create album")
+
+	if err := services.Authorize(ctx, rbac.AlbumsWrite); err != nil {
+		log.Warn("failed to create album", logger.ErrorString(err))
+
+		return models.AlbumWithTracksAPI{}, err
+	}
+
+	a, err := s.albumSaver.SaveAlbum(ctx, a)
+	if err != nil {
+		if serviceErr := albumError(err); serviceErr != nil {
+			log.Warn("failed to create album", logger.ErrorString(err))
+
+			return models.AlbumWithTracksAPI{}, serviceErr
+		}
+
+		log.Error("failed to create album", logger.ErrorString(err))
+
+		return models.AlbumWithTracksAPI{}, err
+	}
+
+	log.Info("success to create album", slog.Uint64("id", a.ID))
+
+	return a.WithTracksAPI(), nil
+}
+
+// ChangeAlbum обновляет данные определенного альбома.
+func (s *Service) ChangeAlbum(ctx context.Context, a models.Album) (models.AlbumWithTracksAPI, error) {
+	log := s.log.With(slog.Uint64("id", a.ID), logger.Actor(ctx))
+
+	log.Info("attempt to change album")
+
+	if err := services.Authorize(ctx, rbac.AlbumsWrite); err != nil {
+		log.Warn("failed to change album", logger.ErrorString(err))
+
+		return models.AlbumWithTracksAPI{}, err
+	}
+
+	a, err := s.albumUpdater.UpdateAlbum(ctx, a)
+	if err != nil {
+		if serviceErr := albumError(err); serviceErr != nil {
+			log.Warn("failed to change album", logger.ErrorString(err))
+
+			return models.AlbumWithTracksAPI{}, serviceErr
+		}
+
+		log.Error("failed to change album", logger.ErrorString(err))
+
+		return models.AlbumWithTracksAPI{}, err
+	}
+
+	log.Info("success to change album")
+
+	return a.WithTracksAPI(), nil
+}
+
+// RemoveAlbum удаляет данные определенного альбома.
+func (s *Service) RemoveAlbum(ctx context.Context, id uint64) (models.AlbumIDAPI, error) {
+	log := s.log.With(slog.Uint64("id", id), logger.Actor(ctx))
+
+	log.Info("attempt to remove album")
+
+	if err := services.Authorize(ctx, rbac.AlbumsDelete); err != nil {
+		log.Warn("failed to remove album", logger.ErrorString(err))
+
+		return models.AlbumIDAPI{}, err
+	}
+
+	id, err := s.albumDeleter.DeleteAlbum(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAlbumNotFound) {
+			log.Warn("failed to remove album", logger.ErrorString(err))
+
+			return models.AlbumIDAPI{}, services.ErrAlbumNotFound
+		}
+
+		log.Error("failed to remove album", logger.ErrorString(err))
+
+		return models.AlbumIDAPI{}, err
+	}
+
+	log.Info("success to remove album")
+
+	return models.AlbumIDAPI{ID: id}, nil
+}
+
+// albumError преобразует ожидаемые ошибки слоя данных в ошибки бизнес-логики.
+// Возвращает nil, если ошибка не является ожидаемой.
+func albumError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrAlbumNotFound):
+		return services.ErrAlbumNotFound
+
+	case errors.Is(err, repositories.ErrArtistNotFound):
+		return services.ErrArtistNotFound
+
+	case errors.Is(err, repositories.ErrSongNotFound):
+		return services.ErrSongNotFound
+
+	case errors.Is(err, repositories.ErrAlbumTrackConflict):
+		return services.ErrAlbumTrackConflict
+
+	default:
+		return nil
+	}
+}
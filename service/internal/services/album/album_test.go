@@ -0,0 +1,462 @@
+package album
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/repositories"
+	"github.com/sedonn/song-library-service/internal/services"
+	"github.com/sedonn/song-library-service/internal/services/album/mocks"
+)
+
+var (
+	discardLogger          = logger.NewDiscardLogger()
+	errUnexpected          = errors.New("unexpected error")
+	expectedAlbumID uint64 = 1
+	expectedAlbum          = models.Album{
+		ID:    expectedAlbumID,
+		Title: "album",
+		Type:  models.AlbumTypeAlbum,
+		Tracks: models.AlbumTracks{
+			{AlbumID: expectedAlbumID, SongID: 2, DiscNumber: 1, TrackNumber: 2, Song: models.Song{ID: 2}},
+			{AlbumID: expectedAlbumID, SongID: 1, DiscNumber: 1, TrackNumber: 1, Song: models.Song{ID: 1}},
+		},
+	}
+)
+
+func TestService_GetAlbum(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		albumProvider AlbumProvider
+	}
+	type args struct {
+		ctx context.Context
+		id  uint64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.AlbumWithTracksAPI
+		wantErr error
+	}{
+		{
+			name: "GetAlbum happy path",
+			fields: fields{
+				albumProvider: func() AlbumProvider {
+					ap := mocks.NewAlbumProvider(t)
+					ap.
+						On("Album", mock.Anything, expectedAlbumID).
+						Once().
+						Return(expectedAlbum, nil)
+
+					return ap
+				}(),
+			},
+			args: args{
+				id: expectedAlbumID,
+			},
+			want: expectedAlbum.WithTracksAPI(),
+		},
+		{
+			name: "GetAlbum error album not found",
+			fields: fields{
+				albumProvider: func() AlbumProvider {
+					ap := mocks.NewAlbumProvider(t)
+					ap.
+						On("Album", mock.Anything, expectedAlbumID).
+						Once().
+						Return(models.Album{}, repositories.ErrAlbumNotFound)
+
+					return ap
+				}(),
+			},
+			args: args{
+				id: expectedAlbumID,
+			},
+			want:    models.AlbumWithTracksAPI{},
+			wantErr: services.ErrAlbumNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:           discardLogger,
+				albumProvider: tt.fields.albumProvider,
+			}
+			got, err := s.GetAlbum(tt.args.ctx, tt.args.id)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.GetAlbum() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_SearchAlbums(t *testing.T) {
+	t.Parallel()
+
+	pagination := models.Pagination{PageNumber: 1, PageSize: 10}
+	attrs := models.Album{Title: "album", Artist: models.Artist{Name: "artist"}, Type: models.AlbumTypeAlbum}
+
+	type fields struct {
+		albumProvider AlbumProvider
+	}
+	type args struct {
+		ctx   context.Context
+		attrs models.Album
+		p     models.Pagination
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.AlbumsAPI
+		wantErr error
+	}{
+		{
+			name: "SearchAlbums happy path",
+			fields: fields{
+				albumProvider: func() AlbumProvider {
+					ap := mocks.NewAlbumProvider(t)
+					ap.
+						On("Albums", mock.Anything, attrs, pagination).
+						Once().
+						Return(models.Albums{expectedAlbum}, uint64(11), nil)
+
+					return ap
+				}(),
+			},
+			args: args{
+				attrs: attrs,
+				p:     pagination,
+			},
+			want: models.AlbumsAPI{
+				Albums: models.Albums{expectedAlbum}.API(),
+				Pagination: models.PaginationMetadataAPI{
+					CurrentPageNumber: 1,
+					PageCount:         2,
+					PageSize:          10,
+					RecordCount:       11,
+				},
+			},
+		},
+		{
+			name: "SearchAlbums error database",
+			fields: fields{
+				albumProvider: func() AlbumProvider {
+					ap := mocks.NewAlbumProvider(t)
+					ap.
+						On("Albums", mock.Anything, attrs, pagination).
+						Once().
+						Return(models.Albums{}, uint64(0), errUnexpected)
+
+					return ap
+				}(),
+			},
+			args: args{
+				attrs: attrs,
+				p:     pagination,
+			},
+			want:    models.AlbumsAPI{},
+			wantErr: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:           discardLogger,
+				albumProvider: tt.fields.albumProvider,
+			}
+			got, err := s.SearchAlbums(tt.args.ctx, tt.args.attrs, tt.args.p)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.SearchAlbums() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_CreateAlbum(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		albumSaver AlbumSaver
+	}
+	type args struct {
+		ctx context.Context
+		a   models.Album
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.AlbumWithTracksAPI
+		wantErr error
+	}{
+		{
+			name: "CreateAlbum happy path",
+			fields: fields{
+				albumSaver: func() AlbumSaver {
+					as := mocks.NewAlbumSaver(t)
+					as.
+						On("SaveAlbum", mock.Anything, expectedAlbum).
+						Once().
+						Return(expectedAlbum, nil)
+
+					return as
+				}(),
+			},
+			args: args{
+				a: expectedAlbum,
+			},
+			want: expectedAlbum.WithTracksAPI(),
+		},
+		{
+			name: "CreateAlbum error artist not found",
+			fields: fields{
+				albumSaver: func() AlbumSaver {
+					as := mocks.NewAlbumSaver(t)
+					as.
+						On("SaveAlbum", mock.Anything, expectedAlbum).
+						Once().
+						Return(models.Album{}, repositories.ErrArtistNotFound)
+
+					return as
+				}(),
+			},
+			args: args{
+				a: expectedAlbum,
+			},
+			want:    models.AlbumWithTracksAPI{},
+			wantErr: services.ErrArtistNotFound,
+		},
+		{
+			name: "CreateAlbum error song not found",
+			fields: fields{
+				albumSaver: func() AlbumSaver {
+					as := mocks.NewAlbumSaver(t)
+					as.
+						On("SaveAlbum", mock.Anything, expectedAlbum).
+						Once().
+						Return(models.Album{}, repositories.ErrSongNotFound)
+
+					return as
+				}(),
+			},
+			args: args{
+				a: expectedAlbum,
+			},
+			want:    models.AlbumWithTracksAPI{},
+			wantErr: services.ErrSongNotFound,
+		},
+		{
+			name: "CreateAlbum error track conflict",
+			fields: fields{
+				albumSaver: func() AlbumSaver {
+					as := mocks.NewAlbumSaver(t)
+					as.
+						On("SaveAlbum", mock.Anything, expectedAlbum).
+						Once().
+						Return(models.Album{}, repositories.ErrAlbumTrackConflict)
+
+					return as
+				}(),
+			},
+			args: args{
+				a: expectedAlbum,
+			},
+			want:    models.AlbumWithTracksAPI{},
+			wantErr: services.ErrAlbumTrackConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:        discardLogger,
+				albumSaver: tt.fields.albumSaver,
+			}
+			got, err := s.CreateAlbum(tt.args.ctx, tt.args.a)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.CreateAlbum() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_ChangeAlbum(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		albumUpdater AlbumUpdater
+	}
+	type args struct {
+		ctx context.Context
+		a   models.Album
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.AlbumWithTracksAPI
+		wantErr error
+	}{
+		{
+			name: "ChangeAlbum happy path",
+			fields: fields{
+				albumUpdater: func() AlbumUpdater {
+					au := mocks.NewAlbumUpdater(t)
+					au.
+						On("UpdateAlbum", mock.Anything, expectedAlbum).
+						Once().
+						Return(expectedAlbum, nil)
+
+					return au
+				}(),
+			},
+			args: args{
+				a: expectedAlbum,
+			},
+			want: expectedAlbum.WithTracksAPI(),
+		},
+		{
+			name: "ChangeAlbum error album not found",
+			fields: fields{
+				albumUpdater: func() AlbumUpdater {
+					au := mocks.NewAlbumUpdater(t)
+					au.
+						On("UpdateAlbum", mock.Anything, expectedAlbum).
+						Once().
+						Return(models.Album{}, repositories.ErrAlbumNotFound)
+
+					return au
+				}(),
+			},
+			args: args{
+				a: expectedAlbum,
+			},
+			want:    models.AlbumWithTracksAPI{},
+			wantErr: services.ErrAlbumNotFound,
+		},
+		{
+			name: "ChangeAlbum error track conflict",
+			fields: fields{
+				albumUpdater: func() AlbumUpdater {
+					au := mocks.NewAlbumUpdater(t)
+					au.
+						On("UpdateAlbum", mock.Anything, expectedAlbum).
+						Once().
+						Return(models.Album{}, repositories.ErrAlbumTrackConflict)
+
+					return au
+				}(),
+			},
+			args: args{
+				a: expectedAlbum,
+			},
+			want:    models.AlbumWithTracksAPI{},
+			wantErr: services.ErrAlbumTrackConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:          discardLogger,
+				albumUpdater: tt.fields.albumUpdater,
+			}
+			got, err := s.ChangeAlbum(tt.args.ctx, tt.args.a)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.ChangeAlbum() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_RemoveAlbum(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		albumDeleter AlbumDeleter
+	}
+	type args struct {
+		ctx context.Context
+		id  uint64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.AlbumIDAPI
+		wantErr error
+	}{
+		{
+			name: "RemoveAlbum happy path",
+			fields: fields{
+				albumDeleter: func() AlbumDeleter {
+					ad := mocks.NewAlbumDeleter(t)
+					ad.
+						On("DeleteAlbum", mock.Anything, expectedAlbumID).
+						Once().
+						Return(expectedAlbumID, nil)
+
+					return ad
+				}(),
+			},
+			args: args{
+				id: expectedAlbumID,
+			},
+			want: models.AlbumIDAPI{ID: expectedAlbumID},
+		},
+		{
+			name: "RemoveAlbum error album not found",
+			fields: fields{
+				albumDeleter: func() AlbumDeleter {
+					ad := mocks.NewAlbumDeleter(t)
+					ad.
+						On("DeleteAlbum", mock.Anything, expectedAlbumID).
+						Once().
+						Return(uint64(0), repositories.ErrAlbumNotFound)
+
+					return ad
+				}(),
+			},
+			args: args{
+				id: expectedAlbumID,
+			},
+			want:    models.AlbumIDAPI{},
+			wantErr: services.ErrAlbumNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:          discardLogger,
+				albumDeleter: tt.fields.albumDeleter,
+			}
+			got, err := s.RemoveAlbum(tt.args.ctx, tt.args.id)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.RemoveAlbum() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
@@ -3,15 +3,26 @@ package artist
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"math"
+
+	"go.opentelemetry.io/otel"
 
 	artistrest "github.com/sedonn/song-library-service/internal/controllers/rest/artist"
 	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/identifiers"
 	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/metrics"
+	"github.com/sedonn/song-library-service/internal/pkg/names"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
 	"github.com/sedonn/song-library-service/internal/repositories"
 	"github.com/sedonn/song-library-service/internal/services"
 )
 
+// tracer создает спаны методов сервиса глобальным поставщиком трассировщиков.
+var tracer = otel.Tracer("github.com/sedonn/song-library-service/internal/services/artist")
+
 // ArtistSaver описывает поведение объекта слоя данных, который обеспечивает сохранение данных исполнителей.
 //
 //go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=ArtistSaver
@@ -26,6 +37,12 @@ type ArtistSaver interface {
 type ArtistProvider interface {
 	// Artist возвращает данные определенного исполнителя.
 	Artist(ctx context.Context, id uint64) (models.Artist, error)
+	// Artists возвращает исполнителей, найденных по определенным параметрам, и общее количество найденных исполнителей.
+	Artists(ctx context.Context, attrs models.Artist, p models.Pagination) (models.Artists, uint64, error)
+	// ArtistByISNI возвращает исполнителя с определенным кодом ISNI.
+	ArtistByISNI(ctx context.Context, isni string) (models.Artist, error)
+	// ArtistByMBID возвращает исполнителя с определенным идентификатором MusicBrainz.
+	ArtistByMBID(ctx context.Context, mbid string) (models.Artist, error)
 }
 
 // ArtistUpdater описывает поведение объекта слоя данных, который обеспечивает обновление данных о исполнителях.
@@ -44,39 +61,91 @@ type ArtistDeleter interface {
 	DeleteArtist(ctx context.Context, id uint64) (uint64, error)
 }
 
+// ArtistMerger описывает поведение объекта слоя данных, который обеспечивает слияние исполнителей-дубликатов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=ArtistMerger
+type ArtistMerger interface {
+	// MergeArtists переносит все ссылки на исполнителя-дубликата на основного исполнителя и удаляет дубликат.
+	MergeArtists(ctx context.Context, id, duplicateID uint64) (models.Artist, error)
+}
+
+// ArtistAliasEditor описывает поведение объекта слоя данных, который обеспечивает работу с псевдонимами исполнителей.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=ArtistAliasEditor
+type ArtistAliasEditor interface {
+	// ArtistAliases возвращает псевдонимы определенного исполнителя.
+	ArtistAliases(ctx context.Context, artistID uint64) (models.ArtistAliases, error)
+	// SaveArtistAlias сохраняет новый псевдоним исполнителя.
+	SaveArtistAlias(ctx context.Context, alias models.ArtistAlias) (models.ArtistAlias, error)
+	// DeleteArtistAlias удаляет определенный псевдоним определенного исполнителя.
+	DeleteArtistAlias(ctx context.Context, artistID, aliasID uint64) (uint64, error)
+}
+
 type Service struct {
 	log            *slog.Logger
 	artistProvider ArtistProvider
 	artistSaver    ArtistSaver
 	artistUpdater  ArtistUpdater
 	artistDeleter  ArtistDeleter
+	artistMerger   ArtistMerger
+	aliasEditor    ArtistAliasEditor
 }
 
 var _ artistrest.ArtistService = (*Service)(nil)
 
 // New создает новый объект сервиса исполнителей.
-func New(log *slog.Logger, as ArtistSaver, ap ArtistProvider, au ArtistUpdater, ad ArtistDeleter) *Service {
+func New(
+	log *slog.Logger,
+	as ArtistSaver,
+	ap ArtistProvider,
+	au ArtistUpdater,
+	ad ArtistDeleter,
+	am ArtistMerger,
+	aae ArtistAliasEditor,
+) *Service {
 	return &Service{
 		log:            log,
 		artistProvider: ap,
 		artistSaver:    as,
 		artistUpdater:  au,
 		artistDeleter:  ad,
+		artistMerger:   am,
+		aliasEditor:    aae,
 	}
 }
 
 // CreateArtist создает нового исполнителя.
+// Если название для сортировки не задано, то оно строится из названия исполнителя.
 func (s *Service) CreateArtist(ctx context.Context, a models.Artist) (models.ArtistAPI, error) {
-	log := s.log.With(slog.String("name", a.Name))
+	ctx, span := tracer.Start(ctx, "artist.Service.CreateArtist")
+	defer span.End()
+
+	log := s.log.With(slog.String("name", a.Name), logger.Actor(ctx), logger.Trace(ctx))
 
 	log.Info("attempt to create artist")
 
+	if err := services.Authorize(ctx, rbac.ArtistsWrite); err != nil {
+		log.Warn("failed to create artist", logger.ErrorString(err))
+
+		return models.ArtistAPI{}, err
+	}
+
+	if a.SortName == "" {
+		a.SortName = names.SortName(a.Name)
+	}
+
+	if err := normalizeArtistIdentifiers(&a); err != nil {
+		log.Warn("failed to create artist", logger.ErrorString(err))
+
+		return models.ArtistAPI{}, err
+	}
+
 	a, err := s.artistSaver.SaveArtist(ctx, a)
 	if err != nil {
-		if errors.Is(err, repositories.ErrArtistExists) {
+		if serviceErr := artistError(err); serviceErr != nil {
 			log.Warn("failed to create artist", logger.ErrorString(err))
 
-			return models.ArtistAPI{}, services.ErrArtistExists
+			return models.ArtistAPI{}, serviceErr
 		}
 
 		log.Error("failed to create artist", logger.ErrorString(err))
@@ -91,10 +160,19 @@ func (s *Service) CreateArtist(ctx context.Context, a models.Artist) (models.Art
 
 // GetArtist возвращает нового исполнителя.
 func (s *Service) GetArtist(ctx context.Context, id uint64) (models.ArtistAPI, error) {
-	log := s.log.With(slog.Uint64("id", id))
+	ctx, span := tracer.Start(ctx, "artist.Service.GetArtist")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), logger.Trace(ctx))
 
 	log.Info("attempt to get artist")
 
+	if err := services.Authorize(ctx, rbac.ArtistsRead); err != nil {
+		log.Warn("failed to get artist", logger.ErrorString(err))
+
+		return models.ArtistAPI{}, err
+	}
+
 	a, err := s.artistProvider.Artist(ctx, id)
 	if err != nil {
 		if errors.Is(err, repositories.ErrArtistNotFound) {
@@ -112,26 +190,42 @@ func (s *Service) GetArtist(ctx context.Context, id uint64) (models.ArtistAPI, e
 }
 
 // ChangeArtist изменяет данные определенного исполнителя.
+// Если название исполнителя изменяется без названия для сортировки, то название для сортировки строится заново.
 func (s *Service) ChangeArtist(ctx context.Context, a models.Artist) (models.ArtistAPI, error) {
-	log := s.log.With(slog.Uint64("id", a.ID))
+	ctx, span := tracer.Start(ctx, "artist.Service.ChangeArtist")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", a.ID), logger.Actor(ctx), logger.Trace(ctx))
 
 	log.Info("attempt to change artist")
 
+	if err := services.Authorize(ctx, rbac.ArtistsWrite); err != nil {
+		log.Warn("failed to change artist", logger.ErrorString(err))
+
+		return models.ArtistAPI{}, err
+	}
+
+	if a.Name != "" && a.SortName == "" {
+		a.SortName = names.SortName(a.Name)
+	}
+
+	if err := normalizeArtistIdentifiers(&a); err != nil {
+		log.Warn("failed to change artist", logger.ErrorString(err))
+
+		return models.ArtistAPI{}, err
+	}
+
 	a, err := s.artistUpdater.UpdateArtist(ctx, a)
 	if err != nil {
-		switch {
-		case errors.Is(err, repositories.ErrArtistNotFound):
+		if serviceErr := artistError(err); serviceErr != nil {
 			log.Warn("failed to change artist", logger.ErrorString(err))
-			return models.ArtistAPI{}, services.ErrArtistNotFound
-
-		case errors.Is(err, repositories.ErrArtistExists):
-			log.Warn("failed to change artist", logger.ErrorString(err))
-			return models.ArtistAPI{}, services.ErrArtistExists
 
-		default:
-			log.Error("failed to change artist", logger.ErrorString(err))
-			return models.ArtistAPI{}, err
+			return models.ArtistAPI{}, serviceErr
 		}
+
+		log.Error("failed to change artist", logger.ErrorString(err))
+
+		return models.ArtistAPI{}, err
 	}
 
 	log.Info("success to change artist")
@@ -139,12 +233,118 @@ func (s *Service) ChangeArtist(ctx context.Context, a models.Artist) (models.Art
 	return a.API(), nil
 }
 
+// SearchArtists выполняет поиск исполнителей по определенным параметрам.
+// Исполнители упорядочены по названию для сортировки.
+func (s *Service) SearchArtists(ctx context.Context, attrs models.Artist, p models.Pagination) (models.ArtistsAPI, error) {
+	ctx, span := tracer.Start(ctx, "artist.Service.SearchArtists")
+	defer span.End()
+
+	log := s.log.With(logger.Trace(ctx))
+
+	log.Info("attempt to search artists")
+
+	if err := services.Authorize(ctx, rbac.ArtistsRead); err != nil {
+		log.Warn("failed to search artists", logger.ErrorString(err))
+
+		return models.ArtistsAPI{}, err
+	}
+
+	artists, total, err := s.artistProvider.Artists(ctx, attrs, p)
+	if err != nil {
+		log.Error("failed to search artists", logger.ErrorString(err))
+
+		return models.ArtistsAPI{}, err
+	}
+
+	metrics.SearchResults.WithLabelValues("artists").Observe(float64(total))
+
+	log.Info("success to search artists", slog.Uint64("total", total))
+
+	return models.ArtistsAPI{
+		Artists: artists.API(),
+		Pagination: models.PaginationMetadataAPI{
+			CurrentPageNumber: p.PageNumber,
+			PageCount:         uint64(math.Ceil(float64(total) / float64(p.PageSize))),
+			RecordCount:       total,
+			PageSize:          p.PageSize,
+		},
+	}, nil
+}
+
+// GetArtistByISNI возвращает исполнителя с определенным кодом ISNI. Код может содержать пробелы.
+func (s *Service) GetArtistByISNI(ctx context.Context, isni string) (models.ArtistAPI, error) {
+	ctx, span := tracer.Start(ctx, "artist.Service.GetArtistByISNI")
+	defer span.End()
+
+	return s.getArtistByIdentifier(ctx, "isni", isni, identifiers.NormalizeISNI, s.artistProvider.ArtistByISNI)
+}
+
+// GetArtistByMBID возвращает исполнителя с определенным идентификатором MusicBrainz.
+func (s *Service) GetArtistByMBID(ctx context.Context, mbid string) (models.ArtistAPI, error) {
+	ctx, span := tracer.Start(ctx, "artist.Service.GetArtistByMBID")
+	defer span.End()
+
+	return s.getArtistByIdentifier(ctx, "mbid", mbid, identifiers.NormalizeMBID, s.artistProvider.ArtistByMBID)
+}
+
+// getArtistByIdentifier приводит стандартный идентификатор определенного вида к каноническому виду
+// и возвращает исполнителя с этим идентификатором.
+func (s *Service) getArtistByIdentifier(
+	ctx context.Context,
+	kind, id string,
+	normalize func(string) (string, error),
+	provide func(context.Context, string) (models.Artist, error),
+) (models.ArtistAPI, error) {
+	log := s.log.With(slog.String(kind, id), logger.Trace(ctx))
+
+	log.Info("attempt to get artist by " + kind)
+
+	if err := services.Authorize(ctx, rbac.ArtistsRead); err != nil {
+		log.Warn("failed to get artist by "+kind, logger.ErrorString(err))
+
+		return models.ArtistAPI{}, err
+	}
+
+	id, err := normalize(id)
+	if err != nil {
+		log.Warn("failed to get artist by "+kind, logger.ErrorString(err))
+
+		return models.ArtistAPI{}, fmt.Errorf("%w: %s: %w", services.ErrInvalidIdentifier, kind, err)
+	}
+
+	a, err := provide(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrArtistNotFound) {
+			log.Warn("failed to get artist by "+kind, logger.ErrorString(err))
+
+			return models.ArtistAPI{}, services.ErrArtistNotFound
+		}
+
+		log.Error("failed to get artist by "+kind, logger.ErrorString(err))
+
+		return models.ArtistAPI{}, err
+	}
+
+	log.Info("success to get artist by "+kind, slog.Uint64("id", a.ID))
+
+	return a.API(), nil
+}
+
 // RemoveArtist удаляет данные определенного исполнителя.
 func (s *Service) RemoveArtist(ctx context.Context, id uint64) (models.ArtistIDAPI, error) {
-	log := s.log.With(slog.Uint64("id", id))
+	ctx, span := tracer.Start(ctx, "artist.Service.RemoveArtist")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), logger.Actor(ctx), logger.Trace(ctx))
 
 	log.Info("attempt to remove artist")
 
+	if err := services.Authorize(ctx, rbac.ArtistsDelete); err != nil {
+		log.Warn("failed to remove artist", logger.ErrorString(err))
+
+		return models.ArtistIDAPI{}, err
+	}
+
 	id, err := s.artistDeleter.DeleteArtist(ctx, id)
 	if err != nil {
 		if errors.Is(err, repositories.ErrArtistNotFound) {
@@ -162,3 +362,202 @@ func (s *Service) RemoveArtist(ctx context.Context, id uint64) (models.ArtistIDA
 
 	return models.ArtistIDAPI{ID: id}, nil
 }
+
+// MergeArtists сливает исполнителя-дубликата с определенным исполнителем.
+// Песни, альбомы, участие в создании песен и псевдонимы дубликата переносятся на исполнителя, после чего дубликат удаляется.
+// Название дубликата становится псевдонимом исполнителя.
+func (s *Service) MergeArtists(ctx context.Context, id, duplicateID uint64) (models.ArtistAPI, error) {
+	ctx, span := tracer.Start(ctx, "artist.Service.MergeArtists")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), slog.Uint64("duplicate_id", duplicateID), logger.Actor(ctx), logger.Trace(ctx))
+
+	log.Info("attempt to merge artists")
+
+	if err := services.Authorize(ctx, rbac.ArtistsDelete); err != nil {
+		log.Warn("failed to merge artists", logger.ErrorString(err))
+
+		return models.ArtistAPI{}, err
+	}
+
+	if id == duplicateID {
+		log.Warn("failed to merge artists", logger.ErrorString(services.ErrMergeIntoItself))
+
+		return models.ArtistAPI{}, services.ErrMergeIntoItself
+	}
+
+	a, err := s.artistMerger.MergeArtists(ctx, id, duplicateID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrArtistNotFound) {
+			log.Warn("failed to merge artists", logger.ErrorString(err))
+
+			return models.ArtistAPI{}, services.ErrArtistNotFound
+		}
+
+		log.Error("failed to merge artists", logger.ErrorString(err))
+
+		return models.ArtistAPI{}, err
+	}
+
+	log.Info("success to merge artists")
+
+	return a.API(), nil
+}
+
+// GetArtistAliases возвращает псевдонимы определенного исполнителя.
+func (s *Service) GetArtistAliases(ctx context.Context, artistID uint64) (models.ArtistAliasesAPI, error) {
+	ctx, span := tracer.Start(ctx, "artist.Service.GetArtistAliases")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", artistID), logger.Trace(ctx))
+
+	log.Info("attempt to get artist aliases")
+
+	if err := services.Authorize(ctx, rbac.ArtistsRead); err != nil {
+		log.Warn("failed to get artist aliases", logger.ErrorString(err))
+
+		return models.ArtistAliasesAPI{}, err
+	}
+
+	aliases, err := s.aliasEditor.ArtistAliases(ctx, artistID)
+	if err != nil {
+		if serviceErr := artistAliasError(err); serviceErr != nil {
+			log.Warn("failed to get artist aliases", logger.ErrorString(err))
+
+			return models.ArtistAliasesAPI{}, serviceErr
+		}
+
+		log.Error("failed to get artist aliases", logger.ErrorString(err))
+
+		return models.ArtistAliasesAPI{}, err
+	}
+
+	log.Info("success to get artist aliases", slog.Int("count", len(aliases)))
+
+	return aliases.API(artistID), nil
+}
+
+// AddArtistAlias добавляет новый псевдоним исполнителя.
+// Псевдоним не может совпадать с названием или псевдонимом какого-либо исполнителя без учета регистра и лишних пробелов.
+func (s *Service) AddArtistAlias(ctx context.Context, alias models.ArtistAlias) (models.ArtistAliasAPI, error) {
+	ctx, span := tracer.Start(ctx, "artist.Service.AddArtistAlias")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", alias.ArtistID), slog.String("alias", alias.Name), logger.Actor(ctx), logger.Trace(ctx))
+
+	log.Info("attempt to add artist alias")
+
+	if err := services.Authorize(ctx, rbac.ArtistsWrite); err != nil {
+		log.Warn("failed to add artist alias", logger.ErrorString(err))
+
+		return models.ArtistAliasAPI{}, err
+	}
+
+	alias, err := s.aliasEditor.SaveArtistAlias(ctx, alias)
+	if err != nil {
+		if serviceErr := artistAliasError(err); serviceErr != nil {
+			log.Warn("failed to add artist alias", logger.ErrorString(err))
+
+			return models.ArtistAliasAPI{}, serviceErr
+		}
+
+		log.Error("failed to add artist alias", logger.ErrorString(err))
+
+		return models.ArtistAliasAPI{}, err
+	}
+
+	log.Info("success to add artist alias", slog.Uint64("alias_id", alias.ID))
+
+	return alias.API(), nil
+}
+
+// RemoveArtistAlias удаляет определенный псевдоним определенного исполнителя.
+func (s *Service) RemoveArtistAlias(ctx context.Context, artistID, aliasID uint64) (models.ArtistAliasIDAPI, error) {
+	ctx, span := tracer.Start(ctx, "artist.Service.RemoveArtistAlias")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", artistID), slog.Uint64("alias_id", aliasID), logger.Actor(ctx), logger.Trace(ctx))
+
+	log.Info("attempt to remove artist alias")
+
+	if err := services.Authorize(ctx, rbac.ArtistsWrite); err != nil {
+		log.Warn("failed to remove artist alias", logger.ErrorString(err))
+
+		return models.ArtistAliasIDAPI{}, err
+	}
+
+	aliasID, err := s.aliasEditor.DeleteArtistAlias(ctx, artistID, aliasID)
+	if err != nil {
+		if serviceErr := artistAliasError(err); serviceErr != nil {
+			log.Warn("failed to remove artist alias", logger.ErrorString(err))
+
+			return models.ArtistAliasIDAPI{}, serviceErr
+		}
+
+		log.Error("failed to remove artist alias", logger.ErrorString(err))
+
+		return models.ArtistAliasIDAPI{}, err
+	}
+
+	log.Info("success to remove artist alias")
+
+	return models.ArtistAliasIDAPI{ID: aliasID}, nil
+}
+
+// normalizeArtistIdentifiers приводит заданные коды ISNI и MBID исполнителя к каноническому виду.
+// Возвращает ошибку ErrInvalidIdentifier, если какой-либо код не соответствует формату или контрольной сумме.
+func normalizeArtistIdentifiers(a *models.Artist) error {
+	var err error
+	if a.ISNI != "" {
+		if a.ISNI, err = identifiers.NormalizeISNI(a.ISNI); err != nil {
+			return fmt.Errorf("%w: isni: %w", services.ErrInvalidIdentifier, err)
+		}
+	}
+
+	if a.MBID != "" {
+		if a.MBID, err = identifiers.NormalizeMBID(a.MBID); err != nil {
+			return fmt.Errorf("%w: mbid: %w", services.ErrInvalidIdentifier, err)
+		}
+	}
+
+	return nil
+}
+
+// artistError преобразует ошибки слоя данных исполнителей в ошибки бизнес-логики.
+// Возвращает nil, если ошибка не является ожидаемой.
+func artistError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrArtistNotFound):
+		return services.ErrArtistNotFound
+
+	case errors.Is(err, repositories.ErrArtistExists):
+		return services.ErrArtistExists
+
+	case errors.Is(err, repositories.ErrArtistActiveYearsInvalid):
+		return services.ErrArtistActiveYearsInvalid
+
+	case errors.Is(err, repositories.ErrIdentifierExists):
+		return services.ErrIdentifierExists
+
+	default:
+		return nil
+	}
+}
+
+// artistAliasError преобразует ошибки слоя данных псевдонимов исполнителей в ошибки бизнес-логики.
+// Возвращает nil, если ошибка не является ожидаемой.
+func artistAliasError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrArtistNotFound):
+		return services.ErrArtistNotFound
+
+	case errors.Is(err, repositories.ErrArtistAliasNotFound):
+		return services.ErrArtistAliasNotFound
+
+	case errors.Is(err, repositories.ErrArtistAliasExists):
+		return services.ErrArtistAliasExists
+
+	default:
+		return nil
+	}
+}
@@ -51,7 +51,8 @@ func TestService_CreateArtist(t *testing.T) {
 				}(),
 			},
 			args: args{
-				a: expectedArtist,
+				ctx: context.Background(),
+				a:   expectedArtist,
 			},
 			want: expectedArtist.API(),
 		},
@@ -68,11 +69,50 @@ func TestService_CreateArtist(t *testing.T) {
 				}(),
 			},
 			args: args{
-				a: expectedArtist,
+				ctx: context.Background(),
+				a:   expectedArtist,
 			},
 			want:    models.ArtistAPI{},
 			wantErr: services.ErrArtistExists,
 		},
+		{
+			name: "CreateArtist default sort name",
+			fields: fields{
+				artistSaver: func() ArtistSaver {
+					as := mocks.NewArtistSaver(t)
+					as.
+						On("SaveArtist", mock.Anything, models.Artist{Name: "The Beatles", SortName: "Beatles, The"}).
+						Once().
+						Return(models.Artist{ID: expectedArtistID, Name: "The Beatles", SortName: "Beatles, The"}, nil)
+
+					return as
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				a:   models.Artist{Name: "The Beatles"},
+			},
+			want: models.Artist{ID: expectedArtistID, Name: "The Beatles", SortName: "Beatles, The"}.API(),
+		},
+		{
+			name: "CreateArtist error active years invalid",
+			fields: fields{
+				artistSaver: func() ArtistSaver {
+					as := mocks.NewArtistSaver(t)
+					as.
+						On("SaveArtist", mock.Anything, expectedArtist).
+						Once().
+						Return(models.Artist{}, repositories.ErrArtistActiveYearsInvalid)
+
+					return as
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				a:   expectedArtist,
+			},
+			wantErr: services.ErrArtistActiveYearsInvalid,
+		},
 	}
 
 	for _, tt := range tests {
@@ -122,7 +162,8 @@ func TestService_GetArtist(t *testing.T) {
 				}(),
 			},
 			args: args{
-				id: expectedArtistID,
+				ctx: context.Background(),
+				id:  expectedArtistID,
 			},
 			want:    expectedArtist.API(),
 			wantErr: nil,
@@ -141,7 +182,8 @@ func TestService_GetArtist(t *testing.T) {
 				}(),
 			},
 			args: args{
-				id: expectedArtistID,
+				ctx: context.Background(),
+				id:  expectedArtistID,
 			},
 			want:    models.ArtistAPI{},
 			wantErr: services.ErrArtistNotFound,
@@ -195,7 +237,8 @@ func TestService_ChangeArtist(t *testing.T) {
 				}(),
 			},
 			args: args{
-				a: expectedArtist,
+				ctx: context.Background(),
+				a:   expectedArtist,
 			},
 			want: expectedArtist.API(),
 		},
@@ -213,7 +256,8 @@ func TestService_ChangeArtist(t *testing.T) {
 				}(),
 			},
 			args: args{
-				a: expectedArtist,
+				ctx: context.Background(),
+				a:   expectedArtist,
 			},
 			want:    models.ArtistAPI{},
 			wantErr: services.ErrArtistNotFound,
@@ -232,7 +276,8 @@ func TestService_ChangeArtist(t *testing.T) {
 				}(),
 			},
 			args: args{
-				a: expectedArtist,
+				ctx: context.Background(),
+				a:   expectedArtist,
 			},
 			want:    models.ArtistAPI{},
 			wantErr: services.ErrArtistExists,
@@ -285,7 +330,8 @@ func TestService_RemoveArtist(t *testing.T) {
 				}(),
 			},
 			args: args{
-				id: expectedArtistID,
+				ctx: context.Background(),
+				id:  expectedArtistID,
 			},
 			want:    models.ArtistIDAPI{ID: expectedArtistID},
 			wantErr: nil,
@@ -304,7 +350,8 @@ func TestService_RemoveArtist(t *testing.T) {
 				}(),
 			},
 			args: args{
-				id: expectedArtistID,
+				ctx: context.Background(),
+				id:  expectedArtistID,
 			},
 			want:    models.ArtistIDAPI{},
 			wantErr: services.ErrArtistNotFound,
@@ -326,3 +373,415 @@ func TestService_RemoveArtist(t *testing.T) {
 		})
 	}
 }
+
+func TestService_MergeArtists(t *testing.T) {
+	t.Parallel()
+
+	var duplicateArtistID uint64 = 2
+
+	type fields struct {
+		artistMerger ArtistMerger
+	}
+	type args struct {
+		ctx         context.Context
+		id          uint64
+		duplicateID uint64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.ArtistAPI
+		wantErr error
+	}{
+		{
+			name: "MergeArtists happy path",
+			fields: fields{
+				artistMerger: func() ArtistMerger {
+					am := mocks.NewArtistMerger(t)
+					am.
+						On("MergeArtists", mock.Anything, expectedArtistID, duplicateArtistID).
+						Once().
+						Return(expectedArtist, nil)
+
+					return am
+				}(),
+			},
+			args: args{
+				ctx:         context.Background(),
+				id:          expectedArtistID,
+				duplicateID: duplicateArtistID,
+			},
+			want: expectedArtist.API(),
+		},
+		{
+			name: "MergeArtists error merge into itself",
+			fields: fields{
+				artistMerger: mocks.NewArtistMerger(t),
+			},
+			args: args{
+				ctx:         context.Background(),
+				id:          expectedArtistID,
+				duplicateID: expectedArtistID,
+			},
+			wantErr: services.ErrMergeIntoItself,
+		},
+		{
+			name: "MergeArtists error artist not found",
+			fields: fields{
+				artistMerger: func() ArtistMerger {
+					am := mocks.NewArtistMerger(t)
+					am.
+						On("MergeArtists", mock.Anything, expectedArtistID, duplicateArtistID).
+						Once().
+						Return(models.Artist{}, repositories.ErrArtistNotFound)
+
+					return am
+				}(),
+			},
+			args: args{
+				ctx:         context.Background(),
+				id:          expectedArtistID,
+				duplicateID: duplicateArtistID,
+			},
+			wantErr: services.ErrArtistNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:          discardLogger,
+				artistMerger: tt.fields.artistMerger,
+			}
+			got, err := s.MergeArtists(tt.args.ctx, tt.args.id, tt.args.duplicateID)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.MergeArtists() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_AddArtistAlias(t *testing.T) {
+	t.Parallel()
+
+	expectedAlias := models.ArtistAlias{ID: 1, ArtistID: expectedArtistID, Name: "alias"}
+
+	type fields struct {
+		aliasEditor ArtistAliasEditor
+	}
+	type args struct {
+		ctx   context.Context
+		alias models.ArtistAlias
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.ArtistAliasAPI
+		wantErr error
+	}{
+		{
+			name: "AddArtistAlias happy path",
+			fields: fields{
+				aliasEditor: func() ArtistAliasEditor {
+					aae := mocks.NewArtistAliasEditor(t)
+					aae.
+						On("SaveArtistAlias", mock.Anything, mock.AnythingOfType("models.ArtistAlias")).
+						Once().
+						Return(expectedAlias, nil)
+
+					return aae
+				}(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				alias: models.ArtistAlias{ArtistID: expectedArtistID, Name: "alias"},
+			},
+			want: expectedAlias.API(),
+		},
+		{
+			name: "AddArtistAlias error alias exists",
+			fields: fields{
+				aliasEditor: func() ArtistAliasEditor {
+					aae := mocks.NewArtistAliasEditor(t)
+					aae.
+						On("SaveArtistAlias", mock.Anything, mock.AnythingOfType("models.ArtistAlias")).
+						Once().
+						Return(models.ArtistAlias{}, repositories.ErrArtistAliasExists)
+
+					return aae
+				}(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				alias: models.ArtistAlias{ArtistID: expectedArtistID, Name: "alias"},
+			},
+			wantErr: services.ErrArtistAliasExists,
+		},
+		{
+			name: "AddArtistAlias error artist not found",
+			fields: fields{
+				aliasEditor: func() ArtistAliasEditor {
+					aae := mocks.NewArtistAliasEditor(t)
+					aae.
+						On("SaveArtistAlias", mock.Anything, mock.AnythingOfType("models.ArtistAlias")).
+						Once().
+						Return(models.ArtistAlias{}, repositories.ErrArtistNotFound)
+
+					return aae
+				}(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				alias: models.ArtistAlias{ArtistID: expectedArtistID, Name: "alias"},
+			},
+			wantErr: services.ErrArtistNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:         discardLogger,
+				aliasEditor: tt.fields.aliasEditor,
+			}
+			got, err := s.AddArtistAlias(tt.args.ctx, tt.args.alias)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.AddArtistAlias() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_RemoveArtistAlias(t *testing.T) {
+	t.Parallel()
+
+	var expectedAliasID uint64 = 1
+
+	type fields struct {
+		aliasEditor ArtistAliasEditor
+	}
+	type args struct {
+		ctx      context.Context
+		artistID uint64
+		aliasID  uint64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.ArtistAliasIDAPI
+		wantErr error
+	}{
+		{
+			name: "RemoveArtistAlias happy path",
+			fields: fields{
+				aliasEditor: func() ArtistAliasEditor {
+					aae := mocks.NewArtistAliasEditor(t)
+					aae.
+						On("DeleteArtistAlias", mock.Anything, expectedArtistID, expectedAliasID).
+						Once().
+						Return(expectedAliasID, nil)
+
+					return aae
+				}(),
+			},
+			args: args{
+				ctx:      context.Background(),
+				artistID: expectedArtistID,
+				aliasID:  expectedAliasID,
+			},
+			want: models.ArtistAliasIDAPI{ID: expectedAliasID},
+		},
+		{
+			name: "RemoveArtistAlias error alias not found",
+			fields: fields{
+				aliasEditor: func() ArtistAliasEditor {
+					aae := mocks.NewArtistAliasEditor(t)
+					aae.
+						On("DeleteArtistAlias", mock.Anything, expectedArtistID, expectedAliasID).
+						Once().
+						Return(uint64(0), repositories.ErrArtistAliasNotFound)
+
+					return aae
+				}(),
+			},
+			args: args{
+				ctx:      context.Background(),
+				artistID: expectedArtistID,
+				aliasID:  expectedAliasID,
+			},
+			wantErr: services.ErrArtistAliasNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:         discardLogger,
+				aliasEditor: tt.fields.aliasEditor,
+			}
+			got, err := s.RemoveArtistAlias(tt.args.ctx, tt.args.artistID, tt.args.aliasID)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.RemoveArtistAlias() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_SearchArtists(t *testing.T) {
+	t.Parallel()
+
+	pagination := models.Pagination{PageNumber: 1, PageSize: 10}
+
+	type fields struct {
+		artistProvider ArtistProvider
+	}
+	type args struct {
+		ctx   context.Context
+		attrs models.Artist
+		p     models.Pagination
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.ArtistsAPI
+		wantErr error
+	}{
+		{
+			name: "SearchArtists happy path",
+			fields: fields{
+				artistProvider: func() ArtistProvider {
+					ap := mocks.NewArtistProvider(t)
+					ap.
+						On("Artists", mock.Anything, models.Artist{Country: "GB"}, pagination).
+						Once().
+						Return(models.Artists{expectedArtist}, uint64(11), nil)
+
+					return ap
+				}(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				attrs: models.Artist{Country: "GB"},
+				p:     pagination,
+			},
+			want: models.ArtistsAPI{
+				Artists: models.Artists{expectedArtist}.API(),
+				Pagination: models.PaginationMetadataAPI{
+					CurrentPageNumber: 1,
+					PageCount:         2,
+					PageSize:          10,
+					RecordCount:       11,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:            discardLogger,
+				artistProvider: tt.fields.artistProvider,
+			}
+			got, err := s.SearchArtists(tt.args.ctx, tt.args.attrs, tt.args.p)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.SearchArtists() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_GetArtistByISNI(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		artistProvider ArtistProvider
+	}
+	type args struct {
+		ctx  context.Context
+		isni string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.ArtistAPI
+		wantErr error
+	}{
+		{
+			name: "GetArtistByISNI happy path",
+			fields: fields{
+				artistProvider: func() ArtistProvider {
+					ap := mocks.NewArtistProvider(t)
+					ap.
+						On("ArtistByISNI", mock.Anything, "000000012281955X").
+						Once().
+						Return(expectedArtist, nil)
+
+					return ap
+				}(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				isni: "0000 0001 2281 955x",
+			},
+			want: expectedArtist.API(),
+		},
+		{
+			name: "GetArtistByISNI error invalid checksum",
+			fields: fields{
+				artistProvider: mocks.NewArtistProvider(t),
+			},
+			args: args{
+				ctx:  context.Background(),
+				isni: "0000000122819551",
+			},
+			wantErr: services.ErrInvalidIdentifier,
+		},
+		{
+			name: "GetArtistByISNI error artist not found",
+			fields: fields{
+				artistProvider: func() ArtistProvider {
+					ap := mocks.NewArtistProvider(t)
+					ap.
+						On("ArtistByISNI", mock.Anything, "000000012281955X").
+						Once().
+						Return(models.Artist{}, repositories.ErrArtistNotFound)
+
+					return ap
+				}(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				isni: "000000012281955X",
+			},
+			wantErr: services.ErrArtistNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:            discardLogger,
+				artistProvider: tt.fields.artistProvider,
+			}
+			got, err := s.GetArtistByISNI(tt.args.ctx, tt.args.isni)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.GetArtistByISNI() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
@@ -42,6 +42,99 @@ func (_m *ArtistProvider) Artist(ctx context.Context, id uint64) (models.Artist,
 	return r0, r1
 }
 
+// ArtistByISNI provides a mock function with given fields: ctx, isni
+func (_m *ArtistProvider) ArtistByISNI(ctx context.Context, isni string) (models.Artist, error) {
+	ret := _m.Called(ctx, isni)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistByISNI")
+	}
+
+	var r0 models.Artist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Artist, error)); ok {
+		return rf(ctx, isni)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Artist); ok {
+		r0 = rf(ctx, isni)
+	} else {
+		r0 = ret.Get(0).(models.Artist)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, isni)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ArtistByMBID provides a mock function with given fields: ctx, mbid
+func (_m *ArtistProvider) ArtistByMBID(ctx context.Context, mbid string) (models.Artist, error) {
+	ret := _m.Called(ctx, mbid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArtistByMBID")
+	}
+
+	var r0 models.Artist
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Artist, error)); ok {
+		return rf(ctx, mbid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Artist); ok {
+		r0 = rf(ctx, mbid)
+	} else {
+		r0 = ret.Get(0).(models.Artist)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, mbid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Artists provides a mock function with given fields: ctx, attrs, p
+func (_m *ArtistProvider) Artists(ctx context.Context, attrs models.Artist, p models.Pagination) (models.Artists, uint64, error) {
+	ret := _m.Called(ctx, attrs, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Artists")
+	}
+
+	var r0 models.Artists
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Artist, models.Pagination) (models.Artists, uint64, error)); ok {
+		return rf(ctx, attrs, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Artist, models.Pagination) models.Artists); ok {
+		r0 = rf(ctx, attrs, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.Artists)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Artist, models.Pagination) uint64); ok {
+		r1 = rf(ctx, attrs, p)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, models.Artist, models.Pagination) error); ok {
+		r2 = rf(ctx, attrs, p)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewArtistProvider creates a new instance of ArtistProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewArtistProvider(t interface {
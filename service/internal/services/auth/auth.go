@@ -0,0 +1,233 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	icauth "github.com/sedonn/song-library-service/internal/controllers/grpc/interceptor/auth"
+	mwauth "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/auth"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/jwt"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/repositories"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// APIKeyProvider описывает поведение объекта слоя данных, который обеспечивает предоставление данных об API-ключах.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=APIKeyProvider
+type APIKeyProvider interface {
+	// APIKeyByHash возвращает API-ключ по SHA-256 от ключа.
+	APIKeyByHash(ctx context.Context, hash string) (models.APIKey, error)
+}
+
+// Service предоставляет бизнес-логику аутентификации клиентов API и определения их прав доступа.
+type Service struct {
+	log            *slog.Logger
+	staticKeys     map[string]config.APIKeyConfig
+	apiKeyProvider APIKeyProvider
+	verifier       *jwt.Verifier
+	rolesClaim     string
+	tenantClaim    string
+	policy         *rbac.Policy
+}
+
+var (
+	_ mwauth.Authenticator = (*Service)(nil)
+	_ icauth.Authenticator = (*Service)(nil)
+)
+
+// New создает новый объект сервиса аутентификации. Ключи проверки JWT загружаются из файлов конфигурации.
+// Если не задан ни один ключ проверки JWT, то токены не принимаются. Если в конфигурации не заданы роли,
+// то используются роли по умолчанию.
+func New(log *slog.Logger, cfg *config.AuthConfig, akp APIKeyProvider) (*Service, error) {
+	staticKeys := make(map[string]config.APIKeyConfig, len(cfg.APIKeys))
+	for _, k := range cfg.APIKeys {
+		staticKeys[strings.ToLower(k.Hash)] = k
+	}
+
+	roles := cfg.Roles
+	if len(roles) == 0 {
+		roles = rbac.DefaultRoles
+	}
+	policy, err := rbac.NewPolicy(roles)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := jwtKeys(&cfg.JWT)
+	if err != nil {
+		return nil, err
+	}
+
+	var verifier *jwt.Verifier
+	if len(keys) > 0 {
+		verifier = jwt.NewVerifier(keys, jwt.Options{
+			Issuer:   cfg.JWT.Issuer,
+			Audience: cfg.JWT.Audience,
+			Leeway:   cfg.JWT.Leeway,
+		})
+	}
+
+	return &Service{
+		log:            log,
+		staticKeys:     staticKeys,
+		apiKeyProvider: akp,
+		verifier:       verifier,
+		rolesClaim:     cfg.JWT.RolesClaim,
+		tenantClaim:    cfg.JWT.TenantClaim,
+		policy:         policy,
+	}, nil
+}
+
+// jwtKeys загружает ключи проверки JWT из конфигурации.
+func jwtKeys(cfg *config.JWTConfig) ([]jwt.Key, error) {
+	var keys []jwt.Key
+
+	if cfg.HS256Secret != "" {
+		keys = append(keys, jwt.Key{Secret: []byte(cfg.HS256Secret)})
+	}
+
+	if cfg.RS256PublicKeyFile != "" {
+		data, err := os.ReadFile(cfg.RS256PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read RS256 public key: %w", err)
+		}
+
+		key, err := jwt.ParseRSAPublicKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse RS256 public key: %w", err)
+		}
+
+		keys = append(keys, jwt.Key{PublicKey: key})
+	}
+
+	if cfg.JWKSFile != "" {
+		data, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("read JWKS: %w", err)
+		}
+
+		set, err := jwt.ParseJWKS(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse JWKS: %w", err)
+		}
+
+		keys = append(keys, set...)
+	}
+
+	return keys, nil
+}
+
+// AuthenticateAPIKey проверяет API-ключ среди статических ключей из конфигурации и ключей из БД.
+// Отозванные ключи из БД не принимаются.
+func (s *Service) AuthenticateAPIKey(ctx context.Context, key string) (principal.Principal, error) {
+	sum := sha256.Sum256([]byte(key))
+	hash := hex.EncodeToString(sum[:])
+
+	log := s.log.With(slog.String("method", principal.MethodAPIKey))
+
+	log.Debug("attempt to authenticate client")
+
+	if k, ok := s.staticKeys[hash]; ok {
+		log.Debug("success to authenticate client", slog.String("subject", k.Name))
+
+		return s.principal(k.Name, principal.MethodAPIKey, k.Roles, k.Tenant), nil
+	}
+
+	k, err := s.apiKeyProvider.APIKeyByHash(ctx, hash)
+	if err != nil {
+		if errors.Is(err, repositories.ErrAPIKeyNotFound) {
+			log.Warn("failed to authenticate client", logger.ErrorString(err))
+
+			return principal.Principal{}, services.ErrUnauthenticated
+		}
+
+		log.Error("failed to authenticate client", logger.ErrorString(err))
+
+		return principal.Principal{}, err
+	}
+
+	if k.RevokedAt != nil {
+		log.Warn("failed to authenticate client", slog.String("subject", k.Name), slog.String("error", "api key revoked"))
+
+		return principal.Principal{}, services.ErrUnauthenticated
+	}
+
+	log.Debug("success to authenticate client", slog.String("subject", k.Name))
+
+	return s.principal(k.Name, principal.MethodAPIKey, k.Roles, k.BoundTenant), nil
+}
+
+// AuthenticateToken проверяет подпись и утверждения JWT. Токен должен содержать субъект.
+func (s *Service) AuthenticateToken(_ context.Context, token string) (principal.Principal, error) {
+	log := s.log.With(slog.String("method", principal.MethodJWT))
+
+	log.Debug("attempt to authenticate client")
+
+	if s.verifier == nil {
+		log.Warn("failed to authenticate client", slog.String("error", "no JWT verification keys configured"))
+
+		return principal.Principal{}, services.ErrUnauthenticated
+	}
+
+	claims, err := s.verifier.Verify(token)
+	if err != nil {
+		log.Warn("failed to authenticate client", logger.ErrorString(err))
+
+		return principal.Principal{}, services.ErrUnauthenticated
+	}
+
+	if claims.Subject == "" {
+		log.Warn("failed to authenticate client", slog.String("error", "token has no subject"))
+
+		return principal.Principal{}, services.ErrUnauthenticated
+	}
+
+	log.Debug("success to authenticate client", slog.String("subject", claims.Subject))
+
+	tenantID, _ := claims.Raw[s.tenantClaim].(string)
+
+	return s.principal(claims.Subject, principal.MethodJWT, tokenRoles(claims.Raw[s.rolesClaim]), tenantID), nil
+}
+
+// principal создает аутентифицированного клиента с правами доступа, которые предоставляют его роли.
+func (s *Service) principal(subject, method string, roles []string, tenantID string) principal.Principal {
+	return principal.Principal{
+		Subject: subject,
+		Method:  method,
+		Roles:   roles,
+		Grants:  s.policy.Grants(roles),
+		Tenant:  tenantID,
+	}
+}
+
+// tokenRoles возвращает роли из утверждения токена, которое содержит массив строк или строку с ролями через пробел.
+func tokenRoles(claim any) []string {
+	switch v := claim.(type) {
+	case string:
+		return strings.Fields(v)
+
+	case []any:
+		roles := make([]string, 0, len(v))
+		for _, r := range v {
+			if role, ok := r.(string); ok {
+				roles = append(roles, role)
+			}
+		}
+
+		return roles
+
+	default:
+		return nil
+	}
+}
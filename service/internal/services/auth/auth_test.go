@@ -0,0 +1,263 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/repositories"
+	"github.com/sedonn/song-library-service/internal/services"
+	"github.com/sedonn/song-library-service/internal/services/auth/mocks"
+)
+
+var (
+	discardLogger = logger.NewDiscardLogger()
+	errDB         = errors.New("db error")
+	revokedAt     = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// hashKey возвращает SHA-256 от API-ключа в шестнадцатеричном виде.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// hs256Token создает токен HS256 с определенными утверждениями в формате JSON.
+func hs256Token(secret, claims string) string {
+	signed := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(claims))
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestService_AuthenticateAPIKey(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.AuthConfig{
+		Roles: map[string][]string{
+			rbac.RoleEditor: rbac.DefaultRoles[rbac.RoleEditor],
+			"importer":      {rbac.PlaylistsImport},
+		},
+		APIKeys: []config.APIKeyConfig{{Name: "static", Hash: hashKey("static-key"), Roles: []string{rbac.RoleEditor}}},
+	}
+
+	tests := []struct {
+		name           string
+		key            string
+		apiKeyProvider func(t *testing.T) APIKeyProvider
+		want           principal.Principal
+		wantErr        error
+	}{
+		{
+			name:           "Static key",
+			key:            "static-key",
+			apiKeyProvider: func(t *testing.T) APIKeyProvider { return mocks.NewAPIKeyProvider(t) },
+			want: principal.Principal{
+				Subject: "static",
+				Method:  principal.MethodAPIKey,
+				Roles:   []string{rbac.RoleEditor},
+				Grants:  []string{"*:read", "*:write"},
+			},
+		},
+		{
+			name: "Database key",
+			key:  "db-key",
+			apiKeyProvider: func(t *testing.T) APIKeyProvider {
+				akp := mocks.NewAPIKeyProvider(t)
+				akp.
+					On("APIKeyByHash", mock.Anything, hashKey("db-key")).
+					Once().
+					Return(models.APIKey{ID: 1, Name: "importer", Roles: []string{"importer"}}, nil)
+
+				return akp
+			},
+			want: principal.Principal{
+				Subject: "importer",
+				Method:  principal.MethodAPIKey,
+				Roles:   []string{"importer"},
+				Grants:  []string{rbac.PlaylistsImport},
+			},
+		},
+		{
+			name: "Revoked key",
+			key:  "db-key",
+			apiKeyProvider: func(t *testing.T) APIKeyProvider {
+				akp := mocks.NewAPIKeyProvider(t)
+				akp.
+					On("APIKeyByHash", mock.Anything, hashKey("db-key")).
+					Once().
+					Return(models.APIKey{ID: 1, Name: "importer", RevokedAt: &revokedAt}, nil)
+
+				return akp
+			},
+			wantErr: services.ErrUnauthenticated,
+		},
+		{
+			name: "Unknown key",
+			key:  "unknown-key",
+			apiKeyProvider: func(t *testing.T) APIKeyProvider {
+				akp := mocks.NewAPIKeyProvider(t)
+				akp.
+					On("APIKeyByHash", mock.Anything, hashKey("unknown-key")).
+					Once().
+					Return(models.APIKey{}, repositories.ErrAPIKeyNotFound)
+
+				return akp
+			},
+			wantErr: services.ErrUnauthenticated,
+		},
+		{
+			name: "Database error",
+			key:  "db-key",
+			apiKeyProvider: func(t *testing.T) APIKeyProvider {
+				akp := mocks.NewAPIKeyProvider(t)
+				akp.
+					On("APIKeyByHash", mock.Anything, hashKey("db-key")).
+					Once().
+					Return(models.APIKey{}, errDB)
+
+				return akp
+			},
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := New(discardLogger, cfg, tt.apiKeyProvider(t))
+			require.NoError(t, err)
+
+			got, err := s.AuthenticateAPIKey(context.Background(), tt.key)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.AuthenticateAPIKey() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_AuthenticateToken(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.AuthConfig{
+		JWT: config.JWTConfig{HS256Secret: "secret", Issuer: "issuer", Leeway: time.Minute, RolesClaim: "roles"},
+	}
+
+	tests := []struct {
+		name    string
+		cfg     *config.AuthConfig
+		token   string
+		want    principal.Principal
+		wantErr error
+	}{
+		{
+			name:  "Valid token",
+			cfg:   cfg,
+			token: hs256Token("secret", `{"exp":4102444800,"sub":"user-1","iss":"issuer"}`),
+			want:  principal.Principal{Subject: "user-1", Method: principal.MethodJWT},
+		},
+		{
+			name:  "Roles array",
+			cfg:   cfg,
+			token: hs256Token("secret", `{"exp":4102444800,"sub":"user-1","iss":"issuer","roles":["admin"]}`),
+			want: principal.Principal{
+				Subject: "user-1",
+				Method:  principal.MethodJWT,
+				Roles:   []string{rbac.RoleAdmin},
+				Grants:  []string{"*"},
+			},
+		},
+		{
+			name:  "Roles string",
+			cfg:   cfg,
+			token: hs256Token("secret", `{"exp":4102444800,"sub":"user-1","iss":"issuer","roles":"viewer unknown"}`),
+			want: principal.Principal{
+				Subject: "user-1",
+				Method:  principal.MethodJWT,
+				Roles:   []string{rbac.RoleViewer, "unknown"},
+				Grants:  []string{"*:read"},
+			},
+		},
+		{
+			name:    "Invalid signature",
+			cfg:     cfg,
+			token:   hs256Token("other", `{"exp":4102444800,"sub":"user-1","iss":"issuer"}`),
+			wantErr: services.ErrUnauthenticated,
+		},
+		{
+			name:    "Invalid issuer",
+			cfg:     cfg,
+			token:   hs256Token("secret", `{"exp":4102444800,"sub":"user-1","iss":"other"}`),
+			wantErr: services.ErrUnauthenticated,
+		},
+		{
+			name:    "No expiry",
+			cfg:     cfg,
+			token:   hs256Token("secret", `{"sub":"user-1","iss":"issuer"}`),
+			wantErr: services.ErrUnauthenticated,
+		},
+		{
+			name:    "No subject",
+			cfg:     cfg,
+			token:   hs256Token("secret", `{"exp":4102444800,"iss":"issuer"}`),
+			wantErr: services.ErrUnauthenticated,
+		},
+		{
+			name:    "No verification keys",
+			cfg:     &config.AuthConfig{},
+			token:   hs256Token("secret", `{"exp":4102444800,"sub":"user-1"}`),
+			wantErr: services.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := New(discardLogger, tt.cfg, mocks.NewAPIKeyProvider(t))
+			require.NoError(t, err)
+
+			got, err := s.AuthenticateToken(context.Background(), tt.token)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.AuthenticateToken() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestNew_InvalidKeyFile(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(discardLogger, &config.AuthConfig{
+		JWT: config.JWTConfig{JWKSFile: "testdata/missing.json"},
+	}, mocks.NewAPIKeyProvider(t))
+	assert.Error(t, err)
+}
+
+func TestNew_InvalidPolicy(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(discardLogger, &config.AuthConfig{
+		Roles: map[string][]string{rbac.RoleViewer: {"songs"}},
+	}, mocks.NewAPIKeyProvider(t))
+	assert.ErrorIs(t, err, rbac.ErrInvalidPolicy)
+}
@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+)
+
+// Authorize проверяет, что клиенту из контекста разрешена операция с определенным правом доступа.
+// Вызовы без клиента в контексте выполняются самим микросервисом (фоновые задачи) или при выключенной
+// аутентификации и не ограничиваются.
+func Authorize(ctx context.Context, permission string) error {
+	if ctx == nil {
+		return nil
+	}
+
+	p, ok := principal.FromContext(ctx)
+	if !ok || p.Can(permission) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", ErrForbidden, permission)
+}
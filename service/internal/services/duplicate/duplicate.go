@@ -0,0 +1,227 @@
+package duplicate
+
+import (
+	"context"
+	"log/slog"
+	"math"
+
+	duplicaterest "github.com/sedonn/song-library-service/internal/controllers/rest/duplicate"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/lyrics"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// lyricsDuplicateThreshold это минимальное сходство текстов по lyrics.Similarity, начиная с которого песни
+// одного исполнителя считаются дубликатами при разных названиях. При таком пороге совпадают тексты, которые
+// отличаются регистром, знаками препинания и отдельными словами, но не разные песни с общим припевом.
+const lyricsDuplicateThreshold = 0.8
+
+// DuplicateProvider описывает поведение объекта слоя данных, который обеспечивает поиск дубликатов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=DuplicateProvider
+type DuplicateProvider interface {
+	// DuplicateSongCandidates возвращает песни-кандидаты в дубликаты: песни исполнителей с одинаковым ключом
+	// дубликатов названия, если таких песен больше одной. Песни упорядочены по ключу исполнителя и ID.
+	DuplicateSongCandidates(ctx context.Context) ([]models.DuplicateSongCandidate, error)
+	// SongsByIDs возвращает песни с определенными ID. Несуществующие песни пропускаются.
+	SongsByIDs(ctx context.Context, ids []uint64) (models.Songs, error)
+	// DuplicateArtists возвращает группы исполнителей, у которых совпадают ключи дубликатов названий.
+	// Возвращает группы исполнителей, общее количество групп без учета пагинации, ошибку.
+	DuplicateArtists(ctx context.Context, p models.Pagination) ([]models.Artists, uint64, error)
+}
+
+// Service предоставляет бизнес-логику поиска дубликатов песен и исполнителей.
+type Service struct {
+	log               *slog.Logger
+	duplicateProvider DuplicateProvider
+}
+
+var _ duplicaterest.DuplicateService = (*Service)(nil)
+
+// New создает новый объект сервиса поиска дубликатов.
+func New(log *slog.Logger, dp DuplicateProvider) *Service {
+	return &Service{
+		log:               log,
+		duplicateProvider: dp,
+	}
+}
+
+// FindDuplicateSongs возвращает группы песен одного исполнителя с похожими названиями или текстами.
+// Для каждой песни группы вычисляется сходство текста с текстом первой песни группы.
+func (s *Service) FindDuplicateSongs(ctx context.Context, p models.Pagination) (models.DuplicateSongsAPI, error) {
+	s.log.Info("attempt to find duplicate songs")
+
+	if err := services.Authorize(ctx, rbac.SongsRead); err != nil {
+		s.log.Warn("failed to find duplicate songs", logger.ErrorString(err))
+
+		return models.DuplicateSongsAPI{}, err
+	}
+
+	candidates, err := s.duplicateProvider.DuplicateSongCandidates(ctx)
+	if err != nil {
+		s.log.Error("failed to find duplicate songs", logger.ErrorString(err))
+
+		return models.DuplicateSongsAPI{}, err
+	}
+
+	groups := duplicateSongGroups(candidates)
+	total := uint64(len(groups))
+
+	offset := min((p.PageNumber-1)*uint64(p.PageSize), total)
+	groups = groups[offset:min(offset+uint64(p.PageSize), total)]
+
+	groupsAPI := make([]models.DuplicateSongGroupAPI, 0, len(groups))
+	if len(groups) > 0 {
+		var ids []uint64
+		for _, group := range groups {
+			ids = append(ids, group...)
+		}
+
+		songs, err := s.duplicateProvider.SongsByIDs(ctx, ids)
+		if err != nil {
+			s.log.Error("failed to find duplicate songs", logger.ErrorString(err))
+
+			return models.DuplicateSongsAPI{}, err
+		}
+
+		byID := make(map[uint64]models.Song, len(songs))
+		for _, song := range songs {
+			byID[song.ID] = song
+		}
+
+		for _, group := range groups {
+			found := make(models.Songs, 0, len(group))
+			for _, id := range group {
+				if song, ok := byID[id]; ok {
+					found = append(found, song)
+				}
+			}
+
+			groupAPI := models.DuplicateSongGroupAPI{Songs: make([]models.DuplicateSongAPI, len(found))}
+			for j, song := range found {
+				groupAPI.Songs[j] = models.DuplicateSongAPI{
+					SongAPI:          song.API(),
+					LyricsSimilarity: lyrics.Similarity(found[0].Text, song.Text),
+				}
+			}
+			groupsAPI = append(groupsAPI, groupAPI)
+		}
+	}
+
+	s.log.Info("success to find duplicate songs", slog.Uint64("total", total))
+
+	return models.DuplicateSongsAPI{
+		Groups:     groupsAPI,
+		Pagination: paginationMetadata(p, total),
+	}, nil
+}
+
+// FindDuplicateArtists возвращает группы исполнителей с похожими названиями.
+func (s *Service) FindDuplicateArtists(ctx context.Context, p models.Pagination) (models.DuplicateArtistsAPI, error) {
+	s.log.Info("attempt to find duplicate artists")
+
+	if err := services.Authorize(ctx, rbac.ArtistsRead); err != nil {
+		s.log.Warn("failed to find duplicate artists", logger.ErrorString(err))
+
+		return models.DuplicateArtistsAPI{}, err
+	}
+
+	groups, total, err := s.duplicateProvider.DuplicateArtists(ctx, p)
+	if err != nil {
+		s.log.Error("failed to find duplicate artists", logger.ErrorString(err))
+
+		return models.DuplicateArtistsAPI{}, err
+	}
+
+	groupsAPI := make([]models.DuplicateArtistGroupAPI, len(groups))
+	for i, artists := range groups {
+		groupsAPI[i].Artists = artists.API()
+	}
+
+	s.log.Info("success to find duplicate artists", slog.Uint64("total", total))
+
+	return models.DuplicateArtistsAPI{
+		Groups:     groupsAPI,
+		Pagination: paginationMetadata(p, total),
+	}, nil
+}
+
+// duplicateSongGroups объединяет песни-кандидаты одного исполнителя в группы дубликатов. Песни попадают в одну группу,
+// если у них совпадают ключи дубликатов названий или сходство текстов не меньше lyricsDuplicateThreshold,
+// в том числе через другие песни группы. Группы упорядочены по ключу исполнителя и ID первой песни,
+// песни внутри группы - по ID. Возвращает ID песен каждой группы.
+func duplicateSongGroups(candidates []models.DuplicateSongCandidate) [][]uint64 {
+	var groups [][]uint64
+	for start := 0; start < len(candidates); {
+		end := start + 1
+		for end < len(candidates) && candidates[end].ArtistKey == candidates[start].ArtistKey {
+			end++
+		}
+
+		groups = append(groups, artistDuplicateSongGroups(candidates[start:end])...)
+		start = end
+	}
+
+	return groups
+}
+
+// artistDuplicateSongGroups объединяет песни одного исполнителя в группы дубликатов, сравнивая каждую пару песен.
+func artistDuplicateSongGroups(songs []models.DuplicateSongCandidate) [][]uint64 {
+	parent := make([]int, len(songs))
+	for i := range parent {
+		parent[i] = i
+	}
+
+	find := func(i int) int {
+		for parent[i] != i {
+			parent[i] = parent[parent[i]]
+			i = parent[i]
+		}
+
+		return i
+	}
+
+	for i := range songs {
+		for j := i + 1; j < len(songs); j++ {
+			if find(i) == find(j) {
+				continue
+			}
+
+			if songs[i].NameKey == songs[j].NameKey ||
+				lyrics.Similarity(songs[i].Text, songs[j].Text) >= lyricsDuplicateThreshold {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	var roots []int
+	members := make(map[int][]uint64)
+	for i, song := range songs {
+		root := find(i)
+		if _, ok := members[root]; !ok {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], song.ID)
+	}
+
+	var groups [][]uint64
+	for _, root := range roots {
+		if len(members[root]) > 1 {
+			groups = append(groups, members[root])
+		}
+	}
+
+	return groups
+}
+
+// paginationMetadata создает метаданные пагинации по группам дубликатов.
+func paginationMetadata(p models.Pagination, total uint64) models.PaginationMetadataAPI {
+	return models.PaginationMetadataAPI{
+		CurrentPageNumber: p.PageNumber,
+		PageCount:         uint64(math.Ceil(float64(total) / float64(p.PageSize))),
+		RecordCount:       total,
+		PageSize:          p.PageSize,
+	}
+}
@@ -0,0 +1,236 @@
+package duplicate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/services/duplicate/mocks"
+)
+
+var (
+	discardLogger = logger.NewDiscardLogger()
+	pagination    = models.Pagination{PageNumber: 1, PageSize: 10}
+	errUnexpected = errors.New("unexpected error")
+)
+
+func TestService_FindDuplicateSongs(t *testing.T) {
+	t.Parallel()
+
+	acdc := models.Artist{ID: 1, Name: "AC/DC"}
+	songs := models.Songs{
+		{ID: 1, Name: "Thunderstruck", Artist: acdc, Text: "thunder thunder thunder"},
+		{ID: 2, Name: "Thunderstruck!", Artist: models.Artist{ID: 2, Name: "ACDC"}, Text: "Thunder, thunder, thunder!"},
+		{ID: 3, Name: "Hells Bells", Artist: acdc, Text: "I'm a rolling thunder"},
+		{ID: 4, Name: "Hell's Bells", Artist: acdc},
+		{ID: 5, Name: "Highway to Hell", Artist: acdc, Text: "Living easy, living free\nSeason ticket on a one-way ride"},
+		{ID: 6, Name: "Highway to Hell (Live)", Artist: acdc, Text: "living easy living free\n\nseason ticket on a one way ride"},
+		{ID: 7, Name: "Back in Black", Artist: acdc, Text: "Back in black, I hit the sack"},
+	}
+
+	tests := []struct {
+		name              string
+		duplicateProvider func(t *testing.T) DuplicateProvider
+		want              models.DuplicateSongsAPI
+		wantErr           error
+	}{
+		{
+			name: "FindDuplicateSongs by names",
+			duplicateProvider: func(t *testing.T) DuplicateProvider {
+				dp := mocks.NewDuplicateProvider(t)
+				dp.
+					On("DuplicateSongCandidates", mock.Anything).
+					Once().
+					Return([]models.DuplicateSongCandidate{
+						{ID: 1, NameKey: "thunderstruck", ArtistKey: "acdc", Text: songs[0].Text},
+						{ID: 2, NameKey: "thunderstruck", ArtistKey: "acdc", Text: songs[1].Text},
+						{ID: 3, NameKey: "hellsbells", ArtistKey: "acdc", Text: songs[2].Text},
+						{ID: 4, NameKey: "hellsbells", ArtistKey: "acdc", Text: songs[3].Text},
+					}, nil)
+				dp.
+					On("SongsByIDs", mock.Anything, []uint64{1, 2, 3, 4}).
+					Once().
+					Return(songs[:4], nil)
+
+				return dp
+			},
+			want: models.DuplicateSongsAPI{
+				Groups: []models.DuplicateSongGroupAPI{
+					{
+						Songs: []models.DuplicateSongAPI{
+							{SongAPI: songs[0].API(), LyricsSimilarity: 1},
+							{SongAPI: songs[1].API(), LyricsSimilarity: 1},
+						},
+					},
+					{
+						Songs: []models.DuplicateSongAPI{
+							{SongAPI: songs[2].API(), LyricsSimilarity: 1},
+							{SongAPI: songs[3].API(), LyricsSimilarity: 0},
+						},
+					},
+				},
+				Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 1, PageSize: 10, RecordCount: 2},
+			},
+		},
+		{
+			name: "FindDuplicateSongs by lyrics with different names",
+			duplicateProvider: func(t *testing.T) DuplicateProvider {
+				dp := mocks.NewDuplicateProvider(t)
+				dp.
+					On("DuplicateSongCandidates", mock.Anything).
+					Once().
+					Return([]models.DuplicateSongCandidate{
+						{ID: 5, NameKey: "highwaytohell", ArtistKey: "acdc", Text: songs[4].Text},
+						{ID: 6, NameKey: "highwaytohelllive", ArtistKey: "acdc", Text: songs[5].Text},
+						{ID: 7, NameKey: "backinblack", ArtistKey: "acdc", Text: songs[6].Text},
+					}, nil)
+				dp.
+					On("SongsByIDs", mock.Anything, []uint64{5, 6}).
+					Once().
+					Return(songs[4:6], nil)
+
+				return dp
+			},
+			want: models.DuplicateSongsAPI{
+				Groups: []models.DuplicateSongGroupAPI{
+					{
+						Songs: []models.DuplicateSongAPI{
+							{SongAPI: songs[4].API(), LyricsSimilarity: 1},
+							{SongAPI: songs[5].API(), LyricsSimilarity: 1},
+						},
+					},
+				},
+				Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 1, PageSize: 10, RecordCount: 1},
+			},
+		},
+		{
+			name: "FindDuplicateSongs no duplicates",
+			duplicateProvider: func(t *testing.T) DuplicateProvider {
+				dp := mocks.NewDuplicateProvider(t)
+				dp.
+					On("DuplicateSongCandidates", mock.Anything).
+					Once().
+					Return([]models.DuplicateSongCandidate{
+						{ID: 5, NameKey: "highwaytohell", ArtistKey: "acdc", Text: songs[4].Text},
+						{ID: 7, NameKey: "backinblack", ArtistKey: "acdc", Text: songs[6].Text},
+					}, nil)
+
+				return dp
+			},
+			want: models.DuplicateSongsAPI{
+				Groups:     []models.DuplicateSongGroupAPI{},
+				Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 0, PageSize: 10, RecordCount: 0},
+			},
+		},
+		{
+			name: "FindDuplicateSongs error candidates",
+			duplicateProvider: func(t *testing.T) DuplicateProvider {
+				dp := mocks.NewDuplicateProvider(t)
+				dp.
+					On("DuplicateSongCandidates", mock.Anything).
+					Once().
+					Return(nil, errUnexpected)
+
+				return dp
+			},
+			wantErr: errUnexpected,
+		},
+		{
+			name: "FindDuplicateSongs error songs",
+			duplicateProvider: func(t *testing.T) DuplicateProvider {
+				dp := mocks.NewDuplicateProvider(t)
+				dp.
+					On("DuplicateSongCandidates", mock.Anything).
+					Once().
+					Return([]models.DuplicateSongCandidate{
+						{ID: 1, NameKey: "thunderstruck", ArtistKey: "acdc"},
+						{ID: 2, NameKey: "thunderstruck", ArtistKey: "acdc"},
+					}, nil)
+				dp.
+					On("SongsByIDs", mock.Anything, []uint64{1, 2}).
+					Once().
+					Return(nil, errUnexpected)
+
+				return dp
+			},
+			wantErr: errUnexpected,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := New(discardLogger, tt.duplicateProvider(t))
+			got, err := s.FindDuplicateSongs(context.Background(), pagination)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.FindDuplicateSongs() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_FindDuplicateArtists(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		duplicateProvider DuplicateProvider
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    models.DuplicateArtistsAPI
+		wantErr error
+	}{
+		{
+			name: "FindDuplicateArtists happy path",
+			fields: fields{
+				duplicateProvider: func() DuplicateProvider {
+					dp := mocks.NewDuplicateProvider(t)
+					dp.
+						On("DuplicateArtists", mock.Anything, pagination).
+						Once().
+						Return([]models.Artists{{{ID: 1, Name: "AC/DC"}, {ID: 2, Name: "ACDC"}}}, uint64(1), nil)
+
+					return dp
+				}(),
+			},
+			want: models.DuplicateArtistsAPI{
+				Groups: []models.DuplicateArtistGroupAPI{
+					{
+						Artists: models.Artists{{ID: 1, Name: "AC/DC"}, {ID: 2, Name: "ACDC"}}.API(),
+					},
+				},
+				Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 1, PageSize: 10, RecordCount: 1},
+			},
+		},
+		{
+			name: "FindDuplicateArtists error",
+			fields: fields{
+				duplicateProvider: func() DuplicateProvider {
+					dp := mocks.NewDuplicateProvider(t)
+					dp.
+						On("DuplicateArtists", mock.Anything, pagination).
+						Once().
+						Return(nil, uint64(0), errUnexpected)
+
+					return dp
+				}(),
+			},
+			wantErr: errUnexpected,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := New(discardLogger, tt.fields.duplicateProvider)
+			got, err := s.FindDuplicateArtists(context.Background(), pagination)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.FindDuplicateArtists() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
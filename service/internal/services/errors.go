@@ -11,6 +11,93 @@ var ( // ErrSongNotFound song_id не найден.
 	// ErrArtistExists artist_name уже существует.
 	ErrArtistExists = errors.New("artist already exists")
 
+	// ErrArtistActiveYearsInvalid год распада исполнителя раньше года основания.
+	ErrArtistActiveYearsInvalid = errors.New("artist disbanded year precedes formed year")
+
+	// ErrArtistAliasNotFound псевдоним исполнителя не найден.
+	ErrArtistAliasNotFound = errors.New("artist alias not found")
+
+	// ErrArtistAliasExists псевдоним совпадает с названием или псевдонимом какого-либо исполнителя.
+	ErrArtistAliasExists = errors.New("artist alias already exists")
+
+	// ErrAlbumNotFound album_id не найден.
+	ErrAlbumNotFound = errors.New("album not found")
+
+	// ErrAlbumTrackConflict композиция уже есть в альбоме или ее позиция уже занята.
+	ErrAlbumTrackConflict = errors.New("album track conflicts with existing track")
+
+	// ErrGenreNotFound жанр не найден.
+	ErrGenreNotFound = errors.New("genre not found")
+
+	// ErrGenreExists жанр с таким названием уже существует.
+	ErrGenreExists = errors.New("genre already exists")
+
+	// ErrTagNotFound метка не найдена.
+	ErrTagNotFound = errors.New("tag not found")
+
+	// ErrTagExists метка с таким названием уже существует.
+	ErrTagExists = errors.New("tag already exists")
+
+	// ErrPlaylistNotFound playlist_id не найден.
+	ErrPlaylistNotFound = errors.New("playlist not found")
+
+	// ErrPlaylistItemNotFound песни нет в плейлисте.
+	ErrPlaylistItemNotFound = errors.New("song not found in playlist")
+
+	// ErrPlaylistItemExists песня уже есть в плейлисте.
+	ErrPlaylistItemExists = errors.New("song already exists in playlist")
+
+	// ErrInvalidPlaylistFile файл плейлиста не соответствует формату.
+	ErrInvalidPlaylistFile = errors.New("invalid playlist file")
+
+	// ErrSongRelationNotFound связи между песнями не существует.
+	ErrSongRelationNotFound = errors.New("song relation not found")
+
+	// ErrSongRelationExists связь между песнями уже существует.
+	ErrSongRelationExists = errors.New("song relation already exists")
+
+	// ErrSongRelationCycle связь приводит к тому, что песня становится оригиналом самой себя.
+	ErrSongRelationCycle = errors.New("song relation creates a cycle")
+
+	// ErrMergeIntoItself запись нельзя слить саму с собой.
+	ErrMergeIntoItself = errors.New("cannot merge record into itself")
+
+	// ErrInvalidIdentifier стандартный идентификатор не соответствует формату или контрольной сумме.
+	ErrInvalidIdentifier = errors.New("invalid identifier")
+
+	// ErrInvalidSongLink ссылка песни некорректна или имеет неподдерживаемую схему.
+	ErrInvalidSongLink = errors.New("invalid song link")
+
+	// ErrIdentifierExists стандартный идентификатор уже присвоен другой записи.
+	ErrIdentifierExists = errors.New("identifier already assigned")
+
+	// ErrSongLinkExists ссылка на эту запись музыкального сервиса уже есть у другой песни.
+	ErrSongLinkExists = errors.New("song link already attached to another song")
+
+	// ErrJobNotFound job_id не найден.
+	ErrJobNotFound = errors.New("job not found")
+
+	// ErrUnknownJobType для вида задачи нет обработчика.
+	ErrUnknownJobType = errors.New("unknown job type")
+
+	// ErrUnauthenticated клиент не передал учетные данные или они не приняты.
+	ErrUnauthenticated = errors.New("unauthenticated")
+
+	// ErrForbidden ролям клиента не разрешена операция.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrTenantForbidden клиент привязан к другой библиотеке.
+	ErrTenantForbidden = errors.New("tenant not permitted for client")
+
+	// ErrInvalidTenant идентификатор библиотеки не соответствует формату.
+	ErrInvalidTenant = errors.New("invalid tenant")
+
+	// ErrRateLimited клиент превысил допустимую частоту запросов.
+	ErrRateLimited = errors.New("rate limit exceeded")
+
+	// ErrQuotaExceeded клиент исчерпал суточную квоту запросов.
+	ErrQuotaExceeded = errors.New("daily quota exceeded")
+
 	// ErrPageNumberOutOfRange номер страницы выходит за границы допустимого диапазона страниц.
 	ErrPageNumberOutOfRange = errors.New("page number out of range")
 )
@@ -0,0 +1,208 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	jobrest "github.com/sedonn/song-library-service/internal/controllers/rest/job"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/pkg/tenant"
+	"github.com/sedonn/song-library-service/internal/repositories"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// JobProvider описывает поведение объекта слоя данных, который обеспечивает предоставление данных о задачах.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=JobProvider
+type JobProvider interface {
+	// Job возвращает определенную задачу.
+	Job(ctx context.Context, id uint64) (models.Job, error)
+	// Jobs выполняет поиск задач по определенным параметрам.
+	// Возвращает задачи, общее количество найденных задач без учета пагинации, ошибку.
+	Jobs(ctx context.Context, attrs models.Job, p models.Pagination) (models.Jobs, uint64, error)
+}
+
+// JobQueue описывает поведение объекта слоя данных, который обеспечивает очередь задач.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=JobQueue
+type JobQueue interface {
+	// ClaimJobs берет в работу не более limit задач, время выполнения которых наступило.
+	// Взятая в работу задача считается брошенной, если не завершена в течение lease.
+	ClaimJobs(ctx context.Context, limit int, lease time.Duration) (models.Jobs, error)
+	// FinishJob сохраняет статус, время следующей попытки и ошибку задачи после попытки выполнения.
+	FinishJob(ctx context.Context, j models.Job) error
+}
+
+// Handler выполняет задачу определенного вида для определенной песни.
+type Handler func(ctx context.Context, songID uint64) error
+
+// Service предоставляет бизнес-логику фоновых задач.
+type Service struct {
+	log         *slog.Logger
+	cfg         *config.JobsConfig
+	jobProvider JobProvider
+	jobQueue    JobQueue
+	handlers    map[string]Handler
+	now         func() time.Time
+}
+
+var _ jobrest.JobService = (*Service)(nil)
+
+// New создает новый объект сервиса фоновых задач. Обработчики задач регистрируются через Handle.
+func New(log *slog.Logger, cfg *config.JobsConfig, jp JobProvider, jq JobQueue) *Service {
+	return &Service{
+		log:         log,
+		cfg:         cfg,
+		jobProvider: jp,
+		jobQueue:    jq,
+		handlers:    make(map[string]Handler),
+		now:         time.Now,
+	}
+}
+
+// Handle регистрирует обработчик задач определенного вида. Регистрировать обработчики необходимо
+// до начала выполнения задач.
+func (s *Service) Handle(jobType string, h Handler) {
+	s.handlers[jobType] = h
+}
+
+// GetJob возвращает определенную задачу.
+func (s *Service) GetJob(ctx context.Context, id uint64) (models.JobAPI, error) {
+	log := s.log.With(slog.Uint64("id", id))
+
+	log.Info("attempt to get job")
+
+	if err := services.Authorize(ctx, rbac.JobsRead); err != nil {
+		log.Warn("failed to get job", logger.ErrorString(err))
+
+		return models.JobAPI{}, err
+	}
+
+	j, err := s.jobProvider.Job(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrJobNotFound) {
+			log.Warn("failed to get job", logger.ErrorString(err))
+			return models.JobAPI{}, services.ErrJobNotFound
+		}
+
+		log.Error("failed to get job", logger.ErrorString(err))
+		return models.JobAPI{}, err
+	}
+
+	log.Info("success to get job")
+
+	return j.API(), nil
+}
+
+// SearchJobs выполняет поиск задач по песне, виду и статусу задачи.
+func (s *Service) SearchJobs(ctx context.Context, attrs models.Job, p models.Pagination) (models.JobsAPI, error) {
+	s.log.Info("attempt to search jobs")
+
+	if err := services.Authorize(ctx, rbac.JobsRead); err != nil {
+		s.log.Warn("failed to search jobs", logger.ErrorString(err))
+
+		return models.JobsAPI{}, err
+	}
+
+	jobs, total, err := s.jobProvider.Jobs(ctx, attrs, p)
+	if err != nil {
+		s.log.Error("failed to search jobs", logger.ErrorString(err))
+
+		return models.JobsAPI{}, err
+	}
+
+	s.log.Info("success to search jobs", slog.Uint64("total", total))
+
+	return models.JobsAPI{
+		Jobs: jobs.API(),
+		Pagination: models.PaginationMetadataAPI{
+			CurrentPageNumber: p.PageNumber,
+			PageCount:         uint64(math.Ceil(float64(total) / float64(p.PageSize))),
+			RecordCount:       total,
+			PageSize:          p.PageSize,
+		},
+	}, nil
+}
+
+// ClaimJobs берет в работу не более limit задач, время выполнения которых наступило.
+func (s *Service) ClaimJobs(ctx context.Context, limit int) (models.Jobs, error) {
+	jobs, err := s.jobQueue.ClaimJobs(ctx, limit, s.cfg.Lease)
+	if err != nil {
+		s.log.Error("failed to claim jobs", logger.ErrorString(err))
+
+		return models.Jobs{}, err
+	}
+
+	return jobs, nil
+}
+
+// ProcessJob выполняет взятую в работу задачу и сохраняет результат попытки.
+// Неудачная попытка повторяется с экспоненциальной паузой. Задача, не выполненная за допустимое
+// количество попыток или не имеющая обработчика, больше не выполняется.
+// Результат попытки сохраняется даже после отмены ctx, чтобы не ждать, пока задача будет считаться брошенной.
+// Задача выполняется в библиотеке, которой она принадлежит.
+func (s *Service) ProcessJob(ctx context.Context, j models.Job) error {
+	log := s.log.With(
+		slog.Uint64("id", j.ID),
+		slog.String("tenant", j.TenantID),
+		slog.String("type", j.Type),
+		slog.Uint64("song_id", j.SongID),
+		slog.Uint64("attempt", uint64(j.Attempts)),
+	)
+
+	log.Info("attempt to process job")
+
+	err := s.runJob(tenant.NewContext(ctx, j.TenantID), j)
+	switch {
+	case err == nil:
+		j.Status = models.JobStatusDone
+		j.LastError = ""
+		log.Info("success to process job")
+
+	case errors.Is(err, services.ErrUnknownJobType) || j.Attempts >= s.cfg.MaxAttempts:
+		j.Status = models.JobStatusDead
+		j.LastError = err.Error()
+		log.Error("failed to process job, no attempts left", logger.ErrorString(err))
+
+	default:
+		j.Status = models.JobStatusPending
+		j.RunAt = s.now().Add(s.retryBackoff(j.Attempts))
+		j.LastError = err.Error()
+		log.Warn("failed to process job, retry scheduled", logger.ErrorString(err), slog.Time("run_at", j.RunAt))
+	}
+
+	if err := s.jobQueue.FinishJob(context.WithoutCancel(ctx), j); err != nil {
+		log.Error("failed to finish job", logger.ErrorString(err))
+
+		return err
+	}
+
+	return nil
+}
+
+// runJob выполняет задачу обработчиком ее вида.
+func (s *Service) runJob(ctx context.Context, j models.Job) error {
+	h, ok := s.handlers[j.Type]
+	if !ok {
+		return fmt.Errorf("%w: %s", services.ErrUnknownJobType, j.Type)
+	}
+
+	return h(ctx, j.SongID)
+}
+
+// retryBackoff возвращает паузу перед попыткой, следующей за определенной попыткой.
+func (s *Service) retryBackoff(attempt uint32) time.Duration {
+	backoff := s.cfg.RetryBackoff
+	for i := uint32(1); i < attempt && backoff < s.cfg.MaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, s.cfg.MaxRetryBackoff)
+}
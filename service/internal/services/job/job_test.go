@@ -0,0 +1,160 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/tenant"
+	"github.com/sedonn/song-library-service/internal/repositories"
+	"github.com/sedonn/song-library-service/internal/services"
+	"github.com/sedonn/song-library-service/internal/services/job/mocks"
+)
+
+var (
+	discardLogger = logger.NewDiscardLogger()
+	errUnexpected = errors.New("unexpected error")
+	now           = time.Date(2026, time.October, 19, 21, 0, 0, 0, time.UTC)
+	jobsConfig    = &config.JobsConfig{
+		Lease:           time.Minute,
+		MaxAttempts:     3,
+		RetryBackoff:    10 * time.Second,
+		MaxRetryBackoff: 15 * time.Second,
+	}
+)
+
+func TestService_GetJob(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		job     models.Job
+		err     error
+		want    models.JobAPI
+		wantErr error
+	}{
+		{
+			name: "GetJob happy path",
+			job:  models.Job{ID: 1, Type: models.JobTypeEnrichSong, SongID: 2, Status: models.JobStatusDone},
+			want: models.Job{ID: 1, Type: models.JobTypeEnrichSong, SongID: 2, Status: models.JobStatusDone}.API(),
+		},
+		{
+			name:    "GetJob error not found",
+			err:     repositories.ErrJobNotFound,
+			wantErr: services.ErrJobNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			jp := mocks.NewJobProvider(t)
+			jp.On("Job", mock.Anything, uint64(1)).Once().Return(tt.job, tt.err)
+
+			s := New(discardLogger, jobsConfig, jp, mocks.NewJobQueue(t))
+			got, err := s.GetJob(context.Background(), 1)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.GetJob() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_ProcessJob(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		job       models.Job
+		handleErr error
+		want      models.Job
+	}{
+		{
+			name: "ProcessJob done",
+			job:  models.Job{ID: 1, TenantID: "team-a", Type: models.JobTypeEnrichSong, SongID: 2, Attempts: 2, LastError: "unexpected error"},
+			want: models.Job{ID: 1, TenantID: "team-a", Type: models.JobTypeEnrichSong, SongID: 2, Attempts: 2, Status: models.JobStatusDone},
+		},
+		{
+			name:      "ProcessJob retry after first attempt",
+			job:       models.Job{ID: 1, Type: models.JobTypeEnrichSong, SongID: 2, Attempts: 1},
+			handleErr: errUnexpected,
+			want: models.Job{
+				ID:        1,
+				Type:      models.JobTypeEnrichSong,
+				SongID:    2,
+				Attempts:  1,
+				Status:    models.JobStatusPending,
+				RunAt:     now.Add(10 * time.Second),
+				LastError: "unexpected error",
+			},
+		},
+		{
+			name:      "ProcessJob retry backoff limited",
+			job:       models.Job{ID: 1, Type: models.JobTypeEnrichSong, SongID: 2, Attempts: 2},
+			handleErr: errUnexpected,
+			want: models.Job{
+				ID:        1,
+				Type:      models.JobTypeEnrichSong,
+				SongID:    2,
+				Attempts:  2,
+				Status:    models.JobStatusPending,
+				RunAt:     now.Add(15 * time.Second),
+				LastError: "unexpected error",
+			},
+		},
+		{
+			name:      "ProcessJob dead after last attempt",
+			job:       models.Job{ID: 1, Type: models.JobTypeEnrichSong, SongID: 2, Attempts: 3},
+			handleErr: errUnexpected,
+			want: models.Job{
+				ID:        1,
+				Type:      models.JobTypeEnrichSong,
+				SongID:    2,
+				Attempts:  3,
+				Status:    models.JobStatusDead,
+				LastError: "unexpected error",
+			},
+		},
+		{
+			name: "ProcessJob dead unknown type",
+			job:  models.Job{ID: 1, Type: "unknown", SongID: 2, Attempts: 1},
+			want: models.Job{
+				ID:        1,
+				Type:      "unknown",
+				SongID:    2,
+				Attempts:  1,
+				Status:    models.JobStatusDead,
+				LastError: "unknown job type: unknown",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			jq := mocks.NewJobQueue(t)
+			jq.On("FinishJob", mock.Anything, tt.want).Once().Return(nil)
+
+			s := New(discardLogger, jobsConfig, mocks.NewJobProvider(t), jq)
+			s.now = func() time.Time { return now }
+			s.Handle(models.JobTypeEnrichSong, func(ctx context.Context, songID uint64) error {
+				assert.Equal(t, tt.job.SongID, songID)
+				id, _ := tenant.FromContext(ctx)
+				assert.Equal(t, tt.job.TenantID, id)
+				return tt.handleErr
+			})
+
+			assert.NoError(t, s.ProcessJob(context.Background(), tt.job))
+		})
+	}
+}
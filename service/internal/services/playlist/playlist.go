@@ -0,0 +1,565 @@
+package playlist
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+
+	playlistrest "github.com/sedonn/song-library-service/internal/controllers/rest/playlist"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/links"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/metrics"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/repositories"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+const (
+	// exportPageSize это количество песен, загружаемых за один запрос при экспорте.
+	exportPageSize = 100
+	// defaultImportName это название импортированного плейлиста, если оно не задано ни в запросе, ни в файле.
+	defaultImportName = "Imported playlist"
+)
+
+// PlaylistProvider описывает поведение объекта слоя данных, который обеспечивает предоставление данных о плейлистах.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=PlaylistProvider
+type PlaylistProvider interface {
+	// Playlist возвращает данные определенного плейлиста.
+	Playlist(ctx context.Context, id uint64) (models.Playlist, error)
+	// Playlists возвращает плейлисты, найденные по подстроке названия, упорядоченные по названию,
+	// и общее количество найденных плейлистов без учета пагинации.
+	Playlists(ctx context.Context, attrs models.Playlist, p models.Pagination) (models.Playlists, uint64, error)
+	// PlaylistSongs возвращает песни определенного плейлиста в порядке их следования.
+	// Возвращает песни, общее количество песен плейлиста без учета пагинации, ошибку.
+	PlaylistSongs(ctx context.Context, id uint64, p models.Pagination) (models.Songs, uint64, error)
+}
+
+// PlaylistSaver описывает поведение объекта слоя данных, который обеспечивает сохранение данных плейлистов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=PlaylistSaver
+type PlaylistSaver interface {
+	// SavePlaylist сохраняет данные нового плейлиста вместе с песнями в переданном порядке.
+	SavePlaylist(ctx context.Context, p models.Playlist) (models.Playlist, error)
+}
+
+// PlaylistUpdater описывает поведение объекта слоя данных, который обеспечивает обновление данных плейлистов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=PlaylistUpdater
+type PlaylistUpdater interface {
+	// UpdatePlaylist обновляет данные определенного плейлиста.
+	UpdatePlaylist(ctx context.Context, p models.Playlist) (models.Playlist, error)
+}
+
+// PlaylistDeleter описывает поведение объекта слоя данных, который обеспечивает удаление данных плейлистов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=PlaylistDeleter
+type PlaylistDeleter interface {
+	// DeletePlaylist удаляет данные определенного плейлиста.
+	DeletePlaylist(ctx context.Context, id uint64) (uint64, error)
+}
+
+// PlaylistItemEditor описывает поведение объекта слоя данных, который обеспечивает изменение состава и порядка песен плейлистов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=PlaylistItemEditor
+type PlaylistItemEditor interface {
+	// AddPlaylistSong добавляет песню в определенную позицию плейлиста.
+	AddPlaylistSong(ctx context.Context, item models.PlaylistItem) (models.PlaylistItem, error)
+	// MovePlaylistSong перемещает песню плейлиста в определенную позицию.
+	MovePlaylistSong(ctx context.Context, item models.PlaylistItem) (models.PlaylistItem, error)
+	// DeletePlaylistSong удаляет песню из определенного плейлиста.
+	DeletePlaylistSong(ctx context.Context, playlistID, songID uint64) (uint64, error)
+}
+
+// SongMatcher описывает поведение объекта слоя данных, который обеспечивает поиск песен по записям внешних плейлистов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=SongMatcher
+type SongMatcher interface {
+	// MatchSong возвращает песню, которая соответствует записи внешнего плейлиста:
+	// по записи музыкального сервиса, по ссылке или по названию песни и названию исполнителя.
+	MatchSong(ctx context.Context, attrs models.Song) (models.Song, error)
+}
+
+// Service предоставляет бизнес-логику работы с плейлистами.
+type Service struct {
+	log                *slog.Logger
+	playlistProvider   PlaylistProvider
+	playlistSaver      PlaylistSaver
+	playlistUpdater    PlaylistUpdater
+	playlistDeleter    PlaylistDeleter
+	playlistItemEditor PlaylistItemEditor
+	songMatcher        SongMatcher
+}
+
+var _ playlistrest.PlaylistService = (*Service)(nil)
+
+// New создает новый объект сервиса плейлистов.
+func New(
+	log *slog.Logger,
+	pp PlaylistProvider,
+	ps PlaylistSaver,
+	pu PlaylistUpdater,
+	pd PlaylistDeleter,
+	pie PlaylistItemEditor,
+	sm SongMatcher,
+) *Service {
+	return &Service{
+		log:                log,
+		playlistProvider:   pp,
+		playlistSaver:      ps,
+		playlistUpdater:    pu,
+		playlistDeleter:    pd,
+		playlistItemEditor: pie,
+		songMatcher:        sm,
+	}
+}
+
+// GetPlaylist возвращает данные определенного плейлиста.
+func (s *Service) GetPlaylist(ctx context.Context, id uint64) (models.PlaylistAPI, error) {
+	log := s.log.With(slog.Uint64("id", id))
+
+	log.Info("attempt to get playlist")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsRead); err != nil {
+		log.Warn("failed to get playlist", logger.ErrorString(err))
+
+		return models.PlaylistAPI{}, err
+	}
+
+	p, err := s.playlistProvider.Playlist(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrPlaylistNotFound) {
+			log.Warn("failed to provide playlist", logger.ErrorString(err))
+
+			return models.PlaylistAPI{}, services.ErrPlaylistNotFound
+		}
+
+		log.Error("failed to get playlist", logger.ErrorString(err))
+
+		return models.PlaylistAPI{}, err
+	}
+
+	return p.API(), nil
+}
+
+// SearchPlaylists выполняет поиск плейлистов по подстроке названия. Плейлисты упорядочены по названию.
+func (s *Service) SearchPlaylists(ctx context.Context, attrs models.Playlist, p models.Pagination) (models.PlaylistsAPI, error) {
+	s.log.Info("attempt to search playlists")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsRead); err != nil {
+		s.log.Warn("failed to search playlists", logger.ErrorString(err))
+
+		return models.PlaylistsAPI{}, err
+	}
+
+	playlists, total, err := s.playlistProvider.Playlists(ctx, attrs, p)
+	if err != nil {
+		s.log.Error("failed to search playlists", logger.ErrorString(err))
+
+		return models.PlaylistsAPI{}, err
+	}
+
+	metrics.SearchResults.WithLabelValues("playlists").Observe(float64(total))
+
+	s.log.Info("success to search playlists", slog.Uint64("total", total))
+
+	return models.PlaylistsAPI{
+		Playlists: playlists.API(),
+		Pagination: models.PaginationMetadataAPI{
+			CurrentPageNumber: p.PageNumber,
+			PageCount:         uint64(math.Ceil(float64(total) / float64(p.PageSize))),
+			RecordCount:       total,
+			PageSize:          p.PageSize,
+		},
+	}, nil
+}
+
+// GetPlaylistSongs возвращает песни определенного плейлиста в порядке их следования с пагинацией.
+func (s *Service) GetPlaylistSongs(ctx context.Context, id uint64, p models.Pagination) (models.PlaylistSongsAPI, error) {
+	log := s.log.With(slog.Uint64("id", id))
+
+	log.Info("attempt to get playlist songs")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsRead); err != nil {
+		log.Warn("failed to get playlist songs", logger.ErrorString(err))
+
+		return models.PlaylistSongsAPI{}, err
+	}
+
+	songs, total, err := s.playlistProvider.PlaylistSongs(ctx, id, p)
+	if err != nil {
+		if errors.Is(err, repositories.ErrPlaylistNotFound) {
+			log.Warn("failed to provide playlist songs", logger.ErrorString(err))
+
+			return models.PlaylistSongsAPI{}, services.ErrPlaylistNotFound
+		}
+
+		log.Error("failed to get playlist songs", logger.ErrorString(err))
+
+		return models.PlaylistSongsAPI{}, err
+	}
+
+	log.Info("success to get playlist songs", slog.Uint64("total", total))
+
+	return models.PlaylistSongsAPI{
+		Songs: songs.API(),
+		Pagination: models.PaginationMetadataAPI{
+			CurrentPageNumber: p.PageNumber,
+			PageCount:         uint64(math.Ceil(float64(total) / float64(p.PageSize))),
+			RecordCount:       total,
+			PageSize:          p.PageSize,
+		},
+	}, nil
+}
+
+// CreatePlaylist создает новый плейлист.
+func (s *Service) CreatePlaylist(ctx context.Context, p models.Playlist) (models.PlaylistAPI, error) {
+	log := s.log.With(slog.String("name", p.Name), logger.Actor(ctx))
+
+	log.Info("attempt to create playlist")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsWrite); err != nil {
+		log.Warn("failed to create playlist", logger.ErrorString(err))
+
+		return models.PlaylistAPI{}, err
+	}
+
+	p, err := s.playlistSaver.SavePlaylist(ctx, p)
+	if err != nil {
+		if serviceErr := playlistItemError(err); serviceErr != nil {
+			log.Warn("failed to create playlist", logger.ErrorString(err))
+
+			return models.PlaylistAPI{}, serviceErr
+		}
+
+		log.Error("failed to create playlist", logger.ErrorString(err))
+
+		return models.PlaylistAPI{}, err
+	}
+
+	log.Info("success to create playlist", slog.Uint64("id", p.ID))
+
+	return p.API(), nil
+}
+
+// ChangePlaylist обновляет данные определенного плейлиста.
+func (s *Service) ChangePlaylist(ctx context.Context, p models.Playlist) (models.PlaylistAPI, error) {
+	log := s.log.With(slog.Uint64("id", p.ID), logger.Actor(ctx))
+
+	log.Info("attempt to change playlist")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsWrite); err != nil {
+		log.Warn("failed to change playlist", logger.ErrorString(err))
+
+		return models.PlaylistAPI{}, err
+	}
+
+	p, err := s.playlistUpdater.UpdatePlaylist(ctx, p)
+	if err != nil {
+		if errors.Is(err, repositories.ErrPlaylistNotFound) {
+			log.Warn("failed to change playlist", logger.ErrorString(err))
+
+			return models.PlaylistAPI{}, services.ErrPlaylistNotFound
+		}
+
+		log.Error("failed to change playlist", logger.ErrorString(err))
+
+		return models.PlaylistAPI{}, err
+	}
+
+	log.Info("success to change playlist")
+
+	return p.API(), nil
+}
+
+// RemovePlaylist удаляет определенный плейлист.
+func (s *Service) RemovePlaylist(ctx context.Context, id uint64) (models.PlaylistIDAPI, error) {
+	log := s.log.With(slog.Uint64("id", id), logger.Actor(ctx))
+
+	log.Info("attempt to remove playlist")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsDelete); err != nil {
+		log.Warn("failed to remove playlist", logger.ErrorString(err))
+
+		return models.PlaylistIDAPI{}, err
+	}
+
+	id, err := s.playlistDeleter.DeletePlaylist(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrPlaylistNotFound) {
+			log.Warn("failed to remove playlist", logger.ErrorString(err))
+
+			return models.PlaylistIDAPI{}, services.ErrPlaylistNotFound
+		}
+
+		log.Error("failed to remove playlist", logger.ErrorString(err))
+
+		return models.PlaylistIDAPI{}, err
+	}
+
+	log.Info("success to remove playlist")
+
+	return models.PlaylistIDAPI{ID: id}, nil
+}
+
+// AddPlaylistSong добавляет песню в определенную позицию плейлиста.
+func (s *Service) AddPlaylistSong(ctx context.Context, item models.PlaylistItem) (models.PlaylistItemAPI, error) {
+	log := s.log.With(slog.Uint64("id", item.PlaylistID), slog.Uint64("song_id", item.SongID), logger.Actor(ctx))
+
+	log.Info("attempt to add playlist song")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsWrite); err != nil {
+		log.Warn("failed to add playlist song", logger.ErrorString(err))
+
+		return models.PlaylistItemAPI{}, err
+	}
+
+	item, err := s.playlistItemEditor.AddPlaylistSong(ctx, item)
+	if err != nil {
+		if serviceErr := playlistItemError(err); serviceErr != nil {
+			log.Warn("failed to add playlist song", logger.ErrorString(err))
+
+			return models.PlaylistItemAPI{}, serviceErr
+		}
+
+		log.Error("failed to add playlist song", logger.ErrorString(err))
+
+		return models.PlaylistItemAPI{}, err
+	}
+
+	log.Info("success to add playlist song", slog.Uint64("position", uint64(item.Position)))
+
+	return item.API(), nil
+}
+
+// MovePlaylistSong перемещает песню плейлиста в определенную позицию.
+func (s *Service) MovePlaylistSong(ctx context.Context, item models.PlaylistItem) (models.PlaylistItemAPI, error) {
+	log := s.log.With(slog.Uint64("id", item.PlaylistID), slog.Uint64("song_id", item.SongID), logger.Actor(ctx))
+
+	log.Info("attempt to move playlist song")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsWrite); err != nil {
+		log.Warn("failed to move playlist song", logger.ErrorString(err))
+
+		return models.PlaylistItemAPI{}, err
+	}
+
+	item, err := s.playlistItemEditor.MovePlaylistSong(ctx, item)
+	if err != nil {
+		if serviceErr := playlistItemError(err); serviceErr != nil {
+			log.Warn("failed to move playlist song", logger.ErrorString(err))
+
+			return models.PlaylistItemAPI{}, serviceErr
+		}
+
+		log.Error("failed to move playlist song", logger.ErrorString(err))
+
+		return models.PlaylistItemAPI{}, err
+	}
+
+	log.Info("success to move playlist song", slog.Uint64("position", uint64(item.Position)))
+
+	return item.API(), nil
+}
+
+// RemovePlaylistSong удаляет песню из определенного плейлиста.
+func (s *Service) RemovePlaylistSong(ctx context.Context, playlistID, songID uint64) (models.SongIDAPI, error) {
+	log := s.log.With(slog.Uint64("id", playlistID), slog.Uint64("song_id", songID), logger.Actor(ctx))
+
+	log.Info("attempt to remove playlist song")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsWrite); err != nil {
+		log.Warn("failed to remove playlist song", logger.ErrorString(err))
+
+		return models.SongIDAPI{}, err
+	}
+
+	songID, err := s.playlistItemEditor.DeletePlaylistSong(ctx, playlistID, songID)
+	if err != nil {
+		if serviceErr := playlistItemError(err); serviceErr != nil {
+			log.Warn("failed to remove playlist song", logger.ErrorString(err))
+
+			return models.SongIDAPI{}, serviceErr
+		}
+
+		log.Error("failed to remove playlist song", logger.ErrorString(err))
+
+		return models.SongIDAPI{}, err
+	}
+
+	log.Info("success to remove playlist song")
+
+	return models.SongIDAPI{ID: songID}, nil
+}
+
+// ExportPlaylist экспортирует песни определенного плейлиста в порядке их следования в определенном формате.
+func (s *Service) ExportPlaylist(ctx context.Context, id uint64, f playlistfmt.Format) ([]byte, error) {
+	log := s.log.With(slog.Uint64("id", id), slog.String("format", string(f)))
+
+	log.Info("attempt to export playlist")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsRead); err != nil {
+		log.Warn("failed to export playlist", logger.ErrorString(err))
+
+		return nil, err
+	}
+
+	pl, err := s.playlistProvider.Playlist(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrPlaylistNotFound) {
+			log.Warn("failed to export playlist", logger.ErrorString(err))
+
+			return nil, services.ErrPlaylistNotFound
+		}
+
+		log.Error("failed to export playlist", logger.ErrorString(err))
+
+		return nil, err
+	}
+
+	var songs models.Songs
+	for p := (models.Pagination{PageNumber: 1, PageSize: exportPageSize}); ; p.PageNumber++ {
+		page, total, err := s.playlistProvider.PlaylistSongs(ctx, id, p)
+		if err != nil {
+			log.Error("failed to export playlist", logger.ErrorString(err))
+
+			return nil, err
+		}
+
+		songs = append(songs, page...)
+		if len(page) < exportPageSize || uint64(len(songs)) >= total {
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := playlistfmt.Encode(&buf, f, playlistfmt.Playlist{Title: pl.Name, Tracks: songs.Tracks()}); err != nil {
+		log.Error("failed to export playlist", logger.ErrorString(err))
+
+		return nil, err
+	}
+
+	log.Info("success to export playlist", slog.Int("count", len(songs)))
+
+	return buf.Bytes(), nil
+}
+
+// ImportPlaylist создает новый плейлист из файла плейлиста определенного формата.
+// Записи файла сопоставляются с песнями библиотеки по ссылке или по названию песни и исполнителя,
+// повторяющиеся песни добавляются один раз. Несопоставленные записи возвращаются в ответе.
+// Если название не задано, то используется название из файла.
+func (s *Service) ImportPlaylist(ctx context.Context, name string, f playlistfmt.Format, data []byte) (models.PlaylistImportAPI, error) {
+	log := s.log.With(slog.String("format", string(f)), logger.Actor(ctx))
+
+	log.Info("attempt to import playlist")
+
+	if err := services.Authorize(ctx, rbac.PlaylistsImport); err != nil {
+		log.Warn("failed to import playlist", logger.ErrorString(err))
+
+		return models.PlaylistImportAPI{}, err
+	}
+
+	file, err := playlistfmt.Decode(bytes.NewReader(data), f)
+	if err != nil {
+		log.Warn("failed to import playlist", logger.ErrorString(err))
+
+		return models.PlaylistImportAPI{}, fmt.Errorf("%w: %w", services.ErrInvalidPlaylistFile, err)
+	}
+
+	pl := models.Playlist{Name: name}
+	if pl.Name == "" {
+		pl.Name = file.Title
+	}
+	if pl.Name == "" {
+		pl.Name = defaultImportName
+	}
+
+	var (
+		unmatched = make([]models.PlaylistEntryAPI, 0)
+		added     = make(map[uint64]struct{}, len(file.Tracks))
+	)
+	for i, t := range file.Tracks {
+		attrs := models.Song{
+			Name:   t.Title,
+			Link:   t.Location,
+			Artist: models.Artist{Name: t.Creator},
+		}
+		if l, err := links.Normalize(t.Location); err == nil {
+			attrs.Link, attrs.LinkProvider, attrs.LinkMediaID = l.URL, l.Provider, l.MediaID
+		}
+
+		song, err := s.songMatcher.MatchSong(ctx, attrs)
+		if err != nil {
+			if !errors.Is(err, repositories.ErrSongNotFound) {
+				log.Error("failed to import playlist", logger.ErrorString(err))
+
+				return models.PlaylistImportAPI{}, err
+			}
+
+			unmatched = append(unmatched, models.PlaylistEntryAPI{
+				Position: uint32(i + 1),
+				Location: t.Location,
+				Title:    t.Title,
+				Creator:  t.Creator,
+			})
+			continue
+		}
+
+		if _, ok := added[song.ID]; ok {
+			continue
+		}
+		added[song.ID] = struct{}{}
+		pl.Items = append(pl.Items, models.PlaylistItem{SongID: song.ID})
+	}
+
+	pl, err = s.playlistSaver.SavePlaylist(ctx, pl)
+	if err != nil {
+		if serviceErr := playlistItemError(err); serviceErr != nil {
+			log.Warn("failed to import playlist", logger.ErrorString(err))
+
+			return models.PlaylistImportAPI{}, serviceErr
+		}
+
+		log.Error("failed to import playlist", logger.ErrorString(err))
+
+		return models.PlaylistImportAPI{}, err
+	}
+
+	log.Info("success to import playlist",
+		slog.Uint64("id", pl.ID),
+		slog.Int("matched", len(pl.Items)),
+		slog.Int("unmatched", len(unmatched)),
+	)
+
+	return models.PlaylistImportAPI{
+		Playlist:     pl.API(),
+		MatchedCount: uint32(len(pl.Items)),
+		Unmatched:    unmatched,
+	}, nil
+}
+
+// playlistItemError преобразует ошибки слоя данных песен плейлистов в ошибки бизнес-логики.
+// Возвращает nil, если ошибка не является ожидаемой.
+func playlistItemError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrPlaylistNotFound):
+		return services.ErrPlaylistNotFound
+
+	case errors.Is(err, repositories.ErrSongNotFound):
+		return services.ErrSongNotFound
+
+	case errors.Is(err, repositories.ErrPlaylistItemNotFound):
+		return services.ErrPlaylistItemNotFound
+
+	case errors.Is(err, repositories.ErrPlaylistItemExists):
+		return services.ErrPlaylistItemExists
+
+	default:
+		return nil
+	}
+}
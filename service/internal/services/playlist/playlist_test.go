@@ -0,0 +1,779 @@
+package playlist
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
+	"github.com/sedonn/song-library-service/internal/repositories"
+	"github.com/sedonn/song-library-service/internal/services"
+	"github.com/sedonn/song-library-service/internal/services/playlist/mocks"
+)
+
+var (
+	discardLogger             = logger.NewDiscardLogger()
+	expectedPlaylistID uint64 = 1
+	expectedSongID     uint64 = 2
+	expectedPlaylist          = models.Playlist{ID: expectedPlaylistID, Name: "road trip"}
+	expectedPagination        = models.Pagination{PageNumber: 1, PageSize: 10}
+	expectedItem              = models.PlaylistItem{PlaylistID: expectedPlaylistID, SongID: expectedSongID, Position: 1}
+	errUnexpected             = errors.New("unexpected error")
+)
+
+func TestService_SearchPlaylists(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		playlistProvider PlaylistProvider
+	}
+	type args struct {
+		ctx   context.Context
+		attrs models.Playlist
+		p     models.Pagination
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.PlaylistsAPI
+		wantErr error
+	}{
+		{
+			name: "SearchPlaylists happy path",
+			fields: fields{
+				playlistProvider: func() PlaylistProvider {
+					pp := mocks.NewPlaylistProvider(t)
+					pp.
+						On("Playlists", mock.Anything, models.Playlist{Name: "road"}, expectedPagination).
+						Once().
+						Return(models.Playlists{expectedPlaylist}, uint64(11), nil)
+
+					return pp
+				}(),
+			},
+			args: args{
+				attrs: models.Playlist{Name: "road"},
+				p:     expectedPagination,
+			},
+			want: models.PlaylistsAPI{
+				Playlists: models.Playlists{expectedPlaylist}.API(),
+				Pagination: models.PaginationMetadataAPI{
+					CurrentPageNumber: 1,
+					PageCount:         2,
+					PageSize:          10,
+					RecordCount:       11,
+				},
+			},
+		},
+		{
+			name: "SearchPlaylists error database",
+			fields: fields{
+				playlistProvider: func() PlaylistProvider {
+					pp := mocks.NewPlaylistProvider(t)
+					pp.
+						On("Playlists", mock.Anything, models.Playlist{Name: "road"}, expectedPagination).
+						Once().
+						Return(models.Playlists{}, uint64(0), errUnexpected)
+
+					return pp
+				}(),
+			},
+			args: args{
+				attrs: models.Playlist{Name: "road"},
+				p:     expectedPagination,
+			},
+			want:    models.PlaylistsAPI{},
+			wantErr: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:              discardLogger,
+				playlistProvider: tt.fields.playlistProvider,
+			}
+			got, err := s.SearchPlaylists(tt.args.ctx, tt.args.attrs, tt.args.p)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.SearchPlaylists() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_GetPlaylistSongs(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		playlistProvider PlaylistProvider
+	}
+	type args struct {
+		ctx context.Context
+		id  uint64
+		p   models.Pagination
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.PlaylistSongsAPI
+		wantErr error
+	}{
+		{
+			name: "GetPlaylistSongs happy path",
+			fields: fields{
+				playlistProvider: func() PlaylistProvider {
+					pp := mocks.NewPlaylistProvider(t)
+					pp.
+						On("PlaylistSongs", mock.Anything, expectedPlaylistID, expectedPagination).
+						Once().
+						Return(models.Songs{{ID: expectedSongID}}, uint64(11), nil)
+
+					return pp
+				}(),
+			},
+			args: args{
+				id: expectedPlaylistID,
+				p:  expectedPagination,
+			},
+			want: models.PlaylistSongsAPI{
+				Songs: models.Songs{{ID: expectedSongID}}.API(),
+				Pagination: models.PaginationMetadataAPI{
+					CurrentPageNumber: 1,
+					PageCount:         2,
+					PageSize:          10,
+					RecordCount:       11,
+				},
+			},
+		},
+		{
+			name: "GetPlaylistSongs error playlist not found",
+			fields: fields{
+				playlistProvider: func() PlaylistProvider {
+					pp := mocks.NewPlaylistProvider(t)
+					pp.
+						On("PlaylistSongs", mock.Anything, expectedPlaylistID, expectedPagination).
+						Once().
+						Return(models.Songs{}, uint64(0), repositories.ErrPlaylistNotFound)
+
+					return pp
+				}(),
+			},
+			args: args{
+				id: expectedPlaylistID,
+				p:  expectedPagination,
+			},
+			want:    models.PlaylistSongsAPI{},
+			wantErr: services.ErrPlaylistNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:              discardLogger,
+				playlistProvider: tt.fields.playlistProvider,
+			}
+			got, err := s.GetPlaylistSongs(tt.args.ctx, tt.args.id, tt.args.p)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.GetPlaylistSongs() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_CreatePlaylist(t *testing.T) {
+	t.Parallel()
+
+	playlist := models.Playlist{
+		Name:  "road trip",
+		Items: models.PlaylistItems{{SongID: expectedSongID}, {SongID: expectedSongID}},
+	}
+
+	type fields struct {
+		playlistSaver PlaylistSaver
+	}
+	type args struct {
+		ctx context.Context
+		p   models.Playlist
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.PlaylistAPI
+		wantErr error
+	}{
+		{
+			name: "CreatePlaylist happy path",
+			fields: fields{
+				playlistSaver: func() PlaylistSaver {
+					ps := mocks.NewPlaylistSaver(t)
+					ps.
+						On("SavePlaylist", mock.Anything, models.Playlist{Name: "road trip"}).
+						Once().
+						Return(expectedPlaylist, nil)
+
+					return ps
+				}(),
+			},
+			args: args{p: models.Playlist{Name: "road trip"}},
+			want: expectedPlaylist.API(),
+		},
+		{
+			name: "CreatePlaylist error song already in playlist",
+			fields: fields{
+				playlistSaver: func() PlaylistSaver {
+					ps := mocks.NewPlaylistSaver(t)
+					ps.
+						On("SavePlaylist", mock.Anything, playlist).
+						Once().
+						Return(models.Playlist{}, repositories.ErrPlaylistItemExists)
+
+					return ps
+				}(),
+			},
+			args:    args{p: playlist},
+			want:    models.PlaylistAPI{},
+			wantErr: services.ErrPlaylistItemExists,
+		},
+		{
+			name: "CreatePlaylist error database",
+			fields: fields{
+				playlistSaver: func() PlaylistSaver {
+					ps := mocks.NewPlaylistSaver(t)
+					ps.
+						On("SavePlaylist", mock.Anything, playlist).
+						Once().
+						Return(models.Playlist{}, errUnexpected)
+
+					return ps
+				}(),
+			},
+			args:    args{p: playlist},
+			want:    models.PlaylistAPI{},
+			wantErr: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:           discardLogger,
+				playlistSaver: tt.fields.playlistSaver,
+			}
+			got, err := s.CreatePlaylist(tt.args.ctx, tt.args.p)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.CreatePlaylist() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_ChangePlaylist(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		playlistUpdater PlaylistUpdater
+	}
+	type args struct {
+		ctx context.Context
+		p   models.Playlist
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.PlaylistAPI
+		wantErr error
+	}{
+		{
+			name: "ChangePlaylist happy path",
+			fields: fields{
+				playlistUpdater: func() PlaylistUpdater {
+					pu := mocks.NewPlaylistUpdater(t)
+					pu.
+						On("UpdatePlaylist", mock.Anything, expectedPlaylist).
+						Once().
+						Return(expectedPlaylist, nil)
+
+					return pu
+				}(),
+			},
+			args: args{
+				p: expectedPlaylist,
+			},
+			want: expectedPlaylist.API(),
+		},
+		{
+			name: "ChangePlaylist error playlist not found",
+			fields: fields{
+				playlistUpdater: func() PlaylistUpdater {
+					pu := mocks.NewPlaylistUpdater(t)
+					pu.
+						On("UpdatePlaylist", mock.Anything, expectedPlaylist).
+						Once().
+						Return(models.Playlist{}, repositories.ErrPlaylistNotFound)
+
+					return pu
+				}(),
+			},
+			args: args{
+				p: expectedPlaylist,
+			},
+			want:    models.PlaylistAPI{},
+			wantErr: services.ErrPlaylistNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:             discardLogger,
+				playlistUpdater: tt.fields.playlistUpdater,
+			}
+			got, err := s.ChangePlaylist(tt.args.ctx, tt.args.p)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.ChangePlaylist() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_AddPlaylistSong(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		playlistItemEditor PlaylistItemEditor
+	}
+	type args struct {
+		ctx  context.Context
+		item models.PlaylistItem
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.PlaylistItemAPI
+		wantErr error
+	}{
+		{
+			name: "AddPlaylistSong happy path",
+			fields: fields{
+				playlistItemEditor: func() PlaylistItemEditor {
+					pie := mocks.NewPlaylistItemEditor(t)
+					pie.
+						On("AddPlaylistSong", mock.Anything, expectedItem).
+						Once().
+						Return(expectedItem, nil)
+
+					return pie
+				}(),
+			},
+			args: args{
+				item: expectedItem,
+			},
+			want: expectedItem.API(),
+		},
+		{
+			name: "AddPlaylistSong error song not found",
+			fields: fields{
+				playlistItemEditor: func() PlaylistItemEditor {
+					pie := mocks.NewPlaylistItemEditor(t)
+					pie.
+						On("AddPlaylistSong", mock.Anything, expectedItem).
+						Once().
+						Return(models.PlaylistItem{}, repositories.ErrSongNotFound)
+
+					return pie
+				}(),
+			},
+			args: args{
+				item: expectedItem,
+			},
+			want:    models.PlaylistItemAPI{},
+			wantErr: services.ErrSongNotFound,
+		},
+		{
+			name: "AddPlaylistSong error song already in playlist",
+			fields: fields{
+				playlistItemEditor: func() PlaylistItemEditor {
+					pie := mocks.NewPlaylistItemEditor(t)
+					pie.
+						On("AddPlaylistSong", mock.Anything, expectedItem).
+						Once().
+						Return(models.PlaylistItem{}, repositories.ErrPlaylistItemExists)
+
+					return pie
+				}(),
+			},
+			args: args{
+				item: expectedItem,
+			},
+			want:    models.PlaylistItemAPI{},
+			wantErr: services.ErrPlaylistItemExists,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:                discardLogger,
+				playlistItemEditor: tt.fields.playlistItemEditor,
+			}
+			got, err := s.AddPlaylistSong(tt.args.ctx, tt.args.item)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.AddPlaylistSong() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_MovePlaylistSong(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		playlistItemEditor PlaylistItemEditor
+	}
+	type args struct {
+		ctx  context.Context
+		item models.PlaylistItem
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.PlaylistItemAPI
+		wantErr error
+	}{
+		{
+			name: "MovePlaylistSong happy path",
+			fields: fields{
+				playlistItemEditor: func() PlaylistItemEditor {
+					pie := mocks.NewPlaylistItemEditor(t)
+					pie.
+						On("MovePlaylistSong", mock.Anything, expectedItem).
+						Once().
+						Return(expectedItem, nil)
+
+					return pie
+				}(),
+			},
+			args: args{
+				item: expectedItem,
+			},
+			want: expectedItem.API(),
+		},
+		{
+			name: "MovePlaylistSong error song not in playlist",
+			fields: fields{
+				playlistItemEditor: func() PlaylistItemEditor {
+					pie := mocks.NewPlaylistItemEditor(t)
+					pie.
+						On("MovePlaylistSong", mock.Anything, expectedItem).
+						Once().
+						Return(models.PlaylistItem{}, repositories.ErrPlaylistItemNotFound)
+
+					return pie
+				}(),
+			},
+			args: args{
+				item: expectedItem,
+			},
+			want:    models.PlaylistItemAPI{},
+			wantErr: services.ErrPlaylistItemNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:                discardLogger,
+				playlistItemEditor: tt.fields.playlistItemEditor,
+			}
+			got, err := s.MovePlaylistSong(tt.args.ctx, tt.args.item)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.MovePlaylistSong() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_RemovePlaylistSong(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		playlistItemEditor PlaylistItemEditor
+	}
+	type args struct {
+		ctx        context.Context
+		playlistID uint64
+		songID     uint64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.SongIDAPI
+		wantErr error
+	}{
+		{
+			name: "RemovePlaylistSong happy path",
+			fields: fields{
+				playlistItemEditor: func() PlaylistItemEditor {
+					pie := mocks.NewPlaylistItemEditor(t)
+					pie.
+						On("DeletePlaylistSong", mock.Anything, expectedPlaylistID, expectedSongID).
+						Once().
+						Return(expectedSongID, nil)
+
+					return pie
+				}(),
+			},
+			args: args{
+				playlistID: expectedPlaylistID,
+				songID:     expectedSongID,
+			},
+			want: models.SongIDAPI{ID: expectedSongID},
+		},
+		{
+			name: "RemovePlaylistSong error playlist not found",
+			fields: fields{
+				playlistItemEditor: func() PlaylistItemEditor {
+					pie := mocks.NewPlaylistItemEditor(t)
+					pie.
+						On("DeletePlaylistSong", mock.Anything, expectedPlaylistID, expectedSongID).
+						Once().
+						Return(uint64(0), repositories.ErrPlaylistNotFound)
+
+					return pie
+				}(),
+			},
+			args: args{
+				playlistID: expectedPlaylistID,
+				songID:     expectedSongID,
+			},
+			want:    models.SongIDAPI{},
+			wantErr: services.ErrPlaylistNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:                discardLogger,
+				playlistItemEditor: tt.fields.playlistItemEditor,
+			}
+			got, err := s.RemovePlaylistSong(tt.args.ctx, tt.args.playlistID, tt.args.songID)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.RemovePlaylistSong() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_ExportPlaylist(t *testing.T) {
+	t.Parallel()
+
+	songs := models.Songs{
+		{ID: 1, Name: "One", Link: "https://example.com/1", Artist: models.Artist{Name: "A"}},
+		{ID: 2, Name: "Two", Link: "https://example.com/2", Artist: models.Artist{Name: "B"}},
+	}
+
+	type fields struct {
+		playlistProvider PlaylistProvider
+	}
+	type args struct {
+		ctx context.Context
+		id  uint64
+		f   playlistfmt.Format
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    string
+		wantErr error
+	}{
+		{
+			name: "ExportPlaylist happy path",
+			fields: fields{
+				playlistProvider: func() PlaylistProvider {
+					pp := mocks.NewPlaylistProvider(t)
+					pp.
+						On("Playlist", mock.Anything, expectedPlaylistID).
+						Once().
+						Return(expectedPlaylist, nil)
+					pp.
+						On("PlaylistSongs", mock.Anything, expectedPlaylistID, models.Pagination{PageNumber: 1, PageSize: exportPageSize}).
+						Once().
+						Return(songs, uint64(len(songs)), nil)
+
+					return pp
+				}(),
+			},
+			args: args{
+				id: expectedPlaylistID,
+				f:  playlistfmt.M3U8,
+			},
+			want: "#EXTM3U\n#PLAYLIST:road trip\n" +
+				"#EXTINF:-1,A - One\nhttps://example.com/1\n" +
+				"#EXTINF:-1,B - Two\nhttps://example.com/2\n",
+		},
+		{
+			name: "ExportPlaylist error playlist not found",
+			fields: fields{
+				playlistProvider: func() PlaylistProvider {
+					pp := mocks.NewPlaylistProvider(t)
+					pp.
+						On("Playlist", mock.Anything, expectedPlaylistID).
+						Once().
+						Return(models.Playlist{}, repositories.ErrPlaylistNotFound)
+
+					return pp
+				}(),
+			},
+			args: args{
+				id: expectedPlaylistID,
+				f:  playlistfmt.M3U8,
+			},
+			wantErr: services.ErrPlaylistNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:              discardLogger,
+				playlistProvider: tt.fields.playlistProvider,
+			}
+			got, err := s.ExportPlaylist(tt.args.ctx, tt.args.id, tt.args.f)
+			assert.Equal(t, tt.want, string(got))
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.ExportPlaylist() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_ImportPlaylist(t *testing.T) {
+	t.Parallel()
+
+	file := []byte(`{"playlist":{"title":"From file","track":[` +
+		`{"location":["https://example.com/1"],"title":"One","creator":"A"},` +
+		`{"title":"Unknown","creator":"Nobody"},` +
+		`{"title":"one","creator":"a"}]}}`)
+
+	type fields struct {
+		playlistSaver PlaylistSaver
+		songMatcher   SongMatcher
+	}
+	type args struct {
+		ctx  context.Context
+		name string
+		f    playlistfmt.Format
+		data []byte
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.PlaylistImportAPI
+		wantErr error
+	}{
+		{
+			name: "ImportPlaylist happy path",
+			fields: fields{
+				playlistSaver: func() PlaylistSaver {
+					ps := mocks.NewPlaylistSaver(t)
+					ps.
+						On("SavePlaylist", mock.Anything, models.Playlist{
+							Name:  "From file",
+							Items: models.PlaylistItems{{SongID: 1}},
+						}).
+						Once().
+						Return(models.Playlist{
+							ID:    expectedPlaylistID,
+							Name:  "From file",
+							Items: models.PlaylistItems{{PlaylistID: expectedPlaylistID, SongID: 1, Position: 1}},
+						}, nil)
+
+					return ps
+				}(),
+				songMatcher: func() SongMatcher {
+					sm := mocks.NewSongMatcher(t)
+					sm.
+						On("MatchSong", mock.Anything, models.Song{Name: "One", Link: "https://example.com/1", Artist: models.Artist{Name: "A"}}).
+						Once().
+						Return(models.Song{ID: 1}, nil)
+					sm.
+						On("MatchSong", mock.Anything, models.Song{Name: "Unknown", Artist: models.Artist{Name: "Nobody"}}).
+						Once().
+						Return(models.Song{}, repositories.ErrSongNotFound)
+					sm.
+						On("MatchSong", mock.Anything, models.Song{Name: "one", Artist: models.Artist{Name: "a"}}).
+						Once().
+						Return(models.Song{ID: 1}, nil)
+
+					return sm
+				}(),
+			},
+			args: args{
+				f:    playlistfmt.JSPF,
+				data: file,
+			},
+			want: models.PlaylistImportAPI{
+				Playlist:     models.Playlist{ID: expectedPlaylistID, Name: "From file"}.API(),
+				MatchedCount: 1,
+				Unmatched: []models.PlaylistEntryAPI{
+					{Position: 2, Title: "Unknown", Creator: "Nobody"},
+				},
+			},
+		},
+		{
+			name: "ImportPlaylist error invalid file",
+			args: args{
+				f:    playlistfmt.XSPF,
+				data: []byte("<playlist><trackList>"),
+			},
+			wantErr: services.ErrInvalidPlaylistFile,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:           discardLogger,
+				playlistSaver: tt.fields.playlistSaver,
+				songMatcher:   tt.fields.songMatcher,
+			}
+			got, err := s.ImportPlaylist(tt.args.ctx, tt.args.name, tt.args.f, tt.args.data)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.ImportPlaylist() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package quota
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	mwratelimit "github.com/sedonn/song-library-service/internal/controllers/rest/middleware/ratelimit"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// QuotaUsageIncrementer описывает поведение объекта слоя данных, который обеспечивает учет запросов клиентов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=QuotaUsageIncrementer
+type QuotaUsageIncrementer interface {
+	// IncrementQuotaUsage увеличивает на n количество запросов клиента за сутки и возвращает новое количество.
+	IncrementQuotaUsage(ctx context.Context, client string, day time.Time, n uint64) (uint64, error)
+}
+
+// Service предоставляет бизнес-логику суточных квот запросов клиентов API. Запросы учитываются в БД
+// резервами по cfg.Batch запросов, поэтому большинство запросов не обращается к БД.
+type Service struct {
+	log                   *slog.Logger
+	cfg                   *config.QuotaConfig
+	quotaUsageIncrementer QuotaUsageIncrementer
+	now                   func() time.Time
+
+	mu           sync.Mutex
+	day          time.Time
+	reservations map[string]*reservation
+}
+
+// reservation это резерв запросов в суточной квоте клиента, полученный этим экземпляром микросервиса.
+type reservation struct {
+	mu sync.Mutex
+	// used это количество запросов клиента за сутки в БД после последнего резервирования.
+	used uint64
+	// next это количество запросов клиента с учетом израсходованной части резерва,
+	// end - количество запросов, на котором резерв заканчивается.
+	next, end uint64
+}
+
+var _ mwratelimit.QuotaService = (*Service)(nil)
+
+// New создает новый объект сервиса суточных квот.
+func New(log *slog.Logger, cfg *config.QuotaConfig, qui QuotaUsageIncrementer) *Service {
+	return &Service{
+		log:                   log,
+		cfg:                   cfg,
+		quotaUsageIncrementer: qui,
+		now:                   time.Now,
+		reservations:          make(map[string]*reservation),
+	}
+}
+
+// Consume учитывает запрос клиента в его суточной квоте. Если квота клиента уже исчерпана, то возвращает
+// ErrQuotaExceeded вместе с состоянием квоты. Клиенты без квоты не ограничиваются, и их запросы не учитываются.
+func (s *Service) Consume(ctx context.Context, client string) (models.Quota, error) {
+	log := s.log.With(slog.String("client", client))
+
+	limit, ok := s.cfg.Clients[client]
+	if !ok {
+		limit = s.cfg.Daily
+	}
+	if limit == 0 {
+		return models.Quota{}, nil
+	}
+
+	log.Debug("attempt to consume quota")
+
+	day := s.now().UTC().Truncate(24 * time.Hour)
+	q := models.Quota{Limit: limit, Reset: day.Add(24 * time.Hour)}
+
+	r := s.reservation(client, day)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Резерв пополняется, только пока квота клиента в БД не исчерпана, поэтому запросы клиента
+	// с исчерпанной квотой не обращаются к БД до конца суток.
+	if r.next == r.end && r.used < limit {
+		n := max(s.cfg.Batch, 1)
+		used, err := s.quotaUsageIncrementer.IncrementQuotaUsage(ctx, client, day, n)
+		if err != nil {
+			log.Error("failed to consume quota", logger.ErrorString(err))
+
+			return models.Quota{}, err
+		}
+
+		r.used = used
+		r.next = used - n
+		r.end = min(used, max(limit, r.next))
+
+		log.Debug("quota reserved", slog.Uint64("used", used), slog.Uint64("reserved", r.end-r.next))
+	}
+
+	if r.next == r.end {
+		log.Warn("failed to consume quota", logger.ErrorString(services.ErrQuotaExceeded), slog.Uint64("limit", limit))
+
+		return q, services.ErrQuotaExceeded
+	}
+
+	r.next++
+	q.Remaining = limit - r.next
+
+	log.Debug("success to consume quota", slog.Uint64("remaining", q.Remaining))
+
+	return q, nil
+}
+
+// reservation возвращает резерв клиента на определенные сутки. Резервы прошедших суток удаляются.
+func (s *Service) reservation(client string, day time.Time) *reservation {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.day.Equal(day) {
+		s.day = day
+		clear(s.reservations)
+	}
+
+	r, ok := s.reservations[client]
+	if !ok {
+		r = &reservation{}
+		s.reservations[client] = r
+	}
+
+	return r
+}
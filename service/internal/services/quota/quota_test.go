@@ -0,0 +1,167 @@
+package quota
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sedonn/song-library-service/internal/config"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/services"
+	"github.com/sedonn/song-library-service/internal/services/quota/mocks"
+)
+
+var (
+	discardLogger = logger.NewDiscardLogger()
+	errDB         = errors.New("db error")
+)
+
+func TestService_Consume(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 20, 15, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+	day := time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC)
+	reset := day.Add(24 * time.Hour)
+
+	cfg := &config.QuotaConfig{
+		Daily:   100,
+		Clients: map[string]uint64{"api_key:importer": 1000, "api_key:unlimited": 0},
+	}
+
+	tests := []struct {
+		name                  string
+		client                string
+		quotaUsageIncrementer func(t *testing.T) QuotaUsageIncrementer
+		want                  models.Quota
+		wantErr               error
+	}{
+		{
+			name:   "Default quota",
+			client: "jwt:user-1",
+			quotaUsageIncrementer: func(t *testing.T) QuotaUsageIncrementer {
+				qui := mocks.NewQuotaUsageIncrementer(t)
+				qui.
+					On("IncrementQuotaUsage", mock.Anything, "jwt:user-1", day, uint64(1)).
+					Once().
+					Return(uint64(40), nil)
+
+				return qui
+			},
+			want: models.Quota{Limit: 100, Remaining: 60, Reset: reset},
+		},
+		{
+			name:   "Client quota",
+			client: "api_key:importer",
+			quotaUsageIncrementer: func(t *testing.T) QuotaUsageIncrementer {
+				qui := mocks.NewQuotaUsageIncrementer(t)
+				qui.
+					On("IncrementQuotaUsage", mock.Anything, "api_key:importer", day, uint64(1)).
+					Once().
+					Return(uint64(1000), nil)
+
+				return qui
+			},
+			want: models.Quota{Limit: 1000, Remaining: 0, Reset: reset},
+		},
+		{
+			name:   "Client without quota",
+			client: "api_key:unlimited",
+			quotaUsageIncrementer: func(t *testing.T) QuotaUsageIncrementer {
+				return mocks.NewQuotaUsageIncrementer(t)
+			},
+			want: models.Quota{},
+		},
+		{
+			name:   "Quota exceeded",
+			client: "ip:192.0.2.1",
+			quotaUsageIncrementer: func(t *testing.T) QuotaUsageIncrementer {
+				qui := mocks.NewQuotaUsageIncrementer(t)
+				qui.
+					On("IncrementQuotaUsage", mock.Anything, "ip:192.0.2.1", day, uint64(1)).
+					Once().
+					Return(uint64(101), nil)
+
+				return qui
+			},
+			want:    models.Quota{Limit: 100, Remaining: 0, Reset: reset},
+			wantErr: services.ErrQuotaExceeded,
+		},
+		{
+			name:   "Storage failure",
+			client: "jwt:user-1",
+			quotaUsageIncrementer: func(t *testing.T) QuotaUsageIncrementer {
+				qui := mocks.NewQuotaUsageIncrementer(t)
+				qui.
+					On("IncrementQuotaUsage", mock.Anything, "jwt:user-1", day, uint64(1)).
+					Once().
+					Return(uint64(0), errDB)
+
+				return qui
+			},
+			want:    models.Quota{},
+			wantErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := New(discardLogger, cfg, tt.quotaUsageIncrementer(t))
+			s.now = func() time.Time { return now }
+
+			got, err := s.Consume(context.Background(), tt.client)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestService_Consume_Batch(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.October, 20, 15, 30, 0, 0, time.UTC)
+	day := time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC)
+	nextDay := day.Add(24 * time.Hour)
+
+	qui := mocks.NewQuotaUsageIncrementer(t)
+	qui.
+		On("IncrementQuotaUsage", mock.Anything, "jwt:user-1", day, uint64(5)).
+		Once().
+		Return(uint64(5), nil)
+	qui.
+		On("IncrementQuotaUsage", mock.Anything, "jwt:user-1", day, uint64(5)).
+		Once().
+		Return(uint64(10), nil)
+	qui.
+		On("IncrementQuotaUsage", mock.Anything, "jwt:user-1", nextDay, uint64(5)).
+		Once().
+		Return(uint64(5), nil)
+
+	s := New(discardLogger, &config.QuotaConfig{Daily: 7, Batch: 5}, qui)
+	s.now = func() time.Time { return now }
+
+	for i := range 7 {
+		q, err := s.Consume(context.Background(), "jwt:user-1")
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(6-i), q.Remaining)
+	}
+
+	for range 3 {
+		q, err := s.Consume(context.Background(), "jwt:user-1")
+		assert.ErrorIs(t, err, services.ErrQuotaExceeded, "exceeded quota must be checked without database")
+		assert.Equal(t, uint64(0), q.Remaining)
+	}
+
+	s.now = func() time.Time { return now.Add(24 * time.Hour) }
+
+	q, err := s.Consume(context.Background(), "jwt:user-1")
+	assert.NoError(t, err, "quota must be reset next day")
+	assert.Equal(t, uint64(6), q.Remaining)
+}
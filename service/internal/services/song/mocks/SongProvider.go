@@ -42,6 +42,64 @@ func (_m *SongProvider) Song(ctx context.Context, id uint64) (models.Song, error
 	return r0, r1
 }
 
+// SongByISRC provides a mock function with given fields: ctx, isrc
+func (_m *SongProvider) SongByISRC(ctx context.Context, isrc string) (models.Song, error) {
+	ret := _m.Called(ctx, isrc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SongByISRC")
+	}
+
+	var r0 models.Song
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Song, error)); ok {
+		return rf(ctx, isrc)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Song); ok {
+		r0 = rf(ctx, isrc)
+	} else {
+		r0 = ret.Get(0).(models.Song)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, isrc)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongFacets provides a mock function with given fields: ctx, attrs
+func (_m *SongProvider) SongFacets(ctx context.Context, attrs models.Song) (models.Facets, error) {
+	ret := _m.Called(ctx, attrs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SongFacets")
+	}
+
+	var r0 models.Facets
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Song) (models.Facets, error)); ok {
+		return rf(ctx, attrs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Song) models.Facets); ok {
+		r0 = rf(ctx, attrs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.Facets)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Song) error); ok {
+		r1 = rf(ctx, attrs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Songs provides a mock function with given fields: ctx, attrs, p
 func (_m *SongProvider) Songs(ctx context.Context, attrs models.Song, p models.Pagination) (models.Songs, uint64, error) {
 	ret := _m.Called(ctx, attrs, p)
@@ -79,6 +137,66 @@ func (_m *SongProvider) Songs(ctx context.Context, attrs models.Song, p models.P
 	return r0, r1, r2
 }
 
+// SongsByArtists provides a mock function with given fields: ctx, artistIDs, limit
+func (_m *SongProvider) SongsByArtists(ctx context.Context, artistIDs []uint64, limit int) (models.Songs, error) {
+	ret := _m.Called(ctx, artistIDs, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SongsByArtists")
+	}
+
+	var r0 models.Songs
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64, int) (models.Songs, error)); ok {
+		return rf(ctx, artistIDs, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []uint64, int) models.Songs); ok {
+		r0 = rf(ctx, artistIDs, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.Songs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []uint64, int) error); ok {
+		r1 = rf(ctx, artistIDs, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SongsByISWC provides a mock function with given fields: ctx, iswc
+func (_m *SongProvider) SongsByISWC(ctx context.Context, iswc string) (models.Songs, error) {
+	ret := _m.Called(ctx, iswc)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SongsByISWC")
+	}
+
+	var r0 models.Songs
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Songs, error)); ok {
+		return rf(ctx, iswc)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Songs); ok {
+		r0 = rf(ctx, iswc)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(models.Songs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, iswc)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewSongProvider creates a new instance of SongProvider. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewSongProvider(t interface {
@@ -1,19 +1,42 @@
 package song
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"math"
+	"net/http"
 	"strings"
+	"time"
 
+	"go.opentelemetry.io/otel"
+
+	"github.com/sedonn/song-library-service/internal/clients/musicinfo"
 	songrest "github.com/sedonn/song-library-service/internal/controllers/rest/song"
 	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/identifiers"
+	"github.com/sedonn/song-library-service/internal/pkg/links"
 	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/lyrics"
+	"github.com/sedonn/song-library-service/internal/pkg/metrics"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
 	"github.com/sedonn/song-library-service/internal/repositories"
 	"github.com/sedonn/song-library-service/internal/services"
 )
 
+// tracer создает спаны методов сервиса глобальным поставщиком трассировщиков.
+var tracer = otel.Tracer("github.com/sedonn/song-library-service/internal/services/song")
+
+const (
+	// exportPageSize это количество песен, загружаемых за один запрос при экспорте.
+	exportPageSize = 100
+	// exportMaxSongs это максимальное количество песен в экспортируемом плейлисте.
+	exportMaxSongs = 10000
+)
+
 // SongProvider описывает поведение объекта слоя данных, который обеспечивает предоставление данных о песнях.
 //
 //go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=SongProvider
@@ -23,6 +46,14 @@ type SongProvider interface {
 	// Songs выполняет поиск песен по определенным параметрам.
 	// Возвращает песни, общее количество найденных песен без учета пагинации, ошибку.
 	Songs(ctx context.Context, attrs models.Song, p models.Pagination) (models.Songs, uint64, error)
+	// SongFacets возвращает количество найденных песен по каждому жанру и каждой метке.
+	SongFacets(ctx context.Context, attrs models.Song) (models.Facets, error)
+	// SongByISRC возвращает песню с определенным кодом ISRC.
+	SongByISRC(ctx context.Context, isrc string) (models.Song, error)
+	// SongsByISWC возвращает все записи произведения с определенным кодом ISWC.
+	SongsByISWC(ctx context.Context, iswc string) (models.Songs, error)
+	// SongsByArtists возвращает не более limit первых песен каждого из определенных исполнителей.
+	SongsByArtists(ctx context.Context, artistIDs []uint64, limit int) (models.Songs, error)
 }
 
 // SongSaver описывает поведение объекта слоя данных, который обеспечивает сохранение данных песен.
@@ -41,6 +72,34 @@ type SongUpdater interface {
 	UpdateSong(ctx context.Context, s models.Song) (models.Song, error)
 }
 
+// SongTagger описывает поведение объекта слоя данных, который обеспечивает привязку меток к песням.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=SongTagger
+type SongTagger interface {
+	// SetSongTags заменяет метки определенного вида у определенной песни.
+	SetSongTags(ctx context.Context, songID uint64, kind string, names []string) (models.Song, error)
+}
+
+// SongRelator описывает поведение объекта слоя данных, который обеспечивает связи между песнями.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=SongRelator
+type SongRelator interface {
+	// RelatedSongs возвращает все связи определенной песни в обоих направлениях вместе со связанными песнями.
+	RelatedSongs(ctx context.Context, songID uint64) (models.SongRelations, error)
+	// SaveSongRelation сохраняет связь производной песни с оригиналом.
+	SaveSongRelation(ctx context.Context, rel models.SongRelation) (models.SongRelation, error)
+	// DeleteSongRelation удаляет связь производной песни с оригиналом.
+	DeleteSongRelation(ctx context.Context, rel models.SongRelation) (models.SongRelation, error)
+}
+
+// SongMerger описывает поведение объекта слоя данных, который обеспечивает слияние песен-дубликатов.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=SongMerger
+type SongMerger interface {
+	// MergeSongs переносит все ссылки на песню-дубликат на основную песню и удаляет дубликат.
+	MergeSongs(ctx context.Context, id, duplicateID uint64) (models.Song, error)
+}
+
 // SongDeleter описывает поведение объекта слоя данных, который обеспечивает удаление данных песен.
 //
 //go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=SongDeleter
@@ -49,35 +108,111 @@ type SongDeleter interface {
 	DeleteSong(ctx context.Context, id uint64) (uint64, error)
 }
 
+// SongLinkHealthEditor описывает поведение объекта слоя данных, который обеспечивает хранение результатов
+// проверки доступности ссылок песен.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=SongLinkHealthEditor
+type SongLinkHealthEditor interface {
+	// SongsWithStaleLinks возвращает ID и библиотеки не более limit песен, ссылки которых не проверялись
+	// или проверялись до checkedBefore, и проверка которых еще не стоит в очереди.
+	SongsWithStaleLinks(ctx context.Context, checkedBefore time.Time, limit int) (models.Songs, error)
+	// UpdateSongLinkHealth сохраняет результат проверки доступности ссылки определенной песни.
+	UpdateSongLinkHealth(ctx context.Context, id uint64, statusCode int, broken bool) error
+	// SongLinkStats возвращает количество песен со ссылками по статусу ссылки.
+	SongLinkStats(ctx context.Context) (models.LinkStats, error)
+}
+
+// JobSaver описывает поведение объекта слоя данных, который обеспечивает постановку фоновых задач в очередь.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=JobSaver
+type JobSaver interface {
+	// SaveJobs сохраняет новые задачи.
+	SaveJobs(ctx context.Context, jobs models.Jobs) (models.Jobs, error)
+}
+
+// SongInfoProvider описывает поведение внешнего сервиса, который предоставляет информацию о песнях
+// по названиям исполнителя и песни.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=SongInfoProvider
+type SongInfoProvider interface {
+	// SongInfo возвращает дату выхода, текст и ссылку песни определенного исполнителя.
+	SongInfo(ctx context.Context, artist, title string) (models.SongInfo, error)
+}
+
+// LinkChecker описывает поведение объекта, который проверяет доступность ссылок.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=LinkChecker
+type LinkChecker interface {
+	// CheckLink возвращает код статуса ответа по ссылке.
+	CheckLink(ctx context.Context, link string) (int, error)
+}
+
 // Service предоставляет бизнес-логику работы с библиотекой песен.
 type Service struct {
-	log          *slog.Logger
-	songProvider SongProvider
-	songSaver    SongSaver
-	songUpdater  SongUpdater
-	songDeleter  SongDeleter
+	log                  *slog.Logger
+	songProvider         SongProvider
+	songSaver            SongSaver
+	songUpdater          SongUpdater
+	songDeleter          SongDeleter
+	songTagger           SongTagger
+	songRelator          SongRelator
+	songMerger           SongMerger
+	songLinkHealthEditor SongLinkHealthEditor
+	jobSaver             JobSaver
+	songInfoProvider     SongInfoProvider
+	linkChecker          LinkChecker
 }
 
 var _ songrest.SongService = (*Service)(nil)
 
-// New создает новый объект сервиса песен.
-func New(log *slog.Logger, sp SongProvider, ss SongSaver, su SongUpdater, sd SongDeleter) *Service {
+// New создает новый объект сервиса песен. Если sip равен nil, то данные новых песен не дополняются
+// информацией из внешнего сервиса.
+func New(
+	log *slog.Logger,
+	sp SongProvider,
+	ss SongSaver,
+	su SongUpdater,
+	sd SongDeleter,
+	st SongTagger,
+	sr SongRelator,
+	sm SongMerger,
+	slhe SongLinkHealthEditor,
+	js JobSaver,
+	sip SongInfoProvider,
+	lc LinkChecker,
+) *Service {
 	return &Service{
-		log:          log,
-		songProvider: sp,
-		songSaver:    ss,
-		songUpdater:  su,
-		songDeleter:  sd,
+		log:                  log,
+		songProvider:         sp,
+		songSaver:            ss,
+		songUpdater:          su,
+		songDeleter:          sd,
+		songTagger:           st,
+		songRelator:          sr,
+		songMerger:           sm,
+		songLinkHealthEditor: slhe,
+		jobSaver:             js,
+		songInfoProvider:     sip,
+		linkChecker:          lc,
 	}
 }
 
 // GetSongWithCoupletPagination возвращает определенную песню с пагинацией по куплетам.
 // Текст разбивается на куплеты по \n\n символам.
 func (s *Service) GetSongWithCoupletPagination(ctx context.Context, id uint64, p models.Pagination) (models.SongWithCoupletPaginationAPI, error) {
-	log := s.log.With(slog.Uint64("id", id))
+	ctx, span := tracer.Start(ctx, "song.Service.GetSongWithCoupletPagination")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), logger.Trace(ctx))
 
 	log.Info("attempt to get song")
 
+	if err := services.Authorize(ctx, rbac.SongsRead); err != nil {
+		log.Warn("failed to get song", logger.ErrorString(err))
+
+		return models.SongWithCoupletPaginationAPI{}, err
+	}
+
 	song, err := s.songProvider.Song(ctx, id)
 	if err != nil {
 		if errors.Is(err, repositories.ErrSongNotFound) {
@@ -109,20 +244,96 @@ func (s *Service) GetSongWithCoupletPagination(ctx context.Context, id uint64, p
 	}, nil
 }
 
+// GetSong возвращает определенную песню с полным текстом.
+func (s *Service) GetSong(ctx context.Context, id uint64) (models.SongAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.GetSong")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), logger.Trace(ctx))
+
+	log.Info("attempt to get song")
+
+	if err := services.Authorize(ctx, rbac.SongsRead); err != nil {
+		log.Warn("failed to get song", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	song, err := s.songProvider.Song(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSongNotFound) {
+			log.Warn("failed to get song", logger.ErrorString(err))
+
+			return models.SongAPI{}, services.ErrSongNotFound
+		}
+
+		log.Error("failed to get song", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	log.Info("success to get song")
+
+	return song.API(), nil
+}
+
+// GetArtistsSongs возвращает не более limit первых песен каждого из определенных исполнителей одним запросом.
+// Исполнители без песен в результат не попадают.
+func (s *Service) GetArtistsSongs(ctx context.Context, artistIDs []uint64, limit int) (map[uint64][]models.SongAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.GetArtistsSongs")
+	defer span.End()
+
+	log := s.log.With(slog.Int("artists", len(artistIDs)), slog.Int("limit", limit), logger.Trace(ctx))
+
+	log.Info("attempt to get artists songs")
+
+	if err := services.Authorize(ctx, rbac.SongsRead); err != nil {
+		log.Warn("failed to get artists songs", logger.ErrorString(err))
+
+		return nil, err
+	}
+
+	songs, err := s.songProvider.SongsByArtists(ctx, artistIDs, limit)
+	if err != nil {
+		log.Error("failed to get artists songs", logger.ErrorString(err))
+
+		return nil, err
+	}
+
+	artistsSongs := make(map[uint64][]models.SongAPI, len(artistIDs))
+	for _, song := range songs {
+		artistsSongs[song.ArtistID] = append(artistsSongs[song.ArtistID], song.API())
+	}
+
+	log.Info("success to get artists songs", slog.Int("count", len(songs)))
+
+	return artistsSongs, nil
+}
+
 // SearchSongs выполняет поиск песен по определенным параметрам.
-func (s *Service) SearchSongs(ctx context.Context, attrs models.Song, p models.Pagination) (models.SongsAPI, error) {
-	s.log.Info("attempt to search songs")
+// Количество найденных песен по жанрам и меткам подсчитывается только при withFacets.
+func (s *Service) SearchSongs(ctx context.Context, attrs models.Song, p models.Pagination, withFacets bool) (models.SongsAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.SearchSongs")
+	defer span.End()
+
+	log := s.log.With(logger.Trace(ctx))
+
+	log.Info("attempt to search songs")
+
+	if err := services.Authorize(ctx, rbac.SongsRead); err != nil {
+		log.Warn("failed to search songs", logger.ErrorString(err))
+
+		return models.SongsAPI{}, err
+	}
 
 	songs, total, err := s.songProvider.Songs(ctx, attrs, p)
 	if err != nil {
-		s.log.Error("failed to search songs", logger.ErrorString(err))
+		log.Error("failed to search songs", logger.ErrorString(err))
 
 		return models.SongsAPI{}, err
 	}
 
-	s.log.Info("success to search songs", slog.Uint64("total", total))
-
-	return models.SongsAPI{
+	result := models.SongsAPI{
 		Songs: songs.API(),
 		Pagination: models.PaginationMetadataAPI{
 			CurrentPageNumber: p.PageNumber,
@@ -130,39 +341,427 @@ func (s *Service) SearchSongs(ctx context.Context, attrs models.Song, p models.P
 			RecordCount:       total,
 			PageSize:          p.PageSize,
 		},
-	}, nil
+	}
+
+	if withFacets {
+		facets, err := s.songProvider.SongFacets(ctx, attrs)
+		if err != nil {
+			log.Error("failed to search songs", logger.ErrorString(err))
+
+			return models.SongsAPI{}, err
+		}
+
+		facetsAPI := facets.API()
+		result.Facets = &facetsAPI
+	}
+
+	metrics.SearchResults.WithLabelValues("songs").Observe(float64(total))
+
+	log.Info("success to search songs", slog.Uint64("total", total))
+
+	return result, nil
+}
+
+// ExportSongs экспортирует найденные по определенным параметрам песни как плейлист определенного формата.
+// Экспортируются не более exportMaxSongs первых песен.
+func (s *Service) ExportSongs(ctx context.Context, attrs models.Song, f playlistfmt.Format) ([]byte, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.ExportSongs")
+	defer span.End()
+
+	log := s.log.With(slog.String("format", string(f)), logger.Trace(ctx))
+
+	log.Info("attempt to export songs")
+
+	if err := services.Authorize(ctx, rbac.SongsRead); err != nil {
+		log.Warn("failed to export songs", logger.ErrorString(err))
+
+		return nil, err
+	}
+
+	var songs models.Songs
+	for p := (models.Pagination{PageNumber: 1, PageSize: exportPageSize}); len(songs) < exportMaxSongs; p.PageNumber++ {
+		page, total, err := s.songProvider.Songs(ctx, attrs, p)
+		if err != nil {
+			log.Error("failed to export songs", logger.ErrorString(err))
+
+			return nil, err
+		}
+
+		songs = append(songs, page...)
+		if len(page) < exportPageSize || uint64(len(songs)) >= total {
+			break
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := playlistfmt.Encode(&buf, f, playlistfmt.Playlist{Tracks: songs.Tracks()}); err != nil {
+		log.Error("failed to export songs", logger.ErrorString(err))
+
+		return nil, err
+	}
+
+	log.Info("success to export songs", slog.Int("count", len(songs)))
+
+	return buf.Bytes(), nil
 }
 
 // CreateSong создает новую песню.
 func (s *Service) CreateSong(ctx context.Context, song models.Song) (models.SongAPI, error) {
-	log := s.log.With(slog.String("name", song.Name))
+	ctx, span := tracer.Start(ctx, "song.Service.CreateSong")
+	defer span.End()
+
+	log := s.log.With(slog.String("name", song.Name), logger.Actor(ctx), logger.Trace(ctx))
 
 	log.Info("attempt to create song")
 
+	if err := services.Authorize(ctx, rbac.SongsWrite); err != nil {
+		log.Warn("failed to create song", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	if err := normalizeSongIdentifiers(&song); err != nil {
+		log.Warn("failed to create song", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	if err := normalizeSongLink(&song); err != nil {
+		log.Warn("failed to create song", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	AnalyzeLyrics(&song)
+
 	song, err := s.songSaver.SaveSong(ctx, song)
 	if err != nil {
-		if errors.Is(err, repositories.ErrArtistNotFound) {
+		switch {
+		case errors.Is(err, repositories.ErrArtistNotFound):
 			log.Warn("failed to create song", logger.ErrorString(err))
-
 			return models.SongAPI{}, services.ErrArtistNotFound
-		}
 
-		log.Error("failed to create song", logger.ErrorString(err))
+		case errors.Is(err, repositories.ErrIdentifierExists):
+			log.Warn("failed to create song", logger.ErrorString(err))
+			return models.SongAPI{}, services.ErrIdentifierExists
 
-		return models.SongAPI{}, err
+		case errors.Is(err, repositories.ErrSongLinkExists):
+			log.Warn("failed to create song", logger.ErrorString(err))
+			return models.SongAPI{}, services.ErrSongLinkExists
+
+		default:
+			log.Error("failed to create song", logger.ErrorString(err))
+			return models.SongAPI{}, err
+		}
 	}
 
+	s.enqueueJobs(ctx, log, s.songJobs(song))
+
+	metrics.SongsCreated.Inc()
+
 	log.Info("success to create song", slog.Uint64("id", song.ID))
 
 	return song.API(), nil
 }
 
+// songJobs возвращает фоновые задачи новой песни: заполнение незаполненных данных из внешнего сервиса
+// и проверку ссылки.
+func (s *Service) songJobs(song models.Song) models.Jobs {
+	var jobs models.Jobs
+	if s.songInfoProvider != nil && needsEnrichment(song) {
+		jobs = append(jobs, models.Job{Type: models.JobTypeEnrichSong, SongID: song.ID})
+	}
+	if song.Link != "" {
+		jobs = append(jobs, models.Job{Type: models.JobTypeValidateLink, SongID: song.ID})
+	}
+
+	return jobs
+}
+
+// enqueueJobs ставит фоновые задачи в очередь. Данные песни к этому моменту уже сохранены,
+// поэтому ошибка постановки задач только логируется.
+func (s *Service) enqueueJobs(ctx context.Context, log *slog.Logger, jobs models.Jobs) {
+	if len(jobs) == 0 {
+		return
+	}
+
+	if _, err := s.jobSaver.SaveJobs(ctx, jobs); err != nil {
+		log.Error("failed to enqueue jobs", logger.ErrorString(err))
+	}
+}
+
+// needsEnrichment проверяет, есть ли у песни незаполненные данные, которые может предоставить внешний сервис.
+func needsEnrichment(song models.Song) bool {
+	return song.ReleaseDate.IsZero() || song.Text == "" || song.Link == ""
+}
+
+// EnrichSong заполняет незаполненные дату выхода, текст и ссылку определенной песни информацией из внешнего сервиса.
+// Если ссылка заполнена, то ставит в очередь ее проверку. Удаленная песня и песня, неизвестная внешнему сервису,
+// не считаются ошибкой.
+func (s *Service) EnrichSong(ctx context.Context, id uint64) error {
+	ctx, span := tracer.Start(ctx, "song.Service.EnrichSong")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), logger.Trace(ctx))
+
+	log.Info("attempt to enrich song")
+
+	song, err := s.songProvider.Song(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSongNotFound) {
+			log.Warn("failed to enrich song", logger.ErrorString(err))
+			return nil
+		}
+
+		log.Error("failed to enrich song", logger.ErrorString(err))
+		return err
+	}
+
+	if s.songInfoProvider == nil || !needsEnrichment(song) {
+		log.Info("success to enrich song, nothing to enrich")
+		return nil
+	}
+
+	info, err := s.songInfoProvider.SongInfo(ctx, song.Artist.Name, song.Name)
+	if err != nil {
+		if errors.Is(err, musicinfo.ErrSongInfoNotFound) {
+			log.Info("success to enrich song, song info not found")
+			return nil
+		}
+
+		log.Warn("failed to enrich song", logger.ErrorString(err))
+		return err
+	}
+
+	update := models.Song{ID: song.ID}
+	if song.ReleaseDate.IsZero() {
+		update.ReleaseDate = info.ReleaseDate
+	}
+	if song.Text == "" && info.Text != "" {
+		update.Text = info.Text
+		AnalyzeLyrics(&update)
+	}
+	if song.Link == "" && info.Link != "" {
+		update.Link = info.Link
+		if err := normalizeSongLink(&update); err != nil {
+			log.Warn("found song link is skipped", logger.ErrorString(err))
+			update.Link = ""
+		}
+	}
+
+	if isEmptyEnrichment(update) {
+		log.Info("success to enrich song, nothing to enrich")
+		return nil
+	}
+
+	_, err = s.songUpdater.UpdateSong(ctx, update)
+	if errors.Is(err, repositories.ErrSongLinkExists) {
+		log.Warn("found song link is skipped", logger.ErrorString(err))
+
+		update.Link, update.LinkProvider, update.LinkMediaID = "", "", ""
+		if isEmptyEnrichment(update) {
+			log.Info("success to enrich song, nothing to enrich")
+			return nil
+		}
+
+		_, err = s.songUpdater.UpdateSong(ctx, update)
+	}
+	if err != nil {
+		if errors.Is(err, repositories.ErrSongNotFound) {
+			log.Warn("failed to enrich song", logger.ErrorString(err))
+			return nil
+		}
+
+		log.Error("failed to enrich song", logger.ErrorString(err))
+		return err
+	}
+
+	if update.Link != "" {
+		s.enqueueJobs(ctx, log, models.Jobs{{Type: models.JobTypeValidateLink, SongID: song.ID}})
+	}
+
+	log.Info("success to enrich song")
+
+	return nil
+}
+
+// isEmptyEnrichment проверяет, что внешний сервис не дополнил ни одного атрибута песни.
+func isEmptyEnrichment(update models.Song) bool {
+	return update.ReleaseDate.IsZero() && update.Text == "" && update.Link == ""
+}
+
+// ValidateSongLink проверяет доступность ссылки определенной песни и сохраняет результат проверки.
+// Недоступность ссылки не считается ошибкой: она учитывается в количестве неудачных проверок подряд.
+// Удаленная песня и песня без ссылки также не считаются ошибкой.
+func (s *Service) ValidateSongLink(ctx context.Context, id uint64) error {
+	ctx, span := tracer.Start(ctx, "song.Service.ValidateSongLink")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), logger.Trace(ctx))
+
+	log.Info("attempt to validate song link")
+
+	song, err := s.songProvider.Song(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSongNotFound) {
+			log.Warn("failed to validate song link", logger.ErrorString(err))
+			return nil
+		}
+
+		log.Error("failed to validate song link", logger.ErrorString(err))
+		return err
+	}
+
+	if song.Link == "" {
+		log.Info("success to validate song link, song has no link")
+		return nil
+	}
+
+	code, err := s.linkChecker.CheckLink(ctx, song.Link)
+	if err != nil && ctx.Err() != nil {
+		log.Warn("failed to validate song link", logger.ErrorString(err))
+		return err
+	}
+
+	broken := err != nil || code >= http.StatusBadRequest
+	switch {
+	case err != nil:
+		log.Warn("song link is unreachable", logger.ErrorString(err))
+	case broken:
+		log.Warn("song link is broken", slog.Int("status", code))
+	}
+
+	if err := s.songLinkHealthEditor.UpdateSongLinkHealth(ctx, id, code, broken); err != nil {
+		if errors.Is(err, repositories.ErrSongNotFound) {
+			log.Warn("failed to validate song link", logger.ErrorString(err))
+			return nil
+		}
+
+		log.Error("failed to validate song link", logger.ErrorString(err))
+		return err
+	}
+
+	log.Info("success to validate song link", slog.Int("status", code), slog.Bool("broken", broken))
+
+	return nil
+}
+
+// ScheduleLinkChecks ставит в очередь проверку не более limit ссылок, которые не проверялись
+// или проверялись раньше, чем recheckAfter назад.
+func (s *Service) ScheduleLinkChecks(ctx context.Context, recheckAfter time.Duration, limit int) error {
+	ctx, span := tracer.Start(ctx, "song.Service.ScheduleLinkChecks")
+	defer span.End()
+
+	log := s.log.With(logger.Trace(ctx))
+
+	log.Info("attempt to schedule link checks")
+
+	songs, err := s.songLinkHealthEditor.SongsWithStaleLinks(ctx, time.Now().Add(-recheckAfter), limit)
+	if err != nil {
+		log.Error("failed to schedule link checks", logger.ErrorString(err))
+
+		return err
+	}
+
+	if len(songs) == 0 {
+		log.Info("success to schedule link checks", slog.Int("count", 0))
+
+		return nil
+	}
+
+	// Проверка ставится в очередь в библиотеке песни, так как планировщик работает со всеми библиотеками.
+	jobs := make(models.Jobs, len(songs))
+	for i, v := range songs {
+		jobs[i] = models.Job{TenantID: v.TenantID, Type: models.JobTypeValidateLink, SongID: v.ID}
+	}
+
+	if _, err := s.jobSaver.SaveJobs(ctx, jobs); err != nil {
+		log.Error("failed to schedule link checks", logger.ErrorString(err))
+
+		return err
+	}
+
+	log.Info("success to schedule link checks", slog.Int("count", len(jobs)))
+
+	return nil
+}
+
+// GetLinkReport возвращает количество песен со ссылками по статусу ссылки и песни с недоступными ссылками.
+func (s *Service) GetLinkReport(ctx context.Context, p models.Pagination) (models.LinkReportAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.GetLinkReport")
+	defer span.End()
+
+	log := s.log.With(logger.Trace(ctx))
+
+	log.Info("attempt to get link report")
+
+	if err := services.Authorize(ctx, rbac.SongsRead); err != nil {
+		log.Warn("failed to get link report", logger.ErrorString(err))
+
+		return models.LinkReportAPI{}, err
+	}
+
+	stats, err := s.songLinkHealthEditor.SongLinkStats(ctx)
+	if err != nil {
+		log.Error("failed to get link report", logger.ErrorString(err))
+
+		return models.LinkReportAPI{}, err
+	}
+
+	songs, total, err := s.songProvider.Songs(ctx, models.Song{LinkHealth: models.LinkHealth{Status: models.LinkStatusBroken}}, p)
+	if err != nil {
+		log.Error("failed to get link report", logger.ErrorString(err))
+
+		return models.LinkReportAPI{}, err
+	}
+
+	log.Info("success to get link report", slog.Uint64("broken", stats.Broken))
+
+	return models.LinkReportAPI{
+		Stats:       stats.API(),
+		BrokenSongs: songs.API(),
+		Pagination: models.PaginationMetadataAPI{
+			CurrentPageNumber: p.PageNumber,
+			PageCount:         uint64(math.Ceil(float64(total) / float64(p.PageSize))),
+			RecordCount:       total,
+			PageSize:          p.PageSize,
+		},
+	}, nil
+}
+
 // ChangeSong обновляет данные определенной песни.
 func (s *Service) ChangeSong(ctx context.Context, song models.Song) (models.SongAPI, error) {
-	log := s.log.With(slog.Uint64("id", song.ID))
+	ctx, span := tracer.Start(ctx, "song.Service.ChangeSong")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", song.ID), logger.Actor(ctx), logger.Trace(ctx))
 
 	log.Info("attempt to change song")
 
+	if err := services.Authorize(ctx, rbac.SongsWrite); err != nil {
+		log.Warn("failed to change song", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	if err := normalizeSongIdentifiers(&song); err != nil {
+		log.Warn("failed to change song", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	if err := normalizeSongLink(&song); err != nil {
+		log.Warn("failed to change song", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	if song.Text != "" {
+		AnalyzeLyrics(&song)
+	}
+
+	linkChanged := song.Link != ""
+
 	song, err := s.songUpdater.UpdateSong(ctx, song)
 	if err != nil {
 		switch {
@@ -174,23 +773,121 @@ func (s *Service) ChangeSong(ctx context.Context, song models.Song) (models.Song
 			log.Warn("failed to change song", logger.ErrorString(err))
 			return models.SongAPI{}, services.ErrArtistNotFound
 
+		case errors.Is(err, repositories.ErrIdentifierExists):
+			log.Warn("failed to change song", logger.ErrorString(err))
+			return models.SongAPI{}, services.ErrIdentifierExists
+
+		case errors.Is(err, repositories.ErrSongLinkExists):
+			log.Warn("failed to change song", logger.ErrorString(err))
+			return models.SongAPI{}, services.ErrSongLinkExists
+
 		default:
 			log.Error("failed to change song", logger.ErrorString(err))
 			return models.SongAPI{}, err
 		}
 	}
 
+	if linkChanged {
+		s.enqueueJobs(ctx, log, models.Jobs{{Type: models.JobTypeValidateLink, SongID: song.ID}})
+	}
+
 	log.Info("success to change song")
 
 	return song.API(), nil
 }
 
+// GetSongByISRC возвращает песню с определенным кодом ISRC. Код может содержать разделители.
+func (s *Service) GetSongByISRC(ctx context.Context, isrc string) (models.SongAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.GetSongByISRC")
+	defer span.End()
+
+	log := s.log.With(slog.String("isrc", isrc), logger.Trace(ctx))
+
+	log.Info("attempt to get song by isrc")
+
+	if err := services.Authorize(ctx, rbac.SongsRead); err != nil {
+		log.Warn("failed to get song by isrc", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	isrc, err := identifiers.NormalizeISRC(isrc)
+	if err != nil {
+		log.Warn("failed to get song by isrc", logger.ErrorString(err))
+
+		return models.SongAPI{}, fmt.Errorf("%w: isrc: %w", services.ErrInvalidIdentifier, err)
+	}
+
+	song, err := s.songProvider.SongByISRC(ctx, isrc)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSongNotFound) {
+			log.Warn("failed to get song by isrc", logger.ErrorString(err))
+
+			return models.SongAPI{}, services.ErrSongNotFound
+		}
+
+		log.Error("failed to get song by isrc", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	log.Info("success to get song by isrc", slog.Uint64("id", song.ID))
+
+	return song.API(), nil
+}
+
+// GetSongsByISWC возвращает все записи произведения с определенным кодом ISWC. Код может содержать разделители.
+func (s *Service) GetSongsByISWC(ctx context.Context, iswc string) (models.WorkSongsAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.GetSongsByISWC")
+	defer span.End()
+
+	log := s.log.With(slog.String("iswc", iswc), logger.Trace(ctx))
+
+	log.Info("attempt to get songs by iswc")
+
+	if err := services.Authorize(ctx, rbac.SongsRead); err != nil {
+		log.Warn("failed to get songs by iswc", logger.ErrorString(err))
+
+		return models.WorkSongsAPI{}, err
+	}
+
+	iswc, err := identifiers.NormalizeISWC(iswc)
+	if err != nil {
+		log.Warn("failed to get songs by iswc", logger.ErrorString(err))
+
+		return models.WorkSongsAPI{}, fmt.Errorf("%w: iswc: %w", services.ErrInvalidIdentifier, err)
+	}
+
+	songs, err := s.songProvider.SongsByISWC(ctx, iswc)
+	if err != nil {
+		log.Error("failed to get songs by iswc", logger.ErrorString(err))
+
+		return models.WorkSongsAPI{}, err
+	}
+
+	log.Info("success to get songs by iswc", slog.Int("count", len(songs)))
+
+	return models.WorkSongsAPI{
+		ISWC:  iswc,
+		Songs: songs.API(),
+	}, nil
+}
+
 // RemoveSong удаляет определенную песню.
 func (s *Service) RemoveSong(ctx context.Context, id uint64) (models.SongIDAPI, error) {
-	log := s.log.With(slog.Uint64("id", id))
+	ctx, span := tracer.Start(ctx, "song.Service.RemoveSong")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), logger.Actor(ctx), logger.Trace(ctx))
 
 	log.Info("attempt to remove song")
 
+	if err := services.Authorize(ctx, rbac.SongsDelete); err != nil {
+		log.Warn("failed to remove song", logger.ErrorString(err))
+
+		return models.SongIDAPI{}, err
+	}
+
 	id, err := s.songDeleter.DeleteSong(ctx, id)
 	if err != nil {
 		if errors.Is(err, repositories.ErrSongNotFound) {
@@ -204,7 +901,279 @@ func (s *Service) RemoveSong(ctx context.Context, id uint64) (models.SongIDAPI,
 		return models.SongIDAPI{}, err
 	}
 
+	metrics.SongsDeleted.Inc()
+
 	log.Info("success to remove song")
 
 	return models.SongIDAPI{ID: id}, nil
 }
+
+// ChangeSongTags заменяет жанры или свободные метки определенной песни.
+func (s *Service) ChangeSongTags(ctx context.Context, id uint64, kind string, names []string) (models.SongAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.ChangeSongTags")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), slog.String("kind", kind), logger.Actor(ctx), logger.Trace(ctx))
+
+	log.Info("attempt to change song tags")
+
+	if err := services.Authorize(ctx, rbac.SongsWrite); err != nil {
+		log.Warn("failed to change song tags", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	song, err := s.songTagger.SetSongTags(ctx, id, kind, names)
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrSongNotFound):
+			log.Warn("failed to change song tags", logger.ErrorString(err))
+			return models.SongAPI{}, services.ErrSongNotFound
+
+		case errors.Is(err, repositories.ErrTagNotFound):
+			log.Warn("failed to change song tags", logger.ErrorString(err))
+			if kind == models.TagKindGenre {
+				return models.SongAPI{}, services.ErrGenreNotFound
+			}
+			return models.SongAPI{}, services.ErrTagNotFound
+
+		default:
+			log.Error("failed to change song tags", logger.ErrorString(err))
+			return models.SongAPI{}, err
+		}
+	}
+
+	log.Info("success to change song tags")
+
+	return song.API(), nil
+}
+
+// GetRelatedSongs возвращает песни, связанные с определенной песней, сгруппированные по типу и направлению связи.
+func (s *Service) GetRelatedSongs(ctx context.Context, id uint64) (models.RelatedSongsAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.GetRelatedSongs")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), logger.Trace(ctx))
+
+	log.Info("attempt to get related songs")
+
+	if err := services.Authorize(ctx, rbac.SongsRead); err != nil {
+		log.Warn("failed to get related songs", logger.ErrorString(err))
+
+		return models.RelatedSongsAPI{}, err
+	}
+
+	relations, err := s.songRelator.RelatedSongs(ctx, id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrSongNotFound) {
+			log.Warn("failed to get related songs", logger.ErrorString(err))
+
+			return models.RelatedSongsAPI{}, services.ErrSongNotFound
+		}
+
+		log.Error("failed to get related songs", logger.ErrorString(err))
+
+		return models.RelatedSongsAPI{}, err
+	}
+
+	log.Info("success to get related songs", slog.Int("count", len(relations)))
+
+	return relations.RelatedAPI(id), nil
+}
+
+// LinkSongs связывает производную песню с оригиналом.
+// Песня не может прямо или через другие песни оказаться оригиналом самой себя.
+func (s *Service) LinkSongs(ctx context.Context, rel models.SongRelation) (models.SongRelationAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.LinkSongs")
+	defer span.End()
+
+	log := s.log.With(
+		slog.Uint64("id", rel.SongID),
+		slog.Uint64("original_id", rel.OriginalID),
+		slog.String("type", rel.Type),
+		logger.Actor(ctx),
+		logger.Trace(ctx),
+	)
+
+	log.Info("attempt to link songs")
+
+	if err := services.Authorize(ctx, rbac.SongsWrite); err != nil {
+		log.Warn("failed to link songs", logger.ErrorString(err))
+
+		return models.SongRelationAPI{}, err
+	}
+
+	if rel.SongID == rel.OriginalID {
+		log.Warn("failed to link songs", logger.ErrorString(services.ErrSongRelationCycle))
+
+		return models.SongRelationAPI{}, services.ErrSongRelationCycle
+	}
+
+	rel, err := s.songRelator.SaveSongRelation(ctx, rel)
+	if err != nil {
+		if serviceErr := songRelationError(err); serviceErr != nil {
+			log.Warn("failed to link songs", logger.ErrorString(err))
+
+			return models.SongRelationAPI{}, serviceErr
+		}
+
+		log.Error("failed to link songs", logger.ErrorString(err))
+
+		return models.SongRelationAPI{}, err
+	}
+
+	log.Info("success to link songs")
+
+	return rel.API(), nil
+}
+
+// UnlinkSongs удаляет связь производной песни с оригиналом.
+func (s *Service) UnlinkSongs(ctx context.Context, rel models.SongRelation) (models.SongRelationAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.UnlinkSongs")
+	defer span.End()
+
+	log := s.log.With(
+		slog.Uint64("id", rel.SongID),
+		slog.Uint64("original_id", rel.OriginalID),
+		slog.String("type", rel.Type),
+		logger.Actor(ctx),
+		logger.Trace(ctx),
+	)
+
+	log.Info("attempt to unlink songs")
+
+	if err := services.Authorize(ctx, rbac.SongsWrite); err != nil {
+		log.Warn("failed to unlink songs", logger.ErrorString(err))
+
+		return models.SongRelationAPI{}, err
+	}
+
+	rel, err := s.songRelator.DeleteSongRelation(ctx, rel)
+	if err != nil {
+		if serviceErr := songRelationError(err); serviceErr != nil {
+			log.Warn("failed to unlink songs", logger.ErrorString(err))
+
+			return models.SongRelationAPI{}, serviceErr
+		}
+
+		log.Error("failed to unlink songs", logger.ErrorString(err))
+
+		return models.SongRelationAPI{}, err
+	}
+
+	log.Info("success to unlink songs")
+
+	return rel.API(), nil
+}
+
+// MergeSongs сливает песню-дубликат с определенной песней. Участники, метки, места в альбомах и плейлистах
+// и связи дубликата переносятся на песню, после чего дубликат удаляется.
+func (s *Service) MergeSongs(ctx context.Context, id, duplicateID uint64) (models.SongAPI, error) {
+	ctx, span := tracer.Start(ctx, "song.Service.MergeSongs")
+	defer span.End()
+
+	log := s.log.With(slog.Uint64("id", id), slog.Uint64("duplicate_id", duplicateID), logger.Actor(ctx), logger.Trace(ctx))
+
+	log.Info("attempt to merge songs")
+
+	if err := services.Authorize(ctx, rbac.SongsDelete); err != nil {
+		log.Warn("failed to merge songs", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	if id == duplicateID {
+		log.Warn("failed to merge songs", logger.ErrorString(services.ErrMergeIntoItself))
+
+		return models.SongAPI{}, services.ErrMergeIntoItself
+	}
+
+	song, err := s.songMerger.MergeSongs(ctx, id, duplicateID)
+	if err != nil {
+		if serviceErr := songRelationError(err); serviceErr != nil {
+			log.Warn("failed to merge songs", logger.ErrorString(err))
+
+			return models.SongAPI{}, serviceErr
+		}
+
+		log.Error("failed to merge songs", logger.ErrorString(err))
+
+		return models.SongAPI{}, err
+	}
+
+	metrics.SongsDeleted.Inc()
+
+	log.Info("success to merge songs")
+
+	return song.API(), nil
+}
+
+// songRelationError преобразует ошибки слоя данных связей между песнями в ошибки бизнес-логики.
+// Возвращает nil, если ошибка не является ожидаемой.
+func songRelationError(err error) error {
+	switch {
+	case errors.Is(err, repositories.ErrSongNotFound):
+		return services.ErrSongNotFound
+
+	case errors.Is(err, repositories.ErrSongRelationNotFound):
+		return services.ErrSongRelationNotFound
+
+	case errors.Is(err, repositories.ErrSongRelationExists):
+		return services.ErrSongRelationExists
+
+	case errors.Is(err, repositories.ErrSongRelationCycle):
+		return services.ErrSongRelationCycle
+
+	default:
+		return nil
+	}
+}
+
+// AnalyzeLyrics определяет язык текста песни и подсчитывает его статистику.
+func AnalyzeLyrics(song *models.Song) {
+	stats := lyrics.Analyze(song.Text)
+
+	song.Language = lyrics.DetectLanguage(song.Text)
+	song.LyricsStats = models.LyricsStats{
+		LineCount:       stats.LineCount,
+		CoupletCount:    stats.CoupletCount,
+		WordCount:       stats.WordCount,
+		UniqueWordRatio: stats.UniqueWordRatio,
+		ReadingTime:     stats.ReadingTime,
+	}
+}
+
+// normalizeSongLink приводит ссылку песни к каноническому виду и заполняет музыкальный сервис и идентификатор записи.
+// Возвращает ошибку ErrInvalidSongLink, если ссылка некорректна или имеет неподдерживаемую схему.
+func normalizeSongLink(song *models.Song) error {
+	if song.Link == "" {
+		return nil
+	}
+
+	l, err := links.Normalize(song.Link)
+	if err != nil {
+		return fmt.Errorf("%w: %w", services.ErrInvalidSongLink, err)
+	}
+	song.Link, song.LinkProvider, song.LinkMediaID = l.URL, l.Provider, l.MediaID
+
+	return nil
+}
+
+// normalizeSongIdentifiers приводит заданные коды ISRC и ISWC песни к каноническому виду.
+// Возвращает ошибку ErrInvalidIdentifier, если какой-либо код не соответствует формату или контрольной сумме.
+func normalizeSongIdentifiers(song *models.Song) error {
+	var err error
+	if song.ISRC != "" {
+		if song.ISRC, err = identifiers.NormalizeISRC(song.ISRC); err != nil {
+			return fmt.Errorf("%w: isrc: %w", services.ErrInvalidIdentifier, err)
+		}
+	}
+
+	if song.ISWC != "" {
+		if song.ISWC, err = identifiers.NormalizeISWC(song.ISWC); err != nil {
+			return fmt.Errorf("%w: iswc: %w", services.ErrInvalidIdentifier, err)
+		}
+	}
+
+	return nil
+}
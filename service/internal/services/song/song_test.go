@@ -1,14 +1,25 @@
 package song
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"log/slog"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
+	"github.com/sedonn/song-library-service/internal/clients/musicinfo"
 	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/links"
 	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/playlistfmt"
 	"github.com/sedonn/song-library-service/internal/repositories"
 	"github.com/sedonn/song-library-service/internal/services"
 	"github.com/sedonn/song-library-service/internal/services/song/mocks"
@@ -22,10 +33,19 @@ var (
 	expectedSongID                   uint64 = 1
 	expectedSongCoupletCount         uint64 = 1
 	expectedSong                            = models.Song{
-		ID:   expectedSongID,
-		Text: "one couplet",
+		ID:       expectedSongID,
+		Text:     "one couplet",
+		Language: "en",
+		LyricsStats: models.LyricsStats{
+			LineCount:       1,
+			CoupletCount:    1,
+			WordCount:       2,
+			UniqueWordRatio: 1,
+			ReadingTime:     1,
+		},
 	}
 	expectedSongIDAPI = models.SongIDAPI{ID: expectedSongID}
+	errUnexpected     = errors.New("unexpected error")
 )
 
 func TestService_GetSongWithCoupletPagination(t *testing.T) {
@@ -60,7 +80,8 @@ func TestService_GetSongWithCoupletPagination(t *testing.T) {
 				}(),
 			},
 			args: args{
-				id: expectedSongID,
+				ctx: context.Background(),
+				id:  expectedSongID,
 				p: models.Pagination{
 					PageNumber: defaultPageNumber,
 				},
@@ -89,7 +110,8 @@ func TestService_GetSongWithCoupletPagination(t *testing.T) {
 				}(),
 			},
 			args: args{
-				id: expectedSongID,
+				ctx: context.Background(),
+				id:  expectedSongID,
 				p: models.Pagination{
 					PageNumber: defaultPageNumber,
 				},
@@ -111,7 +133,8 @@ func TestService_GetSongWithCoupletPagination(t *testing.T) {
 				}(),
 			},
 			args: args{
-				id: expectedSongID,
+				ctx: context.Background(),
+				id:  expectedSongID,
 				p: models.Pagination{
 					PageNumber: expectedSongOutOfRangePageNumber,
 				},
@@ -168,7 +191,8 @@ func TestSongLibrary_CreateSong(t *testing.T) {
 				}(),
 			},
 			args: args{
-				s: expectedSong,
+				ctx: context.Background(),
+				s:   expectedSong,
 			},
 			want: expectedSong.API(),
 		},
@@ -186,10 +210,74 @@ func TestSongLibrary_CreateSong(t *testing.T) {
 				}(),
 			},
 			args: args{
-				s: expectedSong,
+				ctx: context.Background(),
+				s:   expectedSong,
 			},
 			wantErr: services.ErrArtistNotFound,
 		},
+		{
+			name: "CreateSong error invalid isrc",
+			fields: fields{
+				songSaver: mocks.NewSongSaver(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				s:   models.Song{Text: "one couplet", ISRC: "US-RC1-76"},
+			},
+			wantErr: services.ErrInvalidIdentifier,
+		},
+		{
+			name: "CreateSong error isrc exists",
+			fields: fields{
+				songSaver: func() SongSaver {
+					ss := mocks.NewSongSaver(t)
+					ss.
+						On("SaveSong", mock.Anything, mock.MatchedBy(func(s models.Song) bool { return s.ISRC == "USRC17607839" })).
+						Once().
+						Return(models.Song{}, repositories.ErrIdentifierExists)
+
+					return ss
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				s:   models.Song{Text: "one couplet", ISRC: "us-rc1-76-07839"},
+			},
+			wantErr: services.ErrIdentifierExists,
+		},
+		{
+			name: "CreateSong error unsupported link scheme",
+			fields: fields{
+				songSaver: mocks.NewSongSaver(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				s:   models.Song{Text: "one couplet", Link: "ftp://example.com/song.mp3"},
+			},
+			wantErr: services.ErrInvalidSongLink,
+		},
+		{
+			name: "CreateSong error link exists",
+			fields: fields{
+				songSaver: func() SongSaver {
+					ss := mocks.NewSongSaver(t)
+					ss.
+						On("SaveSong", mock.Anything, mock.MatchedBy(func(s models.Song) bool {
+							return s.Link == "https://www.youtube.com/watch?v=Xsp3_a-PMTw" &&
+								s.LinkProvider == links.ProviderYouTube && s.LinkMediaID == "Xsp3_a-PMTw"
+						})).
+						Once().
+						Return(models.Song{}, repositories.ErrSongLinkExists)
+
+					return ss
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				s:   models.Song{Text: "one couplet", Link: "https://youtu.be/Xsp3_a-PMTw?si=abc"},
+			},
+			wantErr: services.ErrSongLinkExists,
+		},
 	}
 
 	for _, tt := range tests {
@@ -208,6 +296,430 @@ func TestSongLibrary_CreateSong(t *testing.T) {
 	}
 }
 
+func TestSongLibrary_CreateSong_Jobs(t *testing.T) {
+	t.Parallel()
+
+	song := models.Song{ID: 2, Name: "Uprising", ArtistID: 1, Link: "https://www.youtube.com/watch?v=w8KQmps-Sog"}
+
+	ss := mocks.NewSongSaver(t)
+	ss.
+		On("SaveSong", mock.Anything, mock.MatchedBy(func(s models.Song) bool { return s.Name == song.Name })).
+		Once().
+		Return(song, nil)
+
+	js := mocks.NewJobSaver(t)
+	js.
+		On("SaveJobs", mock.Anything, models.Jobs{
+			{Type: models.JobTypeEnrichSong, SongID: song.ID},
+			{Type: models.JobTypeValidateLink, SongID: song.ID},
+		}).
+		Once().
+		Return(models.Jobs{}, errors.New("queue unavailable"))
+
+	sl := &Service{
+		log:              discardLogger,
+		songSaver:        ss,
+		jobSaver:         js,
+		songInfoProvider: mocks.NewSongInfoProvider(t),
+	}
+	got, err := sl.CreateSong(context.Background(), song)
+	assert.NoError(t, err, "song must be created even if jobs are not enqueued")
+	assert.Equal(t, song.API(), got)
+}
+
+func TestService_EnrichSong(t *testing.T) {
+	t.Parallel()
+
+	artist := models.Artist{ID: 1, Name: "Muse"}
+	info := models.SongInfo{
+		ReleaseDate: time.Date(2006, time.July, 16, 0, 0, 0, 0, time.UTC),
+		Text:        "one couplet",
+		Link:        "https://www.youtube.com/watch?v=Xsp3_a-PMTw",
+	}
+	errUnavailable := errors.New("unavailable")
+
+	type fields struct {
+		songProvider     SongProvider
+		songUpdater      SongUpdater
+		jobSaver         JobSaver
+		songInfoProvider SongInfoProvider
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "EnrichSong fills missing attributes",
+			fields: fields{
+				songProvider: func() SongProvider {
+					sp := mocks.NewSongProvider(t)
+					sp.
+						On("Song", mock.Anything, uint64(1)).
+						Once().
+						Return(models.Song{ID: 1, Name: "Supermassive Black Hole", Artist: artist, Link: "https://example.com"}, nil)
+
+					return sp
+				}(),
+				songUpdater: func() SongUpdater {
+					su := mocks.NewSongUpdater(t)
+					su.
+						On("UpdateSong", mock.Anything, mock.MatchedBy(func(s models.Song) bool {
+							return s.ID == 1 && s.ReleaseDate.Equal(info.ReleaseDate) && s.Text == info.Text &&
+								s.Language == "en" && s.Link == ""
+						})).
+						Once().
+						Return(models.Song{}, nil)
+
+					return su
+				}(),
+				jobSaver: mocks.NewJobSaver(t),
+				songInfoProvider: func() SongInfoProvider {
+					sip := mocks.NewSongInfoProvider(t)
+					sip.On("SongInfo", mock.Anything, artist.Name, "Supermassive Black Hole").Once().Return(info, nil)
+
+					return sip
+				}(),
+			},
+		},
+		{
+			name: "EnrichSong fills link and enqueues link validation",
+			fields: fields{
+				songProvider: func() SongProvider {
+					sp := mocks.NewSongProvider(t)
+					sp.
+						On("Song", mock.Anything, uint64(1)).
+						Once().
+						Return(models.Song{ID: 1, Name: "Supermassive Black Hole", Artist: artist, ReleaseDate: info.ReleaseDate, Text: "text"}, nil)
+
+					return sp
+				}(),
+				songUpdater: func() SongUpdater {
+					su := mocks.NewSongUpdater(t)
+					su.
+						On("UpdateSong", mock.Anything, models.Song{
+							ID:           1,
+							Link:         info.Link,
+							LinkProvider: links.ProviderYouTube,
+							LinkMediaID:  "Xsp3_a-PMTw",
+						}).
+						Once().
+						Return(models.Song{}, nil)
+
+					return su
+				}(),
+				jobSaver: func() JobSaver {
+					js := mocks.NewJobSaver(t)
+					js.
+						On("SaveJobs", mock.Anything, models.Jobs{{Type: models.JobTypeValidateLink, SongID: 1}}).
+						Once().
+						Return(models.Jobs{}, nil)
+
+					return js
+				}(),
+				songInfoProvider: func() SongInfoProvider {
+					sip := mocks.NewSongInfoProvider(t)
+					sip.On("SongInfo", mock.Anything, artist.Name, "Supermassive Black Hole").Once().Return(info, nil)
+
+					return sip
+				}(),
+			},
+		},
+		{
+			name: "EnrichSong skips link attached to another song",
+			fields: fields{
+				songProvider: func() SongProvider {
+					sp := mocks.NewSongProvider(t)
+					sp.
+						On("Song", mock.Anything, uint64(1)).
+						Once().
+						Return(models.Song{ID: 1, Name: "Supermassive Black Hole", Artist: artist, Text: "text"}, nil)
+
+					return sp
+				}(),
+				songUpdater: func() SongUpdater {
+					su := mocks.NewSongUpdater(t)
+					su.
+						On("UpdateSong", mock.Anything, mock.MatchedBy(func(s models.Song) bool { return s.Link != "" })).
+						Once().
+						Return(models.Song{}, repositories.ErrSongLinkExists)
+					su.
+						On("UpdateSong", mock.Anything, models.Song{ID: 1, ReleaseDate: info.ReleaseDate}).
+						Once().
+						Return(models.Song{}, nil)
+
+					return su
+				}(),
+				jobSaver: mocks.NewJobSaver(t),
+				songInfoProvider: func() SongInfoProvider {
+					sip := mocks.NewSongInfoProvider(t)
+					sip.On("SongInfo", mock.Anything, artist.Name, "Supermassive Black Hole").Once().Return(info, nil)
+
+					return sip
+				}(),
+			},
+		},
+		{
+			name: "EnrichSong song info not found",
+			fields: fields{
+				songProvider: func() SongProvider {
+					sp := mocks.NewSongProvider(t)
+					sp.On("Song", mock.Anything, uint64(1)).Once().Return(models.Song{ID: 1, Name: "Uprising", Artist: artist}, nil)
+
+					return sp
+				}(),
+				songUpdater: mocks.NewSongUpdater(t),
+				jobSaver:    mocks.NewJobSaver(t),
+				songInfoProvider: func() SongInfoProvider {
+					sip := mocks.NewSongInfoProvider(t)
+					sip.
+						On("SongInfo", mock.Anything, artist.Name, "Uprising").
+						Once().
+						Return(models.SongInfo{}, musicinfo.ErrSongInfoNotFound)
+
+					return sip
+				}(),
+			},
+		},
+		{
+			name: "EnrichSong song deleted",
+			fields: fields{
+				songProvider: func() SongProvider {
+					sp := mocks.NewSongProvider(t)
+					sp.On("Song", mock.Anything, uint64(1)).Once().Return(models.Song{}, repositories.ErrSongNotFound)
+
+					return sp
+				}(),
+				songUpdater:      mocks.NewSongUpdater(t),
+				jobSaver:         mocks.NewJobSaver(t),
+				songInfoProvider: mocks.NewSongInfoProvider(t),
+			},
+		},
+		{
+			name: "EnrichSong error song info unavailable",
+			fields: fields{
+				songProvider: func() SongProvider {
+					sp := mocks.NewSongProvider(t)
+					sp.On("Song", mock.Anything, uint64(1)).Once().Return(models.Song{ID: 1, Name: "Uprising", Artist: artist}, nil)
+
+					return sp
+				}(),
+				songUpdater: mocks.NewSongUpdater(t),
+				jobSaver:    mocks.NewJobSaver(t),
+				songInfoProvider: func() SongInfoProvider {
+					sip := mocks.NewSongInfoProvider(t)
+					sip.On("SongInfo", mock.Anything, artist.Name, "Uprising").Once().Return(models.SongInfo{}, errUnavailable)
+
+					return sip
+				}(),
+			},
+			wantErr: errUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sl := &Service{
+				log:              discardLogger,
+				songProvider:     tt.fields.songProvider,
+				songUpdater:      tt.fields.songUpdater,
+				jobSaver:         tt.fields.jobSaver,
+				songInfoProvider: tt.fields.songInfoProvider,
+			}
+			err := sl.EnrichSong(context.Background(), 1)
+			assert.ErrorIsf(t, err, tt.wantErr, "SongLibrary.EnrichSong() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_ValidateSongLink(t *testing.T) {
+	t.Parallel()
+
+	link := "https://www.youtube.com/watch?v=Xsp3_a-PMTw"
+
+	tests := []struct {
+		name       string
+		song       models.Song
+		code       int
+		err        error
+		wantBroken bool
+		wantErr    error
+	}{
+		{
+			name: "ValidateSongLink happy path",
+			song: models.Song{ID: 1, Link: link},
+			code: 200,
+		},
+		{
+			name: "ValidateSongLink song without link",
+			song: models.Song{ID: 1},
+		},
+		{
+			name:       "ValidateSongLink broken not found",
+			song:       models.Song{ID: 1, Link: link},
+			code:       404,
+			wantBroken: true,
+		},
+		{
+			name:       "ValidateSongLink broken unreachable",
+			song:       models.Song{ID: 1, Link: link},
+			err:        errors.New("connection refused"),
+			wantBroken: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := mocks.NewSongProvider(t)
+			sp.On("Song", mock.Anything, tt.song.ID).Once().Return(tt.song, nil)
+
+			lc := mocks.NewLinkChecker(t)
+			slhe := mocks.NewSongLinkHealthEditor(t)
+			if tt.song.Link != "" {
+				lc.On("CheckLink", mock.Anything, tt.song.Link).Once().Return(tt.code, tt.err)
+				slhe.
+					On("UpdateSongLinkHealth", mock.Anything, tt.song.ID, tt.code, tt.wantBroken).
+					Once().
+					Return(nil)
+			}
+
+			sl := &Service{
+				log:                  discardLogger,
+				songProvider:         sp,
+				songLinkHealthEditor: slhe,
+				linkChecker:          lc,
+			}
+			err := sl.ValidateSongLink(context.Background(), tt.song.ID)
+			assert.ErrorIsf(t, err, tt.wantErr, "SongLibrary.ValidateSongLink() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_ScheduleLinkChecks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		songs    models.Songs
+		err      error
+		wantJobs models.Jobs
+		wantErr  error
+	}{
+		{
+			name:  "ScheduleLinkChecks happy path",
+			songs: models.Songs{{ID: 1, TenantID: "team-a"}, {ID: 2, TenantID: "team-b"}},
+			wantJobs: models.Jobs{
+				{TenantID: "team-a", Type: models.JobTypeValidateLink, SongID: 1},
+				{TenantID: "team-b", Type: models.JobTypeValidateLink, SongID: 2},
+			},
+		},
+		{
+			name: "ScheduleLinkChecks nothing to check",
+		},
+		{
+			name:    "ScheduleLinkChecks error unexpected",
+			err:     errUnexpected,
+			wantErr: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			slhe := mocks.NewSongLinkHealthEditor(t)
+			slhe.
+				On("SongsWithStaleLinks", mock.Anything, mock.AnythingOfType("time.Time"), 500).
+				Once().
+				Return(tt.songs, tt.err)
+
+			js := mocks.NewJobSaver(t)
+			if tt.wantJobs != nil {
+				js.On("SaveJobs", mock.Anything, tt.wantJobs).Once().Return(tt.wantJobs, nil)
+			}
+
+			sl := &Service{
+				log:                  discardLogger,
+				songLinkHealthEditor: slhe,
+				jobSaver:             js,
+			}
+			err := sl.ScheduleLinkChecks(context.Background(), 24*time.Hour, 500)
+			assert.ErrorIsf(t, err, tt.wantErr, "SongLibrary.ScheduleLinkChecks() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_GetLinkReport(t *testing.T) {
+	t.Parallel()
+
+	p := models.Pagination{PageNumber: 1, PageSize: 10}
+	brokenFilter := models.Song{LinkHealth: models.LinkHealth{Status: models.LinkStatusBroken}}
+	brokenSong := models.Song{
+		ID:         1,
+		Link:       "https://example.com/missing",
+		LinkHealth: models.LinkHealth{StatusCode: 404, FailureStreak: 2},
+	}
+	stats := models.LinkStats{OK: 3, Broken: 1, Unchecked: 2}
+
+	tests := []struct {
+		name     string
+		statsErr error
+		songsErr error
+		want     models.LinkReportAPI
+		wantErr  error
+	}{
+		{
+			name: "GetLinkReport happy path",
+			want: models.LinkReportAPI{
+				Stats:       stats.API(),
+				BrokenSongs: models.Songs{brokenSong}.API(),
+				Pagination:  models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 1, RecordCount: 1, PageSize: 10},
+			},
+		},
+		{
+			name:     "GetLinkReport error stats",
+			statsErr: errUnexpected,
+			wantErr:  errUnexpected,
+		},
+		{
+			name:     "GetLinkReport error songs",
+			songsErr: errUnexpected,
+			wantErr:  errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			slhe := mocks.NewSongLinkHealthEditor(t)
+			slhe.On("SongLinkStats", mock.Anything).Once().Return(stats, tt.statsErr)
+
+			sp := mocks.NewSongProvider(t)
+			if tt.statsErr == nil {
+				sp.On("Songs", mock.Anything, brokenFilter, p).Once().Return(models.Songs{brokenSong}, uint64(1), tt.songsErr)
+			}
+
+			sl := &Service{
+				log:                  discardLogger,
+				songProvider:         sp,
+				songLinkHealthEditor: slhe,
+			}
+			got, err := sl.GetLinkReport(context.Background(), p)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "SongLibrary.GetLinkReport() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
 func TestSongLibrary_ChangeSong(t *testing.T) {
 	t.Parallel()
 
@@ -239,7 +751,8 @@ func TestSongLibrary_ChangeSong(t *testing.T) {
 				}(),
 			},
 			args: args{
-				s: expectedSong,
+				ctx: context.Background(),
+				s:   expectedSong,
 			},
 			want: expectedSong.API(),
 		},
@@ -257,7 +770,8 @@ func TestSongLibrary_ChangeSong(t *testing.T) {
 				}(),
 			},
 			args: args{
-				s: expectedSong,
+				ctx: context.Background(),
+				s:   expectedSong,
 			},
 			wantErr: services.ErrSongNotFound,
 		},
@@ -275,7 +789,8 @@ func TestSongLibrary_ChangeSong(t *testing.T) {
 				}(),
 			},
 			args: args{
-				s: expectedSong,
+				ctx: context.Background(),
+				s:   expectedSong,
 			},
 			wantErr: services.ErrArtistNotFound,
 		},
@@ -297,8 +812,91 @@ func TestSongLibrary_ChangeSong(t *testing.T) {
 	}
 }
 
-func TestSongLibrary_RemoveSong(t *testing.T) {
-	type fields struct {
+func TestService_SongCredits(t *testing.T) {
+	t.Parallel()
+
+	producer := models.Artist{ID: 2, Name: "Rich Costey"}
+	featured := models.Artist{ID: 3, Name: "Matt Bellamy"}
+	credits := models.SongCredits{
+		{SongID: expectedSongID, ArtistID: producer.ID, Artist: producer, Role: models.CreditRoleProducer},
+		{SongID: expectedSongID, ArtistID: featured.ID, Artist: featured, Role: models.CreditRoleFeatured},
+	}
+	// creditsArg проверяет участников создания песни, которые сервис передает в слой данных.
+	creditsArg := func(want models.SongCredits) any {
+		return mock.MatchedBy(func(s models.Song) bool {
+			return (want == nil) == (s.Credits == nil) && len(want) == len(s.Credits) &&
+				assert.ObjectsAreEqual(want.API(), s.Credits.API())
+		})
+	}
+
+	t.Run("Add credits", func(t *testing.T) {
+		t.Parallel()
+
+		added := models.SongCredits{
+			{ArtistID: producer.ID, Role: models.CreditRoleProducer},
+			{ArtistID: featured.ID, Role: models.CreditRoleFeatured},
+		}
+		ss := mocks.NewSongSaver(t)
+		ss.
+			On("SaveSong", mock.Anything, creditsArg(added)).
+			Once().
+			Return(models.Song{ID: expectedSongID, Name: "Uprising", Credits: credits}, nil)
+
+		sl := &Service{log: discardLogger, songSaver: ss}
+		got, err := sl.CreateSong(context.Background(), models.Song{Name: "Uprising", Credits: added})
+		assert.NoError(t, err)
+		assert.Equal(t, credits.API(), got.Credits)
+	})
+
+	t.Run("List credits", func(t *testing.T) {
+		t.Parallel()
+
+		sp := mocks.NewSongProvider(t)
+		sp.
+			On("Song", mock.Anything, expectedSongID).
+			Once().
+			Return(models.Song{ID: expectedSongID, Credits: credits}, nil)
+
+		sl := &Service{log: discardLogger, songProvider: sp}
+		got, err := sl.GetSong(context.Background(), expectedSongID)
+		assert.NoError(t, err)
+		assert.Equal(t, credits.API(), got.Credits)
+	})
+
+	tests := []struct {
+		name    string
+		credits models.SongCredits
+		stored  models.SongCredits
+	}{
+		{
+			name:    "Replace credits",
+			credits: models.SongCredits{{ArtistID: producer.ID, Role: models.CreditRoleProducer}},
+			stored:  credits[:1],
+		},
+		{name: "Remove credits", credits: models.SongCredits{}, stored: models.SongCredits{}},
+		{name: "Keep credits", credits: nil, stored: credits},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			su := mocks.NewSongUpdater(t)
+			su.
+				On("UpdateSong", mock.Anything, creditsArg(tt.credits)).
+				Once().
+				Return(models.Song{ID: expectedSongID, Credits: tt.stored}, nil)
+
+			sl := &Service{log: discardLogger, songUpdater: su}
+			got, err := sl.ChangeSong(context.Background(), models.Song{ID: expectedSongID, Credits: tt.credits})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.stored.API(), got.Credits)
+		})
+	}
+}
+
+func TestSongLibrary_RemoveSong(t *testing.T) {
+	type fields struct {
 		songDeleter SongDeleter
 	}
 	type args struct {
@@ -326,7 +924,8 @@ func TestSongLibrary_RemoveSong(t *testing.T) {
 				}(),
 			},
 			args: args{
-				id: expectedSongID,
+				ctx: context.Background(),
+				id:  expectedSongID,
 			},
 			want: expectedSongIDAPI,
 		},
@@ -344,7 +943,8 @@ func TestSongLibrary_RemoveSong(t *testing.T) {
 				}(),
 			},
 			args: args{
-				id: expectedSongID,
+				ctx: context.Background(),
+				id:  expectedSongID,
 			},
 			wantErr: services.ErrSongNotFound,
 		},
@@ -361,3 +961,545 @@ func TestSongLibrary_RemoveSong(t *testing.T) {
 		})
 	}
 }
+
+func TestService_ChangeSongTags(t *testing.T) {
+	type fields struct {
+		songTagger SongTagger
+	}
+	type args struct {
+		ctx   context.Context
+		id    uint64
+		kind  string
+		names []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.SongAPI
+		wantErr error
+	}{
+		{
+			name: "ChangeSongTags happy path",
+			fields: fields{
+				songTagger: func() SongTagger {
+					st := mocks.NewSongTagger(t)
+					st.
+						On("SetSongTags", mock.Anything, expectedSongID, models.TagKindTag, []string{"live"}).
+						Once().
+						Return(expectedSong, nil)
+
+					return st
+				}(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				id:    expectedSongID,
+				kind:  models.TagKindTag,
+				names: []string{"live"},
+			},
+			want: expectedSong.API(),
+		},
+		{
+			name: "ChangeSongTags error song not found",
+			fields: fields{
+				songTagger: func() SongTagger {
+					st := mocks.NewSongTagger(t)
+					st.
+						On("SetSongTags", mock.Anything, expectedSongID, models.TagKindTag, []string{"live"}).
+						Once().
+						Return(models.Song{}, repositories.ErrSongNotFound)
+
+					return st
+				}(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				id:    expectedSongID,
+				kind:  models.TagKindTag,
+				names: []string{"live"},
+			},
+			wantErr: services.ErrSongNotFound,
+		},
+		{
+			name: "ChangeSongTags error genre not found",
+			fields: fields{
+				songTagger: func() SongTagger {
+					st := mocks.NewSongTagger(t)
+					st.
+						On("SetSongTags", mock.Anything, expectedSongID, models.TagKindGenre, []string{"unknown"}).
+						Once().
+						Return(models.Song{}, repositories.ErrTagNotFound)
+
+					return st
+				}(),
+			},
+			args: args{
+				ctx:   context.Background(),
+				id:    expectedSongID,
+				kind:  models.TagKindGenre,
+				names: []string{"unknown"},
+			},
+			wantErr: services.ErrGenreNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sl := &Service{
+				log:        discardLogger,
+				songTagger: tt.fields.songTagger,
+			}
+			got, err := sl.ChangeSongTags(tt.args.ctx, tt.args.id, tt.args.kind, tt.args.names)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "SongLibrary.ChangeSongTags() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_ExportSongs(t *testing.T) {
+	attrs := models.Song{Language: "en"}
+	firstPage := make(models.Songs, exportPageSize)
+	for i := range firstPage {
+		firstPage[i] = models.Song{ID: uint64(i + 1), Link: "https://example.com/song"}
+	}
+	lastSong := models.Song{ID: exportPageSize + 1, Name: "Last", Link: "https://example.com/last", Artist: models.Artist{Name: "A"}}
+
+	sp := mocks.NewSongProvider(t)
+	sp.
+		On("Songs", mock.Anything, attrs, models.Pagination{PageNumber: 1, PageSize: exportPageSize}).
+		Once().
+		Return(firstPage, uint64(exportPageSize+1), nil)
+	sp.
+		On("Songs", mock.Anything, attrs, models.Pagination{PageNumber: 2, PageSize: exportPageSize}).
+		Once().
+		Return(models.Songs{lastSong}, uint64(exportPageSize+1), nil)
+
+	sl := &Service{
+		log:          discardLogger,
+		songProvider: sp,
+	}
+	got, err := sl.ExportSongs(context.Background(), attrs, playlistfmt.M3U8)
+	assert.NoError(t, err)
+	assert.Equal(t, exportPageSize+1, strings.Count(string(got), "#EXTINF:"))
+	assert.True(t, strings.HasSuffix(string(got), "#EXTINF:-1,A - Last\nhttps://example.com/last\n"))
+}
+
+func TestService_SearchSongs(t *testing.T) {
+	t.Parallel()
+
+	attrs := models.Song{Language: "en"}
+	p := models.Pagination{PageNumber: 1, PageSize: 10}
+	songs := models.Songs{{ID: 1, Name: "first"}}
+
+	tests := []struct {
+		name       string
+		withFacets bool
+		facetsErr  error
+		want       models.SongsAPI
+		wantErr    error
+	}{
+		{
+			name: "SearchSongs without facets",
+			want: models.SongsAPI{
+				Songs:      songs.API(),
+				Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 1, RecordCount: 1, PageSize: 10},
+			},
+		},
+		{
+			name:       "SearchSongs with facets",
+			withFacets: true,
+			want: models.SongsAPI{
+				Songs:      songs.API(),
+				Pagination: models.PaginationMetadataAPI{CurrentPageNumber: 1, PageCount: 1, RecordCount: 1, PageSize: 10},
+				Facets: &models.SongFacetsAPI{
+					Genres: []models.FacetAPI{{Name: "rock", Count: 1}},
+					Tags:   []models.FacetAPI{},
+				},
+			},
+		},
+		{
+			name:       "SearchSongs facets error unexpected",
+			withFacets: true,
+			facetsErr:  errUnexpected,
+			wantErr:    errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := mocks.NewSongProvider(t)
+			sp.On("Songs", mock.Anything, attrs, p).Once().Return(songs, uint64(1), nil)
+			if tt.withFacets {
+				sp.
+					On("SongFacets", mock.Anything, attrs).
+					Once().
+					Return(models.Facets{{Kind: models.TagKindGenre, Name: "rock", Count: 1}}, tt.facetsErr)
+			}
+
+			s := &Service{
+				log:          discardLogger,
+				songProvider: sp,
+			}
+			got, err := s.SearchSongs(context.Background(), attrs, p, tt.withFacets)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.SearchSongs() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_LinkSongs(t *testing.T) {
+	rel := models.SongRelation{SongID: expectedSongID, OriginalID: 2, Type: models.SongRelationCover}
+
+	type fields struct {
+		songRelator SongRelator
+	}
+	type args struct {
+		ctx context.Context
+		rel models.SongRelation
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.SongRelationAPI
+		wantErr error
+	}{
+		{
+			name: "LinkSongs happy path",
+			fields: fields{
+				songRelator: func() SongRelator {
+					sr := mocks.NewSongRelator(t)
+					sr.
+						On("SaveSongRelation", mock.Anything, rel).
+						Once().
+						Return(rel, nil)
+
+					return sr
+				}(),
+			},
+			args: args{ctx: context.Background(), rel: rel},
+			want: rel.API(),
+		},
+		{
+			name: "LinkSongs error self relation",
+			fields: fields{
+				songRelator: mocks.NewSongRelator(t),
+			},
+			args: args{
+				ctx: context.Background(),
+				rel: models.SongRelation{SongID: expectedSongID, OriginalID: expectedSongID, Type: models.SongRelationLive},
+			},
+			wantErr: services.ErrSongRelationCycle,
+		},
+		{
+			name: "LinkSongs error cycle",
+			fields: fields{
+				songRelator: func() SongRelator {
+					sr := mocks.NewSongRelator(t)
+					sr.
+						On("SaveSongRelation", mock.Anything, rel).
+						Once().
+						Return(models.SongRelation{}, repositories.ErrSongRelationCycle)
+
+					return sr
+				}(),
+			},
+			args:    args{ctx: context.Background(), rel: rel},
+			wantErr: services.ErrSongRelationCycle,
+		},
+		{
+			name: "LinkSongs error song not found",
+			fields: fields{
+				songRelator: func() SongRelator {
+					sr := mocks.NewSongRelator(t)
+					sr.
+						On("SaveSongRelation", mock.Anything, rel).
+						Once().
+						Return(models.SongRelation{}, repositories.ErrSongNotFound)
+
+					return sr
+				}(),
+			},
+			args:    args{ctx: context.Background(), rel: rel},
+			wantErr: services.ErrSongNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sl := &Service{
+				log:         discardLogger,
+				songRelator: tt.fields.songRelator,
+			}
+			got, err := sl.LinkSongs(tt.args.ctx, tt.args.rel)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "SongLibrary.LinkSongs() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_GetRelatedSongs(t *testing.T) {
+	original := models.Song{ID: 2, Name: "Original"}
+	cover := models.Song{ID: 3, Name: "Cover"}
+	relations := models.SongRelations{
+		{SongID: expectedSongID, OriginalID: original.ID, Original: original, Type: models.SongRelationRemix},
+		{SongID: cover.ID, Song: cover, OriginalID: expectedSongID, Type: models.SongRelationCover},
+	}
+
+	sr := mocks.NewSongRelator(t)
+	sr.
+		On("RelatedSongs", mock.Anything, expectedSongID).
+		Once().
+		Return(relations, nil)
+
+	sl := &Service{
+		log:         discardLogger,
+		songRelator: sr,
+	}
+	got, err := sl.GetRelatedSongs(context.Background(), expectedSongID)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedSongIDAPI, got.Song)
+	assert.Equal(t, map[string][]models.SongAPI{models.SongRelationRemix: {original.API()}}, got.Originals)
+	assert.Equal(t, map[string][]models.SongAPI{models.SongRelationCover: {cover.API()}}, got.Derivatives)
+}
+
+func TestService_MergeSongs(t *testing.T) {
+	var duplicateSongID uint64 = 2
+
+	type fields struct {
+		songMerger SongMerger
+	}
+	type args struct {
+		ctx         context.Context
+		id          uint64
+		duplicateID uint64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.SongAPI
+		wantErr error
+	}{
+		{
+			name: "MergeSongs happy path",
+			fields: fields{
+				songMerger: func() SongMerger {
+					sm := mocks.NewSongMerger(t)
+					sm.
+						On("MergeSongs", mock.Anything, expectedSongID, duplicateSongID).
+						Once().
+						Return(expectedSong, nil)
+
+					return sm
+				}(),
+			},
+			args: args{ctx: context.Background(), id: expectedSongID, duplicateID: duplicateSongID},
+			want: expectedSong.API(),
+		},
+		{
+			name: "MergeSongs error merge into itself",
+			fields: fields{
+				songMerger: mocks.NewSongMerger(t),
+			},
+			args:    args{ctx: context.Background(), id: expectedSongID, duplicateID: expectedSongID},
+			wantErr: services.ErrMergeIntoItself,
+		},
+		{
+			name: "MergeSongs error relation cycle",
+			fields: fields{
+				songMerger: func() SongMerger {
+					sm := mocks.NewSongMerger(t)
+					sm.
+						On("MergeSongs", mock.Anything, expectedSongID, duplicateSongID).
+						Once().
+						Return(models.Song{}, repositories.ErrSongRelationCycle)
+
+					return sm
+				}(),
+			},
+			args:    args{ctx: context.Background(), id: expectedSongID, duplicateID: duplicateSongID},
+			wantErr: services.ErrSongRelationCycle,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sl := &Service{
+				log:        discardLogger,
+				songMerger: tt.fields.songMerger,
+			}
+			got, err := sl.MergeSongs(tt.args.ctx, tt.args.id, tt.args.duplicateID)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "SongLibrary.MergeSongs() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_GetSongByISRC(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		songProvider SongProvider
+	}
+	type args struct {
+		ctx  context.Context
+		isrc string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.SongAPI
+		wantErr error
+	}{
+		{
+			name: "GetSongByISRC happy path",
+			fields: fields{
+				songProvider: func() SongProvider {
+					sp := mocks.NewSongProvider(t)
+					sp.
+						On("SongByISRC", mock.Anything, "USRC17607839").
+						Once().
+						Return(expectedSong, nil)
+
+					return sp
+				}(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				isrc: "US-RC1-76-07839",
+			},
+			want: expectedSong.API(),
+		},
+		{
+			name: "GetSongByISRC error invalid isrc",
+			fields: fields{
+				songProvider: mocks.NewSongProvider(t),
+			},
+			args: args{
+				ctx:  context.Background(),
+				isrc: "not-an-isrc",
+			},
+			wantErr: services.ErrInvalidIdentifier,
+		},
+		{
+			name: "GetSongByISRC error song not found",
+			fields: fields{
+				songProvider: func() SongProvider {
+					sp := mocks.NewSongProvider(t)
+					sp.
+						On("SongByISRC", mock.Anything, "USRC17607839").
+						Once().
+						Return(models.Song{}, repositories.ErrSongNotFound)
+
+					return sp
+				}(),
+			},
+			args: args{
+				ctx:  context.Background(),
+				isrc: "USRC17607839",
+			},
+			wantErr: services.ErrSongNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:          discardLogger,
+				songProvider: tt.fields.songProvider,
+			}
+			got, err := s.GetSongByISRC(tt.args.ctx, tt.args.isrc)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.GetSongByISRC() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_GetArtistsSongs(t *testing.T) {
+	t.Parallel()
+
+	artistIDs := []uint64{1, 2, 3}
+	songs := models.Songs{
+		{ID: 1, ArtistID: 1, Name: "first"},
+		{ID: 2, ArtistID: 1, Name: "second"},
+		{ID: 3, ArtistID: 3, Name: "third"},
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		want    map[uint64][]models.SongAPI
+		wantErr error
+	}{
+		{
+			name: "GetArtistsSongs happy path",
+			want: map[uint64][]models.SongAPI{
+				1: {songs[0].API(), songs[1].API()},
+				3: {songs[2].API()},
+			},
+		},
+		{
+			name:    "GetArtistsSongs error unexpected",
+			err:     errUnexpected,
+			wantErr: errUnexpected,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sp := mocks.NewSongProvider(t)
+			sp.On("SongsByArtists", mock.Anything, artistIDs, 2).Once().Return(songs, tt.err)
+
+			s := &Service{
+				log:          discardLogger,
+				songProvider: sp,
+			}
+			got, err := s.GetArtistsSongs(context.Background(), artistIDs, 2)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.GetArtistsSongs() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_Tracing(t *testing.T) {
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	otel.SetTracerProvider(tp)
+
+	sp := mocks.NewSongProvider(t)
+	sp.
+		On("Song", mock.Anything, expectedSongID).
+		Once().
+		Return(expectedSong, nil)
+
+	var buf bytes.Buffer
+	sl := &Service{
+		log:          slog.New(slog.NewJSONHandler(&buf, nil)),
+		songProvider: sp,
+	}
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, err := sl.GetSong(ctx, expectedSongID)
+	parent.End()
+	assert.NoError(t, err)
+
+	spans := sr.Ended()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "song.Service.GetSong", spans[0].Name())
+		assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	}
+
+	traceID := parent.SpanContext().TraceID().String()
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		assert.Contains(t, line, `"trace_id":"`+traceID+`"`, "service logs must be correlated with trace")
+	}
+}
@@ -0,0 +1,203 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	tagrest "github.com/sedonn/song-library-service/internal/controllers/rest/tag"
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/repositories"
+	"github.com/sedonn/song-library-service/internal/services"
+)
+
+// TagProvider описывает поведение объекта слоя данных, который обеспечивает предоставление данных о метках.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=TagProvider
+type TagProvider interface {
+	// Tags возвращает все метки определенного вида.
+	Tags(ctx context.Context, kind string) (models.Tags, error)
+}
+
+// TagSaver описывает поведение объекта слоя данных, который обеспечивает сохранение данных меток.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=TagSaver
+type TagSaver interface {
+	// SaveTag сохраняет данные новой метки.
+	SaveTag(ctx context.Context, t models.Tag) (models.Tag, error)
+}
+
+// TagUpdater описывает поведение объекта слоя данных, который обеспечивает обновление данных меток.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=TagUpdater
+type TagUpdater interface {
+	// UpdateTag обновляет название определенной метки.
+	UpdateTag(ctx context.Context, t models.Tag) (models.Tag, error)
+}
+
+// TagDeleter описывает поведение объекта слоя данных, который обеспечивает удаление данных меток.
+//
+//go:generate go run github.com/vektra/mockery/v2@v2.46.1 --name=TagDeleter
+type TagDeleter interface {
+	// DeleteTag удаляет определенную метку определенного вида.
+	DeleteTag(ctx context.Context, kind string, id uint64) (uint64, error)
+}
+
+// Service предоставляет бизнес-логику работы со словарем меток определенного вида.
+type Service struct {
+	log         *slog.Logger
+	kind        string
+	tagProvider TagProvider
+	tagSaver    TagSaver
+	tagUpdater  TagUpdater
+	tagDeleter  TagDeleter
+}
+
+var _ tagrest.TagService = (*Service)(nil)
+
+// New создает новый объект сервиса меток определенного вида: жанров или свободных меток.
+func New(log *slog.Logger, kind string, tp TagProvider, ts TagSaver, tu TagUpdater, td TagDeleter) *Service {
+	return &Service{
+		log:         log.With(slog.String("kind", kind)),
+		kind:        kind,
+		tagProvider: tp,
+		tagSaver:    ts,
+		tagUpdater:  tu,
+		tagDeleter:  td,
+	}
+}
+
+// GetTags возвращает все метки, упорядоченные по названию.
+func (s *Service) GetTags(ctx context.Context) (models.TagsAPI, error) {
+	s.log.Info("attempt to get tags")
+
+	if err := services.Authorize(ctx, rbac.TagsRead); err != nil {
+		s.log.Warn("failed to get tags", logger.ErrorString(err))
+
+		return models.TagsAPI{}, err
+	}
+
+	tags, err := s.tagProvider.Tags(ctx, s.kind)
+	if err != nil {
+		s.log.Error("failed to get tags", logger.ErrorString(err))
+
+		return models.TagsAPI{}, err
+	}
+
+	return models.TagsAPI{Tags: tags.API()}, nil
+}
+
+// CreateTag создает новую метку.
+func (s *Service) CreateTag(ctx context.Context, name string) (models.TagAPI, error) {
+	log := s.log.With(slog.String("name", name), logger.Actor(ctx))
+
+	log.Info("attempt to create tag")
+
+	if err := services.Authorize(ctx, rbac.TagsWrite); err != nil {
+		log.Warn("failed to create tag", logger.ErrorString(err))
+
+		return models.TagAPI{}, err
+	}
+
+	t, err := s.tagSaver.SaveTag(ctx, models.Tag{Kind: s.kind, Name: name})
+	if err != nil {
+		if serviceErr := s.tagError(err); serviceErr != nil {
+			log.Warn("failed to create tag", logger.ErrorString(err))
+
+			return models.TagAPI{}, serviceErr
+		}
+
+		log.Error("failed to create tag", logger.ErrorString(err))
+
+		return models.TagAPI{}, err
+	}
+
+	log.Info("success to create tag", slog.Uint64("id", t.ID))
+
+	return t.API(), nil
+}
+
+// ChangeTag переименовывает определенную метку.
+func (s *Service) ChangeTag(ctx context.Context, id uint64, name string) (models.TagAPI, error) {
+	log := s.log.With(slog.Uint64("id", id), logger.Actor(ctx))
+
+	log.Info("attempt to change tag")
+
+	if err := services.Authorize(ctx, rbac.TagsWrite); err != nil {
+		log.Warn("failed to change tag", logger.ErrorString(err))
+
+		return models.TagAPI{}, err
+	}
+
+	t, err := s.tagUpdater.UpdateTag(ctx, models.Tag{ID: id, Kind: s.kind, Name: name})
+	if err != nil {
+		if serviceErr := s.tagError(err); serviceErr != nil {
+			log.Warn("failed to change tag", logger.ErrorString(err))
+
+			return models.TagAPI{}, serviceErr
+		}
+
+		log.Error("failed to change tag", logger.ErrorString(err))
+
+		return models.TagAPI{}, err
+	}
+
+	log.Info("success to change tag")
+
+	return t.API(), nil
+}
+
+// RemoveTag удаляет определенную метку вместе с ее привязками к песням.
+func (s *Service) RemoveTag(ctx context.Context, id uint64) (models.TagIDAPI, error) {
+	log := s.log.With(slog.Uint64("id", id), logger.Actor(ctx))
+
+	log.Info("attempt to remove tag")
+
+	if err := services.Authorize(ctx, rbac.TagsDelete); err != nil {
+		log.Warn("failed to remove tag", logger.ErrorString(err))
+
+		return models.TagIDAPI{}, err
+	}
+
+	id, err := s.tagDeleter.DeleteTag(ctx, s.kind, id)
+	if err != nil {
+		if serviceErr := s.tagError(err); serviceErr != nil {
+			log.Warn("failed to remove tag", logger.ErrorString(err))
+
+			return models.TagIDAPI{}, serviceErr
+		}
+
+		log.Error("failed to remove tag", logger.ErrorString(err))
+
+		return models.TagIDAPI{}, err
+	}
+
+	log.Info("success to remove tag")
+
+	return models.TagIDAPI{ID: id}, nil
+}
+
+// tagError преобразует ошибки слоя данных в ошибки бизнес-логики с учетом вида метки.
+// Возвращает nil, если ошибка не является ожидаемой.
+func (s *Service) tagError(err error) error {
+	genre := s.kind == models.TagKindGenre
+
+	switch {
+	case errors.Is(err, repositories.ErrTagNotFound) && genre:
+		return services.ErrGenreNotFound
+
+	case errors.Is(err, repositories.ErrTagNotFound):
+		return services.ErrTagNotFound
+
+	case errors.Is(err, repositories.ErrTagExists) && genre:
+		return services.ErrGenreExists
+
+	case errors.Is(err, repositories.ErrTagExists):
+		return services.ErrTagExists
+
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,356 @@
+package tag
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/sedonn/song-library-service/internal/domain/models"
+	"github.com/sedonn/song-library-service/internal/pkg/logger"
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/repositories"
+	"github.com/sedonn/song-library-service/internal/services"
+	"github.com/sedonn/song-library-service/internal/services/tag/mocks"
+)
+
+var (
+	discardLogger        = logger.NewDiscardLogger()
+	expectedTagID uint64 = 1
+	expectedGenre        = models.Tag{ID: expectedTagID, Kind: models.TagKindGenre, Name: "rock"}
+	expectedTag          = models.Tag{ID: expectedTagID, Kind: models.TagKindTag, Name: "live"}
+)
+
+func TestService_GetTags(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		kind        string
+		tagProvider TagProvider
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    models.TagsAPI
+		wantErr error
+	}{
+		{
+			name: "GetTags happy path",
+			fields: fields{
+				kind: models.TagKindGenre,
+				tagProvider: func() TagProvider {
+					tp := mocks.NewTagProvider(t)
+					tp.
+						On("Tags", mock.Anything, models.TagKindGenre).
+						Once().
+						Return(models.Tags{expectedGenre}, nil)
+
+					return tp
+				}(),
+			},
+			want: models.TagsAPI{Tags: models.Tags{expectedGenre}.API()},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:         discardLogger,
+				kind:        tt.fields.kind,
+				tagProvider: tt.fields.tagProvider,
+			}
+			got, err := s.GetTags(context.Background())
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.GetTags() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_CreateTag(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		kind     string
+		tagSaver TagSaver
+	}
+	type args struct {
+		ctx  context.Context
+		name string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.TagAPI
+		wantErr error
+	}{
+		{
+			name: "CreateTag happy path",
+			fields: fields{
+				kind: models.TagKindTag,
+				tagSaver: func() TagSaver {
+					ts := mocks.NewTagSaver(t)
+					ts.
+						On("SaveTag", mock.Anything, models.Tag{Kind: models.TagKindTag, Name: expectedTag.Name}).
+						Once().
+						Return(expectedTag, nil)
+
+					return ts
+				}(),
+			},
+			args: args{
+				name: expectedTag.Name,
+			},
+			want: expectedTag.API(),
+		},
+		{
+			name: "CreateTag error genre exists",
+			fields: fields{
+				kind: models.TagKindGenre,
+				tagSaver: func() TagSaver {
+					ts := mocks.NewTagSaver(t)
+					ts.
+						On("SaveTag", mock.Anything, models.Tag{Kind: models.TagKindGenre, Name: expectedGenre.Name}).
+						Once().
+						Return(models.Tag{}, repositories.ErrTagExists)
+
+					return ts
+				}(),
+			},
+			args: args{
+				name: expectedGenre.Name,
+			},
+			want:    models.TagAPI{},
+			wantErr: services.ErrGenreExists,
+		},
+		{
+			name: "CreateTag error tag exists",
+			fields: fields{
+				kind: models.TagKindTag,
+				tagSaver: func() TagSaver {
+					ts := mocks.NewTagSaver(t)
+					ts.
+						On("SaveTag", mock.Anything, models.Tag{Kind: models.TagKindTag, Name: expectedTag.Name}).
+						Once().
+						Return(models.Tag{}, repositories.ErrTagExists)
+
+					return ts
+				}(),
+			},
+			args: args{
+				name: expectedTag.Name,
+			},
+			want:    models.TagAPI{},
+			wantErr: services.ErrTagExists,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:      discardLogger,
+				kind:     tt.fields.kind,
+				tagSaver: tt.fields.tagSaver,
+			}
+			got, err := s.CreateTag(tt.args.ctx, tt.args.name)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.CreateTag() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_ChangeTag(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		kind       string
+		tagUpdater TagUpdater
+	}
+	type args struct {
+		ctx  context.Context
+		id   uint64
+		name string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.TagAPI
+		wantErr error
+	}{
+		{
+			name: "ChangeTag happy path",
+			fields: fields{
+				kind: models.TagKindGenre,
+				tagUpdater: func() TagUpdater {
+					tu := mocks.NewTagUpdater(t)
+					tu.
+						On("UpdateTag", mock.Anything, expectedGenre).
+						Once().
+						Return(expectedGenre, nil)
+
+					return tu
+				}(),
+			},
+			args: args{
+				id:   expectedTagID,
+				name: expectedGenre.Name,
+			},
+			want: expectedGenre.API(),
+		},
+		{
+			name: "ChangeTag error genre not found",
+			fields: fields{
+				kind: models.TagKindGenre,
+				tagUpdater: func() TagUpdater {
+					tu := mocks.NewTagUpdater(t)
+					tu.
+						On("UpdateTag", mock.Anything, expectedGenre).
+						Once().
+						Return(models.Tag{}, repositories.ErrTagNotFound)
+
+					return tu
+				}(),
+			},
+			args: args{
+				id:   expectedTagID,
+				name: expectedGenre.Name,
+			},
+			want:    models.TagAPI{},
+			wantErr: services.ErrGenreNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:        discardLogger,
+				kind:       tt.fields.kind,
+				tagUpdater: tt.fields.tagUpdater,
+			}
+			got, err := s.ChangeTag(tt.args.ctx, tt.args.id, tt.args.name)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.ChangeTag() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
+
+func TestService_RemoveTag(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		kind       string
+		tagDeleter TagDeleter
+	}
+	type args struct {
+		ctx context.Context
+		id  uint64
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    models.TagIDAPI
+		wantErr error
+	}{
+		{
+			name: "RemoveTag happy path",
+			fields: fields{
+				kind: models.TagKindTag,
+				tagDeleter: func() TagDeleter {
+					td := mocks.NewTagDeleter(t)
+					td.
+						On("DeleteTag", mock.Anything, models.TagKindTag, expectedTagID).
+						Once().
+						Return(expectedTagID, nil)
+
+					return td
+				}(),
+			},
+			args: args{
+				id: expectedTagID,
+			},
+			want: models.TagIDAPI{ID: expectedTagID},
+		},
+		{
+			name: "RemoveTag error tag not found",
+			fields: fields{
+				kind: models.TagKindTag,
+				tagDeleter: func() TagDeleter {
+					td := mocks.NewTagDeleter(t)
+					td.
+						On("DeleteTag", mock.Anything, models.TagKindTag, expectedTagID).
+						Once().
+						Return(uint64(0), repositories.ErrTagNotFound)
+
+					return td
+				}(),
+			},
+			args: args{
+				id: expectedTagID,
+			},
+			want:    models.TagIDAPI{},
+			wantErr: services.ErrTagNotFound,
+		},
+		{
+			name: "RemoveTag by admin",
+			fields: fields{
+				kind: models.TagKindTag,
+				tagDeleter: func() TagDeleter {
+					td := mocks.NewTagDeleter(t)
+					td.
+						On("DeleteTag", mock.Anything, models.TagKindTag, expectedTagID).
+						Once().
+						Return(expectedTagID, nil)
+
+					return td
+				}(),
+			},
+			args: args{
+				ctx: principal.NewContext(context.Background(), principal.Principal{Grants: rbac.DefaultRoles[rbac.RoleAdmin]}),
+				id:  expectedTagID,
+			},
+			want: models.TagIDAPI{ID: expectedTagID},
+		},
+		{
+			name: "RemoveTag error forbidden for editor",
+			fields: fields{
+				kind:       models.TagKindTag,
+				tagDeleter: mocks.NewTagDeleter(t),
+			},
+			args: args{
+				ctx: principal.NewContext(context.Background(), principal.Principal{Grants: rbac.DefaultRoles[rbac.RoleEditor]}),
+				id:  expectedTagID,
+			},
+			want:    models.TagIDAPI{},
+			wantErr: services.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &Service{
+				log:        discardLogger,
+				kind:       tt.fields.kind,
+				tagDeleter: tt.fields.tagDeleter,
+			}
+			got, err := s.RemoveTag(tt.args.ctx, tt.args.id)
+			assert.Equal(t, tt.want, got)
+			assert.ErrorIsf(t, err, tt.wantErr, "Service.RemoveTag() error = %v, wantErr %v", err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	"github.com/sedonn/song-library-service/internal/pkg/principal"
+	"github.com/sedonn/song-library-service/internal/pkg/rbac"
+	"github.com/sedonn/song-library-service/internal/pkg/tenant"
+)
+
+// ResolveTenant добавляет в контекст библиотеку, с которой работает клиент из контекста.
+// Клиент, привязанный к библиотеке, работает только с ней. Клиент без привязки с правом rbac.TenantsSwitch
+// работает с запрошенной библиотекой, а если библиотека не запрошена - с библиотекой по умолчанию.
+// Остальные клиенты, в том числе анонимные при выключенной аутентификации, работают только с библиотекой
+// по умолчанию. Запрос недоступной библиотеки приводит к ошибке ErrTenantForbidden, а некорректная библиотека
+// в запросе или в привязке клиента - к ошибке ErrInvalidTenant.
+func ResolveTenant(ctx context.Context, requested string) (context.Context, error) {
+	if requested != "" && !tenant.Valid(requested) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTenant, requested)
+	}
+
+	bound := tenant.Default
+	if p, ok := principal.FromContext(ctx); ok {
+		switch {
+		case p.Tenant != "" && !tenant.Valid(p.Tenant):
+			return nil, fmt.Errorf("%w: %q", ErrInvalidTenant, p.Tenant)
+		case p.Tenant != "":
+			bound = p.Tenant
+		case p.Can(rbac.TenantsSwitch):
+			return tenant.NewContext(ctx, cmp.Or(requested, tenant.Default)), nil
+		}
+	}
+
+	if requested != "" && requested != bound {
+		return nil, fmt.Errorf("%w: %s", ErrTenantForbidden, requested)
+	}
+
+	return tenant.NewContext(ctx, bound), nil
+}
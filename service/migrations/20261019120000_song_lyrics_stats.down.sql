@@ -0,0 +1,4 @@
+-- reverse: create index "idx_songs_language" to table: "songs"
+DROP INDEX "public"."idx_songs_language";
+-- reverse: modify "songs" table
+ALTER TABLE "public"."songs" DROP COLUMN "reading_time", DROP COLUMN "unique_word_ratio", DROP COLUMN "word_count", DROP COLUMN "couplet_count", DROP COLUMN "line_count", DROP COLUMN "language";
@@ -0,0 +1,4 @@
+-- modify "songs" table
+ALTER TABLE "public"."songs" ADD COLUMN "language" character varying(8) NULL, ADD COLUMN "line_count" bigint NULL, ADD COLUMN "couplet_count" bigint NULL, ADD COLUMN "word_count" bigint NULL, ADD COLUMN "unique_word_ratio" numeric NULL, ADD COLUMN "reading_time" bigint NULL;
+-- create index "idx_songs_language" to table: "songs"
+CREATE INDEX "idx_songs_language" ON "public"."songs" ("language");
@@ -0,0 +1,8 @@
+-- reverse: create index "idx_album_tracks_position" to table: "album_tracks"
+DROP INDEX "public"."idx_album_tracks_position";
+-- reverse: create "album_tracks" table
+DROP TABLE "public"."album_tracks";
+-- reverse: create index "idx_albums_title" to table: "albums"
+DROP INDEX "public"."idx_albums_title";
+-- reverse: create "albums" table
+DROP TABLE "public"."albums";
@@ -0,0 +1,24 @@
+-- create "albums" table
+CREATE TABLE "public"."albums" (
+  "id" bigserial NOT NULL,
+  "title" character varying(130) NULL,
+  "artist_id" bigint NULL,
+  "release_date" timestamptz NULL,
+  "type" character varying(16) NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_albums_artist" FOREIGN KEY ("artist_id") REFERENCES "public"."artists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "idx_albums_title" to table: "albums"
+CREATE INDEX "idx_albums_title" ON "public"."albums" ("title");
+-- create "album_tracks" table
+CREATE TABLE "public"."album_tracks" (
+  "album_id" bigint NOT NULL,
+  "song_id" bigint NOT NULL,
+  "disc_number" bigint NULL,
+  "track_number" bigint NULL,
+  PRIMARY KEY ("album_id", "song_id"),
+  CONSTRAINT "fk_album_tracks_song" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_albums_tracks" FOREIGN KEY ("album_id") REFERENCES "public"."albums" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "idx_album_tracks_position" to table: "album_tracks"
+CREATE UNIQUE INDEX "idx_album_tracks_position" ON "public"."album_tracks" ("album_id", "disc_number", "track_number");
@@ -0,0 +1,4 @@
+-- reverse: create index "idx_song_credits_artist_id" to table: "song_credits"
+DROP INDEX "public"."idx_song_credits_artist_id";
+-- reverse: create "song_credits" table
+DROP TABLE "public"."song_credits";
@@ -0,0 +1,11 @@
+-- create "song_credits" table
+CREATE TABLE "public"."song_credits" (
+  "song_id" bigint NOT NULL,
+  "artist_id" bigint NOT NULL,
+  "role" character varying(16) NOT NULL,
+  PRIMARY KEY ("song_id", "artist_id", "role"),
+  CONSTRAINT "fk_song_credits_artist" FOREIGN KEY ("artist_id") REFERENCES "public"."artists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_songs_credits" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "idx_song_credits_artist_id" to table: "song_credits"
+CREATE INDEX "idx_song_credits_artist_id" ON "public"."song_credits" ("artist_id");
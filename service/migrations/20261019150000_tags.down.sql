@@ -0,0 +1,6 @@
+-- reverse: create "song_tags" table
+DROP TABLE "public"."song_tags";
+-- reverse: create index "idx_tags_kind_name" to table: "tags"
+DROP INDEX "public"."idx_tags_kind_name";
+-- reverse: create "tags" table
+DROP TABLE "public"."tags";
@@ -0,0 +1,17 @@
+-- create "tags" table
+CREATE TABLE "public"."tags" (
+  "id" bigserial NOT NULL,
+  "kind" character varying(16) NULL,
+  "name" character varying(64) NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "idx_tags_kind_name" to table: "tags"
+CREATE UNIQUE INDEX "idx_tags_kind_name" ON "public"."tags" ("kind", "name");
+-- create "song_tags" table
+CREATE TABLE "public"."song_tags" (
+  "song_id" bigint NOT NULL,
+  "tag_id" bigint NOT NULL,
+  PRIMARY KEY ("song_id", "tag_id"),
+  CONSTRAINT "fk_song_tags_song" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_song_tags_tag" FOREIGN KEY ("tag_id") REFERENCES "public"."tags" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
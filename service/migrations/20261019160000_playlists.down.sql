@@ -0,0 +1,10 @@
+-- reverse: create index "idx_playlist_items_song_id" to table: "playlist_items"
+DROP INDEX "public"."idx_playlist_items_song_id";
+-- reverse: create index "idx_playlist_items_rank" to table: "playlist_items"
+DROP INDEX "public"."idx_playlist_items_rank";
+-- reverse: create "playlist_items" table
+DROP TABLE "public"."playlist_items";
+-- reverse: create index "idx_playlists_name" to table: "playlists"
+DROP INDEX "public"."idx_playlists_name";
+-- reverse: create "playlists" table
+DROP TABLE "public"."playlists";
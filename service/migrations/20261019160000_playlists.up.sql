@@ -0,0 +1,22 @@
+-- create "playlists" table
+CREATE TABLE "public"."playlists" (
+  "id" bigserial NOT NULL,
+  "name" character varying(130) NULL,
+  "description" character varying(1000) NULL,
+  PRIMARY KEY ("id")
+);
+-- create index "idx_playlists_name" to table: "playlists"
+CREATE INDEX "idx_playlists_name" ON "public"."playlists" ("name");
+-- create "playlist_items" table
+CREATE TABLE "public"."playlist_items" (
+  "playlist_id" bigint NOT NULL,
+  "song_id" bigint NOT NULL,
+  "rank" bigint NULL,
+  PRIMARY KEY ("playlist_id", "song_id"),
+  CONSTRAINT "fk_playlist_items_song" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_playlists_items" FOREIGN KEY ("playlist_id") REFERENCES "public"."playlists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "idx_playlist_items_rank" to table: "playlist_items"
+CREATE INDEX "idx_playlist_items_rank" ON "public"."playlist_items" ("playlist_id", "rank");
+-- create index "idx_playlist_items_song_id" to table: "playlist_items"
+CREATE INDEX "idx_playlist_items_song_id" ON "public"."playlist_items" ("song_id");
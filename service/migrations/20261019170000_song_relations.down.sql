@@ -0,0 +1,4 @@
+-- reverse: create index "idx_song_relations_original_id" to table: "song_relations"
+DROP INDEX "public"."idx_song_relations_original_id";
+-- reverse: create "song_relations" table
+DROP TABLE "public"."song_relations";
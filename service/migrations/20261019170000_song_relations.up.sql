@@ -0,0 +1,11 @@
+-- create "song_relations" table
+CREATE TABLE "public"."song_relations" (
+  "song_id" bigint NOT NULL,
+  "original_id" bigint NOT NULL,
+  "type" character varying(16) NOT NULL,
+  PRIMARY KEY ("song_id", "original_id", "type"),
+  CONSTRAINT "fk_song_relations_original" FOREIGN KEY ("original_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "fk_song_relations_song" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "idx_song_relations_original_id" to table: "song_relations"
+CREATE INDEX "idx_song_relations_original_id" ON "public"."song_relations" ("original_id");
@@ -0,0 +1,14 @@
+-- reverse: create index "idx_artist_aliases_normalized_name" to table: "artist_aliases"
+DROP INDEX "public"."idx_artist_aliases_normalized_name";
+-- reverse: create index "idx_artist_aliases_artist_id" to table: "artist_aliases"
+DROP INDEX "public"."idx_artist_aliases_artist_id";
+-- reverse: create "artist_aliases" table
+DROP TABLE "public"."artist_aliases";
+-- reverse: create index "idx_artists_normalized_name" to table: "artists"
+DROP INDEX "public"."idx_artists_normalized_name";
+-- reverse: create index "idx_artists_name" to table: "artists"
+DROP INDEX "public"."idx_artists_name";
+-- reverse: drop index "idx_artists_name" from table: "artists"
+CREATE UNIQUE INDEX "idx_artists_name" ON "public"."artists" ("name");
+-- reverse: modify "artists" table
+ALTER TABLE "public"."artists" DROP COLUMN "normalized_name";
@@ -0,0 +1,42 @@
+-- modify "artists" table
+ALTER TABLE "public"."artists" ADD COLUMN "normalized_name" character varying(130) NULL;
+-- backfill "normalized_name" column of table: "artists"
+UPDATE "public"."artists" SET "normalized_name" = LOWER(TRIM(REGEXP_REPLACE("name", '\s+', ' ', 'g')));
+-- merge artists whose names differ only in case and whitespace into the artist with the lowest id:
+-- songs and albums of a duplicate move to the kept artist, credits of a duplicate are copied to the kept artist
+-- (a credit the kept artist already has in the same role stays single) and then deleted with the duplicate
+CREATE TEMPORARY TABLE "artist_merges" ON COMMIT DROP AS
+SELECT "artists"."id" AS "duplicate_id", "kept"."id" AS "id"
+FROM "public"."artists"
+INNER JOIN (
+  SELECT MIN("id") AS "id", "normalized_name" FROM "public"."artists" GROUP BY "normalized_name"
+) AS "kept" ON "kept"."normalized_name" = "artists"."normalized_name" AND "kept"."id" <> "artists"."id";
+UPDATE "public"."songs" SET "artist_id" = "artist_merges"."id"
+FROM "artist_merges" WHERE "songs"."artist_id" = "artist_merges"."duplicate_id";
+UPDATE "public"."albums" SET "artist_id" = "artist_merges"."id"
+FROM "artist_merges" WHERE "albums"."artist_id" = "artist_merges"."duplicate_id";
+INSERT INTO "public"."song_credits" ("song_id", "artist_id", "role")
+SELECT "song_credits"."song_id", "artist_merges"."id", "song_credits"."role"
+FROM "public"."song_credits" INNER JOIN "artist_merges" ON "song_credits"."artist_id" = "artist_merges"."duplicate_id"
+ON CONFLICT DO NOTHING;
+DELETE FROM "public"."song_credits" WHERE "artist_id" IN (SELECT "duplicate_id" FROM "artist_merges");
+DELETE FROM "public"."artists" WHERE "id" IN (SELECT "duplicate_id" FROM "artist_merges");
+-- drop index "idx_artists_name" from table: "artists"
+DROP INDEX "public"."idx_artists_name";
+-- create index "idx_artists_name" to table: "artists"
+CREATE INDEX "idx_artists_name" ON "public"."artists" ("name");
+-- create index "idx_artists_normalized_name" to table: "artists"
+CREATE UNIQUE INDEX "idx_artists_normalized_name" ON "public"."artists" ("normalized_name");
+-- create "artist_aliases" table
+CREATE TABLE "public"."artist_aliases" (
+  "id" bigserial NOT NULL,
+  "artist_id" bigint NULL,
+  "name" character varying(130) NULL,
+  "normalized_name" character varying(130) NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_artists_aliases" FOREIGN KEY ("artist_id") REFERENCES "public"."artists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "idx_artist_aliases_artist_id" to table: "artist_aliases"
+CREATE INDEX "idx_artist_aliases_artist_id" ON "public"."artist_aliases" ("artist_id");
+-- create index "idx_artist_aliases_normalized_name" to table: "artist_aliases"
+CREATE UNIQUE INDEX "idx_artist_aliases_normalized_name" ON "public"."artist_aliases" ("normalized_name");
@@ -0,0 +1,8 @@
+-- reverse: create index "idx_artists_type" to table: "artists"
+DROP INDEX "public"."idx_artists_type";
+-- reverse: create index "idx_artists_sort_name" to table: "artists"
+DROP INDEX "public"."idx_artists_sort_name";
+-- reverse: create index "idx_artists_country" to table: "artists"
+DROP INDEX "public"."idx_artists_country";
+-- reverse: modify "artists" table
+ALTER TABLE "public"."artists" DROP COLUMN "links", DROP COLUMN "bio", DROP COLUMN "disbanded_year", DROP COLUMN "formed_year", DROP COLUMN "type", DROP COLUMN "country", DROP COLUMN "sort_name", DROP CONSTRAINT "chk_artists_active_years";
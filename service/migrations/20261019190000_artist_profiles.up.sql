@@ -0,0 +1,10 @@
+-- modify "artists" table
+ALTER TABLE "public"."artists" ADD CONSTRAINT "chk_artists_active_years" CHECK ((disbanded_year = 0) OR (disbanded_year >= formed_year)), ADD COLUMN "sort_name" character varying(130) NULL, ADD COLUMN "country" character varying(2) NULL, ADD COLUMN "type" character varying(16) NULL, ADD COLUMN "formed_year" bigint NULL, ADD COLUMN "disbanded_year" bigint NULL, ADD COLUMN "bio" text NULL, ADD COLUMN "links" jsonb NULL;
+-- backfill "sort_name" column of table: "artists"
+UPDATE "public"."artists" SET "sort_name" = REGEXP_REPLACE(TRIM(REGEXP_REPLACE("name", '\s+', ' ', 'g')), '^(the|a|an) (.+)$', '\2, \1', 'i');
+-- create index "idx_artists_country" to table: "artists"
+CREATE INDEX "idx_artists_country" ON "public"."artists" ("country");
+-- create index "idx_artists_sort_name" to table: "artists"
+CREATE INDEX "idx_artists_sort_name" ON "public"."artists" ("sort_name");
+-- create index "idx_artists_type" to table: "artists"
+CREATE INDEX "idx_artists_type" ON "public"."artists" ("type");
@@ -0,0 +1,12 @@
+-- reverse: create index "idx_songs_iswc" to table: "songs"
+DROP INDEX "public"."idx_songs_iswc";
+-- reverse: create index "idx_songs_isrc" to table: "songs"
+DROP INDEX "public"."idx_songs_isrc";
+-- reverse: modify "songs" table
+ALTER TABLE "public"."songs" DROP COLUMN "iswc", DROP COLUMN "isrc";
+-- reverse: create index "idx_artists_mbid" to table: "artists"
+DROP INDEX "public"."idx_artists_mbid";
+-- reverse: create index "idx_artists_isni" to table: "artists"
+DROP INDEX "public"."idx_artists_isni";
+-- reverse: modify "artists" table
+ALTER TABLE "public"."artists" DROP COLUMN "mbid", DROP COLUMN "isni";
@@ -0,0 +1,12 @@
+-- modify "artists" table
+ALTER TABLE "public"."artists" ADD COLUMN "isni" character varying(16) NULL, ADD COLUMN "mbid" character varying(36) NULL;
+-- create index "idx_artists_isni" to table: "artists"
+CREATE UNIQUE INDEX "idx_artists_isni" ON "public"."artists" ("isni") WHERE ((isni)::text <> ''::text);
+-- create index "idx_artists_mbid" to table: "artists"
+CREATE UNIQUE INDEX "idx_artists_mbid" ON "public"."artists" ("mbid") WHERE ((mbid)::text <> ''::text);
+-- modify "songs" table
+ALTER TABLE "public"."songs" ADD COLUMN "isrc" character varying(12) NULL, ADD COLUMN "iswc" character varying(11) NULL;
+-- create index "idx_songs_isrc" to table: "songs"
+CREATE UNIQUE INDEX "idx_songs_isrc" ON "public"."songs" ("isrc") WHERE ((isrc)::text <> ''::text);
+-- create index "idx_songs_iswc" to table: "songs"
+CREATE INDEX "idx_songs_iswc" ON "public"."songs" ("iswc");
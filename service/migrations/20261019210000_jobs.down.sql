@@ -0,0 +1,8 @@
+-- reverse: create index "idx_jobs_type" to table: "jobs"
+DROP INDEX "public"."idx_jobs_type";
+-- reverse: create index "idx_jobs_song_id" to table: "jobs"
+DROP INDEX "public"."idx_jobs_song_id";
+-- reverse: create index "idx_jobs_claim" to table: "jobs"
+DROP INDEX "public"."idx_jobs_claim";
+-- reverse: create "jobs" table
+DROP TABLE "public"."jobs";
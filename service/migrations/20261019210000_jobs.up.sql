@@ -0,0 +1,20 @@
+-- create "jobs" table
+CREATE TABLE "public"."jobs" (
+  "id" bigserial NOT NULL,
+  "type" character varying(32) NULL,
+  "song_id" bigint NULL,
+  "status" character varying(16) NULL,
+  "run_at" timestamptz NULL,
+  "attempts" bigint NULL,
+  "last_error" text NULL,
+  "created_at" timestamptz NULL,
+  "updated_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "fk_jobs_song" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- create index "idx_jobs_claim" to table: "jobs"
+CREATE INDEX "idx_jobs_claim" ON "public"."jobs" ("status", "run_at");
+-- create index "idx_jobs_song_id" to table: "jobs"
+CREATE INDEX "idx_jobs_song_id" ON "public"."jobs" ("song_id");
+-- create index "idx_jobs_type" to table: "jobs"
+CREATE INDEX "idx_jobs_type" ON "public"."jobs" ("type");
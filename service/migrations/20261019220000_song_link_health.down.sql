@@ -0,0 +1,6 @@
+-- reverse: create index "idx_songs_link_failure_streak" to table: "songs"
+DROP INDEX "public"."idx_songs_link_failure_streak";
+-- reverse: create index "idx_songs_link_checked_at" to table: "songs"
+DROP INDEX "public"."idx_songs_link_checked_at";
+-- reverse: modify "songs" table
+ALTER TABLE "public"."songs" DROP COLUMN "link_failure_streak", DROP COLUMN "link_checked_at", DROP COLUMN "link_status_code";
@@ -0,0 +1,6 @@
+-- modify "songs" table
+ALTER TABLE "public"."songs" ADD COLUMN "link_status_code" bigint NOT NULL DEFAULT 0, ADD COLUMN "link_checked_at" timestamptz NULL, ADD COLUMN "link_failure_streak" bigint NOT NULL DEFAULT 0;
+-- create index "idx_songs_link_checked_at" to table: "songs"
+CREATE INDEX "idx_songs_link_checked_at" ON "public"."songs" ("link_checked_at");
+-- create index "idx_songs_link_failure_streak" to table: "songs"
+CREATE INDEX "idx_songs_link_failure_streak" ON "public"."songs" ("link_failure_streak");
@@ -0,0 +1,4 @@
+-- reverse: create index "idx_songs_link_media" to table: "songs"
+DROP INDEX "public"."idx_songs_link_media";
+-- reverse: modify "songs" table
+ALTER TABLE "public"."songs" DROP COLUMN "link_media_id", DROP COLUMN "link_provider";
@@ -0,0 +1,4 @@
+-- modify "songs" table
+ALTER TABLE "public"."songs" ADD COLUMN "link_provider" character varying(16) NULL, ADD COLUMN "link_media_id" character varying(64) NULL;
+-- create index "idx_songs_link_media" to table: "songs"
+CREATE UNIQUE INDEX "idx_songs_link_media" ON "public"."songs" ("link_provider", "link_media_id") WHERE ((link_media_id)::text <> ''::text);
@@ -0,0 +1,2 @@
+-- reverse: create "api_keys" table
+DROP TABLE "public"."api_keys";
@@ -0,0 +1,11 @@
+-- create "api_keys" table
+CREATE TABLE "public"."api_keys" (
+  "id" bigserial NOT NULL,
+  "name" character varying(64) NULL,
+  "hash" character varying(64) NULL,
+  "created_at" timestamptz NULL,
+  "revoked_at" timestamptz NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "uni_api_keys_hash" UNIQUE ("hash"),
+  CONSTRAINT "uni_api_keys_name" UNIQUE ("name")
+);
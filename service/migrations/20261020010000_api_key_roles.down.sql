@@ -0,0 +1,2 @@
+-- reverse: modify "api_keys" table
+ALTER TABLE "public"."api_keys" DROP COLUMN "roles";
@@ -0,0 +1,2 @@
+-- modify "api_keys" table
+ALTER TABLE "public"."api_keys" ADD COLUMN "roles" jsonb NULL;
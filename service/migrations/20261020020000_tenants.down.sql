@@ -0,0 +1,60 @@
+-- reverse: modify "api_keys" table
+ALTER TABLE "public"."api_keys" DROP COLUMN "tenant";
+-- reverse: modify "jobs" table
+ALTER TABLE "public"."jobs" DROP CONSTRAINT "fk_jobs_song", DROP COLUMN "tenant_id", ADD CONSTRAINT "fk_jobs_song" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- reverse: modify "song_relations" table
+ALTER TABLE "public"."song_relations" DROP CONSTRAINT "fk_song_relations_original", DROP CONSTRAINT "fk_song_relations_song", DROP COLUMN "tenant_id", ADD CONSTRAINT "fk_song_relations_original" FOREIGN KEY ("original_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "fk_song_relations_song" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- reverse: modify "playlist_items" table
+ALTER TABLE "public"."playlist_items" DROP CONSTRAINT "fk_playlist_items_song", DROP CONSTRAINT "fk_playlists_items", DROP COLUMN "tenant_id", ADD CONSTRAINT "fk_playlist_items_song" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "fk_playlists_items" FOREIGN KEY ("playlist_id") REFERENCES "public"."playlists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- reverse: modify "album_tracks" table
+ALTER TABLE "public"."album_tracks" DROP CONSTRAINT "fk_album_tracks_song", DROP CONSTRAINT "fk_albums_tracks", DROP COLUMN "tenant_id", ADD CONSTRAINT "fk_album_tracks_song" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "fk_albums_tracks" FOREIGN KEY ("album_id") REFERENCES "public"."albums" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- reverse: modify "song_credits" table
+ALTER TABLE "public"."song_credits" DROP CONSTRAINT "fk_song_credits_artist", DROP CONSTRAINT "fk_songs_credits", DROP COLUMN "tenant_id", ADD CONSTRAINT "fk_song_credits_artist" FOREIGN KEY ("artist_id") REFERENCES "public"."artists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "fk_songs_credits" FOREIGN KEY ("song_id") REFERENCES "public"."songs" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- reverse: create index "idx_artist_aliases_normalized_name" to table: "artist_aliases"
+DROP INDEX "public"."idx_artist_aliases_normalized_name";
+-- reverse: drop index "idx_artist_aliases_normalized_name" from table: "artist_aliases"
+CREATE UNIQUE INDEX "idx_artist_aliases_normalized_name" ON "public"."artist_aliases" ("normalized_name");
+-- reverse: modify "artist_aliases" table
+ALTER TABLE "public"."artist_aliases" DROP CONSTRAINT "fk_artists_aliases", DROP COLUMN "tenant_id", ADD CONSTRAINT "fk_artists_aliases" FOREIGN KEY ("artist_id") REFERENCES "public"."artists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- reverse: create index "idx_tags_kind_name" to table: "tags"
+DROP INDEX "public"."idx_tags_kind_name";
+-- reverse: drop index "idx_tags_kind_name" from table: "tags"
+CREATE UNIQUE INDEX "idx_tags_kind_name" ON "public"."tags" ("kind", "name");
+-- reverse: modify "tags" table
+ALTER TABLE "public"."tags" DROP COLUMN "tenant_id";
+-- reverse: create index "idx_playlists_tenant" to table: "playlists"
+DROP INDEX "public"."idx_playlists_tenant";
+-- reverse: modify "playlists" table
+ALTER TABLE "public"."playlists" DROP COLUMN "tenant_id";
+-- reverse: create index "idx_albums_tenant" to table: "albums"
+DROP INDEX "public"."idx_albums_tenant";
+-- reverse: modify "albums" table
+ALTER TABLE "public"."albums" DROP CONSTRAINT "fk_albums_artist", DROP COLUMN "tenant_id", ADD CONSTRAINT "fk_albums_artist" FOREIGN KEY ("artist_id") REFERENCES "public"."artists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- reverse: create index "idx_songs_tenant" to table: "songs"
+DROP INDEX "public"."idx_songs_tenant";
+-- reverse: create index "idx_songs_link_media" to table: "songs"
+DROP INDEX "public"."idx_songs_link_media";
+-- reverse: drop index "idx_songs_link_media" from table: "songs"
+CREATE UNIQUE INDEX "idx_songs_link_media" ON "public"."songs" ("link_provider", "link_media_id") WHERE ((link_media_id)::text <> ''::text);
+-- reverse: create index "idx_songs_isrc" to table: "songs"
+DROP INDEX "public"."idx_songs_isrc";
+-- reverse: drop index "idx_songs_isrc" from table: "songs"
+CREATE UNIQUE INDEX "idx_songs_isrc" ON "public"."songs" ("isrc") WHERE ((isrc)::text <> ''::text);
+-- reverse: modify "songs" table
+ALTER TABLE "public"."songs" DROP CONSTRAINT "fk_songs_artist", DROP COLUMN "tenant_id", ADD CONSTRAINT "fk_songs_artist" FOREIGN KEY ("artist_id") REFERENCES "public"."artists" ("id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- reverse: create index "idx_artists_tenant" to table: "artists"
+DROP INDEX "public"."idx_artists_tenant";
+-- reverse: create index "idx_artists_mbid" to table: "artists"
+DROP INDEX "public"."idx_artists_mbid";
+-- reverse: drop index "idx_artists_mbid" from table: "artists"
+CREATE UNIQUE INDEX "idx_artists_mbid" ON "public"."artists" ("mbid") WHERE ((mbid)::text <> ''::text);
+-- reverse: create index "idx_artists_isni" to table: "artists"
+DROP INDEX "public"."idx_artists_isni";
+-- reverse: drop index "idx_artists_isni" from table: "artists"
+CREATE UNIQUE INDEX "idx_artists_isni" ON "public"."artists" ("isni") WHERE ((isni)::text <> ''::text);
+-- reverse: create index "idx_artists_normalized_name" to table: "artists"
+DROP INDEX "public"."idx_artists_normalized_name";
+-- reverse: drop index "idx_artists_normalized_name" from table: "artists"
+CREATE UNIQUE INDEX "idx_artists_normalized_name" ON "public"."artists" ("normalized_name");
+-- reverse: modify "artists" table
+ALTER TABLE "public"."artists" DROP COLUMN "tenant_id";
@@ -0,0 +1,60 @@
+-- modify "artists" table
+ALTER TABLE "public"."artists" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- drop index "idx_artists_normalized_name" from table: "artists"
+DROP INDEX "public"."idx_artists_normalized_name";
+-- create index "idx_artists_normalized_name" to table: "artists"
+CREATE UNIQUE INDEX "idx_artists_normalized_name" ON "public"."artists" ("tenant_id", "normalized_name");
+-- drop index "idx_artists_isni" from table: "artists"
+DROP INDEX "public"."idx_artists_isni";
+-- create index "idx_artists_isni" to table: "artists"
+CREATE UNIQUE INDEX "idx_artists_isni" ON "public"."artists" ("tenant_id", "isni") WHERE ((isni)::text <> ''::text);
+-- drop index "idx_artists_mbid" from table: "artists"
+DROP INDEX "public"."idx_artists_mbid";
+-- create index "idx_artists_mbid" to table: "artists"
+CREATE UNIQUE INDEX "idx_artists_mbid" ON "public"."artists" ("tenant_id", "mbid") WHERE ((mbid)::text <> ''::text);
+-- create index "idx_artists_tenant" to table: "artists"
+CREATE UNIQUE INDEX "idx_artists_tenant" ON "public"."artists" ("tenant_id", "id");
+-- modify "songs" table
+ALTER TABLE "public"."songs" DROP CONSTRAINT "fk_songs_artist", ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default', ADD CONSTRAINT "fk_songs_artist" FOREIGN KEY ("tenant_id", "artist_id") REFERENCES "public"."artists" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- drop index "idx_songs_isrc" from table: "songs"
+DROP INDEX "public"."idx_songs_isrc";
+-- create index "idx_songs_isrc" to table: "songs"
+CREATE UNIQUE INDEX "idx_songs_isrc" ON "public"."songs" ("tenant_id", "isrc") WHERE ((isrc)::text <> ''::text);
+-- drop index "idx_songs_link_media" from table: "songs"
+DROP INDEX "public"."idx_songs_link_media";
+-- create index "idx_songs_link_media" to table: "songs"
+CREATE UNIQUE INDEX "idx_songs_link_media" ON "public"."songs" ("tenant_id", "link_provider", "link_media_id") WHERE ((link_media_id)::text <> ''::text);
+-- create index "idx_songs_tenant" to table: "songs"
+CREATE UNIQUE INDEX "idx_songs_tenant" ON "public"."songs" ("tenant_id", "id");
+-- modify "albums" table
+ALTER TABLE "public"."albums" DROP CONSTRAINT "fk_albums_artist", ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default', ADD CONSTRAINT "fk_albums_artist" FOREIGN KEY ("tenant_id", "artist_id") REFERENCES "public"."artists" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- create index "idx_albums_tenant" to table: "albums"
+CREATE UNIQUE INDEX "idx_albums_tenant" ON "public"."albums" ("tenant_id", "id");
+-- modify "playlists" table
+ALTER TABLE "public"."playlists" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- create index "idx_playlists_tenant" to table: "playlists"
+CREATE UNIQUE INDEX "idx_playlists_tenant" ON "public"."playlists" ("tenant_id", "id");
+-- modify "tags" table
+ALTER TABLE "public"."tags" ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default';
+-- drop index "idx_tags_kind_name" from table: "tags"
+DROP INDEX "public"."idx_tags_kind_name";
+-- create index "idx_tags_kind_name" to table: "tags"
+CREATE UNIQUE INDEX "idx_tags_kind_name" ON "public"."tags" ("tenant_id", "kind", "name");
+-- modify "artist_aliases" table
+ALTER TABLE "public"."artist_aliases" DROP CONSTRAINT "fk_artists_aliases", ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default', ADD CONSTRAINT "fk_artists_aliases" FOREIGN KEY ("tenant_id", "artist_id") REFERENCES "public"."artists" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- drop index "idx_artist_aliases_normalized_name" from table: "artist_aliases"
+DROP INDEX "public"."idx_artist_aliases_normalized_name";
+-- create index "idx_artist_aliases_normalized_name" to table: "artist_aliases"
+CREATE UNIQUE INDEX "idx_artist_aliases_normalized_name" ON "public"."artist_aliases" ("tenant_id", "normalized_name");
+-- modify "song_credits" table
+ALTER TABLE "public"."song_credits" DROP CONSTRAINT "fk_song_credits_artist", DROP CONSTRAINT "fk_songs_credits", ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default', ADD CONSTRAINT "fk_song_credits_artist" FOREIGN KEY ("tenant_id", "artist_id") REFERENCES "public"."artists" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "fk_songs_credits" FOREIGN KEY ("tenant_id", "song_id") REFERENCES "public"."songs" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "album_tracks" table
+ALTER TABLE "public"."album_tracks" DROP CONSTRAINT "fk_album_tracks_song", DROP CONSTRAINT "fk_albums_tracks", ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default', ADD CONSTRAINT "fk_album_tracks_song" FOREIGN KEY ("tenant_id", "song_id") REFERENCES "public"."songs" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "fk_albums_tracks" FOREIGN KEY ("tenant_id", "album_id") REFERENCES "public"."albums" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "playlist_items" table
+ALTER TABLE "public"."playlist_items" DROP CONSTRAINT "fk_playlist_items_song", DROP CONSTRAINT "fk_playlists_items", ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default', ADD CONSTRAINT "fk_playlist_items_song" FOREIGN KEY ("tenant_id", "song_id") REFERENCES "public"."songs" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "fk_playlists_items" FOREIGN KEY ("tenant_id", "playlist_id") REFERENCES "public"."playlists" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "song_relations" table
+ALTER TABLE "public"."song_relations" DROP CONSTRAINT "fk_song_relations_original", DROP CONSTRAINT "fk_song_relations_song", ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default', ADD CONSTRAINT "fk_song_relations_original" FOREIGN KEY ("tenant_id", "original_id") REFERENCES "public"."songs" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE, ADD CONSTRAINT "fk_song_relations_song" FOREIGN KEY ("tenant_id", "song_id") REFERENCES "public"."songs" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "jobs" table
+ALTER TABLE "public"."jobs" DROP CONSTRAINT "fk_jobs_song", ADD COLUMN "tenant_id" character varying(64) NOT NULL DEFAULT 'default', ADD CONSTRAINT "fk_jobs_song" FOREIGN KEY ("tenant_id", "song_id") REFERENCES "public"."songs" ("tenant_id", "id") ON UPDATE NO ACTION ON DELETE CASCADE;
+-- modify "api_keys" table
+ALTER TABLE "public"."api_keys" ADD COLUMN "tenant" character varying(64) NULL;
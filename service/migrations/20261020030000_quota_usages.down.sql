@@ -0,0 +1,2 @@
+-- reverse: create "quota_usages" table
+DROP TABLE "public"."quota_usages";
@@ -0,0 +1,7 @@
+-- create "quota_usages" table
+CREATE TABLE "public"."quota_usages" (
+  "client" character varying(255) NOT NULL,
+  "day" date NOT NULL,
+  "used" bigint NOT NULL,
+  PRIMARY KEY ("client", "day")
+);
@@ -0,0 +1,2 @@
+-- reverse: modify "api_keys" table
+ALTER TABLE "public"."api_keys" RENAME COLUMN "tenant_id" TO "tenant";
@@ -0,0 +1,2 @@
+-- modify "api_keys" table
+ALTER TABLE "public"."api_keys" RENAME COLUMN "tenant" TO "tenant_id";